@@ -22,6 +22,7 @@ import azureDevOpsSchemaJSON from '../../../../../schema/azuredevops.schema.json
 import bitbucketCloudSchemaJSON from '../../../../../schema/bitbucket_cloud.schema.json'
 import bitbucketServerSchemaJSON from '../../../../../schema/bitbucket_server.schema.json'
 import gerritSchemaJSON from '../../../../../schema/gerrit.schema.json'
+import gitManifestSchemaJSON from '../../../../../schema/git_manifest.schema.json'
 import githubSchemaJSON from '../../../../../schema/github.schema.json'
 import gitlabSchemaJSON from '../../../../../schema/gitlab.schema.json'
 import gitoliteSchemaJSON from '../../../../../schema/gitolite.schema.json'
@@ -1238,6 +1239,59 @@ const GENERIC_GIT: AddExternalServiceOptions = {
     ],
 }
 
+const GIT_MANIFEST: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.GITMANIFEST,
+    title: 'Git (JSON manifest)',
+    icon: GitIcon,
+    jsonSchema: gitManifestSchemaJSON,
+    defaultDisplayName: 'Git (JSON manifest)',
+    defaultConfig: `{
+  "url": "https://git.example.com/sourcegraph-manifest.json"
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    In the configuration below, set <Field>url</Field> to the URL of a JSON manifest listing the
+                    repositories of your Git hosting service. Sourcegraph fetches it every time this code host is
+                    synced.
+                </li>
+                <li>
+                    If the manifest requires authentication, set <Field>token</Field> to a token that is sent as a
+                    bearer token.
+                </li>
+            </ol>
+            <Text>
+                See{' '}
+                <Link rel="noopener noreferrer" target="_blank" to="/help/admin/external_service/git_manifest">
+                    the docs for the manifest format and more advanced options
+                </Link>
+                , or try one of the buttons below.
+            </Text>
+        </div>
+    ),
+    editorActions: [
+        {
+            id: 'setToken',
+            label: 'Set token',
+            run: (config: string) => {
+                const value = '<token>'
+                const edits = modify(config, ['token'], value, defaultModificationOptions)
+                return { edits, selectText: value }
+            },
+        },
+        {
+            id: 'excludeArchived',
+            label: 'Exclude archived repositories',
+            run: (config: string) => {
+                const value = { archived: true }
+                const edits = modify(config, ['exclude', -1], value, defaultModificationOptions)
+                return { edits, selectText: '{"archived": true}' }
+            },
+        },
+    ],
+}
+
 const LOCAL_GIT: AddExternalServiceOptions = {
     kind: ExternalServiceKind.LOCALGIT,
     title: 'Local Git repos',
@@ -1603,6 +1657,7 @@ export const codeHostExternalServices: Record<string, AddExternalServiceOptions>
     srcservegit: SRC_SERVE_GIT,
     gitolite: GITOLITE,
     git: GENERIC_GIT,
+    gitmanifest: GIT_MANIFEST,
     gerrit: GERRIT,
     azuredevops: AZUREDEVOPS,
     phabricator: PHABRICATOR_SERVICE,
@@ -1633,6 +1688,7 @@ export const defaultExternalServices: Record<ExternalServiceKind, AddExternalSer
     [ExternalServiceKind.GITOLITE]: GITOLITE,
     [ExternalServiceKind.PHABRICATOR]: PHABRICATOR_SERVICE,
     [ExternalServiceKind.OTHER]: GENERIC_GIT,
+    [ExternalServiceKind.GITMANIFEST]: GIT_MANIFEST,
     [ExternalServiceKind.LOCALGIT]: LOCAL_GIT,
     [ExternalServiceKind.AWSCODECOMMIT]: AWS_CODE_COMMIT,
     [ExternalServiceKind.PERFORCE]: PERFORCE,
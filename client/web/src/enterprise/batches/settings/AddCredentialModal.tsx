@@ -62,6 +62,7 @@ const scopeRequirements: Record<ExternalServiceKind, JSX.Element> = {
     [ExternalServiceKind.GERRIT]: <span />,
     [ExternalServiceKind.PERFORCE]: <span>with the ability to shelve changelists.</span>,
     // These are just for type completeness and serve as placeholders for a bright future.
+    [ExternalServiceKind.GITMANIFEST]: <span>Unsupported</span>,
     [ExternalServiceKind.GITOLITE]: <span>Unsupported</span>,
     [ExternalServiceKind.GOMODULES]: <span>Unsupported</span>,
     [ExternalServiceKind.PYTHONPACKAGES]: <span>Unsupported</span>,
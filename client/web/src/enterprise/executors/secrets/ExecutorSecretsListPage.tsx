@@ -213,5 +213,7 @@ function executorSecretScopeContext(scope: ExecutorSecretScope): { label: string
             return { label: 'Batch changes', description: 'Batch change execution secrets' }
         case ExecutorSecretScope.CODEINTEL:
             return { label: 'Code graph', description: 'Code graph execution secrets' }
+        case ExecutorSecretScope.CUSTOMJOBS:
+            return { label: 'Custom jobs', description: 'Custom job execution secrets' }
     }
 }
@@ -35,6 +35,7 @@ const mockRepo: SettingsAreaRepositoryFields = {
             due: '2023-07-31T18:31:07Z',
             index: 29,
             total: 41,
+            policy: null,
         },
         updateQueue: {
             updating: true,
@@ -42,6 +42,7 @@ import {
     UPDATE_MIRROR_REPOSITORY,
 } from '../../site-admin/backend'
 import { eventLogger } from '../../tracking/eventLogger'
+import { formatDurationLong } from '../../util/time'
 import { DirectImportRepoAlert } from '../DirectImportRepoAlert'
 
 import { FETCH_SETTINGS_AREA_REPOSITORY_GQL } from './backend'
@@ -102,6 +103,21 @@ const UpdateMirrorRepositoryActionContainer: FC<UpdateMirrorRepositoryActionCont
                         {updateSchedule.index + 1} out of {updateSchedule.total} in the schedule)
                     </div>
                 )}
+                {updateSchedule?.policy && (
+                    <div>
+                        Updated every {formatDurationLong(updateSchedule.policy.minIntervalSeconds * 1000)} to{' '}
+                        {formatDurationLong(updateSchedule.policy.maxIntervalSeconds * 1000)} with{' '}
+                        {updateSchedule.policy.priority} priority, as configured by{' '}
+                        {updateSchedule.policy.externalService.displayName}
+                        {updateSchedule.policy.quietHours.length > 0 && (
+                            <>
+                                {' '}
+                                (not during {updateSchedule.policy.quietHours.join(', ')}{' '}
+                                {updateSchedule.policy.timezone || 'UTC'})
+                            </>
+                        )}
+                    </div>
+                )}
                 {props.repo.mirrorInfo.updateQueue && !props.repo.mirrorInfo.updateQueue.updating && (
                     <div>
                         Queued for update (position {props.repo.mirrorInfo.updateQueue.index + 1} out of{' '}
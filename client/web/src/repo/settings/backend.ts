@@ -42,6 +42,16 @@ export const settingsAreaRepositoryFragment = gql`
                 due
                 index
                 total
+                policy {
+                    externalService {
+                        displayName
+                    }
+                    minIntervalSeconds
+                    maxIntervalSeconds
+                    priority
+                    quietHours
+                    timezone
+                }
             }
             updateQueue {
                 updating
@@ -82,11 +82,43 @@ func (e bigQueryEvent) Save() (map[string]bigquery.Value, string, error) {
 
 // LogEvent logs an event to BigQuery.
 func (l *bigQueryLogger) LogEvent(spanCtx context.Context, event Event) (err error) {
+	record, err := newEventRecord(spanCtx, event)
+	if err != nil || record == nil {
+		return err
+	}
+	if err := l.tableInserter.Put(
+		backgroundContextWithSpan(spanCtx),
+		bigQueryEvent{
+			Name:       record.Name,
+			Source:     record.Source,
+			Identifier: record.Identifier,
+			Metadata:   record.Metadata,
+			CreatedAt:  record.CreatedAt,
+		},
+	); err != nil {
+		return errors.Wrap(err, "inserting BigQuery event")
+	}
+	return nil
+}
+
+// eventRecord is the representation of an event that is persisted by event
+// loggers.
+type eventRecord struct {
+	Name       string          `json:"name"`
+	Source     string          `json:"source"`
+	Identifier string          `json:"identifier"`
+	Metadata   json.RawMessage `json:"metadata"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// newEventRecord validates the event and prepares it to be persisted. It
+// returns nil if the event should be discarded.
+func newEventRecord(spanCtx context.Context, event Event) (*eventRecord, error) {
 	if event.Name == "" {
-		return errors.New("missing event name")
+		return nil, errors.New("missing event name")
 	}
 	if event.Source == "" {
-		return errors.New("missing event source")
+		return nil, errors.New("missing event source")
 	}
 
 	// If empty, the actor is presumed to be unknown - we do not record any events
@@ -94,34 +126,30 @@ func (l *bigQueryLogger) LogEvent(spanCtx context.Context, event Event) (err err
 	if event.Identifier == "" {
 		oteltrace.SpanFromContext(spanCtx).
 			RecordError(errors.New("event is missing actor identifier, discarding event"))
-		return nil
+		return nil, nil
 	}
 
-	// Always have metadata
-	if event.Metadata == nil {
-		event.Metadata = map[string]any{}
+	// Always have metadata. Copy it, since the same event may be logged by
+	// multiple loggers.
+	metadata := make(map[string]any, len(event.Metadata)+1)
+	for k, v := range event.Metadata {
+		metadata[k] = v
 	}
 
 	// HACK: Inject Sourcegraph actor that is held in the span context
-	event.Metadata["sg.actor"] = sgactor.FromContext(spanCtx)
+	metadata["sg.actor"] = sgactor.FromContext(spanCtx)
 
-	metadata, err := json.Marshal(event.Metadata)
+	metadataJSON, err := json.Marshal(metadata)
 	if err != nil {
-		return errors.Wrap(err, "marshaling metadata")
+		return nil, errors.Wrap(err, "marshaling metadata")
 	}
-	if err := l.tableInserter.Put(
-		backgroundContextWithSpan(spanCtx),
-		bigQueryEvent{
-			Name:       string(event.Name),
-			Source:     event.Source,
-			Identifier: event.Identifier,
-			Metadata:   json.RawMessage(metadata),
-			CreatedAt:  time.Now(),
-		},
-	); err != nil {
-		return errors.Wrap(err, "inserting BigQuery event")
-	}
-	return nil
+	return &eventRecord{
+		Name:       string(event.Name),
+		Source:     event.Source,
+		Identifier: event.Identifier,
+		Metadata:   json.RawMessage(metadataJSON),
+		CreatedAt:  time.Now(),
+	}, nil
 }
 
 type stdoutLogger struct {
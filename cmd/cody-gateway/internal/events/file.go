@@ -0,0 +1,45 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// fileLogger is an event logger that appends events as JSON lines to a local
+// file, rotating it once it exceeds a maximum size.
+type fileLogger struct {
+	w *fileutil.RotatingWriter
+}
+
+// NewFileLogger returns a new event logger that appends events as JSON lines to
+// the file at path. Once the file exceeds maxSizeBytes, it is rotated to
+// path.1, path.1 to path.2 and so on, keeping at most maxBackups rotated files.
+func NewFileLogger(path string, maxSizeBytes int64, maxBackups int) (Logger, error) {
+	w, err := fileutil.NewRotatingWriter(path, maxSizeBytes, maxBackups)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening events file")
+	}
+	return &instrumentedLogger{
+		Scope:  "fileLogger",
+		Logger: &fileLogger{w: w},
+	}, nil
+}
+
+// LogEvent appends an event to the file.
+func (l *fileLogger) LogEvent(spanCtx context.Context, event Event) error {
+	record, err := newEventRecord(spanCtx, event)
+	if err != nil || record == nil {
+		return err
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "marshaling event")
+	}
+	if _, err := l.w.Write(append(line, '\n')); err != nil {
+		return errors.Wrap(err, "writing event")
+	}
+	return nil
+}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cody_gateway_events;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS cody_gateway_events
+(
+    id         BIGSERIAL PRIMARY KEY,
+    name       text                     NOT NULL,
+    source     text                     NOT NULL,
+    identifier text                     NOT NULL,
+    metadata   jsonb                    NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
@@ -0,0 +1,34 @@
+package events
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// multiLogger submits events to multiple loggers.
+type multiLogger struct {
+	loggers []Logger
+}
+
+var _ Logger = &multiLogger{}
+
+// NewMultiLogger returns a logger that submits every event to all the given
+// loggers. An event that fails to be logged by one logger is still submitted
+// to the others.
+func NewMultiLogger(loggers ...Logger) Logger {
+	if len(loggers) == 1 {
+		return loggers[0]
+	}
+	return &multiLogger{loggers: loggers}
+}
+
+func (l *multiLogger) LogEvent(spanCtx context.Context, event Event) error {
+	var errs error
+	for _, logger := range l.loggers {
+		if err := logger.LogEvent(spanCtx, event); err != nil {
+			errs = errors.Append(errs, err)
+		}
+	}
+	return errs
+}
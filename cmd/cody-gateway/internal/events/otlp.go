@@ -0,0 +1,110 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// otlpLogger is an event logger that exports events as OpenTelemetry log
+// records over OTLP/HTTP.
+type otlpLogger struct {
+	cli      httpcli.Doer
+	endpoint string
+}
+
+// NewOTLPLogger returns a new event logger that exports events as log records
+// to the given OTLP/HTTP logs endpoint, for example
+// http://otel-collector:4318/v1/logs.
+func NewOTLPLogger(cli httpcli.Doer, endpoint string) Logger {
+	return &instrumentedLogger{
+		Scope: "otlpLogger",
+		Logger: &otlpLogger{
+			cli:      cli,
+			endpoint: endpoint,
+		},
+	}
+}
+
+// LogEvent exports an event as an OpenTelemetry log record.
+func (l *otlpLogger) LogEvent(spanCtx context.Context, event Event) error {
+	record, err := newEventRecord(spanCtx, event)
+	if err != nil || record == nil {
+		return err
+	}
+
+	body, err := proto.Marshal(newOTLPLogsRequest(record, oteltrace.SpanContextFromContext(spanCtx)))
+	if err != nil {
+		return errors.Wrap(err, "marshaling OTLP logs request")
+	}
+
+	req, err := http.NewRequestWithContext(backgroundContextWithSpan(spanCtx), http.MethodPost, l.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := l.cli.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "exporting OTLP logs")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Newf("exporting OTLP logs: unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
+
+func newOTLPLogsRequest(record *eventRecord, spanCtx oteltrace.SpanContext) *collogspb.ExportLogsServiceRequest {
+	timestamp := uint64(record.CreatedAt.UnixNano())
+	logRecord := &logspb.LogRecord{
+		TimeUnixNano:         timestamp,
+		ObservedTimeUnixNano: timestamp,
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		SeverityText:         "INFO",
+		Body:                 stringValue(record.Name),
+		Attributes: []*commonpb.KeyValue{
+			{Key: "event.name", Value: stringValue(record.Name)},
+			{Key: "event.source", Value: stringValue(record.Source)},
+			{Key: "event.identifier", Value: stringValue(record.Identifier)},
+			{Key: "event.metadata", Value: stringValue(string(record.Metadata))},
+		},
+	}
+	if spanCtx.IsValid() {
+		traceID, spanID := spanCtx.TraceID(), spanCtx.SpanID()
+		logRecord.TraceId = traceID[:]
+		logRecord.SpanId = spanID[:]
+		logRecord.Flags = uint32(spanCtx.TraceFlags())
+	}
+
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: &resourcepb.Resource{
+				Attributes: []*commonpb.KeyValue{
+					{Key: "service.name", Value: stringValue("cody-gateway")},
+				},
+			},
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: "cody-gateway/internal/events"},
+				LogRecords: []*logspb.LogRecord{logRecord},
+			}},
+		}},
+	}
+}
+
+func stringValue(s string) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: s}}
+}
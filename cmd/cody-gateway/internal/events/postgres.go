@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/keegancsmith/sqlf"
+
+	connections "github.com/sourcegraph/sourcegraph/internal/database/connections/live"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbconn"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// postgresLogger is a Postgres event logger.
+type postgresLogger struct {
+	db *sql.DB
+}
+
+//go:embed migrations
+var postgresMigrations embed.FS
+
+// postgresSchema describes the migrations of the cody_gateway_events table.
+var postgresSchema = func() *schemas.Schema {
+	fsys, err := fs.Sub(postgresMigrations, "migrations")
+	if err != nil {
+		panic(fmt.Sprintf("malformed Postgres events migrations: %s", err))
+	}
+	schema, err := schemas.ResolveSchema(fsys, "cody_gateway_events")
+	if err != nil {
+		panic(err.Error())
+	}
+	return schema
+}()
+
+// NewPostgresLogger returns a new event logger that inserts events into the
+// cody_gateway_events table of the given database, applying the migrations of
+// the table first.
+func NewPostgresLogger(ctx context.Context, obctx *observation.Context, dsn string) (Logger, error) {
+	db, err := dbconn.ConnectInternal(obctx.Logger, dsn, "cody-gateway", "")
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to Postgres")
+	}
+	if err := connections.MigrateStandaloneDB(ctx, obctx, db, postgresSchema); err != nil {
+		_ = db.Close()
+		return nil, errors.Wrap(err, "migrating Postgres events table")
+	}
+	return &instrumentedLogger{
+		Scope:  "postgresLogger",
+		Logger: &postgresLogger{db: db},
+	}, nil
+}
+
+const insertPostgresEventQuery = `
+INSERT INTO cody_gateway_events (name, source, identifier, metadata, created_at)
+VALUES (%s, %s, %s, %s, %s)
+`
+
+// LogEvent logs an event to Postgres.
+func (l *postgresLogger) LogEvent(spanCtx context.Context, event Event) error {
+	record, err := newEventRecord(spanCtx, event)
+	if err != nil || record == nil {
+		return err
+	}
+
+	q := sqlf.Sprintf(insertPostgresEventQuery,
+		record.Name,
+		record.Source,
+		record.Identifier,
+		string(record.Metadata),
+		record.CreatedAt,
+	)
+	if _, err := l.db.ExecContext(backgroundContextWithSpan(spanCtx), q.Query(sqlf.PostgresBindVar), q.Args()...); err != nil {
+		return errors.Wrap(err, "inserting Postgres event")
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package events_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/cmd/cody-gateway/internal/events"
+	"github.com/sourcegraph/sourcegraph/internal/codygateway"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestMultiLogger(t *testing.T) {
+	ctx := context.Background()
+
+	failing := &mockLogger{PreLogEventHook: func(string) error { return errors.New("failed") }}
+	succeeding := &mockLogger{}
+	logger := events.NewMultiLogger(failing, succeeding)
+
+	err := logger.LogEvent(ctx, events.Event{Identifier: "foo"})
+	assert.ErrorContains(t, err, "failed")
+	// The event is still submitted to all loggers.
+	assert.Len(t, failing.ReceivedEvents, 1)
+	assert.Len(t, succeeding.ReceivedEvents, 1)
+}
+
+func TestFileLogger(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+
+	readEvents := func(t *testing.T, path string) []map[string]any {
+		t.Helper()
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+
+		var lines []map[string]any
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var line map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			lines = append(lines, line)
+		}
+		require.NoError(t, scanner.Err())
+		return lines
+	}
+
+	// Rotation is covered by fileutil.RotatingWriter.
+	logger, err := events.NewFileLogger(path, 1024, 2)
+	require.NoError(t, err)
+	require.NoError(t, logger.LogEvent(ctx, events.Event{
+		Name:       codygateway.EventNameCompletionsFinished,
+		Source:     "test",
+		Identifier: "a",
+		Metadata:   map[string]any{"model": "claude-2"},
+	}))
+	// Events without an identifier are discarded.
+	require.NoError(t, logger.LogEvent(ctx, events.Event{
+		Name:   codygateway.EventNameCompletionsFinished,
+		Source: "test",
+	}))
+
+	lines := readEvents(t, path)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "a", lines[0]["identifier"])
+	assert.Equal(t, "test", lines[0]["source"])
+	assert.Equal(t, string(codygateway.EventNameCompletionsFinished), lines[0]["name"])
+	assert.Equal(t, "claude-2", lines[0]["metadata"].(map[string]any)["model"])
+}
+
+func TestOTLPLogger(t *testing.T) {
+	var received *collogspb.ExportLogsServiceRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/logs", r.URL.Path)
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		received = &collogspb.ExportLogsServiceRequest{}
+		assert.NoError(t, proto.Unmarshal(body, received))
+	}))
+	t.Cleanup(srv.Close)
+
+	logger := events.NewOTLPLogger(http.DefaultClient, srv.URL+"/v1/logs")
+	require.NoError(t, logger.LogEvent(context.Background(), events.Event{
+		Name:       codygateway.EventNameCompletionsFinished,
+		Source:     "test",
+		Identifier: "foo",
+	}))
+
+	require.NotNil(t, received)
+	require.Len(t, received.ResourceLogs, 1)
+	require.Len(t, received.ResourceLogs[0].ScopeLogs, 1)
+	records := received.ResourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, 1)
+	assert.Equal(t, string(codygateway.EventNameCompletionsFinished), records[0].Body.GetStringValue())
+
+	attributes := map[string]string{}
+	for _, kv := range records[0].Attributes {
+		attributes[kv.Key] = kv.Value.GetStringValue()
+	}
+	assert.Equal(t, "test", attributes["event.source"])
+	assert.Equal(t, "foo", attributes["event.identifier"])
+}
@@ -61,6 +61,21 @@ type Config struct {
 		EventBufferWorkers int
 	}
 
+	Events struct {
+		// Sinks are the event loggers to submit events to. If empty, events
+		// are logged to BigQuery if it is configured, or to stdout otherwise.
+		Sinks []string
+
+		PostgresDSN  string
+		OTLPEndpoint string
+
+		File struct {
+			Path         string
+			MaxSizeBytes int64
+			MaxBackups   int
+		}
+	}
+
 	OpenTelemetry OpenTelemetryConfig
 
 	ActorConcurrencyLimit codygateway.ActorConcurrencyLimitConfig
@@ -161,6 +176,39 @@ func (c *Config) Load() {
 	c.BigQuery.EventBufferWorkers = c.GetInt("CODY_GATEWAY_BIGQUERY_EVENT_BUFFER_WORKERS", "0",
 		"The number of workers to process events - set to 0 to use a default that scales off buffer size.")
 
+	c.Events.Sinks = splitMaybe(c.GetOptional("CODY_GATEWAY_EVENTS_SINKS",
+		"Comma-separated list of event sinks to submit usage events to, any of 'bigquery', 'postgres', 'otlp', 'file' and 'stdout'. "+
+			"If not set, events are submitted to BigQuery if CODY_GATEWAY_BIGQUERY_PROJECT_ID is set, or logged to stdout otherwise. "+
+			"Events are buffered as configured by CODY_GATEWAY_BIGQUERY_EVENT_BUFFER_SIZE and CODY_GATEWAY_BIGQUERY_EVENT_BUFFER_WORKERS."))
+	c.Events.PostgresDSN = c.GetOptional("CODY_GATEWAY_EVENTS_POSTGRES_DSN", "The Postgres connection string for the 'postgres' event sink.")
+	c.Events.OTLPEndpoint = c.GetOptional("CODY_GATEWAY_EVENTS_OTLP_ENDPOINT", "The OTLP/HTTP logs endpoint for the 'otlp' event sink, for example http://otel-collector:4318/v1/logs.")
+	c.Events.File.Path = c.GetOptional("CODY_GATEWAY_EVENTS_FILE_PATH", "The path of the JSON lines file for the 'file' event sink.")
+	c.Events.File.MaxSizeBytes = int64(c.GetInt("CODY_GATEWAY_EVENTS_FILE_MAX_SIZE_MB", "100", "The size in megabytes after which the events file is rotated.")) * 1024 * 1024
+	c.Events.File.MaxBackups = c.GetInt("CODY_GATEWAY_EVENTS_FILE_MAX_BACKUPS", "5", "The number of rotated events files to keep.")
+	for _, sink := range c.Events.Sinks {
+		switch sink {
+		case "bigquery":
+			if c.BigQuery.ProjectID == "" {
+				c.AddError(errors.New("must provide CODY_GATEWAY_BIGQUERY_PROJECT_ID for the 'bigquery' event sink"))
+			}
+		case "postgres":
+			if c.Events.PostgresDSN == "" {
+				c.AddError(errors.New("must provide CODY_GATEWAY_EVENTS_POSTGRES_DSN for the 'postgres' event sink"))
+			}
+		case "otlp":
+			if c.Events.OTLPEndpoint == "" {
+				c.AddError(errors.New("must provide CODY_GATEWAY_EVENTS_OTLP_ENDPOINT for the 'otlp' event sink"))
+			}
+		case "file":
+			if c.Events.File.Path == "" {
+				c.AddError(errors.New("must provide CODY_GATEWAY_EVENTS_FILE_PATH for the 'file' event sink"))
+			}
+		case "stdout":
+		default:
+			c.AddError(errors.Newf("unknown event sink %q", sink))
+		}
+	}
+
 	c.OpenTelemetry.TracePolicy = policy.TracePolicy(c.Get("CODY_GATEWAY_TRACE_POLICY", "all", "Trace policy, one of 'all', 'selective', 'none'."))
 	c.OpenTelemetry.GCPProjectID = c.GetOptional("CODY_GATEWAY_OTEL_GCP_PROJECT_ID", "Google Cloud Traces project ID.")
 	if c.OpenTelemetry.GCPProjectID == "" {
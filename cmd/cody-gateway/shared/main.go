@@ -52,32 +52,6 @@ func Main(ctx context.Context, obctx *observation.Context, ready service.ReadyFu
 	}
 	defer shutdownOtel()
 
-	var eventLogger events.Logger
-	if config.BigQuery.ProjectID != "" {
-		eventLogger, err = events.NewBigQueryLogger(config.BigQuery.ProjectID, config.BigQuery.Dataset, config.BigQuery.Table)
-		if err != nil {
-			return errors.Wrap(err, "create BigQuery event logger")
-		}
-
-		// If a buffer is configured, wrap in events.BufferedLogger
-		if config.BigQuery.EventBufferSize > 0 {
-			eventLogger = events.NewBufferedLogger(obctx.Logger, eventLogger,
-				config.BigQuery.EventBufferSize, config.BigQuery.EventBufferWorkers)
-		}
-	} else {
-		eventLogger = events.NewStdoutLogger(obctx.Logger)
-
-		// Useful for testing event logging in a way that has latency that is
-		// somewhat similar to BigQuery.
-		if os.Getenv("CODY_GATEWAY_BUFFERED_LAGGY_EVENT_LOGGING_FUN_TIMES_MODE") == "true" {
-			eventLogger = events.NewBufferedLogger(
-				obctx.Logger,
-				events.NewDelayedLogger(eventLogger),
-				config.BigQuery.EventBufferSize,
-				config.BigQuery.EventBufferWorkers)
-		}
-	}
-
 	// Create an uncached external doer, we never want to cache any responses.
 	// Not only is the cache hit rate going to be really low and requests large-ish,
 	// but also do we not want to retain any data.
@@ -86,6 +60,11 @@ func Main(ctx context.Context, obctx *observation.Context, ready service.ReadyFu
 		return errors.Wrap(err, "failed to initialize external http client")
 	}
 
+	eventLogger, err := newEventLogger(ctx, obctx, config, httpClient)
+	if err != nil {
+		return err
+	}
+
 	// Supported actor/auth sources
 	sources := actor.NewSources(anonymous.NewSource(config.AllowAnonymous, config.ActorConcurrencyLimit))
 	if config.Dotcom.AccessToken != "" {
@@ -217,6 +196,65 @@ func Main(ctx context.Context, obctx *observation.Context, ready service.ReadyFu
 	return nil
 }
 
+// newEventLogger creates the event logger for the configured event sinks.
+func newEventLogger(ctx context.Context, obctx *observation.Context, config *Config, httpClient httpcli.Doer) (events.Logger, error) {
+	sinks := config.Events.Sinks
+	if len(sinks) == 0 {
+		if config.BigQuery.ProjectID != "" {
+			sinks = []string{"bigquery"}
+		} else {
+			eventLogger := events.NewStdoutLogger(obctx.Logger)
+
+			// Useful for testing event logging in a way that has latency that is
+			// somewhat similar to BigQuery.
+			if os.Getenv("CODY_GATEWAY_BUFFERED_LAGGY_EVENT_LOGGING_FUN_TIMES_MODE") == "true" {
+				eventLogger = events.NewBufferedLogger(
+					obctx.Logger,
+					events.NewDelayedLogger(eventLogger),
+					config.BigQuery.EventBufferSize,
+					config.BigQuery.EventBufferWorkers)
+			}
+			return eventLogger, nil
+		}
+	}
+
+	loggers := make([]events.Logger, 0, len(sinks))
+	for _, sink := range sinks {
+		switch sink {
+		case "bigquery":
+			l, err := events.NewBigQueryLogger(config.BigQuery.ProjectID, config.BigQuery.Dataset, config.BigQuery.Table)
+			if err != nil {
+				return nil, errors.Wrap(err, "create BigQuery event logger")
+			}
+			loggers = append(loggers, l)
+		case "postgres":
+			l, err := events.NewPostgresLogger(ctx, obctx, config.Events.PostgresDSN)
+			if err != nil {
+				return nil, errors.Wrap(err, "create Postgres event logger")
+			}
+			loggers = append(loggers, l)
+		case "otlp":
+			loggers = append(loggers, events.NewOTLPLogger(httpClient, config.Events.OTLPEndpoint))
+		case "file":
+			l, err := events.NewFileLogger(config.Events.File.Path, config.Events.File.MaxSizeBytes, config.Events.File.MaxBackups)
+			if err != nil {
+				return nil, errors.Wrap(err, "create file event logger")
+			}
+			loggers = append(loggers, l)
+		case "stdout":
+			loggers = append(loggers, events.NewStdoutLogger(obctx.Logger))
+		}
+	}
+	eventLogger := events.NewMultiLogger(loggers...)
+
+	// If a buffer is configured, wrap in events.BufferedLogger
+	if config.BigQuery.EventBufferSize > 0 {
+		eventLogger = events.NewBufferedLogger(obctx.Logger, eventLogger,
+			config.BigQuery.EventBufferSize, config.BigQuery.EventBufferWorkers)
+	}
+	return eventLogger, nil
+}
+
 func newRedisStore(store redispool.KeyValue) limiter.RedisStore {
 	return &redisStore{
 		store: store,
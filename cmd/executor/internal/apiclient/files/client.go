@@ -82,3 +82,18 @@ func (c *Client) Get(ctx context.Context, job types.Job, bucket string, key stri
 	}
 	return body, nil
 }
+
+func (c *Client) Upload(ctx context.Context, job types.Job, name string, content io.Reader) (err error) {
+	ctx, _, endObservation := c.operations.upload.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("queue", job.Queue),
+		attribute.String("name", name),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	req, err := c.client.NewRequest(job.ID, job.Token, http.MethodPost, fmt.Sprintf("artifacts/%s/%s", job.Queue, name), content)
+	if err != nil {
+		return err
+	}
+
+	return c.client.DoAndDrop(ctx, req)
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -242,3 +243,67 @@ func TestClient_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_Upload(t *testing.T) {
+	observationContext := &observation.TestContext
+
+	tests := []struct {
+		name string
+
+		handler func(t *testing.T) http.Handler
+
+		expectedErr error
+	}{
+		{
+			name: "Upload content",
+			handler: func(t *testing.T) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodPost, r.Method)
+					assert.Equal(t, "/.executors/files/artifacts/customjobs/reports/lint.xml", r.URL.Path)
+					assert.Equal(t, r.Header.Get("Authorization"), "Bearer sometoken")
+					assert.Equal(t, "42", r.Header.Get("X-Sourcegraph-Job-ID"))
+					assert.Equal(t, "test-executor", r.Header.Get("X-Sourcegraph-Executor-Name"))
+					body, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					assert.Equal(t, "hello world!", string(body))
+					w.WriteHeader(http.StatusOK)
+				})
+			},
+		},
+		{
+			name: "Failed to upload content",
+			handler: func(t *testing.T) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				})
+			},
+			expectedErr: errors.New("unexpected status code 500"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(test.handler(t))
+			defer srv.Close()
+			options := apiclient.BaseClientOptions{
+				ExecutorName: "test-executor",
+				EndpointOptions: apiclient.EndpointOptions{
+					URL:        srv.URL,
+					PathPrefix: "/.executors/files",
+					Token:      "hunter2",
+				},
+			}
+
+			client, err := files.New(observationContext, options)
+			require.NoError(t, err)
+
+			job := types.Job{ID: 42, Queue: "customjobs", Token: "sometoken"}
+			err = client.Upload(context.Background(), job, "reports/lint.xml", strings.NewReader("hello world!"))
+			if test.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, test.expectedErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 type operations struct {
 	exists *observation.Operation
 	get    *observation.Operation
+	upload *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -31,5 +32,6 @@ func newOperations(observationCtx *observation.Context) *operations {
 	return &operations{
 		exists: op("Exists"),
 		get:    op("Get"),
+		upload: op("Upload"),
 	}
 }
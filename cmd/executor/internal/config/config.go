@@ -45,6 +45,9 @@ type Config struct {
 	FirecrackerDiskSpace                           string
 	FirecrackerBandwidthIngress                    int
 	FirecrackerBandwidthEgress                     int
+	CacheVolumesDir                                string
+	CacheVolumesMaxSize                            string
+	CacheVolumeSize                                string
 	MaximumRuntimePerJob                           time.Duration
 	CleanupTaskInterval                            time.Duration
 	NumTotalJobs                                   int
@@ -137,6 +140,9 @@ func (c *Config) Load() {
 	c.FirecrackerDiskSpace = c.Get("EXECUTOR_FIRECRACKER_DISK_SPACE", "20G", "How much disk space to allocate to each virtual machine.")
 	c.FirecrackerBandwidthIngress = c.GetInt("EXECUTOR_FIRECRACKER_BANDWIDTH_INGRESS", "524288000", "How much bandwidth to allow for ingress packets to the VM in bytes/s.")
 	c.FirecrackerBandwidthEgress = c.GetInt("EXECUTOR_FIRECRACKER_BANDWIDTH_EGRESS", "524288000", "How much bandwidth to allow for egress packets to the VM in bytes/s.")
+	c.CacheVolumesDir = c.GetOptional("EXECUTOR_CACHE_VOLUMES_DIR", "The directory on the host to store cache volumes requested by jobs in. Cache volumes are disabled if not set. Not supported in Kubernetes.")
+	c.CacheVolumesMaxSize = c.Get("EXECUTOR_CACHE_VOLUMES_MAX_SIZE", "20G", "How much disk space all cache volumes may use. When exceeded, the least recently used volumes are evicted.")
+	c.CacheVolumeSize = c.Get("EXECUTOR_CACHE_VOLUME_SIZE", "5G", "How much disk space to allocate to each cache volume in firecracker.")
 	c.MaximumRuntimePerJob = c.GetInterval("EXECUTOR_MAXIMUM_RUNTIME_PER_JOB", "30m", "The maximum wall time that can be spent on a single job.")
 	c.CleanupTaskInterval = c.GetInterval("EXECUTOR_CLEANUP_TASK_INTERVAL", "1m", "The frequency with which to run periodic cleanup tasks.")
 	c.NumTotalJobs = c.GetInt("EXECUTOR_NUM_TOTAL_JOBS", "0", "The maximum number of jobs that will be dequeued by the worker.")
@@ -338,6 +344,18 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.CacheVolumesDir != "" {
+		// Make sure the cache volume sizes are valid datasize strings.
+		if _, err := datasize.ParseString(c.CacheVolumesMaxSize); err != nil {
+			c.AddError(errors.Wrapf(err, "invalid disk size provided for EXECUTOR_CACHE_VOLUMES_MAX_SIZE: %q", c.CacheVolumesMaxSize))
+		}
+		if c.UseFirecracker {
+			if _, err := datasize.ParseString(c.CacheVolumeSize); err != nil {
+				c.AddError(errors.Wrapf(err, "invalid disk size provided for EXECUTOR_CACHE_VOLUME_SIZE: %q", c.CacheVolumeSize))
+			}
+		}
+	}
+
 	if len(c.KubernetesNodeSelector) > 0 {
 		nodeSelectorValues := strings.Split(c.KubernetesNodeSelector, ",")
 		for _, value := range nodeSelectorValues {
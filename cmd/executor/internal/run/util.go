@@ -19,6 +19,7 @@ import (
 	apiworker "github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/workspace"
 	"github.com/sourcegraph/sourcegraph/internal/conf/deploy"
 	executorutil "github.com/sourcegraph/sourcegraph/internal/executor/util"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -73,9 +74,10 @@ func apiWorkerOptions(c *config.Config, queueTelemetryOptions queue.TelemetryOpt
 			FirecrackerOptions: firecrackerOptions(c),
 			KubernetesOptions:  kubernetesOptions(c),
 		},
-		GitServicePath: "/.executors/git",
-		QueueOptions:   queueOptions(c, queueTelemetryOptions),
-		FilesOptions:   filesOptions(c),
+		GitServicePath:     "/.executors/git",
+		QueueOptions:       queueOptions(c, queueTelemetryOptions),
+		FilesOptions:       filesOptions(c),
+		CacheVolumeOptions: cacheVolumeOptions(c),
 		RedactedValues: map[string]string{
 			// 🚨 SECURITY: Catch uses of the shared frontend token used to clone
 			// git repositories that make it into commands or stdout/stderr streams.
@@ -127,6 +129,14 @@ func firecrackerOptions(c *config.Config) runner.FirecrackerOptions {
 	}
 }
 
+func cacheVolumeOptions(c *config.Config) workspace.CacheVolumeOptions {
+	return workspace.CacheVolumeOptions{
+		Dir:        c.CacheVolumesDir,
+		MaxSize:    c.CacheVolumesMaxSize,
+		VolumeSize: c.CacheVolumeSize,
+	}
+}
+
 func resourceOptions(c *config.Config) command.ResourceOptions {
 	return command.ResourceOptions{
 		NumCPUs:             c.JobNumCPUs,
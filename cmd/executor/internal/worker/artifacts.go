@@ -0,0 +1,134 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/workspace"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// hasArtifacts returns true if any docker step of the job declares artifacts.
+func hasArtifacts(job types.Job) bool {
+	for _, step := range job.DockerSteps {
+		if len(step.Artifacts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// uploadArtifacts uploads the artifacts declared by the docker steps of the job
+// from the workspace. It must only be called once no step runs anymore. Artifacts
+// are best-effort, so failed uploads are logged but don't fail the job.
+func uploadArtifacts(ctx context.Context, filesStore files.Store, ws workspace.Workspace, job types.Job, logger cmdlogger.Logger) {
+	handle := logger.LogEntry("teardown.artifacts", nil)
+	defer func() {
+		handle.Finalize(0)
+		handle.Close()
+	}()
+
+	dir, release, err := ws.ReadFiles(ctx, handle)
+	if err != nil {
+		fmt.Fprintf(handle, "stderr: Failed to read workspace, skipping artifacts: %s\n", err)
+		return
+	}
+	defer release()
+
+	paths, err := artifactPaths(job, dir)
+	if err != nil {
+		fmt.Fprintf(handle, "stderr: %s\n", err)
+	}
+
+	for _, name := range paths {
+		if err := uploadArtifact(ctx, filesStore, job, dir, name); err != nil {
+			fmt.Fprintf(handle, "stderr: Failed to upload artifact %q: %s\n", name, err)
+			continue
+		}
+		fmt.Fprintf(handle, "Uploaded artifact %q\n", name)
+	}
+}
+
+func uploadArtifact(ctx context.Context, filesStore files.Store, job types.Job, dir, name string) error {
+	f, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return filesStore.Upload(ctx, job, name, f)
+}
+
+// artifactPaths resolves the artifact patterns of all docker steps to the paths
+// of the matching regular files, relative to the workspace directory. Patterns
+// that are invalid or point outside of the workspace are skipped and reported in
+// the returned error, as are matches that resolve to a file outside of the
+// workspace through a symlinked directory.
+func artifactPaths(job types.Job, dir string) ([]string, error) {
+	var (
+		paths []string
+		seen  = map[string]struct{}{}
+		errs  error
+	)
+
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving workspace directory")
+	}
+
+	for _, step := range job.DockerSteps {
+		for _, pattern := range step.Artifacts {
+			p := filepath.Join(dir, step.Dir, pattern)
+			if !strings.HasPrefix(p, dir+string(filepath.Separator)) {
+				errs = errors.Append(errs, errors.Newf("invalid artifact %q not within the workspace", pattern))
+				continue
+			}
+
+			matches, err := filepath.Glob(p)
+			if err != nil {
+				errs = errors.Append(errs, errors.Wrapf(err, "invalid artifact pattern %q", pattern))
+				continue
+			}
+			if len(matches) == 0 {
+				errs = errors.Append(errs, errors.Newf("no files match artifact %q", pattern))
+				continue
+			}
+
+			for _, match := range matches {
+				// Lstat, so that symlinks pointing out of the workspace are not followed.
+				info, err := os.Lstat(match)
+				if err != nil || !info.Mode().IsRegular() {
+					continue
+				}
+				// Glob follows symlinked directories, so make sure the file
+				// itself is within the workspace.
+				resolved, err := filepath.EvalSymlinks(match)
+				if err != nil {
+					continue
+				}
+				if !strings.HasPrefix(resolved, resolvedDir+string(filepath.Separator)) {
+					errs = errors.Append(errs, errors.Newf("invalid artifact %q not within the workspace", pattern))
+					continue
+				}
+				name, err := filepath.Rel(dir, match)
+				if err != nil {
+					continue
+				}
+				name = filepath.ToSlash(name)
+				if _, ok := seen[name]; ok {
+					continue
+				}
+				seen[name] = struct{}{}
+				paths = append(paths, name)
+			}
+		}
+	}
+
+	return paths, errs
+}
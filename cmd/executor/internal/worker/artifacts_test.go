@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+)
+
+func TestArtifactPaths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"repo/reports/a.xml", "repo/reports/b.xml", "repo/build.log"} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), os.ModePerm))
+	}
+	require.NoError(t, os.Symlink("/etc/passwd", filepath.Join(dir, "repo", "passwd")))
+	outside := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "secret.xml"), []byte("secret"), os.ModePerm))
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "repo", "linked")))
+
+	job := types.Job{
+		DockerSteps: []types.DockerStep{
+			{Dir: "repo", Artifacts: []string{"reports/*.xml", "passwd", "reports", "linked/*.xml"}},
+			{Artifacts: []string{"repo/build.log", "repo/reports/a.xml", "missing.txt", "../../etc/passwd"}},
+		},
+	}
+
+	paths, err := artifactPaths(job, dir)
+	assert.Equal(t, []string{"repo/reports/a.xml", "repo/reports/b.xml", "repo/build.log"}, paths)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `no files match artifact "missing.txt"`)
+	assert.Contains(t, err.Error(), `invalid artifact "../../etc/passwd" not within the workspace`)
+	assert.Contains(t, err.Error(), `invalid artifact "linked/*.xml" not within the workspace`)
+}
+
+func TestUploadArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "report.txt"), []byte("all good"), os.ModePerm))
+
+	filesStore := NewMockStore()
+	var uploaded string
+	filesStore.UploadFunc.SetDefaultHook(func(ctx context.Context, job types.Job, name string, content io.Reader) error {
+		b, err := io.ReadAll(content)
+		uploaded = string(b)
+		return err
+	})
+
+	released := false
+	ws := NewMockWorkspace()
+	ws.ReadFilesFunc.SetDefaultHook(func(ctx context.Context, handle cmdlogger.LogEntry) (string, func(), error) {
+		return dir, func() { released = true }, nil
+	})
+
+	logger := NewMockLogger()
+	logger.LogEntryFunc.SetDefaultReturn(NewMockLogEntry())
+
+	job := types.Job{ID: 42, Queue: "customjobs", DockerSteps: []types.DockerStep{{Artifacts: []string{"report.txt"}}}}
+	uploadArtifacts(context.Background(), filesStore, ws, job, logger)
+
+	require.Len(t, filesStore.UploadFunc.History(), 1)
+	assert.Equal(t, job, filesStore.UploadFunc.History()[0].Arg1)
+	assert.Equal(t, "report.txt", filesStore.UploadFunc.History()[0].Arg2)
+	assert.Equal(t, "all good", uploaded)
+	assert.True(t, released)
+}
@@ -15,6 +15,18 @@ type DockerOptions struct {
 	ConfigPath       string
 	AddHostGateway   bool
 	Resources        ResourceOptions
+	// Mounts are additional volumes that are mounted read-write into every
+	// container, e.g. dependency cache volumes.
+	Mounts []Mount
+}
+
+// Mount is a path on the host (or in the VM, when running in firecracker)
+// that is mounted into a container.
+type Mount struct {
+	// Source is the path that is mounted.
+	Source string
+	// Target is the absolute path inside the container the source is mounted at.
+	Target string
 }
 
 // ResourceOptions are the resource limits that can be applied to a container or VM.
@@ -81,6 +93,7 @@ func formatDockerCommand(hostDir string, image string, scriptPath string, spec S
 		dockerHostGatewayFlag(options.AddHostGateway),
 		dockerResourceFlags(options.Resources),
 		dockerVolumeFlags(hostDir),
+		dockerMountFlags(options.Mounts),
 		dockerWorkingDirectoryFlags(spec.Dir),
 		dockerEnvFlags(spec.Env),
 		dockerEntrypointFlags,
@@ -119,6 +132,14 @@ func dockerVolumeFlags(wd string) []string {
 	return []string{"-v", wd + ":/data"}
 }
 
+func dockerMountFlags(mounts []Mount) []string {
+	flags := make([]string, 0, 2*len(mounts))
+	for _, m := range mounts {
+		flags = append(flags, "-v", m.Source+":"+m.Target)
+	}
+	return flags
+}
+
 func dockerConfigFlag(dockerConfigPath string) []string {
 	if dockerConfigPath == "" {
 		return nil
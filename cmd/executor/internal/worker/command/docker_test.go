@@ -152,6 +152,46 @@ func TestNewDockerSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "Mounts",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "some/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+				Env:     []string{"FOO=BAR"},
+			},
+			options: command.DockerOptions{
+				Mounts: []command.Mount{
+					{Source: "/cache/npm-abc", Target: "/root/.npm"},
+					{Source: "/cache/go-def", Target: "/go/pkg/mod"},
+				},
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"docker",
+					"run",
+					"--rm",
+					"-v",
+					"/workingDirectory:/data",
+					"-v",
+					"/cache/npm-abc:/root/.npm",
+					"-v",
+					"/cache/go-def:/go/pkg/mod",
+					"-w",
+					"/data/some/dir",
+					"-e",
+					"FOO=BAR",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/some/path",
+				},
+			},
+		},
 		{
 			name:       "CPU and Memory",
 			workingDir: "/workingDirectory",
@@ -12,6 +12,8 @@ const (
 	FirecrackerContainerDir = "/work"
 	// FirecrackerDockerConfDir is the directory where the docker config is mounted in the firecracker VM.
 	FirecrackerDockerConfDir = "/etc/docker/cli"
+	// FirecrackerCacheDir is the directory under which cache volumes are mounted in the firecracker VM.
+	FirecrackerCacheDir = "/cache"
 )
 
 // NewFirecrackerSpec returns a spec that will run the given command in a firecracker VM.
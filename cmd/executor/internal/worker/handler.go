@@ -35,6 +35,7 @@ type handler struct {
 	cloneOptions workspace.CloneOptions
 	operations   *command.Operations
 	jobRuntime   runtime.Runtime
+	cacheVolumes *workspace.CacheVolumes
 }
 
 var (
@@ -117,6 +118,25 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 	}
 	defer ws.Remove(ctx, h.options.RunnerOptions.FirecrackerOptions.KeepWorkspaces)
 
+	// Upload the artifacts declared by the steps once the runner is torn down, so
+	// that they are also collected from failed jobs.
+	if hasArtifacts(job) && h.filesStore != nil {
+		artifactsJob := job
+		if artifactsJob.Queue == "" {
+			artifactsJob.Queue = h.options.QueueName
+		}
+		defer uploadArtifacts(context.Background(), h.filesStore, ws, artifactsJob, commandLogger)
+	}
+
+	// Attach the cache volumes requested by the job. They are released after the
+	// runner is torn down, so no container or VM uses them anymore.
+	var cacheMounts []command.Mount
+	if h.cacheVolumes != nil && len(job.CacheVolumes) > 0 {
+		var release func()
+		cacheMounts, release = h.cacheVolumes.Acquire(ctx, commandLogger, job, ws.CacheVolumeKeys())
+		defer release()
+	}
+
 	// Before we setup a VM (and after we teardown), mark the name as in-use so that
 	// the janitor process cleaning up orphaned VMs doesn't try to stop/remove the one
 	// we're using for the current job.
@@ -130,7 +150,7 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 		ctx,
 		commandLogger,
 		h.filesStore,
-		runtime.RunnerOptions{Path: ws.Path(), DockerAuthConfig: job.DockerAuthConfig, Name: name},
+		runtime.RunnerOptions{Path: ws.Path(), DockerAuthConfig: job.DockerAuthConfig, Name: name, Mounts: cacheMounts},
 	)
 	if err != nil {
 		return errors.Wrap(err, "creating runtime runner")
@@ -162,7 +182,7 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 			// We have a match, so reset the skip key.
 			skipKey = ""
 		}
-		if err := runtimeRunner.Run(ctx, spec); err != nil {
+		if err := runSpec(ctx, logger, runtimeRunner, spec); err != nil {
 			return errors.Wrapf(err, "running command %q", spec.CommandSpecs[0].Key)
 		}
 		if executorutil.IsPreStepKey(spec.CommandSpecs[0].Key) {
@@ -181,6 +201,50 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 	return nil
 }
 
+// runSpec runs the given spec, stopping every attempt after the timeout of the
+// spec and retrying failed attempts as often as the spec allows.
+//
+// The workspace is not restored between attempts: a retry sees any changes
+// the failed attempt made to it.
+func runSpec(ctx context.Context, logger log.Logger, r runner.Runner, spec runner.Spec) error {
+	backoff := spec.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := runSpecAttempt(ctx, r, spec)
+		if err == nil || attempt >= spec.Retries || ctx.Err() != nil {
+			return err
+		}
+
+		logger.Warn(
+			"Command failed, retrying",
+			log.String("key", spec.CommandSpecs[0].Key),
+			log.Int("attempt", attempt+1),
+			log.Duration("backoff", backoff),
+			log.Error(err),
+		)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func runSpecAttempt(ctx context.Context, r runner.Runner, spec runner.Spec) error {
+	if spec.Timeout <= 0 {
+		return r.Run(ctx, spec)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, spec.Timeout)
+	defer cancel()
+
+	err := r.Run(attemptCtx, spec)
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return errors.Newf("timed out after %s", spec.Timeout)
+	}
+	return err
+}
+
 func createHoneyEvent(_ context.Context, job types.Job, err error, duration time.Duration) honey.Event {
 	fields := map[string]any{
 		"duration_ms":    duration.Milliseconds(),
@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/sourcegraph/log/logtest"
 	"github.com/stretchr/testify/assert"
@@ -593,6 +594,92 @@ func TestHandler_Handle(t *testing.T) {
 			},
 			expectedErr: errors.New("running command \"my-key\": failed"),
 		},
+		{
+			name:    "retried command",
+			options: Options{},
+			job:     types.Job{ID: 42, RepositoryName: "my-repo", Commit: "cool-commit"},
+			mockFunc: func(jobRuntime *MockRuntime, logStore *MockExecutionLogEntryStore, jobRunner *MockRunner, jobWorkspace *MockWorkspace) {
+				jobRuntime.PrepareWorkspaceFunc.PushReturn(jobWorkspace, nil)
+				jobRuntime.NewRunnerFunc.PushReturn(jobRunner, nil)
+				jobRuntime.NewRunnerSpecsFunc.PushReturn([]runner.Spec{
+					{
+						CommandSpecs: []command.Spec{
+							{
+								Key:       "my-key",
+								Command:   []string{"npm", "install"},
+								Operation: operations.Exec,
+							},
+						},
+						Image:        "my-image",
+						Retries:      2,
+						RetryBackoff: time.Millisecond,
+					},
+				}, nil)
+				jobRunner.RunFunc.PushReturn(errors.New("failed"))
+				jobRunner.RunFunc.PushReturn(nil)
+			},
+			assertMockFunc: func(t *testing.T, jobRuntime *MockRuntime, logStore *MockExecutionLogEntryStore, jobRunner *MockRunner, jobWorkspace *MockWorkspace) {
+				require.Len(t, jobRunner.RunFunc.History(), 2)
+			},
+		},
+		{
+			name:    "command failed after retries",
+			options: Options{},
+			job:     types.Job{ID: 42, RepositoryName: "my-repo", Commit: "cool-commit"},
+			mockFunc: func(jobRuntime *MockRuntime, logStore *MockExecutionLogEntryStore, jobRunner *MockRunner, jobWorkspace *MockWorkspace) {
+				jobRuntime.PrepareWorkspaceFunc.PushReturn(jobWorkspace, nil)
+				jobRuntime.NewRunnerFunc.PushReturn(jobRunner, nil)
+				jobRuntime.NewRunnerSpecsFunc.PushReturn([]runner.Spec{
+					{
+						CommandSpecs: []command.Spec{
+							{
+								Key:       "my-key",
+								Command:   []string{"npm", "install"},
+								Operation: operations.Exec,
+							},
+						},
+						Image:        "my-image",
+						Retries:      2,
+						RetryBackoff: time.Millisecond,
+					},
+				}, nil)
+				jobRunner.RunFunc.SetDefaultReturn(errors.New("failed"))
+			},
+			assertMockFunc: func(t *testing.T, jobRuntime *MockRuntime, logStore *MockExecutionLogEntryStore, jobRunner *MockRunner, jobWorkspace *MockWorkspace) {
+				require.Len(t, jobRunner.RunFunc.History(), 3)
+			},
+			expectedErr: errors.New("running command \"my-key\": failed"),
+		},
+		{
+			name:    "command timed out",
+			options: Options{},
+			job:     types.Job{ID: 42, RepositoryName: "my-repo", Commit: "cool-commit"},
+			mockFunc: func(jobRuntime *MockRuntime, logStore *MockExecutionLogEntryStore, jobRunner *MockRunner, jobWorkspace *MockWorkspace) {
+				jobRuntime.PrepareWorkspaceFunc.PushReturn(jobWorkspace, nil)
+				jobRuntime.NewRunnerFunc.PushReturn(jobRunner, nil)
+				jobRuntime.NewRunnerSpecsFunc.PushReturn([]runner.Spec{
+					{
+						CommandSpecs: []command.Spec{
+							{
+								Key:       "my-key",
+								Command:   []string{"npm", "install"},
+								Operation: operations.Exec,
+							},
+						},
+						Image:   "my-image",
+						Timeout: time.Millisecond,
+					},
+				}, nil)
+				jobRunner.RunFunc.SetDefaultHook(func(ctx context.Context, _ runner.Spec) error {
+					<-ctx.Done()
+					return ctx.Err()
+				})
+			},
+			assertMockFunc: func(t *testing.T, jobRuntime *MockRuntime, logStore *MockExecutionLogEntryStore, jobRunner *MockRunner, jobWorkspace *MockWorkspace) {
+				require.Len(t, jobRunner.RunFunc.History(), 1)
+			},
+			expectedErr: errors.New("running command \"my-key\": timed out after 1ms"),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -1765,6 +1765,9 @@ type MockStore struct {
 	// GetFunc is an instance of a mock function object controlling the
 	// behavior of the method Get.
 	GetFunc *StoreGetFunc
+	// UploadFunc is an instance of a mock function object controlling the
+	// behavior of the method Upload.
+	UploadFunc *StoreUploadFunc
 }
 
 // NewMockStore creates a new mock of the Store interface. All methods
@@ -1781,6 +1784,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		UploadFunc: &StoreUploadFunc{
+			defaultHook: func(context.Context, types.Job, string, io.Reader) (r0 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -1798,6 +1806,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.Get")
 			},
 		},
+		UploadFunc: &StoreUploadFunc{
+			defaultHook: func(context.Context, types.Job, string, io.Reader) error {
+				panic("unexpected invocation of MockStore.Upload")
+			},
+		},
 	}
 }
 
@@ -1811,6 +1824,9 @@ func NewMockStoreFrom(i files.Store) *MockStore {
 		GetFunc: &StoreGetFunc{
 			defaultHook: i.Get,
 		},
+		UploadFunc: &StoreUploadFunc{
+			defaultHook: i.Upload,
+		},
 	}
 }
 
@@ -2040,6 +2056,116 @@ func (c StoreGetFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreUploadFunc describes the behavior when the Upload method of the
+// parent MockStore instance is invoked.
+type StoreUploadFunc struct {
+	defaultHook func(context.Context, types.Job, string, io.Reader) error
+	hooks       []func(context.Context, types.Job, string, io.Reader) error
+	history     []StoreUploadFuncCall
+	mutex       sync.Mutex
+}
+
+// Upload delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockStore) Upload(v0 context.Context, v1 types.Job, v2 string, v3 io.Reader) error {
+	r0 := m.UploadFunc.nextHook()(v0, v1, v2, v3)
+	m.UploadFunc.appendCall(StoreUploadFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Upload method of the
+// parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreUploadFunc) SetDefaultHook(hook func(context.Context, types.Job, string, io.Reader) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Upload method of the parent MockStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *StoreUploadFunc) PushHook(hook func(context.Context, types.Job, string, io.Reader) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreUploadFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, types.Job, string, io.Reader) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreUploadFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, types.Job, string, io.Reader) error {
+		return r0
+	})
+}
+
+func (f *StoreUploadFunc) nextHook() func(context.Context, types.Job, string, io.Reader) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreUploadFunc) appendCall(r0 StoreUploadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreUploadFuncCall objects describing the
+// invocations of this function.
+func (f *StoreUploadFunc) History() []StoreUploadFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreUploadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreUploadFuncCall is an object that describes an invocation of method
+// Upload on an instance of MockStore.
+type StoreUploadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 types.Job
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 io.Reader
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreUploadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreUploadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockRuntime is a mock implementation of the Runtime interface (from the
 // package
 // github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runtime)
@@ -3063,9 +3189,15 @@ func (c RunnerTempDirFuncCall) Results() []interface{} {
 // github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/workspace)
 // used for unit testing.
 type MockWorkspace struct {
+	// CacheVolumeKeysFunc is an instance of a mock function object
+	// controlling the behavior of the method CacheVolumeKeys.
+	CacheVolumeKeysFunc *WorkspaceCacheVolumeKeysFunc
 	// PathFunc is an instance of a mock function object controlling the
 	// behavior of the method Path.
 	PathFunc *WorkspacePathFunc
+	// ReadFilesFunc is an instance of a mock function object controlling
+	// the behavior of the method ReadFiles.
+	ReadFilesFunc *WorkspaceReadFilesFunc
 	// RemoveFunc is an instance of a mock function object controlling the
 	// behavior of the method Remove.
 	RemoveFunc *WorkspaceRemoveFunc
@@ -3081,11 +3213,21 @@ type MockWorkspace struct {
 // methods return zero values for all results, unless overwritten.
 func NewMockWorkspace() *MockWorkspace {
 	return &MockWorkspace{
+		CacheVolumeKeysFunc: &WorkspaceCacheVolumeKeysFunc{
+			defaultHook: func() (r0 []string) {
+				return
+			},
+		},
 		PathFunc: &WorkspacePathFunc{
 			defaultHook: func() (r0 string) {
 				return
 			},
 		},
+		ReadFilesFunc: &WorkspaceReadFilesFunc{
+			defaultHook: func(context.Context, cmdlogger.LogEntry) (r0 string, r1 func(), r2 error) {
+				return
+			},
+		},
 		RemoveFunc: &WorkspaceRemoveFunc{
 			defaultHook: func(context.Context, bool) {
 				return
@@ -3108,11 +3250,21 @@ func NewMockWorkspace() *MockWorkspace {
 // methods panic on invocation, unless overwritten.
 func NewStrictMockWorkspace() *MockWorkspace {
 	return &MockWorkspace{
+		CacheVolumeKeysFunc: &WorkspaceCacheVolumeKeysFunc{
+			defaultHook: func() []string {
+				panic("unexpected invocation of MockWorkspace.CacheVolumeKeys")
+			},
+		},
 		PathFunc: &WorkspacePathFunc{
 			defaultHook: func() string {
 				panic("unexpected invocation of MockWorkspace.Path")
 			},
 		},
+		ReadFilesFunc: &WorkspaceReadFilesFunc{
+			defaultHook: func(context.Context, cmdlogger.LogEntry) (string, func(), error) {
+				panic("unexpected invocation of MockWorkspace.ReadFiles")
+			},
+		},
 		RemoveFunc: &WorkspaceRemoveFunc{
 			defaultHook: func(context.Context, bool) {
 				panic("unexpected invocation of MockWorkspace.Remove")
@@ -3135,9 +3287,15 @@ func NewStrictMockWorkspace() *MockWorkspace {
 // All methods delegate to the given implementation, unless overwritten.
 func NewMockWorkspaceFrom(i workspace.Workspace) *MockWorkspace {
 	return &MockWorkspace{
+		CacheVolumeKeysFunc: &WorkspaceCacheVolumeKeysFunc{
+			defaultHook: i.CacheVolumeKeys,
+		},
 		PathFunc: &WorkspacePathFunc{
 			defaultHook: i.Path,
 		},
+		ReadFilesFunc: &WorkspaceReadFilesFunc{
+			defaultHook: i.ReadFiles,
+		},
 		RemoveFunc: &WorkspaceRemoveFunc{
 			defaultHook: i.Remove,
 		},
@@ -3150,6 +3308,105 @@ func NewMockWorkspaceFrom(i workspace.Workspace) *MockWorkspace {
 	}
 }
 
+// WorkspaceCacheVolumeKeysFunc describes the behavior when the
+// CacheVolumeKeys method of the parent MockWorkspace instance is invoked.
+type WorkspaceCacheVolumeKeysFunc struct {
+	defaultHook func() []string
+	hooks       []func() []string
+	history     []WorkspaceCacheVolumeKeysFuncCall
+	mutex       sync.Mutex
+}
+
+// CacheVolumeKeys delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockWorkspace) CacheVolumeKeys() []string {
+	r0 := m.CacheVolumeKeysFunc.nextHook()()
+	m.CacheVolumeKeysFunc.appendCall(WorkspaceCacheVolumeKeysFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the CacheVolumeKeys
+// method of the parent MockWorkspace instance is invoked and the hook queue
+// is empty.
+func (f *WorkspaceCacheVolumeKeysFunc) SetDefaultHook(hook func() []string) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CacheVolumeKeys method of the parent MockWorkspace instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *WorkspaceCacheVolumeKeysFunc) PushHook(hook func() []string) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkspaceCacheVolumeKeysFunc) SetDefaultReturn(r0 []string) {
+	f.SetDefaultHook(func() []string {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkspaceCacheVolumeKeysFunc) PushReturn(r0 []string) {
+	f.PushHook(func() []string {
+		return r0
+	})
+}
+
+func (f *WorkspaceCacheVolumeKeysFunc) nextHook() func() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkspaceCacheVolumeKeysFunc) appendCall(r0 WorkspaceCacheVolumeKeysFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkspaceCacheVolumeKeysFuncCall objects
+// describing the invocations of this function.
+func (f *WorkspaceCacheVolumeKeysFunc) History() []WorkspaceCacheVolumeKeysFuncCall {
+	f.mutex.Lock()
+	history := make([]WorkspaceCacheVolumeKeysFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkspaceCacheVolumeKeysFuncCall is an object that describes an
+// invocation of method CacheVolumeKeys on an instance of MockWorkspace.
+type WorkspaceCacheVolumeKeysFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkspaceCacheVolumeKeysFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkspaceCacheVolumeKeysFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // WorkspacePathFunc describes the behavior when the Path method of the
 // parent MockWorkspace instance is invoked.
 type WorkspacePathFunc struct {
@@ -3248,6 +3505,116 @@ func (c WorkspacePathFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// WorkspaceReadFilesFunc describes the behavior when the ReadFiles method
+// of the parent MockWorkspace instance is invoked.
+type WorkspaceReadFilesFunc struct {
+	defaultHook func(context.Context, cmdlogger.LogEntry) (string, func(), error)
+	hooks       []func(context.Context, cmdlogger.LogEntry) (string, func(), error)
+	history     []WorkspaceReadFilesFuncCall
+	mutex       sync.Mutex
+}
+
+// ReadFiles delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockWorkspace) ReadFiles(v0 context.Context, v1 cmdlogger.LogEntry) (string, func(), error) {
+	r0, r1, r2 := m.ReadFilesFunc.nextHook()(v0, v1)
+	m.ReadFilesFunc.appendCall(WorkspaceReadFilesFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ReadFiles method of
+// the parent MockWorkspace instance is invoked and the hook queue is empty.
+func (f *WorkspaceReadFilesFunc) SetDefaultHook(hook func(context.Context, cmdlogger.LogEntry) (string, func(), error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReadFiles method of the parent MockWorkspace instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *WorkspaceReadFilesFunc) PushHook(hook func(context.Context, cmdlogger.LogEntry) (string, func(), error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkspaceReadFilesFunc) SetDefaultReturn(r0 string, r1 func(), r2 error) {
+	f.SetDefaultHook(func(context.Context, cmdlogger.LogEntry) (string, func(), error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkspaceReadFilesFunc) PushReturn(r0 string, r1 func(), r2 error) {
+	f.PushHook(func(context.Context, cmdlogger.LogEntry) (string, func(), error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *WorkspaceReadFilesFunc) nextHook() func(context.Context, cmdlogger.LogEntry) (string, func(), error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkspaceReadFilesFunc) appendCall(r0 WorkspaceReadFilesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkspaceReadFilesFuncCall objects
+// describing the invocations of this function.
+func (f *WorkspaceReadFilesFunc) History() []WorkspaceReadFilesFuncCall {
+	f.mutex.Lock()
+	history := make([]WorkspaceReadFilesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkspaceReadFilesFuncCall is an object that describes an invocation of
+// method ReadFiles on an instance of MockWorkspace.
+type WorkspaceReadFilesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 cmdlogger.LogEntry
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 func()
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkspaceReadFilesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkspaceReadFilesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // WorkspaceRemoveFunc describes the behavior when the Remove method of the
 // parent MockWorkspace instance is invoked.
 type WorkspaceRemoveFunc struct {
@@ -159,7 +159,7 @@ func (r *firecrackerRunner) setupFirecracker(ctx context.Context) (string, error
 			"--network-plugin", "cni",
 			firecrackerResourceFlags(r.options.DockerOptions.Resources),
 			firecrackerCopyfileFlags(r.options.VMStartupScriptPath, daemonConfigFile, dockerConfigPath),
-			firecrackerVolumeFlags(r.workspaceDevice),
+			firecrackerVolumeFlags(r.workspaceDevice, r.options.DockerOptions.Mounts),
 			"--ssh",
 			"--name", r.vmName,
 			"--kernel-image", sanitizeImage(r.options.KernelImage),
@@ -174,6 +174,10 @@ func (r *firecrackerRunner) setupFirecracker(ctx context.Context) (string, error
 		return "", errors.Wrap(err, "failed to start firecracker vm")
 	}
 
+	// The cache volume devices are now mounted in the VM, so containers need to
+	// mount them from there.
+	r.options.DockerOptions.Mounts = firecrackerCacheMounts(r.options.DockerOptions.Mounts)
+
 	if r.options.VMStartupScriptPath != "" {
 		startupScriptCommandSpec := command.Spec{
 			Key:       "setup.startup-script",
@@ -298,8 +302,30 @@ func firecrackerCopyfileFlags(vmStartupScriptPath, daemonConfigFile, dockerConfi
 	return command.Intersperse("--copy-files", copyfiles)
 }
 
-func firecrackerVolumeFlags(workspaceDevice string) []string {
-	return []string{"--volumes", fmt.Sprintf("%s:%s", workspaceDevice, command.FirecrackerContainerDir)}
+func firecrackerVolumeFlags(workspaceDevice string, cacheMounts []command.Mount) []string {
+	volumes := make([]string, 0, 1+len(cacheMounts))
+	volumes = append(volumes, fmt.Sprintf("%s:%s", workspaceDevice, command.FirecrackerContainerDir))
+	for i, m := range cacheMounts {
+		volumes = append(volumes, fmt.Sprintf("%s:%s", m.Source, firecrackerCacheDir(i)))
+	}
+	return command.Intersperse("--volumes", volumes)
+}
+
+// firecrackerCacheMounts maps the given mounts of cache volume devices on the host
+// to the directories they are mounted at in the VM.
+func firecrackerCacheMounts(cacheMounts []command.Mount) []command.Mount {
+	if len(cacheMounts) == 0 {
+		return nil
+	}
+	mounts := make([]command.Mount, len(cacheMounts))
+	for i, m := range cacheMounts {
+		mounts[i] = command.Mount{Source: firecrackerCacheDir(i), Target: m.Target}
+	}
+	return mounts
+}
+
+func firecrackerCacheDir(index int) string {
+	return path.Join(command.FirecrackerCacheDir, strconv.Itoa(index))
 }
 
 // sanitizeImage sanitizes the given docker image for use by ignite. The ignite utility
@@ -411,3 +411,52 @@ func TestFirecrackerRunner_Run(t *testing.T) {
 		"docker --config /docker/config run --rm --add-host=host.docker.internal:host-gateway --cpus 10 --memory 1G -v /work:/data -w /data/workingdir -e FOO=bar --entrypoint /bin/sh alpine /data/.sourcegraph-executor/some/script",
 	}, cmd.RunFunc.History()[0].Arg2.Command)
 }
+
+func TestFirecrackerRunner_CacheVolumes(t *testing.T) {
+	cmd := runner.NewMockCommand()
+	logger := runner.NewMockLogger()
+	operations := command.NewOperations(&observation.TestContext)
+	options := runner.FirecrackerOptions{
+		DockerOptions: command.DockerOptions{
+			Mounts: []command.Mount{
+				{Source: "/dev/loop1", Target: "/root/.npm"},
+				{Source: "/dev/loop2", Target: "/go/pkg/mod"},
+			},
+		},
+	}
+	spec := runner.Spec{
+		CommandSpecs: []command.Spec{
+			{
+				Key:     "some-key",
+				Command: []string{"echo", "hello"},
+			},
+		},
+		Image:      "alpine",
+		ScriptPath: "/some/script",
+	}
+
+	firecrackerRunner := runner.NewFirecrackerRunner(cmd, logger, "/dev/sda", "test", options, types.DockerAuthConfig{}, operations)
+
+	cmd.RunFunc.SetDefaultReturn(nil)
+
+	ctx := context.Background()
+	err := firecrackerRunner.Setup(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		firecrackerRunner.Teardown(ctx)
+	})
+
+	err = firecrackerRunner.Run(ctx, spec)
+	require.NoError(t, err)
+
+	require.Len(t, cmd.RunFunc.History(), 2)
+	startCommand := strings.Join(cmd.RunFunc.History()[0].Arg2.Command, " ")
+	assert.Contains(t, startCommand, "--volumes /dev/sda:/work --volumes /dev/loop1:/cache/0 --volumes /dev/loop2:/cache/1")
+	assert.Equal(t, []string{
+		"ignite",
+		"exec",
+		"test",
+		"--",
+		"docker run --rm -v /work:/data -v /cache/0:/root/.npm -v /cache/1:/go/pkg/mod -w /data --entrypoint /bin/sh alpine /data/.sourcegraph-executor/some/script",
+	}, cmd.RunFunc.History()[1].Arg2.Command)
+}
@@ -2,6 +2,7 @@ package runner
 
 import (
 	"context"
+	"time"
 
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/util"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
@@ -36,6 +37,12 @@ type Spec struct {
 	CommandSpecs []command.Spec
 	Image        string
 	ScriptPath   string
+
+	// Timeout, Retries and RetryBackoff control how the spec is run, see
+	// types.DockerStep.
+	Timeout      time.Duration
+	Retries      int
+	RetryBackoff time.Duration
 }
 
 // Options are the options that can be passed to the runner.
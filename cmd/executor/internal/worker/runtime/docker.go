@@ -40,7 +40,9 @@ func (r *dockerRuntime) PrepareWorkspace(ctx context.Context, logger cmdlogger.L
 }
 
 func (r *dockerRuntime) NewRunner(ctx context.Context, logger cmdlogger.Logger, filesStore files.Store, options RunnerOptions) (runner.Runner, error) {
-	run := runner.NewDockerRunner(r.cmd, logger, options.Path, r.dockerOpts, options.DockerAuthConfig)
+	dockerOpts := r.dockerOpts
+	dockerOpts.Mounts = options.Mounts
+	run := runner.NewDockerRunner(r.cmd, logger, options.Path, dockerOpts, options.DockerAuthConfig)
 	if err := run.Setup(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to setup docker runner")
 	}
@@ -61,8 +63,11 @@ func (r *dockerRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job) ([
 					Operation: r.operations.Exec,
 				},
 			},
-			Image:      step.Image,
-			ScriptPath: ws.ScriptFilenames()[i],
+			Image:        step.Image,
+			ScriptPath:   ws.ScriptFilenames()[i],
+			Timeout:      step.Timeout,
+			Retries:      step.Retries,
+			RetryBackoff: step.RetryBackoff,
 		}
 	}
 
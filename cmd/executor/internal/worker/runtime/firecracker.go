@@ -43,12 +43,14 @@ func (r *firecrackerRuntime) PrepareWorkspace(ctx context.Context, logger cmdlog
 }
 
 func (r *firecrackerRuntime) NewRunner(ctx context.Context, logger cmdlogger.Logger, filesStore files.Store, options RunnerOptions) (runner.Runner, error) {
+	firecrackerOpts := r.firecrackerOpts
+	firecrackerOpts.DockerOptions.Mounts = options.Mounts
 	run := runner.NewFirecrackerRunner(
 		r.cmd,
 		logger,
 		options.Path,
 		options.Name,
-		r.firecrackerOpts,
+		firecrackerOpts,
 		options.DockerAuthConfig,
 		r.operations,
 	)
@@ -72,8 +74,11 @@ func (r *firecrackerRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Jo
 					Operation: r.operations.Exec,
 				},
 			},
-			Image:      step.Image,
-			ScriptPath: ws.ScriptFilenames()[i],
+			Image:        step.Image,
+			ScriptPath:   ws.ScriptFilenames()[i],
+			Timeout:      step.Timeout,
+			Retries:      step.Retries,
+			RetryBackoff: step.RetryBackoff,
 		}
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/workspace"
 	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type kubernetesRuntime struct {
@@ -63,6 +64,11 @@ func (r *kubernetesRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job
 			scriptName := files.ScriptNameFromJobStep(job, i)
 
 			key := kubernetesKey(step.Key, i)
+			// All steps run as containers of a single pod, so they can't be
+			// stopped or restarted individually.
+			if step.Timeout > 0 || step.Retries > 0 {
+				return nil, errors.Newf("step %q sets a timeout or retries, which are not supported when all steps of a job run in a single pod", key)
+			}
 			specs[i] = command.Spec{
 				Key:  key,
 				Name: strings.ReplaceAll(key, ".", "-"),
@@ -98,7 +104,10 @@ func (r *kubernetesRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job
 						Operation: r.operations.Exec,
 					},
 				},
-				Image: step.Image,
+				Image:        step.Image,
+				Timeout:      step.Timeout,
+				Retries:      step.Retries,
+				RetryBackoff: step.RetryBackoff,
 			}
 		}
 		return runnerSpecs, nil
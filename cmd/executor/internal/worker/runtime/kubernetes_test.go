@@ -10,6 +10,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
 	"github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func TestKubernetesRuntime_Name(t *testing.T) {
@@ -193,6 +194,27 @@ func TestKubernetesRuntime_NewRunnerSpecs(t *testing.T) {
 				require.Len(t, ws.ScriptFilenamesFunc.History(), 0)
 			},
 		},
+		{
+			name:      "Single job with retries",
+			singleJob: true,
+			job: types.Job{
+				ID:             42,
+				RepositoryName: "github.com/sourcegraph/sourcegraph",
+				Commit:         "deadbeef",
+				DockerSteps: []types.DockerStep{
+					{
+						Key:      "my-key",
+						Image:    "my-image",
+						Commands: []string{"echo", "hello"},
+						Retries:  2,
+					},
+				},
+			},
+			expectedErr: errors.New(`step "step.kubernetes.my-key" sets a timeout or retries, which are not supported when all steps of a job run in a single pod`),
+			assertMockFunc: func(t *testing.T, ws *MockWorkspace) {
+				require.Len(t, ws.ScriptFilenamesFunc.History(), 0)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -36,6 +36,9 @@ type RunnerOptions struct {
 	Name             string
 	Path             string
 	DockerAuthConfig types.DockerAuthConfig
+	// Mounts are the cache volumes to mount into the containers of the job.
+	// Ignored by runtimes that don't support cache volumes.
+	Mounts []command.Mount
 }
 
 // New creates the runtime based on the configured environment.
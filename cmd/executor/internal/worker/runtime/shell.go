@@ -61,8 +61,11 @@ func (r *shellRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job) ([]
 					Operation: r.operations.Exec,
 				},
 			},
-			Image:      step.Image,
-			ScriptPath: ws.ScriptFilenames()[i],
+			Image:        step.Image,
+			ScriptPath:   ws.ScriptFilenames()[i],
+			Timeout:      step.Timeout,
+			Retries:      step.Retries,
+			RetryBackoff: step.RetryBackoff,
 		}
 	}
 
@@ -66,6 +66,10 @@ type Options struct {
 
 	RunnerOptions runner.Options
 
+	// CacheVolumeOptions configures the cache volumes that are shared between jobs.
+	// Cache volumes are disabled when no directory is configured.
+	CacheVolumeOptions workspace.CacheVolumeOptions
+
 	// NodeExporterEndpoint is the URL of the local node_exporter endpoint, without
 	// the /metrics path.
 	NodeExporterEndpoint string
@@ -114,6 +118,14 @@ func NewWorker(observationCtx *observation.Context, nameSet *janitor.NameSet, op
 		return nil, err
 	}
 
+	var cacheVolumes *workspace.CacheVolumes
+	if options.CacheVolumeOptions.Dir != "" {
+		cacheVolumes, err = workspace.NewCacheVolumes(options.CacheVolumeOptions, options.RunnerOptions.FirecrackerOptions.Enabled, cmdRunner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	h := &handler{
 		nameSet:      nameSet,
 		cmdRunner:    cmdRunner,
@@ -124,6 +136,7 @@ func NewWorker(observationCtx *observation.Context, nameSet *janitor.NameSet, op
 		cloneOptions: cloneOptions,
 		operations:   commandOps,
 		jobRuntime:   jobRuntime,
+		cacheVolumes: cacheVolumes,
 	}
 
 	return workerutil.NewWorker[types.Job](context.Background(), queueClient, h, options.WorkerOptions), nil
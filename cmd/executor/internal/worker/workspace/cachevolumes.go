@@ -0,0 +1,436 @@
+package workspace
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c2h5oh/datasize"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/util"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// CacheVolumeOptions configures the cache volumes that are shared between jobs.
+type CacheVolumeOptions struct {
+	// Dir is the directory on the host that cache volumes are stored in. Cache
+	// volumes are disabled if empty.
+	Dir string
+	// MaxSize is the disk budget for all cache volumes. When it is exceeded, the
+	// least recently used volumes are evicted.
+	MaxSize string
+	// VolumeSize is the size of a single cache volume. Only used in firecracker,
+	// where every volume is an ext4 image of this size.
+	VolumeSize string
+}
+
+// CacheVolumes manages the cache volumes on the host. A volume is used by at
+// most one job at a time. Jobs that request a volume that is in use run without
+// it.
+type CacheVolumes struct {
+	options     CacheVolumeOptions
+	maxSize     int64
+	firecracker bool
+	cmdRunner   util.CmdRunner
+
+	mu    sync.Mutex
+	inUse map[string]struct{}
+}
+
+// NewCacheVolumes creates a manager of the cache volumes in the configured
+// directory. When firecracker is true, volumes are ext4 images that are attached
+// to VMs as loop devices, otherwise they are plain directories.
+func NewCacheVolumes(options CacheVolumeOptions, firecracker bool, cmdRunner util.CmdRunner) (*CacheVolumes, error) {
+	maxSize, err := datasize.ParseString(options.MaxSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cache volumes max size provided: %q", options.MaxSize)
+	}
+	if firecracker {
+		if _, err := datasize.ParseString(options.VolumeSize); err != nil {
+			return nil, errors.Wrapf(err, "invalid cache volume size provided: %q", options.VolumeSize)
+		}
+	}
+	if err := os.MkdirAll(options.Dir, os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "creating cache volumes directory")
+	}
+
+	return &CacheVolumes{
+		options:     options,
+		maxSize:     int64(maxSize.Bytes()),
+		firecracker: firecracker,
+		cmdRunner:   cmdRunner,
+		inUse:       map[string]struct{}{},
+	}, nil
+}
+
+// Acquire prepares the cache volumes requested by the job, using the keys
+// computed by the workspace of the job. It returns the mounts of the volumes
+// that could be acquired, and a function that must be called to release them
+// once the job no longer runs. Caches are best-effort, so volumes that fail to
+// be prepared are skipped instead of failing the job.
+func (c *CacheVolumes) Acquire(ctx context.Context, logger cmdlogger.Logger, job types.Job, keys []string) ([]command.Mount, func()) {
+	handle := logger.LogEntry("setup.cache-volumes", nil)
+	defer func() {
+		// Cache volumes never fail the job.
+		handle.Finalize(0)
+		handle.Close()
+	}()
+
+	type acquiredVolume struct {
+		key    string
+		device string
+	}
+	var acquired []acquiredVolume
+	var mounts []command.Mount
+
+	for i, volume := range job.CacheVolumes {
+		if i >= len(keys) {
+			break
+		}
+		key := keys[i]
+
+		if !c.markInUse(key) {
+			fmt.Fprintf(handle, "Cache volume %q is in use by another job, running without it\n", volume.Name)
+			continue
+		}
+
+		source, err := c.prepare(ctx, handle, key)
+		if err != nil {
+			fmt.Fprintf(handle, "stderr: Failed to prepare cache volume %q, running without it: %s\n", volume.Name, err)
+			c.unmarkInUse(key)
+			continue
+		}
+		fmt.Fprintf(handle, "Mounting cache volume %q (%s) at %s\n", volume.Name, key, volume.MountPath)
+
+		v := acquiredVolume{key: key}
+		if c.firecracker {
+			v.device = source
+		}
+		acquired = append(acquired, v)
+		mounts = append(mounts, command.Mount{Source: source, Target: volume.MountPath})
+	}
+
+	release := func() {
+		handle := logger.LogEntry("teardown.cache-volumes", nil)
+		defer func() {
+			handle.Finalize(0)
+			handle.Close()
+		}()
+
+		// Perform this outside of the task execution context, volumes should be
+		// released even if the job was canceled.
+		ctx := context.Background()
+		for _, v := range acquired {
+			if v.device != "" {
+				if err := detachLoopDevice(ctx, c.cmdRunner, v.device, handle); err != nil {
+					fmt.Fprintf(handle, "stderr: Failed to detach loop device: %s\n", err)
+				}
+			}
+			c.touch(v.key)
+			c.unmarkInUse(v.key)
+		}
+
+		if err := c.evict(handle); err != nil {
+			fmt.Fprintf(handle, "stderr: Failed to evict cache volumes: %s\n", err)
+		}
+	}
+
+	return mounts, release
+}
+
+// prepare creates the volume with the given key if it does not exist yet and
+// returns the source to mount it from.
+func (c *CacheVolumes) prepare(ctx context.Context, handle cmdlogger.LogEntry, key string) (string, error) {
+	volumePath := c.volumePath(key)
+	defer c.touch(key)
+
+	if !c.firecracker {
+		if err := os.MkdirAll(volumePath, os.ModePerm); err != nil {
+			return "", errors.Wrap(err, "creating cache volume directory")
+		}
+		return volumePath, nil
+	}
+
+	if _, err := os.Stat(volumePath); os.IsNotExist(err) {
+		if err := c.createImage(ctx, handle, volumePath); err != nil {
+			return "", err
+		}
+	} else if err != nil {
+		return "", err
+	}
+
+	out, err := commandLogger(ctx, c.cmdRunner, handle, "losetup", "--find", "--show", volumePath)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create loop device: %q", out)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// createImage creates a sparse ext4 image at the given path. The image is
+// created under a temporary name first, so that volumes which failed to be
+// created are never used.
+func (c *CacheVolumes) createImage(ctx context.Context, handle cmdlogger.LogEntry, volumePath string) (err error) {
+	volumeSize, err := datasize.ParseString(c.options.VolumeSize)
+	if err != nil {
+		return errors.Wrapf(err, "invalid cache volume size provided: %q", c.options.VolumeSize)
+	}
+
+	f, err := os.CreateTemp(c.options.Dir, cacheVolumeTmpPrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(f.Name())
+		}
+	}()
+	if err := f.Truncate(int64(volumeSize.Bytes())); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "failed to make cache volume file sparse with %d bytes", volumeSize.Bytes())
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "failed to close cache volume file")
+	}
+
+	if out, err := commandLogger(ctx, c.cmdRunner, handle, "mkfs.ext4", "-F", f.Name()); err != nil {
+		return errors.Wrapf(err, "failed to create ext4 filesystem in cache volume file: %q", out)
+	}
+
+	return os.Rename(f.Name(), volumePath)
+}
+
+// evict removes the least recently used volumes that are not in use until the
+// volumes fit into the disk budget.
+func (c *CacheVolumes) evict(handle cmdlogger.LogEntry) error {
+	entries, err := os.ReadDir(c.options.Dir)
+	if err != nil {
+		return err
+	}
+
+	type volume struct {
+		key      string
+		path     string
+		size     int64
+		lastUsed time.Time
+	}
+	volumes := make([]volume, 0, len(entries))
+	var total int64
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), cacheVolumeTmpPrefix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		p := filepath.Join(c.options.Dir, entry.Name())
+		// The apparent size of images is used, which is an upper bound of the disk
+		// space they take up.
+		size := info.Size()
+		if info.IsDir() {
+			if size, err = dirSize(p); err != nil {
+				continue
+			}
+		}
+		volumes = append(volumes, volume{
+			key:      strings.TrimSuffix(entry.Name(), cacheVolumeImageExt),
+			path:     p,
+			size:     size,
+			lastUsed: info.ModTime(),
+		})
+		total += size
+	}
+
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].lastUsed.Before(volumes[j].lastUsed)
+	})
+
+	var errs error
+	for _, v := range volumes {
+		if total <= c.maxSize {
+			break
+		}
+		if !c.markInUse(v.key) {
+			continue
+		}
+		fmt.Fprintf(handle, "Evicting cache volume %s (%s)\n", v.key, datasize.ByteSize(v.size).HumanReadable())
+		if err := os.RemoveAll(v.path); err != nil {
+			errs = errors.Append(errs, err)
+		} else {
+			total -= v.size
+		}
+		c.unmarkInUse(v.key)
+	}
+
+	return errs
+}
+
+func (c *CacheVolumes) volumePath(key string) string {
+	if c.firecracker {
+		return filepath.Join(c.options.Dir, key+cacheVolumeImageExt)
+	}
+	return filepath.Join(c.options.Dir, key)
+}
+
+// touch marks the volume with the given key as recently used.
+func (c *CacheVolumes) touch(key string) {
+	now := time.Now()
+	_ = os.Chtimes(c.volumePath(key), now, now)
+}
+
+func (c *CacheVolumes) markInUse(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.inUse[key]; ok {
+		return false
+	}
+	c.inUse[key] = struct{}{}
+	return true
+}
+
+func (c *CacheVolumes) unmarkInUse(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.inUse, key)
+}
+
+const (
+	cacheVolumeImageExt  = ".img"
+	cacheVolumeTmpPrefix = ".tmp-"
+)
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// cacheVolumeKeys computes the keys of the cache volumes requested by the job.
+// A key is made up of the repository, the name of the volume and a hash of the
+// contents of its key files in the repository cloned into workspaceDir.
+func cacheVolumeKeys(job types.Job, workspaceDir string) ([]string, error) {
+	if len(job.CacheVolumes) == 0 {
+		return nil, nil
+	}
+
+	repoPath, err := filepath.EvalSymlinks(filepath.Join(workspaceDir, job.RepositoryDirectory))
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving repository directory")
+	}
+	keys := make([]string, 0, len(job.CacheVolumes))
+	for _, volume := range job.CacheVolumes {
+		h := sha256.New()
+		for _, keyFile := range volume.KeyFiles {
+			content, err := readCacheVolumeKeyFile(repoPath, keyFile)
+			if err != nil {
+				return nil, err
+			}
+			if content == nil {
+				continue
+			}
+			fmt.Fprintf(h, "%s\x00%d\x00", keyFile, len(content))
+			h.Write(content)
+		}
+
+		keys = append(keys, fmt.Sprintf(
+			"%s-%s-%s",
+			sanitizeCacheVolumeKeyPart(job.RepositoryName),
+			sanitizeCacheVolumeKeyPart(volume.Name),
+			hex.EncodeToString(h.Sum(nil))[:16],
+		))
+	}
+
+	return keys, nil
+}
+
+// maxCacheVolumeKeyFileSize is the maximum size of a key file. Key files are
+// typically lock files, which are well below this size.
+const maxCacheVolumeKeyFileSize = 32 * 1024 * 1024
+
+// readCacheVolumeKeyFile reads the given key file of the repository at
+// repoPath. It returns nil if the key file does not exist. The repository is
+// controlled by the user who created the job, so key files must be regular
+// files within the repository, and neither they nor their parent directories
+// may be symlinks that point elsewhere.
+func readCacheVolumeKeyFile(repoPath, keyFile string) ([]byte, error) {
+	p := filepath.Join(repoPath, keyFile)
+	if !strings.HasPrefix(p, repoPath+string(filepath.Separator)) {
+		return nil, errors.Newf("invalid cache volume key file %q not within the repository", keyFile)
+	}
+
+	dir, err := filepath.EvalSymlinks(filepath.Dir(p))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "resolving cache volume key file %q", keyFile)
+	}
+	if dir != repoPath && !strings.HasPrefix(dir, repoPath+string(filepath.Separator)) {
+		return nil, errors.Newf("invalid cache volume key file %q not within the repository", keyFile)
+	}
+	p = filepath.Join(dir, filepath.Base(p))
+
+	info, err := os.Lstat(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "reading cache volume key file %q", keyFile)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, errors.Newf("invalid cache volume key file %q is not a regular file", keyFile)
+	}
+	if info.Size() > maxCacheVolumeKeyFileSize {
+		return nil, errors.Newf("cache volume key file %q is larger than %d bytes", keyFile, maxCacheVolumeKeyFileSize)
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading cache volume key file %q", keyFile)
+	}
+	defer f.Close()
+
+	// The file may have grown since it was checked.
+	content, err := io.ReadAll(io.LimitReader(f, maxCacheVolumeKeyFileSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading cache volume key file %q", keyFile)
+	}
+	if len(content) > maxCacheVolumeKeyFileSize {
+		return nil, errors.Newf("cache volume key file %q is larger than %d bytes", keyFile, maxCacheVolumeKeyFileSize)
+	}
+	return content, nil
+}
+
+var cacheVolumeKeyPartPattern = lazyregexp.New(`[^a-zA-Z0-9_.]+`)
+
+// sanitizeCacheVolumeKeyPart makes the given string safe to use in a file name.
+func sanitizeCacheVolumeKeyPart(s string) string {
+	return strings.Trim(cacheVolumeKeyPartPattern.ReplaceAllString(s, "_"), "_.")
+}
@@ -0,0 +1,140 @@
+package workspace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+)
+
+func TestCacheVolumeKeys(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "repo"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "repo", "package-lock.json"), []byte("v1"), os.ModePerm))
+
+	job := types.Job{
+		RepositoryName:      "github.com/sourcegraph/sourcegraph",
+		RepositoryDirectory: "repo",
+		CacheVolumes: []types.CacheVolume{
+			{Name: "npm", MountPath: "/root/.npm", KeyFiles: []string{"package-lock.json", "missing.lock"}},
+			{Name: "go mod", MountPath: "/go/pkg/mod"},
+		},
+	}
+
+	keys, err := cacheVolumeKeys(job, dir)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Regexp(t, `^github.com_sourcegraph_sourcegraph-npm-[0-9a-f]{16}$`, keys[0])
+	assert.Regexp(t, `^github.com_sourcegraph_sourcegraph-go_mod-[0-9a-f]{16}$`, keys[1])
+
+	// The keys are stable.
+	again, err := cacheVolumeKeys(job, dir)
+	require.NoError(t, err)
+	assert.Equal(t, keys, again)
+
+	// Changing a key file changes the key of its volume only.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "repo", "package-lock.json"), []byte("v2"), os.ModePerm))
+	changed, err := cacheVolumeKeys(job, dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, keys[0], changed[0])
+	assert.Equal(t, keys[1], changed[1])
+
+	// Key files must be within the repository.
+	job.CacheVolumes[0].KeyFiles = []string{"../../etc/passwd"}
+	_, err = cacheVolumeKeys(job, dir)
+	assert.Error(t, err)
+
+	// Symlinks to files and directories outside of the repository are rejected.
+	outside := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), os.ModePerm))
+	require.NoError(t, os.Symlink(filepath.Join(outside, "secret"), filepath.Join(dir, "repo", "link.lock")))
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "repo", "linkdir")))
+	for _, keyFile := range []string{"link.lock", "linkdir/secret"} {
+		job.CacheVolumes[0].KeyFiles = []string{keyFile}
+		_, err = cacheVolumeKeys(job, dir)
+		assert.Error(t, err, keyFile)
+	}
+
+	// Key files must be regular files.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "repo", "dir.lock"), os.ModePerm))
+	job.CacheVolumes[0].KeyFiles = []string{"dir.lock"}
+	_, err = cacheVolumeKeys(job, dir)
+	assert.Error(t, err)
+}
+
+func TestCacheVolumes_Docker(t *testing.T) {
+	dir := t.TempDir()
+	logger := NewMockLogger()
+	logger.LogEntryFunc.SetDefaultReturn(NewMockLogEntry())
+
+	cacheVolumes, err := NewCacheVolumes(CacheVolumeOptions{Dir: dir, MaxSize: "10B"}, false, NewMockCmdRunner())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	job := types.Job{CacheVolumes: []types.CacheVolume{{Name: "npm", MountPath: "/root/.npm"}}}
+
+	mounts, release := cacheVolumes.Acquire(ctx, logger, job, []string{"a"})
+	assert.Equal(t, []command.Mount{{Source: filepath.Join(dir, "a"), Target: "/root/.npm"}}, mounts)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a", "dep"), []byte("12345678"), os.ModePerm))
+
+	// A volume in use is not shared with other jobs.
+	otherMounts, otherRelease := cacheVolumes.Acquire(ctx, logger, job, []string{"a"})
+	assert.Empty(t, otherMounts)
+	otherRelease()
+
+	// Volume a fits into the budget, so it is kept.
+	release()
+	assert.DirExists(t, filepath.Join(dir, "a"))
+
+	// Make sure a is the least recently used volume.
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "a"), past, past))
+
+	mounts, release = cacheVolumes.Acquire(ctx, logger, job, []string{"b"})
+	require.Len(t, mounts, 1)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b", "dep"), []byte("12345678"), os.ModePerm))
+	release()
+
+	// Together the volumes exceed the budget, so a is evicted.
+	assert.NoDirExists(t, filepath.Join(dir, "a"))
+	assert.DirExists(t, filepath.Join(dir, "b"))
+}
+
+func TestCacheVolumes_Firecracker(t *testing.T) {
+	dir := t.TempDir()
+	logger := NewMockLogger()
+	logger.LogEntryFunc.SetDefaultReturn(NewMockLogEntry())
+	cmdRunner := NewMockCmdRunner()
+	cmdRunner.CombinedOutputFunc.SetDefaultHook(func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		if name == "losetup" && args[0] == "--find" {
+			return []byte("/dev/loop7\n"), nil
+		}
+		return nil, nil
+	})
+
+	cacheVolumes, err := NewCacheVolumes(CacheVolumeOptions{Dir: dir, MaxSize: "1G", VolumeSize: "1M"}, true, cmdRunner)
+	require.NoError(t, err)
+
+	job := types.Job{CacheVolumes: []types.CacheVolume{{Name: "npm", MountPath: "/root/.npm"}}}
+	mounts, release := cacheVolumes.Acquire(context.Background(), logger, job, []string{"a"})
+	assert.Equal(t, []command.Mount{{Source: "/dev/loop7", Target: "/root/.npm"}}, mounts)
+
+	info, err := os.Stat(filepath.Join(dir, "a.img"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024*1024), info.Size())
+
+	release()
+
+	history := cmdRunner.CombinedOutputFunc.History()
+	require.Len(t, history, 3)
+	assert.Equal(t, "mkfs.ext4", history[0].Arg1)
+	assert.Equal(t, []string{"--find", "--show", filepath.Join(dir, "a.img")}, history[1].Arg2)
+	assert.Equal(t, []string{"--detach", "/dev/loop7"}, history[2].Arg2)
+}
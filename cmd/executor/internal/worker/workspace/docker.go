@@ -14,6 +14,7 @@ import (
 
 type dockerWorkspace struct {
 	scriptFilenames []string
+	cacheVolumeKeys []string
 	workspaceDir    string
 	logger          cmdlogger.Logger
 }
@@ -47,8 +48,15 @@ func NewDockerWorkspace(
 		return nil, err
 	}
 
+	cacheVolumeKeys, err := cacheVolumeKeys(job, workspaceDir)
+	if err != nil {
+		_ = os.RemoveAll(workspaceDir)
+		return nil, err
+	}
+
 	return &dockerWorkspace{
 		scriptFilenames: scriptPaths,
+		cacheVolumeKeys: cacheVolumeKeys,
 		workspaceDir:    workspaceDir,
 		logger:          logger,
 	}, nil
@@ -77,6 +85,14 @@ func (w dockerWorkspace) ScriptFilenames() []string {
 	return w.scriptFilenames
 }
 
+func (w dockerWorkspace) CacheVolumeKeys() []string {
+	return w.cacheVolumeKeys
+}
+
+func (w dockerWorkspace) ReadFiles(ctx context.Context, handle cmdlogger.LogEntry) (string, func(), error) {
+	return w.workspaceDir, func() {}, nil
+}
+
 func (w dockerWorkspace) Remove(ctx context.Context, keepWorkspace bool) {
 	handle := w.logger.LogEntry("teardown.fs", nil)
 	defer func() {
@@ -21,6 +21,7 @@ import (
 type firecrackerWorkspace struct {
 	cmdRunner       util.CmdRunner
 	scriptFilenames []string
+	cacheVolumeKeys []string
 	blockDeviceFile string
 	blockDevice     string
 	tmpMountDir     string
@@ -78,9 +79,17 @@ func NewFirecrackerWorkspace(
 		return nil, err
 	}
 
+	// The workspace is only accessible from the host until it is unmounted, so
+	// the cache volume keys are computed now.
+	cacheVolumeKeys, err := cacheVolumeKeys(job, tmpMountDir)
+	if err != nil {
+		return nil, err
+	}
+
 	return &firecrackerWorkspace{
 		cmdRunner:       cmdRunner,
 		scriptFilenames: scriptPaths,
+		cacheVolumeKeys: cacheVolumeKeys,
 		blockDeviceFile: blockDeviceFile,
 		blockDevice:     blockDevice,
 		tmpMountDir:     tmpMountDir,
@@ -199,6 +208,27 @@ func (w firecrackerWorkspace) ScriptFilenames() []string {
 	return w.scriptFilenames
 }
 
+func (w firecrackerWorkspace) CacheVolumeKeys() []string {
+	return w.cacheVolumeKeys
+}
+
+// ReadFiles mounts the workspace device on the host again. This must only be
+// done after the VM using the device has been torn down.
+func (w firecrackerWorkspace) ReadFiles(ctx context.Context, handle cmdlogger.LogEntry) (string, func(), error) {
+	mountDir, err := mountLoopDevice(ctx, w.cmdRunner, w.blockDevice, handle)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return mountDir, func() {
+		if err := unmount(mountDir); err != nil {
+			fmt.Fprintf(handle, "stderr: Failed to unmount workspace device: %s\n", err)
+			return
+		}
+		_ = os.RemoveAll(mountDir)
+	}, nil
+}
+
 func (w firecrackerWorkspace) Remove(ctx context.Context, keepWorkspace bool) {
 	handle := w.logger.LogEntry("teardown.fs", nil)
 	defer func() {
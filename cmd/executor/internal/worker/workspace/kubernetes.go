@@ -10,6 +10,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
 	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type kubernetesWorkspace struct {
@@ -73,6 +74,18 @@ func (w kubernetesWorkspace) ScriptFilenames() []string {
 	return w.scriptFilenames
 }
 
+// CacheVolumeKeys returns nil, as cache volumes are not supported in kubernetes.
+func (w kubernetesWorkspace) CacheVolumeKeys() []string {
+	return nil
+}
+
+func (w kubernetesWorkspace) ReadFiles(ctx context.Context, handle cmdlogger.LogEntry) (string, func(), error) {
+	if w.workspaceDir == "" {
+		return "", nil, errors.New("the workspace of single job pods is not accessible from the executor")
+	}
+	return w.workspaceDir, func() {}, nil
+}
+
 func (w kubernetesWorkspace) Remove(ctx context.Context, keepWorkspace bool) {
 	handle := w.logger.LogEntry("teardown.fs", nil)
 	defer func() {
@@ -1,6 +1,10 @@
 package workspace
 
-import "context"
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+)
 
 // CloneOptions holds the options for cloning a workspace.
 type CloneOptions struct {
@@ -19,6 +23,14 @@ type Workspace interface {
 	WorkingDirectory() string
 	// ScriptFilenames holds the ordered set of script filenames to be invoked.
 	ScriptFilenames() []string
+	// CacheVolumeKeys returns the keys of the cache volumes requested by the job,
+	// in the same order. The keys depend on the contents of the workspace, so they
+	// are computed when the workspace is created.
+	CacheVolumeKeys() []string
+	// ReadFiles makes the workspace contents readable from the host once no job
+	// step is running anymore, e.g. to collect artifacts. It returns the directory
+	// of the workspace contents and a function that must be called when done.
+	ReadFiles(ctx context.Context, handle cmdlogger.LogEntry) (string, func(), error)
 	// Remove cleans up the workspace post execution. If keep workspace is true,
 	// the implementation will only clean up additional resources, while keeping
 	// the workspace contents on disk for debugging purposes.
@@ -18,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitmanifest"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -191,6 +192,11 @@ func addRepoToExclude(ctx context.Context, logger log.Logger, externalService *t
 		if !schemaContainsExclusion(c.Exclude, exclusion) {
 			c.Exclude = append(c.Exclude, &schema.ExcludedGitoliteRepo{Name: excludableName})
 		}
+	case *schema.GitManifestConnection:
+		exclusion := &schema.ExcludedGitManifestRepo{Name: excludableName}
+		if !schemaContainsExclusion(c.Exclude, exclusion) {
+			c.Exclude = append(c.Exclude, &schema.ExcludedGitManifestRepo{Name: excludableName})
+		}
 	}
 
 	strConfig, err := json.Marshal(config)
@@ -244,6 +250,12 @@ func ExcludableRepoName(repository *types.Repo, logger log.Logger) (name string)
 		} else {
 			logger.Error("invalid repo metadata schema", log.String("extSvcType", extsvc.TypeGitolite))
 		}
+	case extsvc.VariantGitManifest.AsType():
+		if repo, ok := repository.Metadata.(*gitmanifest.Repository); ok {
+			name = repo.Name
+		} else {
+			logger.Error("invalid repo metadata schema", log.String("extSvcType", extsvc.VariantGitManifest.AsType()))
+		}
 	}
 	return
 }
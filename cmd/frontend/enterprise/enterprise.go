@@ -44,6 +44,9 @@ type Services struct {
 	SearchJobsDataExportHandler http.Handler
 	SearchJobsLogsHandler       http.Handler
 
+	// Handler for downloading executor job artifacts.
+	ExecutorArtifactDownloadHandler http.Handler
+
 	// Handler for completions stream.
 	NewChatCompletionsStreamHandler NewChatCompletionsStreamHandler
 
@@ -53,6 +56,9 @@ type Services struct {
 	// Handler for license v2 check.
 	NewDotcomLicenseCheckHandler NewDotcomLicenseCheckHandler
 
+	// Handler for site admin uploads of vulnerability bundles.
+	SentinelBundleUploadHandler http.Handler
+
 	PermissionsGitHubWebhook  webhooks.Registerer
 	NewCodeIntelUploadHandler NewCodeIntelUploadHandler
 	RankingService            RankingService
@@ -122,6 +128,8 @@ func DefaultServices() Services {
 		NewCodeCompletionsHandler:       func() http.Handler { return makeNotFoundHandler("code completions streaming endpoint") },
 		SearchJobsDataExportHandler:     makeNotFoundHandler("search jobs data export handler"),
 		SearchJobsLogsHandler:           makeNotFoundHandler("search jobs logs handler"),
+		ExecutorArtifactDownloadHandler: makeNotFoundHandler("executor artifact download handler"),
+		SentinelBundleUploadHandler:     makeNotFoundHandler("vulnerability bundle upload handler"),
 	}
 }
 
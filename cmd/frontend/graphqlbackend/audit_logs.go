@@ -0,0 +1,208 @@
+package graphqlbackend
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+type auditLogsArgs struct {
+	graphqlutil.ConnectionArgs
+	After  *string
+	Actor  *graphql.ID
+	Entity *string
+	Action *string
+	Since  *time.Time
+	Until  *time.Time
+}
+
+// toListOpts transforms the GraphQL auditLogsArgs into options that can be
+// provided to the AuditLogStore's Count and List methods.
+func (args *auditLogsArgs) toListOpts() (database.AuditLogsListOptions, error) {
+	opts := database.AuditLogsListOptions{
+		Entity: pointers.Deref(args.Entity, ""),
+		Action: pointers.Deref(args.Action, ""),
+		Since:  args.Since,
+		Until:  args.Until,
+	}
+
+	if args.Actor != nil {
+		id, err := UnmarshalUserID(*args.Actor)
+		if err != nil {
+			return opts, errors.Wrap(err, "unmarshalling actor ID")
+		}
+		opts.ActorUID = &id
+	}
+
+	if args.After != nil {
+		var err error
+		opts.BeforeID, err = strconv.ParseInt(*args.After, 10, 64)
+		if err != nil {
+			return opts, errors.Wrap(err, "parsing the after cursor")
+		}
+	}
+
+	return opts, nil
+}
+
+// AuditLogs returns the audit log records stored in the database.
+func (r *schemaResolver) AuditLogs(ctx context.Context, args *auditLogsArgs) (*auditLogConnectionResolver, error) {
+	// 🚨 SECURITY: Only site admins may list audit logs.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	opts, err := args.toListOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	first := int32(50)
+	if args.First != nil {
+		first = *args.First
+	}
+
+	return &auditLogConnectionResolver{
+		db:    r.db,
+		opts:  opts,
+		first: int(first),
+	}, nil
+}
+
+// auditLogConnectionResolver resolves a list of audit log records.
+//
+// 🚨 SECURITY: When instantiating an auditLogConnectionResolver value, the
+// caller MUST check permissions.
+type auditLogConnectionResolver struct {
+	db    database.DB
+	opts  database.AuditLogsListOptions
+	first int
+
+	// cache results because they are used by multiple fields
+	once sync.Once
+	logs []*database.AuditLog
+	err  error
+}
+
+func (r *auditLogConnectionResolver) compute(ctx context.Context) ([]*database.AuditLog, error) {
+	r.once.Do(func() {
+		opts := r.opts
+		// Fetch one more record to know whether there is a next page.
+		opts.LimitOffset = &database.LimitOffset{Limit: r.first + 1}
+		r.logs, r.err = r.db.AuditLogs().List(ctx, opts)
+	})
+	return r.logs, r.err
+}
+
+func (r *auditLogConnectionResolver) Nodes(ctx context.Context) ([]*auditLogResolver, error) {
+	logs, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) > r.first {
+		logs = logs[:r.first]
+	}
+
+	nodes := make([]*auditLogResolver, len(logs))
+	for i, l := range logs {
+		nodes[i] = &auditLogResolver{db: r.db, log: l}
+	}
+	return nodes, nil
+}
+
+func (r *auditLogConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	opts := r.opts
+	// The cursor doesn't change the total count.
+	opts.BeforeID = 0
+	count, err := r.db.AuditLogs().Count(ctx, opts)
+	return int32(count), err
+}
+
+func (r *auditLogConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	logs, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) > r.first && r.first > 0 {
+		return graphqlutil.NextPageCursor(strconv.FormatInt(logs[r.first-1].ID, 10)), nil
+	}
+	return graphqlutil.HasNextPage(false), nil
+}
+
+type auditLogResolver struct {
+	db  database.DB
+	log *database.AuditLog
+}
+
+func marshalAuditLogID(id int64) graphql.ID {
+	return relay.MarshalID("AuditLog", id)
+}
+
+func (r *auditLogResolver) ID() graphql.ID {
+	return marshalAuditLogID(r.log.ID)
+}
+
+func (r *auditLogResolver) AuditID() string {
+	return r.log.AuditID
+}
+
+func (r *auditLogResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.log.CreatedAt}
+}
+
+func (r *auditLogResolver) Entity() string {
+	return r.log.Entity
+}
+
+func (r *auditLogResolver) Action() string {
+	return r.log.Action
+}
+
+func (r *auditLogResolver) Actor(ctx context.Context) (*UserResolver, error) {
+	if r.log.ActorUID == 0 {
+		return nil, nil
+	}
+
+	user, err := UserByIDInt32(ctx, r.db, r.log.ActorUID)
+	if errcode.IsNotFound(err) {
+		// The user may have been deleted since.
+		return nil, nil
+	}
+	return user, err
+}
+
+func (r *auditLogResolver) ActorUID() int32 {
+	return r.log.ActorUID
+}
+
+func (r *auditLogResolver) AnonymousActorUID() *string {
+	return pointers.NonZeroPtr(r.log.AnonymousActorUID)
+}
+
+func (r *auditLogResolver) IP() *string {
+	return pointers.NonZeroPtr(r.log.IP)
+}
+
+func (r *auditLogResolver) UserAgent() *string {
+	return pointers.NonZeroPtr(r.log.UserAgent)
+}
+
+func (r *auditLogResolver) ForwardedFor() *string {
+	return pointers.NonZeroPtr(r.log.ForwardedFor)
+}
+
+func (r *auditLogResolver) Fields() JSONValue {
+	return JSONValue{Value: r.log.Fields}
+}
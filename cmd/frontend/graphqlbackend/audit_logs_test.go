@@ -0,0 +1,103 @@
+package graphqlbackend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestAuditLogsArgs(t *testing.T) {
+	now := time.Date(2023, 10, 5, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	t.Run("all arguments", func(t *testing.T) {
+		have, err := (&auditLogsArgs{
+			After:  pointers.Ptr("40"),
+			Actor:  pointers.Ptr(MarshalUserID(7)),
+			Entity: pointers.Ptr("security events"),
+			Action: pointers.Ptr("AccessGranted"),
+			Since:  pointers.Ptr(now),
+			Until:  pointers.Ptr(later),
+		}).toListOpts()
+		require.NoError(t, err)
+		assert.Equal(t, database.AuditLogsListOptions{
+			ActorUID: pointers.Ptr(int32(7)),
+			Entity:   "security events",
+			Action:   "AccessGranted",
+			Since:    pointers.Ptr(now),
+			Until:    pointers.Ptr(later),
+			BeforeID: 40,
+		}, have)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := (&auditLogsArgs{After: pointers.Ptr("foo")}).toListOpts()
+		assert.Error(t, err)
+	})
+}
+
+func TestAuditLogs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("regular user", func(t *testing.T) {
+		users := dbmocks.NewMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{}, nil)
+
+		db := dbmocks.NewMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+
+		_, err := newSchemaResolver(db, nil).AuditLogs(ctx, &auditLogsArgs{})
+		assert.ErrorIs(t, err, auth.ErrMustBeSiteAdmin)
+	})
+
+	t.Run("admin user", func(t *testing.T) {
+		users := dbmocks.NewMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{SiteAdmin: true}, nil)
+
+		auditLogs := dbmocks.NewMockAuditLogStore()
+		auditLogs.ListFunc.SetDefaultHook(func(_ context.Context, opts database.AuditLogsListOptions) ([]*database.AuditLog, error) {
+			// One more record than requested is fetched to detect the next page.
+			assert.Equal(t, 3, opts.Limit)
+			assert.Equal(t, int64(10), opts.BeforeID)
+			return []*database.AuditLog{{ID: 9}, {ID: 8}, {ID: 7}}, nil
+		})
+		auditLogs.CountFunc.SetDefaultHook(func(_ context.Context, opts database.AuditLogsListOptions) (int, error) {
+			assert.Zero(t, opts.BeforeID)
+			return 9, nil
+		})
+
+		db := dbmocks.NewMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+		db.AuditLogsFunc.SetDefaultReturn(auditLogs)
+
+		r, err := newSchemaResolver(db, nil).AuditLogs(ctx, &auditLogsArgs{
+			ConnectionArgs: graphqlutil.ConnectionArgs{First: pointers.Ptr(int32(2))},
+			After:          pointers.Ptr("10"),
+		})
+		require.NoError(t, err)
+
+		nodes, err := r.Nodes(ctx)
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+		assert.Equal(t, marshalAuditLogID(9), nodes[0].ID())
+
+		page, err := r.PageInfo(ctx)
+		require.NoError(t, err)
+		assert.True(t, page.HasNextPage())
+		assert.Equal(t, "8", *page.EndCursor())
+
+		count, err := r.TotalCount(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int32(9), count)
+	})
+}
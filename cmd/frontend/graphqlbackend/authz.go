@@ -18,6 +18,8 @@ type AuthzResolver interface {
 	ScheduleRepositoryPermissionsSync(ctx context.Context, args *RepositoryIDArgs) (*EmptyResponse, error)
 	ScheduleUserPermissionsSync(ctx context.Context, args *UserPermissionsSyncArgs) (*EmptyResponse, error)
 	SetSubRepositoryPermissionsForUsers(ctx context.Context, args *SubRepoPermsArgs) (*EmptyResponse, error)
+	SetSubRepositoryPermissionsACL(ctx context.Context, args *SubRepoPermsACLArgs) (*EmptyResponse, error)
+	DeleteSubRepositoryPermissionsACL(ctx context.Context, args *RepositoryIDArgs) (*EmptyResponse, error)
 	SetRepositoryPermissionsForBitbucketProject(ctx context.Context, args *RepoPermsBitbucketProjectArgs) (*EmptyResponse, error)
 	CancelPermissionsSyncJob(ctx context.Context, args *CancelPermissionsSyncJobArgs) (CancelPermissionsSyncJobResultMessage, error)
 
@@ -29,6 +31,7 @@ type AuthzResolver interface {
 	AuthzProviderTypes(ctx context.Context) ([]string, error)
 	PermissionsSyncJobs(ctx context.Context, args ListPermissionsSyncJobsArgs) (*graphqlutil.ConnectionResolver[PermissionsSyncJobResolver], error)
 	PermissionsSyncingStats(ctx context.Context) (PermissionsSyncingStatsResolver, error)
+	PermissionsSyncDryRun(ctx context.Context, args *PermissionsSyncDryRunArgs) (PermissionsSyncDryRunResolver, error)
 
 	// RepositoryPermissionsInfo and UserPermissionsInfo are helpers functions.
 	RepositoryPermissionsInfo(ctx context.Context, repoID graphql.ID) (PermissionsInfoResolver, error)
@@ -69,6 +72,11 @@ type SubRepoPermsArgs struct {
 	}
 }
 
+type SubRepoPermsACLArgs struct {
+	Repository graphql.ID
+	ACL        string
+}
+
 type AuthorizedRepoArgs struct {
 	Username *string
 	Email    *string
@@ -172,3 +180,16 @@ type PermissionsSyncingStatsResolver interface {
 	UsersWithStalePermissions(ctx context.Context) (int32, error)
 	ReposWithStalePermissions(ctx context.Context) (int32, error)
 }
+
+type PermissionsSyncDryRunArgs struct {
+	User       *graphql.ID
+	Repository *graphql.ID
+}
+
+type PermissionsSyncDryRunResolver interface {
+	AddedRepositories(ctx context.Context) ([]*RepositoryResolver, error)
+	RemovedRepositories(ctx context.Context) ([]*RepositoryResolver, error)
+	AddedUsers(ctx context.Context) ([]*UserResolver, error)
+	RemovedUsers(ctx context.Context) ([]*UserResolver, error)
+	UnchangedCount() int32
+}
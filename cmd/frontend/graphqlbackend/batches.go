@@ -817,6 +817,10 @@ type ExternalChangesetResolver interface {
 	ScheduleEstimateAt(ctx context.Context) (*gqlutil.DateTime, error)
 
 	CurrentSpec(ctx context.Context) (VisibleChangesetSpecResolver, error)
+
+	DependsOn(ctx context.Context) (ExternalChangesetResolver, error)
+	// StackState returns a value of type *btypes.ChangesetStackState.
+	StackState(ctx context.Context) (*string, error)
 }
 
 // Only GitHubApps are supported for commit signing for now.
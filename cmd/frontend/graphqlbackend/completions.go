@@ -1,9 +1,43 @@
 package graphqlbackend
 
-import "context"
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
 
 type CompletionsResolver interface {
 	Completions(ctx context.Context, args CompletionsArgs) (string, error)
+	CompletionsUsage(ctx context.Context, args CompletionsUsageArgs) (CompletionsUsageReportResolver, error)
+}
+
+type CompletionsUsageArgs struct {
+	Period string
+}
+
+type CompletionsUsageReportResolver interface {
+	Period() string
+	PeriodStart() gqlutil.DateTime
+	Site() CompletionsUsageResolver
+	Users(ctx context.Context) ([]CompletionsUserUsageResolver, error)
+	Orgs(ctx context.Context) ([]CompletionsOrgUsageResolver, error)
+}
+
+type CompletionsUsageResolver interface {
+	Tokens() BigInt
+	CostUSD() float64
+	TokenBudget() *BigInt
+	CostBudgetUSD() *float64
+}
+
+type CompletionsUserUsageResolver interface {
+	User(ctx context.Context) (*UserResolver, error)
+	Usage() CompletionsUsageResolver
+}
+
+type CompletionsOrgUsageResolver interface {
+	Org(ctx context.Context) (*OrgResolver, error)
+	Usage() CompletionsUsageResolver
 }
 
 type CompletionsArgs struct {
@@ -0,0 +1,326 @@
+package graphqlbackend
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const customJobIDKind = "CustomJob"
+
+func marshalCustomJobID(id int) graphql.ID {
+	return relay.MarshalID(customJobIDKind, id)
+}
+
+func unmarshalCustomJobID(id graphql.ID) (jobID int, err error) {
+	err = relay.UnmarshalSpec(id, &jobID)
+	return
+}
+
+type ListCustomJobsArgs struct {
+	First      int32       `json:"first"`
+	After      *string     `json:"after"`
+	Repository *graphql.ID `json:"repository"`
+	State      *string     `json:"state"`
+}
+
+type EnqueueCustomJobArgs struct {
+	Template   string     `json:"template"`
+	Repository graphql.ID `json:"repository"`
+	Revision   *string    `json:"revision"`
+}
+
+type CancelCustomJobArgs struct {
+	ID graphql.ID `json:"id"`
+}
+
+func (r *schemaResolver) CustomJobTemplates(ctx context.Context) ([]*customJobTemplateResolver, error) {
+	// Templates are visible to all users that can trigger them.
+	if _, err := auth.CurrentUser(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	templates := customjobs.Templates()
+	resolvers := make([]*customJobTemplateResolver, 0, len(templates))
+	for _, t := range templates {
+		resolvers = append(resolvers, &customJobTemplateResolver{
+			name:        t.Name,
+			description: t.Description,
+			image:       t.Image,
+			steps:       customJobTemplateSteps(t.Steps),
+		})
+	}
+	return resolvers, nil
+}
+
+func (r *schemaResolver) CustomJobs(ctx context.Context, args ListCustomJobsArgs) (*customJobConnectionResolver, error) {
+	user, err := auth.CurrentUser(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, auth.ErrNotAuthenticated
+	}
+
+	opts := customjobs.ListOpts{
+		// Fetch one more job than requested to determine if there is a next page.
+		Limit: int(args.First) + 1,
+	}
+	// 🚨 SECURITY: Users that are not site admins can only see their own jobs.
+	if !user.SiteAdmin {
+		opts.UserID = user.ID
+	}
+	if args.After != nil {
+		cursor, err := strconv.Atoi(*args.After)
+		if err != nil {
+			return nil, errors.Newf("cannot parse cursor %q", *args.After)
+		}
+		opts.Cursor = cursor
+	}
+	if args.Repository != nil {
+		repoID, err := UnmarshalRepositoryID(*args.Repository)
+		if err != nil {
+			return nil, err
+		}
+		opts.RepositoryID = repoID
+	}
+	if args.State != nil {
+		opts.State = customjobs.JobState(strings.ToLower(*args.State))
+		if !opts.State.Valid() {
+			return nil, errors.Newf("invalid custom job state %q", *args.State)
+		}
+	}
+
+	jobs, err := customjobs.NewStore(r.db).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var next *string
+	if len(jobs) > int(args.First) {
+		jobs = jobs[:args.First]
+		cursor := strconv.Itoa(jobs[len(jobs)-1].ID)
+		next = &cursor
+	}
+
+	return &customJobConnectionResolver{db: r.db, gitserverClient: r.gitserverClient, jobs: jobs, next: next}, nil
+}
+
+func (r *schemaResolver) EnqueueCustomJob(ctx context.Context, args EnqueueCustomJobArgs) (*customJobResolver, error) {
+	user, err := auth.CurrentUser(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, auth.ErrNotAuthenticated
+	}
+
+	template, err := customjobs.TemplateByName(args.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	repoID, err := UnmarshalRepositoryID(args.Repository)
+	if err != nil {
+		return nil, err
+	}
+	// 🚨 SECURITY: Repos().Get only returns repositories visible to the user.
+	repo, err := r.db.Repos().Get(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := "HEAD"
+	if args.Revision != nil && *args.Revision != "" {
+		revision = *args.Revision
+	}
+	commit, err := r.gitserverClient.ResolveRevision(ctx, repo.Name, revision, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := customjobs.NewStore(r.db).Create(ctx, customjobs.CreateOpts{
+		Template:     *template,
+		RepositoryID: repo.ID,
+		Commit:       commit,
+		UserID:       user.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customJobResolver{db: r.db, gitserverClient: r.gitserverClient, job: job}, nil
+}
+
+func (r *schemaResolver) CancelCustomJob(ctx context.Context, args CancelCustomJobArgs) (*customJobResolver, error) {
+	id, err := unmarshalCustomJobID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Only site admins and the creator of the job can cancel it.
+	if _, err := customJobByID(ctx, r.db, r.gitserverClient, id); err != nil {
+		return nil, err
+	}
+
+	job, err := customjobs.NewStore(r.db).Cancel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &customJobResolver{db: r.db, gitserverClient: r.gitserverClient, job: job}, nil
+}
+
+// customJobByID returns the custom job with the given ID, if it is visible to
+// the current user.
+func customJobByID(ctx context.Context, db database.DB, gitserverClient gitserver.Client, id int) (*customJobResolver, error) {
+	user, err := auth.CurrentUser(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, auth.ErrNotAuthenticated
+	}
+
+	job, err := customjobs.NewStore(db).GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// 🚨 SECURITY: Users that are not site admins can only see their own jobs.
+	// Pretend the job doesn't exist so we don't leak its existence.
+	if !user.SiteAdmin && job.UserID != user.ID {
+		return nil, customjobs.ErrNotFound
+	}
+
+	return &customJobResolver{db: db, gitserverClient: gitserverClient, job: job}, nil
+}
+
+func customJobTemplateSteps(steps []*schema.CustomJobStep) []string {
+	runs := make([]string, 0, len(steps))
+	for _, s := range steps {
+		runs = append(runs, s.Run)
+	}
+	return runs
+}
+
+type customJobTemplateResolver struct {
+	name        string
+	description string
+	image       string
+	steps       []string
+}
+
+func (r *customJobTemplateResolver) Name() string { return r.name }
+
+func (r *customJobTemplateResolver) Description() *string {
+	if r.description == "" {
+		return nil
+	}
+	return &r.description
+}
+
+func (r *customJobTemplateResolver) Image() string { return r.image }
+
+func (r *customJobTemplateResolver) Steps() []string { return r.steps }
+
+type customJobConnectionResolver struct {
+	db              database.DB
+	gitserverClient gitserver.Client
+	jobs            []*customjobs.Job
+	next            *string
+}
+
+func (r *customJobConnectionResolver) Nodes() []*customJobResolver {
+	resolvers := make([]*customJobResolver, 0, len(r.jobs))
+	for _, job := range r.jobs {
+		resolvers = append(resolvers, &customJobResolver{db: r.db, gitserverClient: r.gitserverClient, job: job})
+	}
+	return resolvers
+}
+
+func (r *customJobConnectionResolver) PageInfo() *graphqlutil.PageInfo {
+	if r.next == nil {
+		return graphqlutil.HasNextPage(false)
+	}
+	return graphqlutil.NextPageCursor(*r.next)
+}
+
+type customJobResolver struct {
+	db              database.DB
+	gitserverClient gitserver.Client
+	job             *customjobs.Job
+}
+
+func (r *customJobResolver) ID() graphql.ID {
+	return marshalCustomJobID(r.job.ID)
+}
+
+func (r *customJobResolver) Template() string {
+	return r.job.Template
+}
+
+func (r *customJobResolver) Repository(ctx context.Context) (*RepositoryResolver, error) {
+	repo, err := r.db.Repos().Get(ctx, r.job.RepositoryID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return NewRepositoryResolver(r.db, r.gitserverClient, repo), nil
+}
+
+func (r *customJobResolver) Commit() string {
+	return string(r.job.Commit)
+}
+
+func (r *customJobResolver) Creator(ctx context.Context) (*UserResolver, error) {
+	if r.job.UserID == 0 {
+		return nil, nil
+	}
+	user, err := UserByIDInt32(ctx, r.db, r.job.UserID)
+	if errcode.IsNotFound(err) {
+		return nil, nil
+	}
+	return user, err
+}
+
+func (r *customJobResolver) State() string {
+	return strings.ToUpper(string(r.job.State))
+}
+
+func (r *customJobResolver) FailureMessage() *string {
+	return r.job.FailureMessage
+}
+
+func (r *customJobResolver) QueuedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.job.QueuedAt}
+}
+
+func (r *customJobResolver) StartedAt() *gqlutil.DateTime {
+	return gqlutil.FromTime(r.job.StartedAt)
+}
+
+func (r *customJobResolver) FinishedAt() *gqlutil.DateTime {
+	return gqlutil.FromTime(r.job.FinishedAt)
+}
+
+func (r *customJobResolver) ExecutionLogs() []ExecutionLogEntryResolver {
+	resolvers := make([]ExecutionLogEntryResolver, 0, len(r.job.ExecutionLogs))
+	for _, entry := range r.job.ExecutionLogs {
+		resolvers = append(resolvers, NewExecutionLogEntryResolver(r.db, entry))
+	}
+	return resolvers
+}
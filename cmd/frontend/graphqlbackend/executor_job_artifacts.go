@@ -0,0 +1,64 @@
+package graphqlbackend
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
+	"github.com/sourcegraph/sourcegraph/internal/executor/artifacts"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+type ExecutorJobArtifactsArgs struct {
+	Queue string
+	Job   int32
+}
+
+func (r *schemaResolver) ExecutorJobArtifacts(ctx context.Context, args ExecutorJobArtifactsArgs) ([]*executorJobArtifactResolver, error) {
+	// 🚨 SECURITY: Only site admins may list the artifacts of arbitrary jobs.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	return executorJobArtifacts(ctx, artifacts.NewStore(r.db.Handle()), args.Queue, int(args.Job))
+}
+
+func (r *customJobResolver) Artifacts(ctx context.Context) ([]*executorJobArtifactResolver, error) {
+	return executorJobArtifacts(ctx, artifacts.NewStore(r.db.Handle()), customjobs.QueueName, r.job.ID)
+}
+
+func executorJobArtifacts(ctx context.Context, store artifacts.Store, queue string, jobID int) ([]*executorJobArtifactResolver, error) {
+	as, err := store.List(ctx, queue, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*executorJobArtifactResolver, 0, len(as))
+	for _, a := range as {
+		resolvers = append(resolvers, &executorJobArtifactResolver{artifact: a})
+	}
+	return resolvers, nil
+}
+
+type executorJobArtifactResolver struct {
+	artifact *artifacts.Artifact
+}
+
+func (r *executorJobArtifactResolver) Name() string {
+	return r.artifact.Name
+}
+
+func (r *executorJobArtifactResolver) Size() BigInt {
+	return BigInt(r.artifact.Size)
+}
+
+func (r *executorJobArtifactResolver) UploadedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.artifact.UpdatedAt}
+}
+
+func (r *executorJobArtifactResolver) DownloadURL() (string, error) {
+	return url.JoinPath(conf.Get().ExternalURL, fmt.Sprintf("/.api/executors/artifacts/%d", r.artifact.ID))
+}
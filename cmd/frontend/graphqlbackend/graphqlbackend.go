@@ -435,6 +435,7 @@ func NewSchema(
 	schemas := []string{
 		mainSchema,
 		outboundWebhooksSchema,
+		customJobsSchema,
 	}
 
 	for _, optional := range optionals {
@@ -767,6 +768,13 @@ func newSchemaResolver(db database.DB, gitserverClient gitserver.Client) *schema
 		outboundWebhookIDKind: func(ctx context.Context, id graphql.ID) (Node, error) {
 			return OutboundWebhookByID(ctx, db, id)
 		},
+		customJobIDKind: func(ctx context.Context, id graphql.ID) (Node, error) {
+			jobID, err := unmarshalCustomJobID(id)
+			if err != nil {
+				return nil, err
+			}
+			return customJobByID(ctx, db, r.gitserverClient, jobID)
+		},
 		roleIDKind: func(ctx context.Context, id graphql.ID) (Node, error) {
 			return r.roleByID(ctx, id)
 		},
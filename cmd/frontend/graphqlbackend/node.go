@@ -342,6 +342,11 @@ func (r *NodeResolver) ToOutboundWebhook() (OutboundWebhookResolver, bool) {
 	return n, ok
 }
 
+func (r *NodeResolver) ToCustomJob() (*customJobResolver, bool) {
+	n, ok := r.Node.(*customJobResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToTeam() (*TeamResolver, bool) {
 	n, ok := r.Node.(*TeamResolver)
 	return n, ok
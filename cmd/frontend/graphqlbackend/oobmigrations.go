@@ -2,6 +2,7 @@ package graphqlbackend
 
 import (
 	"context"
+	"time"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
@@ -9,6 +10,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/oobmigration"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // OutOfBandMigrationByID resolves a single out-of-band migration by its identifier.
@@ -23,12 +25,13 @@ func (r *schemaResolver) OutOfBandMigrationByID(ctx context.Context, id graphql.
 		return nil, err
 	}
 
-	migration, exists, err := oobmigration.NewStoreWithDB(r.db).GetByID(ctx, int(migrationID))
+	store := oobmigration.NewStoreWithDB(r.db)
+	migration, exists, err := store.GetByID(ctx, int(migrationID))
 	if err != nil || !exists {
 		return nil, err
 	}
 
-	return &outOfBandMigrationResolver{migration}, nil
+	return &outOfBandMigrationResolver{store: store, m: migration}, nil
 }
 
 // OutOfBandMigrations resolves all registered single out-of-band migrations.
@@ -38,14 +41,15 @@ func (r *schemaResolver) OutOfBandMigrations(ctx context.Context) ([]*outOfBandM
 		return nil, err
 	}
 
-	migrations, err := oobmigration.NewStoreWithDB(r.db).List(ctx)
+	store := oobmigration.NewStoreWithDB(r.db)
+	migrations, err := store.List(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	resolvers := make([]*outOfBandMigrationResolver, 0, len(migrations))
 	for i := range migrations {
-		resolvers = append(resolvers, &outOfBandMigrationResolver{migrations[i]})
+		resolvers = append(resolvers, &outOfBandMigrationResolver{store: store, m: migrations[i]})
 	}
 
 	return resolvers, nil
@@ -73,6 +77,59 @@ func (r *schemaResolver) SetMigrationDirection(ctx context.Context, args *struct
 	return &EmptyResponse{}, nil
 }
 
+// SetMigrationPaused pauses or resumes an out-of-band migration by identifier.
+func (r *schemaResolver) SetMigrationPaused(ctx context.Context, args *struct {
+	ID     graphql.ID
+	Paused bool
+}) (*EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins may modify out-of-band migrations
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	migrationID, err := UnmarshalOutOfBandMigrationID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := oobmigration.NewStoreWithDB(r.db).UpdatePaused(ctx, int(migrationID), args.Paused); err != nil {
+		return nil, err
+	}
+
+	return &EmptyResponse{}, nil
+}
+
+// SetMigrationThrottle sets the minimum interval between invocations of an out-of-band migration
+// by identifier. A null interval removes the throttle.
+func (r *schemaResolver) SetMigrationThrottle(ctx context.Context, args *struct {
+	ID              graphql.ID
+	IntervalSeconds *int32
+}) (*EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins may modify out-of-band migrations
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	migrationID, err := UnmarshalOutOfBandMigrationID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var interval time.Duration
+	if args.IntervalSeconds != nil {
+		if *args.IntervalSeconds < 0 {
+			return nil, errors.New("intervalSeconds must not be negative")
+		}
+		interval = time.Duration(*args.IntervalSeconds) * time.Second
+	}
+
+	if err := oobmigration.NewStoreWithDB(r.db).UpdateThrottleInterval(ctx, int(migrationID), interval); err != nil {
+		return nil, err
+	}
+
+	return &EmptyResponse{}, nil
+}
+
 // MarshalOutOfBandMigrationID converts an internal out of band migration id into a GraphQL id.
 func MarshalOutOfBandMigrationID(id int32) graphql.ID {
 	return relay.MarshalID("OutOfBandMigration", id)
@@ -86,7 +143,8 @@ func UnmarshalOutOfBandMigrationID(id graphql.ID) (migrationID int32, err error)
 
 // outOfBandMigrationResolver implements the GraphQL type OutOfBandMigration.
 type outOfBandMigrationResolver struct {
-	m oobmigration.Migration
+	store *oobmigration.Store
+	m     oobmigration.Migration
 }
 
 func (r *outOfBandMigrationResolver) ID() graphql.ID {
@@ -114,6 +172,25 @@ func (r *outOfBandMigrationResolver) LastUpdated() *gqlutil.DateTime {
 }
 func (r *outOfBandMigrationResolver) NonDestructive() bool { return r.m.NonDestructive }
 func (r *outOfBandMigrationResolver) ApplyReverse() bool   { return r.m.ApplyReverse }
+func (r *outOfBandMigrationResolver) Paused() bool         { return r.m.Paused }
+
+func (r *outOfBandMigrationResolver) ThrottleIntervalSeconds() *int32 {
+	if r.m.ThrottleInterval == 0 {
+		return nil
+	}
+
+	seconds := int32(r.m.ThrottleInterval / time.Second)
+	return &seconds
+}
+
+func (r *outOfBandMigrationResolver) EstimatedCompletion(ctx context.Context) (*gqlutil.DateTime, error) {
+	remaining, ok, err := r.store.EstimateRemaining(ctx, r.m)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &gqlutil.DateTime{Time: time.Now().Add(remaining)}, nil
+}
 
 func (r *outOfBandMigrationResolver) Errors() []*outOfBandMigrationErrorResolver {
 	resolvers := make([]*outOfBandMigrationErrorResolver, 0, len(r.m.Errors))
@@ -6,7 +6,6 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
-	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
@@ -16,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/settings"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 // Refer to SearchQueryOutputPhase in GQL definitions.
@@ -59,17 +59,29 @@ func (r *schemaResolver) ParseSearchQuery(ctx context.Context, args *args) (stri
 		searchType = query.SearchTypeLiteral
 	}
 
+	settings, err := settings.CurrentUserFinal(ctx, r.db)
+	if err != nil {
+		return "", err
+	}
+
+	// Print the query with the macros defined in the settings of the current
+	// user expanded, as it is run by search.
+	expandedQuery, err := query.ExpandMacros(args.Query, query.ParseMacros(settings.SearchMacros))
+	if err != nil {
+		return "", err
+	}
+
 	switch args.OutputPhase {
 	case ParseTree:
-		return outputParseTree(searchType, args)
+		return outputParseTree(searchType, expandedQuery, args)
 	case JobTree:
-		return outputJobTree(ctx, searchType, args, r.db, r.logger)
+		return outputJobTree(ctx, searchType, expandedQuery, args, settings, r.logger)
 	}
 	return "", nil
 }
 
-func outputParseTree(searchType query.SearchType, args *args) (string, error) {
-	plan, err := query.Pipeline(query.Init(args.Query, searchType))
+func outputParseTree(searchType query.SearchType, expandedQuery string, args *args) (string, error) {
+	plan, err := query.Pipeline(query.Init(expandedQuery, searchType))
 	if err != nil {
 		return "", err
 	}
@@ -87,16 +99,12 @@ func outputParseTree(searchType query.SearchType, args *args) (string, error) {
 func outputJobTree(
 	ctx context.Context,
 	searchType query.SearchType,
+	expandedQuery string,
 	args *args,
-	db database.DB,
+	settings *schema.Settings,
 	logger log.Logger,
 ) (string, error) {
-	plan, err := query.Pipeline(query.Init(args.Query, searchType))
-	if err != nil {
-		return "", err
-	}
-
-	settings, err := settings.CurrentUserFinal(ctx, db)
+	plan, err := query.Pipeline(query.Init(expandedQuery, searchType))
 	if err != nil {
 		return "", err
 	}
@@ -269,10 +269,11 @@ func (r *repositoryMirrorInfoResolver) UpdateSchedule(ctx context.Context) (*upd
 	if info.Schedule == nil {
 		return nil, nil
 	}
-	return &updateScheduleResolver{schedule: info.Schedule}, nil
+	return &updateScheduleResolver{db: r.db, schedule: info.Schedule}, nil
 }
 
 type updateScheduleResolver struct {
+	db       database.DB
 	schedule *repoupdaterprotocol.RepoScheduleState
 }
 
@@ -292,6 +293,49 @@ func (r *updateScheduleResolver) Total() int32 {
 	return int32(r.schedule.Total)
 }
 
+func (r *updateScheduleResolver) Policy() *updateSchedulingPolicyResolver {
+	if r.schedule.Policy == nil {
+		return nil
+	}
+	return &updateSchedulingPolicyResolver{db: r.db, policy: r.schedule.Policy}
+}
+
+type updateSchedulingPolicyResolver struct {
+	db     database.DB
+	policy *repoupdaterprotocol.RepoSchedulePolicy
+}
+
+func (r *updateSchedulingPolicyResolver) ExternalService(ctx context.Context) (*externalServiceResolver, error) {
+	return externalServiceByID(ctx, r.db, MarshalExternalServiceID(r.policy.ExternalServiceID))
+}
+
+func (r *updateSchedulingPolicyResolver) Pattern() string {
+	return r.policy.Pattern
+}
+
+func (r *updateSchedulingPolicyResolver) MinIntervalSeconds() int32 {
+	return int32(r.policy.MinIntervalSeconds)
+}
+
+func (r *updateSchedulingPolicyResolver) MaxIntervalSeconds() int32 {
+	return int32(r.policy.MaxIntervalSeconds)
+}
+
+func (r *updateSchedulingPolicyResolver) Priority() string {
+	return r.policy.Priority
+}
+
+func (r *updateSchedulingPolicyResolver) QuietHours() []string {
+	if r.policy.QuietHours == nil {
+		return []string{}
+	}
+	return r.policy.QuietHours
+}
+
+func (r *updateSchedulingPolicyResolver) Timezone() string {
+	return r.policy.Timezone
+}
+
 func (r *repositoryMirrorInfoResolver) UpdateQueue(ctx context.Context) (*updateQueueResolver, error) {
 	info, err := r.repoUpdateSchedulerInfo(ctx)
 	if err != nil {
@@ -70,6 +70,11 @@ var insightsAggregationsSchema string
 //go:embed outbound_webhooks.graphql
 var outboundWebhooksSchema string
 
+// customJobsSchema is the custom jobs raw GraphQL schema.
+//
+//go:embed custom_jobs.graphql
+var customJobsSchema string
+
 // embeddingsSchema is the Embeddings raw graqhql schema.
 //
 //go:embed embeddings.graphql
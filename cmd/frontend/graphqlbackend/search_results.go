@@ -436,7 +436,7 @@ var (
 )
 
 func (r *searchResolver) Stats(ctx context.Context) (stats *searchResultsStats, err error) {
-	cacheKey := r.SearchInputs.OriginalQuery
+	cacheKey := r.SearchInputs.ExpandedQuery
 	// Check if value is in the cache.
 	jsonRes, ok := searchResultsStatsCache.Get(cacheKey)
 	if ok {
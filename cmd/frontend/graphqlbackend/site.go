@@ -551,7 +551,8 @@ func (r *upgradeReadinessResolver) RequiredOutOfBandMigrations(ctx context.Conte
 		return nil, errors.Errorf("invalid latest update version %q", updateStatus.UpdateVersion)
 	}
 
-	migrations, err := oobmigration.NewStoreWithDB(r.db).List(ctx)
+	store := oobmigration.NewStoreWithDB(r.db)
+	migrations, err := store.List(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -559,7 +560,7 @@ func (r *upgradeReadinessResolver) RequiredOutOfBandMigrations(ctx context.Conte
 	var requiredMigrations []*outOfBandMigrationResolver
 	for _, m := range migrations {
 		if isRequiredOutOfBandMigration(version, m) {
-			requiredMigrations = append(requiredMigrations, &outOfBandMigrationResolver{m})
+			requiredMigrations = append(requiredMigrations, &outOfBandMigrationResolver{store: store, m: m})
 		}
 	}
 	return requiredMigrations, nil
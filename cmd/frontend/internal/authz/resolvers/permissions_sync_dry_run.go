@@ -0,0 +1,136 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/licensing"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (r *Resolver) PermissionsSyncDryRun(ctx context.Context, args *graphqlbackend.PermissionsSyncDryRunArgs) (graphqlbackend.PermissionsSyncDryRunResolver, error) {
+	if err := r.checkLicense(licensing.FeatureACLs); err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Only site admins can compare permissions of other users and
+	// repositories.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	var req protocol.PermsSyncDryRunArgs
+	switch {
+	case args.User != nil && args.Repository != nil:
+		return nil, errors.New("please provide either user or repository, but not both")
+	case args.User != nil:
+		userID, err := graphqlbackend.UnmarshalUserID(*args.User)
+		if err != nil {
+			return nil, err
+		}
+		req.UserID = userID
+	case args.Repository != nil:
+		repoID, err := graphqlbackend.UnmarshalRepositoryID(*args.Repository)
+		if err != nil {
+			return nil, err
+		}
+		req.RepoID = repoID
+	default:
+		return nil, errors.New("please provide either user or repository")
+	}
+
+	result, err := repoupdater.DefaultClient.PermsSyncDryRun(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &permissionsSyncDryRunResolver{
+		db:     r.db,
+		isUser: req.UserID != 0,
+		result: result,
+	}, nil
+}
+
+type permissionsSyncDryRunResolver struct {
+	db database.DB
+	// isUser is true when the IDs of the result are repository IDs.
+	isUser bool
+	result *protocol.PermsSyncDryRunResult
+}
+
+func (r *permissionsSyncDryRunResolver) AddedRepositories(ctx context.Context) ([]*graphqlbackend.RepositoryResolver, error) {
+	if !r.isUser {
+		return []*graphqlbackend.RepositoryResolver{}, nil
+	}
+	return r.repositories(ctx, r.result.Added)
+}
+
+func (r *permissionsSyncDryRunResolver) RemovedRepositories(ctx context.Context) ([]*graphqlbackend.RepositoryResolver, error) {
+	if !r.isUser {
+		return []*graphqlbackend.RepositoryResolver{}, nil
+	}
+	return r.repositories(ctx, r.result.Removed)
+}
+
+func (r *permissionsSyncDryRunResolver) AddedUsers(ctx context.Context) ([]*graphqlbackend.UserResolver, error) {
+	if r.isUser {
+		return []*graphqlbackend.UserResolver{}, nil
+	}
+	return r.users(ctx, r.result.Added)
+}
+
+func (r *permissionsSyncDryRunResolver) RemovedUsers(ctx context.Context) ([]*graphqlbackend.UserResolver, error) {
+	if r.isUser {
+		return []*graphqlbackend.UserResolver{}, nil
+	}
+	return r.users(ctx, r.result.Removed)
+}
+
+func (r *permissionsSyncDryRunResolver) UnchangedCount() int32 {
+	return int32(r.result.Unchanged)
+}
+
+func (r *permissionsSyncDryRunResolver) repositories(ctx context.Context, ids []int32) ([]*graphqlbackend.RepositoryResolver, error) {
+	resolvers := []*graphqlbackend.RepositoryResolver{}
+	if len(ids) == 0 {
+		return resolvers, nil
+	}
+
+	repoIDs := make([]api.RepoID, len(ids))
+	for i, id := range ids {
+		repoIDs[i] = api.RepoID(id)
+	}
+	repos, err := r.db.Repos().GetByIDs(ctx, repoIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	client := gitserver.NewClient()
+	for _, repo := range repos {
+		resolvers = append(resolvers, graphqlbackend.NewRepositoryResolver(r.db, client, repo))
+	}
+	return resolvers, nil
+}
+
+func (r *permissionsSyncDryRunResolver) users(ctx context.Context, ids []int32) ([]*graphqlbackend.UserResolver, error) {
+	resolvers := []*graphqlbackend.UserResolver{}
+	if len(ids) == 0 {
+		return resolvers, nil
+	}
+
+	users, err := r.db.Users().List(ctx, &database.UsersListOptions{UserIDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		resolvers = append(resolvers, graphqlbackend.NewUserResolver(ctx, r.db, user))
+	}
+	return resolvers, nil
+}
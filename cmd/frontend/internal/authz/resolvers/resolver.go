@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/authz/permssync"
+	"github.com/sourcegraph/sourcegraph/internal/authz/subrepoperms"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
@@ -301,6 +302,69 @@ func (r *Resolver) SetSubRepositoryPermissionsForUsers(ctx context.Context, args
 	return &graphqlbackend.EmptyResponse{}, err
 }
 
+func (r *Resolver) SetSubRepositoryPermissionsACL(ctx context.Context, args *graphqlbackend.SubRepoPermsACLArgs) (*graphqlbackend.EmptyResponse, error) {
+	if err := r.checkLicense(licensing.FeatureExplicitPermissionsAPI); err != nil {
+		return nil, err
+	}
+	if envvar.SourcegraphDotComMode() {
+		return nil, errDisabledSourcegraphDotCom
+	}
+
+	// 🚨 SECURITY: Only site admins can mutate repository permissions.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	repoID, err := graphqlbackend.UnmarshalRepositoryID(args.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the repo ID is valid.
+	if _, err := r.db.Repos().Get(ctx, repoID); err != nil {
+		return nil, err
+	}
+
+	if _, err := subrepoperms.ParseACL([]byte(args.ACL)); err != nil {
+		return nil, errors.Wrap(err, "invalid ACL file")
+	}
+
+	if err := r.db.SubRepoPerms().UpsertACL(ctx, &database.SubRepoPermsACL{
+		RepoID:   repoID,
+		Contents: args.ACL,
+		Source:   database.SubRepoPermsACLSourceUpload,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+func (r *Resolver) DeleteSubRepositoryPermissionsACL(ctx context.Context, args *graphqlbackend.RepositoryIDArgs) (*graphqlbackend.EmptyResponse, error) {
+	if err := r.checkLicense(licensing.FeatureExplicitPermissionsAPI); err != nil {
+		return nil, err
+	}
+	if envvar.SourcegraphDotComMode() {
+		return nil, errDisabledSourcegraphDotCom
+	}
+
+	// 🚨 SECURITY: Only site admins can mutate repository permissions.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	repoID, err := graphqlbackend.UnmarshalRepositoryID(args.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.db.SubRepoPerms().DeleteACL(ctx, repoID, database.SubRepoPermsACLSourceUpload); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func (r *Resolver) SetRepositoryPermissionsForBitbucketProject(
 	ctx context.Context, args *graphqlbackend.RepoPermsBitbucketProjectArgs,
 ) (*graphqlbackend.EmptyResponse, error) {
@@ -33,6 +33,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/licensing"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -1816,6 +1817,119 @@ func TestResolver_SetSubRepositoryPermissionsForUsers(t *testing.T) {
 	})
 }
 
+func TestResolver_SetSubRepositoryPermissionsACL(t *testing.T) {
+	t.Cleanup(licensing.TestingSkipFeatureChecks())
+
+	t.Run("authenticated as non-admin", func(t *testing.T) {
+		users := dbmocks.NewStrictMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{}, nil)
+
+		db := dbmocks.NewStrictMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+		result, err := (&Resolver{db: db}).SetSubRepositoryPermissionsACL(ctx, &graphqlbackend.SubRepoPermsACLArgs{})
+		if want := auth.ErrMustBeSiteAdmin; err != want {
+			t.Errorf("err: want %q but got %v", want, err)
+		}
+		if result != nil {
+			t.Errorf("result: want nil but got %v", result)
+		}
+	})
+
+	t.Run("set and delete ACL", func(t *testing.T) {
+		usersStore := dbmocks.NewStrictMockUserStore()
+		usersStore.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{
+			ID:        1,
+			SiteAdmin: true,
+		}, nil)
+
+		subReposStore := dbmocks.NewStrictMockSubRepoPermsStore()
+		subReposStore.UpsertACLFunc.SetDefaultReturn(nil)
+		subReposStore.DeleteACLFunc.SetDefaultReturn(nil)
+
+		reposStore := dbmocks.NewStrictMockRepoStore()
+		reposStore.GetFunc.SetDefaultReturn(&types.Repo{ID: 1, Name: "foo"}, nil)
+
+		db := dbmocks.NewStrictMockDB()
+		db.UsersFunc.SetDefaultReturn(usersStore)
+		db.SubRepoPermsFunc.SetDefaultReturn(subReposStore)
+		db.ReposFunc.SetDefaultReturn(reposStore)
+
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+		graphqlbackend.RunTests(t, []*graphqlbackend.Test{
+			{
+				Context: ctx,
+				Schema:  mustParseGraphQLSchema(t, db),
+				Query: `
+						mutation {
+  setSubRepositoryPermissionsACL(repository: "UmVwb3NpdG9yeTox", acl: "/secrets/ @alice") {
+    alwaysNil
+  }
+}
+					`,
+				ExpectedResult: `
+						{
+							"setSubRepositoryPermissionsACL": {
+								"alwaysNil": null
+							}
+						}
+					`,
+			},
+			{
+				Context: ctx,
+				Schema:  mustParseGraphQLSchema(t, db),
+				Query: `
+						mutation {
+  setSubRepositoryPermissionsACL(repository: "UmVwb3NpdG9yeTox", acl: "/secrets//keys @alice") {
+    alwaysNil
+  }
+}
+					`,
+				ExpectedErrors: []*gqlerrors.QueryError{
+					{
+						Message: `invalid ACL file: line 1: invalid pattern "/secrets//keys": two consecutive forward slashes`,
+						Path:    []any{"setSubRepositoryPermissionsACL"},
+					},
+				},
+				ExpectedResult: "null",
+			},
+			{
+				Context: ctx,
+				Schema:  mustParseGraphQLSchema(t, db),
+				Query: `
+						mutation {
+  deleteSubRepositoryPermissionsACL(repository: "UmVwb3NpdG9yeTox") {
+    alwaysNil
+  }
+}
+					`,
+				ExpectedResult: `
+						{
+							"deleteSubRepositoryPermissionsACL": {
+								"alwaysNil": null
+							}
+						}
+					`,
+			},
+		})
+
+		upserts := subReposStore.UpsertACLFunc.History()
+		require.Len(t, upserts, 1)
+		require.Equal(t, &database.SubRepoPermsACL{
+			RepoID:   1,
+			Contents: "/secrets/ @alice",
+			Source:   database.SubRepoPermsACLSourceUpload,
+		}, upserts[0].Arg1)
+
+		deletes := subReposStore.DeleteACLFunc.History()
+		require.Len(t, deletes, 1)
+		require.Equal(t, api.RepoID(1), deletes[0].Arg1)
+		require.Equal(t, database.SubRepoPermsACLSourceUpload, deletes[0].Arg2)
+	})
+}
+
 func TestResolver_BitbucketProjectPermissionJobs(t *testing.T) {
 	t.Run("disabled on dotcom", func(t *testing.T) {
 		envvar.MockSourcegraphDotComMode(true)
@@ -2704,3 +2818,84 @@ func TestResolver_PermissionsSyncingStats(t *testing.T) {
 		graphqlbackend.RunTests(t, gqlTests)
 	})
 }
+
+func TestResolver_PermissionsSyncDryRun(t *testing.T) {
+	t.Cleanup(licensing.TestingSkipFeatureChecks())
+
+	t.Run("authenticated as non-admin", func(t *testing.T) {
+		users := dbmocks.NewStrictMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{}, nil)
+
+		db := dbmocks.NewStrictMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+		result, err := (&Resolver{db: db}).PermissionsSyncDryRun(ctx, &graphqlbackend.PermissionsSyncDryRunArgs{})
+		if want := auth.ErrMustBeSiteAdmin; err != want {
+			t.Errorf("err: want %q but got %v", want, err)
+		}
+		if result != nil {
+			t.Errorf("result: want nil but got %v", result)
+		}
+	})
+
+	t.Run("repository", func(t *testing.T) {
+		users := dbmocks.NewStrictMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: true}, nil)
+		users.ListFunc.SetDefaultHook(func(_ context.Context, opts *database.UsersListOptions) ([]*types.User, error) {
+			all := map[int32]*types.User{2: {ID: 2, Username: "alice"}, 3: {ID: 3, Username: "bob"}}
+			var users []*types.User
+			for _, id := range opts.UserIDs {
+				users = append(users, all[id])
+			}
+			return users, nil
+		})
+
+		db := dbmocks.NewStrictMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+
+		repoupdater.MockPermsSyncDryRun = func(_ context.Context, args protocol.PermsSyncDryRunArgs) (*protocol.PermsSyncDryRunResult, error) {
+			assert.Equal(t, protocol.PermsSyncDryRunArgs{RepoID: 1}, args)
+			return &protocol.PermsSyncDryRunResult{Added: []int32{2}, Removed: []int32{3}, Unchanged: 5}, nil
+		}
+		t.Cleanup(func() { repoupdater.MockPermsSyncDryRun = nil })
+
+		graphqlbackend.RunTests(t, []*graphqlbackend.Test{{
+			Context: actor.WithActor(context.Background(), &actor.Actor{UID: 1}),
+			Schema:  mustParseGraphQLSchema(t, db),
+			Query: `
+				query {
+					permissionsSyncDryRun(repository: "UmVwb3NpdG9yeTox") {
+						addedUsers { username }
+						removedUsers { username }
+						addedRepositories { name }
+						unchangedCount
+					}
+				}
+			`,
+			ExpectedResult: `
+				{
+					"permissionsSyncDryRun": {
+						"addedUsers": [{"username": "alice"}],
+						"removedUsers": [{"username": "bob"}],
+						"addedRepositories": [],
+						"unchangedCount": 5
+					}
+				}
+			`,
+		}})
+	})
+
+	t.Run("both user and repository", func(t *testing.T) {
+		users := dbmocks.NewStrictMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: true}, nil)
+
+		db := dbmocks.NewStrictMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+		user, repo := graphql.ID("VXNlcjox"), graphql.ID("UmVwb3NpdG9yeTox")
+		_, err := (&Resolver{db: db}).PermissionsSyncDryRun(ctx, &graphqlbackend.PermissionsSyncDryRunArgs{User: &user, Repository: &repo})
+		require.Error(t, err)
+	})
+}
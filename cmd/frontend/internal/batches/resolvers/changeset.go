@@ -52,6 +52,11 @@ type changesetResolver struct {
 	specOnce sync.Once
 	spec     *btypes.ChangesetSpec
 	specErr  error
+
+	// cache the changeset this changeset depends on
+	dependencyOnce sync.Once
+	dependency     *btypes.Changeset
+	dependencyErr  error
 }
 
 func NewChangesetResolverWithNextSync(store *store.Store, gitserverClient gitserver.Client, logger log.Logger, changeset *btypes.Changeset, repo *types.Repo, nextSyncAt time.Time) *changesetResolver {
@@ -112,6 +117,32 @@ func (r *changesetResolver) computeSpec(ctx context.Context) (*btypes.ChangesetS
 	return r.spec, r.specErr
 }
 
+// computeDependency returns the changeset of the same batch change that the
+// current spec of this changeset depends on. It returns nil if the spec doesn't
+// depend on another changeset or if the dependency couldn't be found.
+func (r *changesetResolver) computeDependency(ctx context.Context) (*btypes.Changeset, error) {
+	r.dependencyOnce.Do(func() {
+		spec, err := r.computeSpec(ctx)
+		if err != nil {
+			r.dependencyErr = err
+			return
+		}
+		if spec.DependsOn == "" {
+			return
+		}
+
+		r.dependency, err = r.store.GetChangeset(ctx, store.GetChangesetOpts{
+			OwnedByBatchChangeID: r.changeset.OwnedByBatchChangeID,
+			RepoID:               r.changeset.RepoID,
+			SpecHeadRef:          spec.DependsOn,
+		})
+		if err != nil && err != store.ErrNoResults {
+			r.dependencyErr = err
+		}
+	})
+	return r.dependency, r.dependencyErr
+}
+
 func (r *changesetResolver) computeNextSyncAt(ctx context.Context) (time.Time, error) {
 	r.nextSyncAtOnce.Do(func() {
 		if r.attemptedPreloadNextSyncAt {
@@ -434,6 +465,41 @@ func (r *changesetResolver) CurrentSpec(ctx context.Context) (graphqlbackend.Vis
 	return NewChangesetSpecResolverWithRepo(r.store, r.repo, spec), nil
 }
 
+func (r *changesetResolver) DependsOn(ctx context.Context) (graphqlbackend.ExternalChangesetResolver, error) {
+	if r.changeset.CurrentSpecID == 0 {
+		return nil, nil
+	}
+
+	dependency, err := r.computeDependency(ctx)
+	if err != nil || dependency == nil {
+		return nil, err
+	}
+
+	return NewChangesetResolver(r.store, r.gitserverClient, r.logger, dependency, r.repo), nil
+}
+
+func (r *changesetResolver) StackState(ctx context.Context) (*string, error) {
+	if r.changeset.CurrentSpecID == 0 {
+		return nil, nil
+	}
+
+	spec, err := r.computeSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if spec.DependsOn == "" {
+		return nil, nil
+	}
+
+	dependency, err := r.computeDependency(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stackState := string(state.ComputeStackState(dependency))
+	return &stackState, nil
+}
+
 func (r *changesetResolver) Labels(ctx context.Context) ([]graphqlbackend.ChangesetLabelResolver, error) {
 	if !r.changeset.Published() {
 		return []graphqlbackend.ChangesetLabelResolver{}, nil
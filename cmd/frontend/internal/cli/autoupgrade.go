@@ -186,6 +186,16 @@ func runMigration(
 		)
 	}
 
+	if multiversion.EnvAutoUpgradeSnapshot != "" {
+		r, err := runnerFactory(schemas.SchemaNames, schemas.Schemas)
+		if err != nil {
+			return err
+		}
+		if _, err := multiversion.TakeSnapshot(ctx, obsvCtx, r, multiversion.EnvAutoUpgradeSnapshot, plan, out); err != nil {
+			return err
+		}
+	}
+
 	return multiversion.RunMigration(
 		ctx,
 		db,
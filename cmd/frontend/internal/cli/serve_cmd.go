@@ -26,6 +26,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/httpapi"
 	oce "github.com/sourcegraph/sourcegraph/cmd/frontend/oneclickexport"
 	"github.com/sourcegraph/sourcegraph/internal/adminanalytics"
+	"github.com/sourcegraph/sourcegraph/internal/audit/dbsink"
 	"github.com/sourcegraph/sourcegraph/internal/auth/userpasswd"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
@@ -242,7 +243,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		return err
 	}
 
-	routines := []goroutine.BackgroundRoutine{server}
+	routines := []goroutine.BackgroundRoutine{server, dbsink.New(logger, db)}
 	if internalAPI != nil {
 		routines = append(routines, internalAPI)
 	}
@@ -289,10 +290,12 @@ func makeExternalAPI(db database.DB, logger sglog.Logger, schema *graphql.Schema
 			BatchesChangesFileUploadHandler: enterprise.BatchesChangesFileUploadHandler,
 			SCIMHandler:                     enterprise.SCIMHandler,
 			NewCodeIntelUploadHandler:       enterprise.NewCodeIntelUploadHandler,
+			SentinelBundleUploadHandler:     enterprise.SentinelBundleUploadHandler,
 			NewComputeStreamHandler:         enterprise.NewComputeStreamHandler,
 			CodeInsightsDataExportHandler:   enterprise.CodeInsightsDataExportHandler,
 			SearchJobsDataExportHandler:     enterprise.SearchJobsDataExportHandler,
 			SearchJobsLogsHandler:           enterprise.SearchJobsLogsHandler,
+			ExecutorArtifactDownloadHandler: enterprise.ExecutorArtifactDownloadHandler,
 			NewDotcomLicenseCheckHandler:    enterprise.NewDotcomLicenseCheckHandler,
 			NewChatCompletionsStreamHandler: enterprise.NewChatCompletionsStreamHandler,
 			NewCodeCompletionsHandler:       enterprise.NewCodeCompletionsHandler,
@@ -15,6 +15,7 @@ import (
 	rankinggraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/ranking/transport/graphql"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
 	sentinelgraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/transport/graphql"
+	sentinelhttp "github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/transport/http"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/lsifuploadstore"
 	sharedresolvers "github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
@@ -133,6 +134,7 @@ func Init(
 		rankingRootResolver,
 	))
 	enterpriseServices.NewCodeIntelUploadHandler = newUploadHandler
+	enterpriseServices.SentinelBundleUploadHandler = sentinelhttp.NewBundleUploadHandler(db, uploadStore)
 	enterpriseServices.RankingService = codeIntelServices.RankingService
 	return nil
 }
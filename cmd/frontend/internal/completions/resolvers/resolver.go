@@ -57,17 +57,14 @@ func (c *completionsResolver) Completions(ctx context.Context, args graphqlbacke
 		Build()
 	defer done()
 
-	client, err := client.Get(
-		completionsConfig.Endpoint,
-		completionsConfig.Provider,
-		completionsConfig.AccessToken,
-	)
+	client, err := client.GetFromConfig(completionsConfig)
 	if err != nil {
 		return "", errors.Wrap(err, "GetCompletionStreamClient")
 	}
 
 	// Check rate limit.
-	if err := c.rl.TryAcquire(ctx); err != nil {
+	commit, err := c.rl.TryAcquire(ctx)
+	if err != nil {
 		return "", err
 	}
 
@@ -78,6 +75,9 @@ func (c *completionsResolver) Completions(ctx context.Context, args graphqlbacke
 	if err != nil {
 		return "", errors.Wrap(err, "client.Complete")
 	}
+	if err := commit(params, resp); err != nil {
+		c.logger.Error("failed to record completions usage", log.Error(err))
+	}
 	return resp.Completion, nil
 }
 
@@ -0,0 +1,134 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/completions/httpapi"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (c *completionsResolver) CompletionsUsage(ctx context.Context, args graphqlbackend.CompletionsUsageArgs) (graphqlbackend.CompletionsUsageReportResolver, error) {
+	// 🚨 SECURITY: Only site admins can view the usage of other users.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, c.db); err != nil {
+		return nil, err
+	}
+
+	var period conftypes.CompletionsUsageBudgetPeriod
+	switch args.Period {
+	case "DAY":
+		period = conftypes.CompletionsUsageBudgetPeriodDay
+	case "MONTH":
+		period = conftypes.CompletionsUsageBudgetPeriodMonth
+	default:
+		return nil, errors.Newf("invalid period %q", args.Period)
+	}
+
+	report, err := httpapi.GetUsageReport(ctx, redispool.Store, period, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &usageReportResolver{db: c.db, report: report}, nil
+}
+
+type usageReportResolver struct {
+	db     database.DB
+	report *httpapi.UsageReport
+}
+
+func (r *usageReportResolver) Period() string {
+	return strings.ToUpper(string(r.report.Period))
+}
+
+func (r *usageReportResolver) PeriodStart() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.report.PeriodStart}
+}
+
+func (r *usageReportResolver) Site() graphqlbackend.CompletionsUsageResolver {
+	return &usageResolver{usage: r.report.Site}
+}
+
+func (r *usageReportResolver) Users(ctx context.Context) ([]graphqlbackend.CompletionsUserUsageResolver, error) {
+	resolvers := make([]graphqlbackend.CompletionsUserUsageResolver, 0, len(r.report.Users))
+	for _, u := range r.report.Users {
+		resolvers = append(resolvers, &userUsageResolver{db: r.db, usage: u})
+	}
+	return resolvers, nil
+}
+
+func (r *usageReportResolver) Orgs(ctx context.Context) ([]graphqlbackend.CompletionsOrgUsageResolver, error) {
+	resolvers := make([]graphqlbackend.CompletionsOrgUsageResolver, 0, len(r.report.Orgs))
+	for _, o := range r.report.Orgs {
+		resolvers = append(resolvers, &orgUsageResolver{db: r.db, usage: o})
+	}
+	return resolvers, nil
+}
+
+type usageResolver struct {
+	usage httpapi.Usage
+}
+
+func (r *usageResolver) Tokens() graphqlbackend.BigInt {
+	return graphqlbackend.BigInt(r.usage.Tokens)
+}
+
+func (r *usageResolver) CostUSD() float64 {
+	return r.usage.CostUSD
+}
+
+func (r *usageResolver) TokenBudget() *graphqlbackend.BigInt {
+	if r.usage.TokenBudget <= 0 {
+		return nil
+	}
+	budget := graphqlbackend.BigInt(r.usage.TokenBudget)
+	return &budget
+}
+
+func (r *usageResolver) CostBudgetUSD() *float64 {
+	if r.usage.CostBudgetUSD <= 0 {
+		return nil
+	}
+	return &r.usage.CostBudgetUSD
+}
+
+type userUsageResolver struct {
+	db    database.DB
+	usage httpapi.SubjectUsage
+}
+
+func (r *userUsageResolver) User(ctx context.Context) (*graphqlbackend.UserResolver, error) {
+	user, err := graphqlbackend.UserByIDInt32(ctx, r.db, r.usage.ID)
+	if errcode.IsNotFound(err) {
+		return nil, nil
+	}
+	return user, err
+}
+
+func (r *userUsageResolver) Usage() graphqlbackend.CompletionsUsageResolver {
+	return &usageResolver{usage: r.usage.Usage}
+}
+
+type orgUsageResolver struct {
+	db    database.DB
+	usage httpapi.SubjectUsage
+}
+
+func (r *orgUsageResolver) Org(ctx context.Context) (*graphqlbackend.OrgResolver, error) {
+	org, err := graphqlbackend.OrgByIDInt32(ctx, r.db, r.usage.ID)
+	if errcode.IsNotFound(err) {
+		return nil, nil
+	}
+	return org, err
+}
+
+func (r *orgUsageResolver) Usage() graphqlbackend.CompletionsUsageResolver {
+	return &usageResolver{usage: r.usage.Usage}
+}
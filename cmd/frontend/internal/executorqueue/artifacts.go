@@ -0,0 +1,150 @@
+package executorqueue
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/executor/artifacts"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// artifactUploadHandler stores the artifact uploaded by the executor running
+// the job. The job is authenticated by jobAuthMiddleware. Artifacts larger than
+// maxUploadSize bytes are rejected.
+func artifactUploadHandler(logger log.Logger, store artifacts.Store, uploadStore uploadstore.Store, maxUploadSize int64) http.Handler {
+	logger = logger.Scoped("artifacts", "executor job artifact uploads")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queue := mux.Vars(r)["queue"]
+		name := mux.Vars(r)["name"]
+		if !validArtifactName(name) {
+			http.Error(w, fmt.Sprintf("invalid artifact name %q", name), http.StatusBadRequest)
+			return
+		}
+		jobID, err := parseJobIdHeader(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		key := artifacts.ObjectKey(queue, int(jobID), name)
+		size, err := uploadStore.Upload(r.Context(), key, http.MaxBytesReader(w, r.Body, maxUploadSize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, fmt.Sprintf("artifact exceeds the maximum size of %d bytes", maxUploadSize), http.StatusRequestEntityTooLarge)
+				return
+			}
+			logger.Error("failed to upload artifact", log.String("key", key), log.Error(err))
+			http.Error(w, "failed to upload artifact", http.StatusInternalServerError)
+			return
+		}
+
+		if _, err := store.Upsert(r.Context(), artifacts.Artifact{
+			Queue:     queue,
+			JobID:     int(jobID),
+			Name:      name,
+			ObjectKey: key,
+			Size:      size,
+		}); err != nil {
+			logger.Error("failed to record artifact", log.String("key", key), log.Error(err))
+			http.Error(w, "failed to record artifact", http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// validArtifactName returns true if the name is a clean relative path that
+// does not point outside of the workspace of the job.
+func validArtifactName(name string) bool {
+	return name != "" &&
+		!strings.HasPrefix(name, "/") &&
+		path.Clean(name) == name &&
+		name != ".." &&
+		!strings.HasPrefix(name, "../")
+}
+
+// NewArtifactDownloadHandler returns a handler that serves the contents of the
+// executor job artifact with the ID in the URL.
+func NewArtifactDownloadHandler(logger log.Logger, db database.DB, store artifacts.Store, uploadStore uploadstore.Store) http.Handler {
+	logger = logger.Scoped("artifacts", "executor job artifact downloads")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(mux.Vars(r)["id"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		artifact, err := store.GetByID(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, artifacts.ErrNotFound) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			logger.Error("failed to get artifact", log.Int("id", id), log.Error(err))
+			http.Error(w, "failed to get artifact", http.StatusInternalServerError)
+			return
+		}
+
+		// 🚨 SECURITY: Artifacts may contain sensitive data, only users that can see
+		// the job may download them. Others get a 404 so that IDs can't be probed.
+		if err := checkArtifactAccess(r.Context(), db, artifact.Queue, artifact.JobID); err != nil {
+			http.Error(w, artifacts.ErrNotFound.Error(), http.StatusNotFound)
+			return
+		}
+
+		content, err := uploadStore.Get(r.Context(), artifact.ObjectKey)
+		if err != nil {
+			logger.Error("failed to get artifact contents", log.String("key", artifact.ObjectKey), log.Error(err))
+			http.Error(w, "failed to get artifact contents", http.StatusInternalServerError)
+			return
+		}
+		defer content.Close()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", path.Base(artifact.Name)))
+		w.Header().Set("Content-Length", strconv.FormatInt(artifact.Size, 10))
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.Copy(w, content); err != nil {
+			logger.Warn("failed to write artifact contents", log.String("key", artifact.ObjectKey), log.Error(err))
+		}
+	})
+}
+
+// checkArtifactAccess returns an error if the current user may not see the
+// artifacts of the given job. Site admins can see all artifacts, other users
+// only those of custom jobs they created.
+func checkArtifactAccess(ctx context.Context, db database.DB, queue string, jobID int) error {
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err == nil {
+		return nil
+	}
+
+	a := actor.FromContext(ctx)
+	if queue != customjobs.QueueName || !a.IsAuthenticated() {
+		return auth.ErrMustBeSiteAdmin
+	}
+	job, err := customjobs.NewStore(db).GetByID(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	if job.UserID != a.UID {
+		return auth.ErrMustBeSiteAdmin
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package executorqueue
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/executor/artifacts"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+)
+
+func TestArtifactUploadHandler(t *testing.T) {
+	store := artifacts.NewMockStore()
+	uploadStore := uploadstoremocks.NewMockStore()
+	uploadStore.UploadFunc.SetDefaultHook(func(ctx context.Context, key string, r io.Reader) (int64, error) {
+		b, err := io.ReadAll(r)
+		return int64(len(b)), err
+	})
+
+	router := mux.NewRouter()
+	router.Path("/artifacts/{queue}/{name:.+}").Handler(artifactUploadHandler(logtest.Scoped(t), store, uploadStore, 1024))
+
+	req := httptest.NewRequest(http.MethodPost, "/artifacts/customjobs/reports/lint.xml", strings.NewReader("hello"))
+	req.Header.Set("X-Sourcegraph-Job-ID", "42")
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, req)
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	require.Len(t, uploadStore.UploadFunc.History(), 1)
+	assert.Equal(t, "customjobs/42/reports/lint.xml", uploadStore.UploadFunc.History()[0].Arg1)
+	require.Len(t, store.UpsertFunc.History(), 1)
+	assert.Equal(t, artifacts.Artifact{
+		Queue:     "customjobs",
+		JobID:     42,
+		Name:      "reports/lint.xml",
+		ObjectKey: "customjobs/42/reports/lint.xml",
+		Size:      5,
+	}, store.UpsertFunc.History()[0].Arg1)
+}
+
+func TestArtifactUploadHandlerTooLarge(t *testing.T) {
+	store := artifacts.NewMockStore()
+	uploadStore := uploadstoremocks.NewMockStore()
+	uploadStore.UploadFunc.SetDefaultHook(func(ctx context.Context, key string, r io.Reader) (int64, error) {
+		b, err := io.ReadAll(r)
+		return int64(len(b)), err
+	})
+
+	router := mux.NewRouter()
+	router.Path("/artifacts/{queue}/{name:.+}").Handler(artifactUploadHandler(logtest.Scoped(t), store, uploadStore, 4))
+
+	req := httptest.NewRequest(http.MethodPost, "/artifacts/customjobs/report.txt", strings.NewReader("hello"))
+	req.Header.Set("X-Sourcegraph-Job-ID", "42")
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rw.Code)
+	assert.Empty(t, store.UpsertFunc.History())
+}
+
+func TestValidArtifactName(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"report.xml":         true,
+		"reports/lint.xml":   true,
+		"":                   false,
+		"/etc/passwd":        false,
+		"..":                 false,
+		"../report.xml":      false,
+		"reports/../lint.md": false,
+		"reports//lint.md":   false,
+	} {
+		assert.Equal(t, valid, validArtifactName(name), name)
+	}
+}
+
+func TestArtifactDownloadHandler(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultHook(func(ctx context.Context) (*types.User, error) {
+		a := actor.FromContext(ctx)
+		return &types.User{ID: a.UID, SiteAdmin: a.UID == 1}, nil
+	})
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	store := artifacts.NewMockStore()
+	store.GetByIDFunc.SetDefaultHook(func(ctx context.Context, id int) (*artifacts.Artifact, error) {
+		if id != 1 {
+			return nil, artifacts.ErrNotFound
+		}
+		return &artifacts.Artifact{ID: 1, Queue: "batches", JobID: 42, Name: "reports/lint.xml", ObjectKey: "batches/42/reports/lint.xml", Size: 5}, nil
+	})
+	uploadStore := uploadstoremocks.NewMockStore()
+	uploadStore.GetFunc.SetDefaultReturn(io.NopCloser(strings.NewReader("hello")), nil)
+
+	router := mux.NewRouter()
+	router.Path("/executors/artifacts/{id}").Handler(NewArtifactDownloadHandler(logtest.Scoped(t), db, store, uploadStore))
+
+	download := func(id string, userID int32) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/executors/artifacts/"+id, nil)
+		req = req.WithContext(actor.WithActor(req.Context(), actor.FromUser(userID)))
+		rw := httptest.NewRecorder()
+		router.ServeHTTP(rw, req)
+		return rw
+	}
+
+	t.Run("site admin", func(t *testing.T) {
+		rw := download("1", 1)
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "hello", rw.Body.String())
+		assert.Equal(t, `attachment; filename="lint.xml"`, rw.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("not a site admin", func(t *testing.T) {
+		rw := download("1", 2)
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+
+	t.Run("unknown artifact", func(t *testing.T) {
+		rw := download("2", 1)
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
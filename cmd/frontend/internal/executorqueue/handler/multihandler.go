@@ -15,6 +15,7 @@ import (
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
 	executortypes "github.com/sourcegraph/sourcegraph/internal/executor/types"
@@ -30,14 +31,15 @@ import (
 
 // MultiHandler handles the HTTP requests of an executor for more than one queue. See ExecutorHandler for single-queue implementation.
 type MultiHandler struct {
-	executorStore         database.ExecutorStore
-	jobTokenStore         executorstore.JobTokenStore
-	metricsStore          metricsstore.DistributedStore
-	CodeIntelQueueHandler QueueHandler[uploadsshared.Index]
-	BatchesQueueHandler   QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]
-	DequeueCache          *rcache.Cache
-	dequeueCacheConfig    *schema.DequeueCacheConfig
-	logger                log.Logger
+	executorStore          database.ExecutorStore
+	jobTokenStore          executorstore.JobTokenStore
+	metricsStore           metricsstore.DistributedStore
+	CodeIntelQueueHandler  QueueHandler[uploadsshared.Index]
+	BatchesQueueHandler    QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]
+	CustomJobsQueueHandler QueueHandler[*customjobs.Job]
+	DequeueCache           *rcache.Cache
+	dequeueCacheConfig     *schema.DequeueCacheConfig
+	logger                 log.Logger
 }
 
 // NewMultiHandler creates a new MultiHandler.
@@ -47,6 +49,7 @@ func NewMultiHandler(
 	metricsStore metricsstore.DistributedStore,
 	codeIntelQueueHandler QueueHandler[uploadsshared.Index],
 	batchesQueueHandler QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob],
+	customJobsQueueHandler QueueHandler[*customjobs.Job],
 ) MultiHandler {
 	siteConfig := conf.Get().SiteConfiguration
 	dequeueCache := rcache.New(executortypes.DequeueCachePrefix)
@@ -55,14 +58,15 @@ func NewMultiHandler(
 		dequeueCacheConfig = siteConfig.ExecutorsMultiqueue.DequeueCacheConfig
 	}
 	multiHandler := MultiHandler{
-		executorStore:         executorStore,
-		jobTokenStore:         jobTokenStore,
-		metricsStore:          metricsStore,
-		CodeIntelQueueHandler: codeIntelQueueHandler,
-		BatchesQueueHandler:   batchesQueueHandler,
-		DequeueCache:          dequeueCache,
-		dequeueCacheConfig:    dequeueCacheConfig,
-		logger:                log.Scoped("executor-multi-queue-handler", "The route handler for all executor queues"),
+		executorStore:          executorStore,
+		jobTokenStore:          jobTokenStore,
+		metricsStore:           metricsStore,
+		CodeIntelQueueHandler:  codeIntelQueueHandler,
+		BatchesQueueHandler:    batchesQueueHandler,
+		CustomJobsQueueHandler: customJobsQueueHandler,
+		DequeueCache:           dequeueCache,
+		dequeueCacheConfig:     dequeueCacheConfig,
+		logger:                 log.Scoped("executor-multi-queue-handler", "The route handler for all executor queues"),
 	}
 	return multiHandler
 }
@@ -186,6 +190,26 @@ func (m *MultiHandler) dequeue(ctx context.Context, req executortypes.DequeueReq
 			logger.Error("Failed to transform record", log.String("queue", selectedQueue), log.Error(err))
 			return executortypes.Job{}, false, err
 		}
+	case m.CustomJobsQueueHandler.Name:
+		record, dequeued, err := m.CustomJobsQueueHandler.Store.Dequeue(ctx, req.ExecutorName, nil)
+		if err != nil {
+			err = errors.Wrapf(err, "dbworkerstore.Dequeue %s", selectedQueue)
+			logger.Error("Failed to dequeue", log.String("queue", selectedQueue), log.Error(err))
+			return executortypes.Job{}, false, err
+		}
+		if !dequeued {
+			// no custom job to dequeue. Even though the queue was populated before, another executor
+			// instance could have dequeued in the meantime
+			return executortypes.Job{}, false, nil
+		}
+
+		job, err = m.CustomJobsQueueHandler.RecordTransformer(ctx, req.Version, record, resourceMetadata)
+		if err != nil {
+			markErr := markRecordAsFailed(ctx, m.CustomJobsQueueHandler.Store, record.RecordID(), err, logger)
+			err = errors.Wrapf(errors.Append(err, markErr), "RecordTransformer %s", selectedQueue)
+			logger.Error("Failed to transform record", log.String("queue", selectedQueue), log.Error(err))
+			return executortypes.Job{}, false, err
+		}
 	}
 	job.Queue = selectedQueue
 
@@ -238,6 +262,8 @@ var DoSelectQueueForDequeueing = func(candidateQueues []string, config *schema.D
 			weight = config.Batches.Weight
 		case "codeintel":
 			weight = config.Codeintel.Weight
+		case "customjobs":
+			weight = customJobsDequeueConfig(config).Weight
 		}
 		choices = append(choices, weightedrand.NewChoice(queue, weight))
 	}
@@ -248,6 +274,15 @@ var DoSelectQueueForDequeueing = func(candidateQueues []string, config *schema.D
 	return chooser.Pick(), nil
 }
 
+// customJobsDequeueConfig returns the dequeue properties of the customjobs queue. Site configurations
+// that predate the queue don't define them, in which case the defaults are used.
+func customJobsDequeueConfig(config *schema.DequeueCacheConfig) *schema.Customjobs {
+	if config.Customjobs != nil {
+		return config.Customjobs
+	}
+	return executortypes.DequeuePropertiesPerQueue.Customjobs
+}
+
 // SelectEligibleQueues returns a list of queues that have not yet reached the limit of dequeues in the
 // current time window.
 func (m *MultiHandler) SelectEligibleQueues(queues []string) ([]string, error) {
@@ -263,6 +298,8 @@ func (m *MultiHandler) SelectEligibleQueues(queues []string) ([]string, error) {
 			limit = m.dequeueCacheConfig.Batches.Limit
 		case m.CodeIntelQueueHandler.Name:
 			limit = m.dequeueCacheConfig.Codeintel.Limit
+		case m.CustomJobsQueueHandler.Name:
+			limit = customJobsDequeueConfig(m.dequeueCacheConfig).Limit
 		}
 		if len(dequeues) < limit {
 			candidateQueues = append(candidateQueues, queue)
@@ -287,6 +324,8 @@ func (m *MultiHandler) SelectNonEmptyQueues(ctx context.Context, queueNames []st
 			count, err = m.BatchesQueueHandler.Store.QueuedCount(ctx, false)
 		case m.CodeIntelQueueHandler.Name:
 			count, err = m.CodeIntelQueueHandler.Store.QueuedCount(ctx, false)
+		case m.CustomJobsQueueHandler.Name:
+			count, err = m.CustomJobsQueueHandler.Store.QueuedCount(ctx, false)
 		}
 		if err != nil {
 			m.logger.Error("fetching queue size", log.Error(err), log.String("queue", queue))
@@ -382,6 +421,8 @@ func (m *MultiHandler) heartbeat(ctx context.Context, executor types.Executor, i
 			known, cancel, err = m.BatchesQueueHandler.Store.Heartbeat(ctx, queue.JobIDs, heartbeatOptions)
 		case m.CodeIntelQueueHandler.Name:
 			known, cancel, err = m.CodeIntelQueueHandler.Store.Heartbeat(ctx, queue.JobIDs, heartbeatOptions)
+		case m.CustomJobsQueueHandler.Name:
+			known, cancel, err = m.CustomJobsQueueHandler.Store.Heartbeat(ctx, queue.JobIDs, heartbeatOptions)
 		}
 
 		if err != nil {
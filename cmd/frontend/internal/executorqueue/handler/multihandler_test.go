@@ -18,6 +18,7 @@ import (
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
 	executortypes "github.com/sourcegraph/sourcegraph/internal/executor/types"
@@ -218,7 +219,7 @@ func TestMultiHandler_HandleDequeue(t *testing.T) {
 			dequeueEvents: []dequeueEvent{
 				{
 					expectedStatusCode:   http.StatusInternalServerError,
-					expectedResponseBody: `{"error":"Invalid queue name(s) 'invalidqueue' found. Supported queue names are 'batches, codeintel'."}`,
+					expectedResponseBody: `{"error":"Invalid queue name(s) 'invalidqueue' found. Supported queue names are 'batches, codeintel, customjobs'."}`,
 				},
 			},
 		},
@@ -490,6 +491,7 @@ func TestMultiHandler_HandleDequeue(t *testing.T) {
 				metricsstore.NewMockDistributedStore(),
 				handler.QueueHandler[uploadsshared.Index]{Name: "codeintel", Store: codeIntelMockStore, RecordTransformer: transformerFunc[uploadsshared.Index]},
 				handler.QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]{Name: "batches", Store: batchesMockStore, RecordTransformer: transformerFunc[*btypes.BatchSpecWorkspaceExecutionJob]},
+				handler.QueueHandler[*customjobs.Job]{Name: "customjobs"},
 			)
 
 			router := mux.NewRouter()
@@ -828,6 +830,7 @@ func TestMultiHandler_HandleHeartbeat(t *testing.T) {
 				metricsStore,
 				handler.QueueHandler[uploadsshared.Index]{Name: "codeintel", Store: codeIntelMockStore},
 				handler.QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]{Name: "batches", Store: batchesMockStore},
+				handler.QueueHandler[*customjobs.Job]{Name: "customjobs"},
 			)
 
 			router := mux.NewRouter()
@@ -925,6 +928,7 @@ func TestMultiHandler_SelectQueueForDequeueing(t *testing.T) {
 				nil,
 				handler.QueueHandler[uploadsshared.Index]{Name: "codeintel"},
 				handler.QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]{Name: "batches"},
+				handler.QueueHandler[*customjobs.Job]{Name: "customjobs"},
 			)
 
 			selectCounts := make(map[string]int, len(tt.candidateQueues))
@@ -1017,6 +1021,7 @@ func TestMultiHandler_SelectEligibleQueues(t *testing.T) {
 		nil,
 		handler.QueueHandler[uploadsshared.Index]{Name: "codeintel"},
 		handler.QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]{Name: "batches"},
+		handler.QueueHandler[*customjobs.Job]{Name: "customjobs"},
 	)
 
 	for _, tt := range tests {
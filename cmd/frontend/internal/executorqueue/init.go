@@ -1,17 +1,24 @@
 package executorqueue
 
 import (
+	"context"
+
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/conf/confdefaults"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/conf/deploy"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/executor/artifacts"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
 )
 
+func LoadConfig() {
+	artifacts.ConfigInst.Load()
+}
+
 // Init initializes the executor endpoints required for use with the executor service.
 func Init(
 	observationCtx *observation.Context,
@@ -32,6 +39,15 @@ func Init(
 
 	logger := log.Scoped("executorqueue", "")
 
+	if err := artifacts.ConfigInst.Validate(); err != nil {
+		return err
+	}
+	artifactStore := artifacts.NewStore(db.Handle())
+	artifactUploadStore, err := artifacts.NewUploadStore(context.Background(), observationCtx, artifacts.ConfigInst)
+	if err != nil {
+		return err
+	}
+
 	queueHandler := newExecutorQueuesHandler(
 		observationCtx,
 		db,
@@ -40,8 +56,10 @@ func Init(
 		codeintelUploadHandler,
 		batchesWorkspaceFileGetHandler,
 		batchesWorkspaceFileExistsHandler,
+		artifactUploadHandler(logger, artifactStore, artifactUploadStore, artifacts.ConfigInst.MaxUploadSize),
 	)
 
 	enterpriseServices.NewExecutorProxyHandler = queueHandler
+	enterpriseServices.ExecutorArtifactDownloadHandler = NewArtifactDownloadHandler(logger, db, artifactStore, artifactUploadStore)
 	return nil
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/batches"
 	codeintelqueue "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/codeintel"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/customjobs"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -31,6 +32,7 @@ func newExecutorQueuesHandler(
 	uploadHandler http.Handler,
 	batchesWorkspaceFileGetHandler http.Handler,
 	batchesWorkspaceFileExistsHandler http.Handler,
+	artifactUploadHandler http.Handler,
 ) func() http.Handler {
 	metricsStore := metricsstore.NewDistributedStore("executors:")
 	executorStore := db.Executors()
@@ -43,12 +45,14 @@ func newExecutorQueuesHandler(
 	// Note: In order register a new queue type please change the validate() check code in cmd/executor/config.go
 	codeIntelQueueHandler := codeintelqueue.QueueHandler(observationCtx, db, accessToken)
 	batchesQueueHandler := batches.QueueHandler(observationCtx, db, accessToken)
+	customJobsQueueHandler := customjobs.QueueHandler(observationCtx, db)
 
 	codeintelHandler := handler.NewHandler(executorStore, jobTokenStore, metricsStore, codeIntelQueueHandler)
 	batchesHandler := handler.NewHandler(executorStore, jobTokenStore, metricsStore, batchesQueueHandler)
-	handlers := []handler.ExecutorHandler{codeintelHandler, batchesHandler}
+	customJobsHandler := handler.NewHandler(executorStore, jobTokenStore, metricsStore, customJobsQueueHandler)
+	handlers := []handler.ExecutorHandler{codeintelHandler, batchesHandler, customJobsHandler}
 
-	multiHandler := handler.NewMultiHandler(executorStore, jobTokenStore, metricsStore, codeIntelQueueHandler, batchesQueueHandler)
+	multiHandler := handler.NewMultiHandler(executorStore, jobTokenStore, metricsStore, codeIntelQueueHandler, batchesQueueHandler, customJobsQueueHandler)
 
 	gitserverClient := gitserver.NewClient()
 
@@ -114,6 +118,7 @@ func newExecutorQueuesHandler(
 		batchChangesRouter := filesRouter.PathPrefix("/batch-changes").Subrouter()
 		batchChangesRouter.Path("/{spec}/{file}").Methods(http.MethodGet).Handler(batchesWorkspaceFileGetHandler)
 		batchChangesRouter.Path("/{spec}/{file}").Methods(http.MethodHead).Handler(batchesWorkspaceFileExistsHandler)
+		filesRouter.Path("/artifacts/{queue}/{name:.+}").Methods(http.MethodPost).Handler(artifactUploadHandler)
 		// The files route are treated as an internal actor and require the executor access token to authenticate.
 		filesRouter.Use(withInternalActor, jobAuthMiddleware(logger, routeFiles, jobTokenStore, executorStore))
 
@@ -262,7 +267,11 @@ func validateJobRequest(
 	// Each route is "special". Set additional information based on the route that is being worked with.
 	switch routeName {
 	case routeFiles:
-		queue = "batches"
+		// Artifact uploads name the queue of the job, the other files routes are
+		// only used by batches jobs.
+		if queue = mux.Vars(r)["queue"]; queue == "" {
+			queue = "batches"
+		}
 	case routeGit:
 		repo = mux.Vars(r)["RepoName"]
 	case routeQueue:
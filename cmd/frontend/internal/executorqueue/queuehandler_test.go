@@ -86,6 +86,7 @@ func TestJobAuthMiddleware(t *testing.T) {
 		name                 string
 		routeName            routeName
 		header               map[string]string
+		path                 string
 		mockFunc             func(executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore)
 		expectedStatusCode   int
 		expectedResponseBody string
@@ -175,6 +176,36 @@ func TestJobAuthMiddleware(t *testing.T) {
 				assert.Equal(t, executorStore.GetByHostnameFunc.History()[0].Arg1, "test-executor")
 			},
 		},
+		{
+			name:      "Files Authorized for the queue of the artifact",
+			routeName: routeFiles,
+			header: map[string]string{
+				"Authorization":               "Bearer somejobtoken",
+				"X-Sourcegraph-Job-ID":        "42",
+				"X-Sourcegraph-Executor-Name": "test-executor",
+			},
+			path: "/artifacts/customjobs/report.xml",
+			mockFunc: func(executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				jobTokenStore.GetByTokenFunc.PushReturn(executorstore.JobToken{JobID: 42, Queue: "customjobs"}, nil)
+				executorStore.GetByHostnameFunc.PushReturn(types.Executor{}, true, nil)
+			},
+			expectedStatusCode: http.StatusTeapot,
+		},
+		{
+			name:      "Files Unauthorized for the queue of the artifact",
+			routeName: routeFiles,
+			header: map[string]string{
+				"Authorization":               "Bearer somejobtoken",
+				"X-Sourcegraph-Job-ID":        "42",
+				"X-Sourcegraph-Executor-Name": "test-executor",
+			},
+			path: "/artifacts/customjobs/report.xml",
+			mockFunc: func(executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				jobTokenStore.GetByTokenFunc.PushReturn(executorstore.JobToken{JobID: 42, Queue: "batches"}, nil)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: "invalid token\n",
+		},
 		{
 			name:      "Files Authorized general access token",
 			routeName: routeFiles,
@@ -397,6 +428,10 @@ func TestJobAuthMiddleware(t *testing.T) {
 				router.HandleFunc("/{RepoName}", func(w http.ResponseWriter, r *http.Request) {
 					w.WriteHeader(http.StatusTeapot)
 				})
+			} else if test.path != "" {
+				router.HandleFunc("/artifacts/{queue}/{name:.+}", func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusTeapot)
+				})
 			} else {
 				router.HandleFunc("/{queueName}", func(w http.ResponseWriter, r *http.Request) {
 					w.WriteHeader(http.StatusTeapot)
@@ -404,7 +439,11 @@ func TestJobAuthMiddleware(t *testing.T) {
 			}
 			router.Use(jobAuthMiddleware(logger, test.routeName, jobTokenStore, executorStore))
 
-			req, err := http.NewRequest("GET", "/test", nil)
+			path := "/test"
+			if test.path != "" {
+				path = test.path
+			}
+			req, err := http.NewRequest("GET", path, nil)
 			require.NoError(t, err)
 			for k, v := range test.header {
 				req.Header.Add(k, v)
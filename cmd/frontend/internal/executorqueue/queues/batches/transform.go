@@ -259,7 +259,7 @@ func transformRecord(ctx context.Context, logger log.Logger, s BatchesStore, job
 				},
 			})
 
-			dockerSteps = append(dockerSteps, apiclient.DockerStep{
+			runStep := apiclient.DockerStep{
 				Key:   executorutil.FormatRunKey(i),
 				Image: step.Container,
 				Dir:   runDir,
@@ -271,7 +271,13 @@ func transformRecord(ctx context.Context, logger log.Logger, s BatchesStore, job
 					"{ set -eo pipefail; } 2>/dev/null",
 					fmt.Sprintf(`(exec "%s/step%d.sh" | tee %s/stdout%d.log) 3>&1 1>&2 2>&3 | tee %s/stderr%d.log`, runDirToScriptDir, i, runDirToScriptDir, i, runDirToScriptDir, i),
 				},
-			})
+				Timeout: step.TimeoutDuration(),
+				Retries: step.Retries,
+			}
+			if step.Retries > 0 {
+				runStep.RetryBackoff = step.RetryBackoffDuration()
+			}
+			dockerSteps = append(dockerSteps, runStep)
 
 			// This step gets the diff, reads stdout and stderr, renders the outputs and builds the AfterStepResult.
 			dockerSteps = append(dockerSteps, apiclient.DockerStep{
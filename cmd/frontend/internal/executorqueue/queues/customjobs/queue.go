@@ -0,0 +1,23 @@
+package customjobs
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	apiclient "github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func QueueHandler(observationCtx *observation.Context, db database.DB) handler.QueueHandler[*customjobs.Job] {
+	recordTransformer := func(ctx context.Context, _ string, record *customjobs.Job, resourceMetadata handler.ResourceMetadata) (apiclient.Job, error) {
+		return transformRecord(ctx, db, record, resourceMetadata)
+	}
+
+	return handler.QueueHandler[*customjobs.Job]{
+		Name:              customjobs.QueueName,
+		Store:             customjobs.NewWorkerStore(observationCtx, db.Handle()),
+		RecordTransformer: recordTransformer,
+	}
+}
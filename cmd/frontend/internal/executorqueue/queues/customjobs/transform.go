@@ -0,0 +1,106 @@
+package customjobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	apiclient "github.com/sourcegraph/sourcegraph/internal/executor/types"
+)
+
+// accessLogTransformer sets the approriate fields on the executor secret access log entry
+// for custom job access. Secrets are accessed in the name of the user who created the job,
+// or by the customjobs machine user if that user has been deleted since.
+type accessLogTransformer struct {
+	database.ExecutorSecretAccessLogCreator
+	userID int32
+}
+
+func (e *accessLogTransformer) Create(ctx context.Context, log *database.ExecutorSecretAccessLog) error {
+	if e.userID != 0 {
+		log.UserID = &e.userID
+	} else {
+		log.MachineUser = "customjobs"
+		log.UserID = nil
+	}
+	return e.ExecutorSecretAccessLogCreator.Create(ctx, log)
+}
+
+func transformRecord(ctx context.Context, db database.DB, job *customjobs.Job, _ handler.ResourceMetadata) (apiclient.Job, error) {
+	var secrets []*database.ExecutorSecret
+	var err error
+	if len(job.Spec.Secrets) > 0 {
+		secretsStore := db.ExecutorSecrets(keyring.Default().ExecutorSecretKey)
+		secrets, _, err = secretsStore.List(ctx, database.ExecutorSecretScopeCustomJobs, database.ExecutorSecretsListOpts{
+			// Note: No namespace set, custom job secrets are only available in the global namespace.
+			Keys: job.Spec.Secrets,
+		})
+		if err != nil {
+			return apiclient.Job{}, err
+		}
+	}
+
+	// And build the env vars from the secrets.
+	secretEnvVars := make([]string, len(secrets))
+	redactedEnvVars := make(map[string]string, len(secrets))
+	secretStore := &accessLogTransformer{ExecutorSecretAccessLogCreator: db.ExecutorSecretAccessLogs(), userID: job.UserID}
+	for i, secret := range secrets {
+		// Get the secret value. This also creates an access log entry in the
+		// name of the user.
+		val, err := secret.Value(ctx, secretStore)
+		if err != nil {
+			return apiclient.Job{}, err
+		}
+
+		secretEnvVars[i] = fmt.Sprintf("%s=%s", secret.Key, val)
+		// We redact secret values as ${{ secrets.NAME }}.
+		redactedEnvVars[val] = fmt.Sprintf("${{ secrets.%s }}", secret.Key)
+	}
+
+	dockerSteps := make([]apiclient.DockerStep, 0, len(job.Spec.Steps))
+	for i, step := range job.Spec.Steps {
+		image := step.Image
+		if image == "" {
+			image = job.Spec.Image
+		}
+
+		// Step env vars take precedence over the template env vars, and
+		// secrets over both.
+		env := make([]string, 0, len(job.Spec.Env)+len(step.Env)+len(secretEnvVars))
+		env = append(env, job.Spec.Env...)
+		env = append(env, step.Env...)
+		env = append(env, secretEnvVars...)
+
+		dockerSteps = append(dockerSteps, apiclient.DockerStep{
+			Key:       fmt.Sprintf("step.%d", i),
+			Image:     image,
+			Commands:  []string{step.Run},
+			Dir:       step.Dir,
+			Env:       env,
+			Artifacts: step.Artifacts,
+		})
+	}
+
+	var cacheVolumes []apiclient.CacheVolume
+	for _, volume := range job.Spec.CacheVolumes {
+		cacheVolumes = append(cacheVolumes, apiclient.CacheVolume{
+			Name:      volume.Name,
+			MountPath: volume.MountPath,
+			KeyFiles:  volume.KeyFiles,
+		})
+	}
+
+	return apiclient.Job{
+		ID:             job.ID,
+		Commit:         string(job.Commit),
+		RepositoryName: string(job.RepositoryName),
+		ShallowClone:   true,
+		DockerSteps:    dockerSteps,
+		CacheVolumes:   cacheVolumes,
+		// 🚨 SECURITY: Catch uses of executor secrets from the executor secret store
+		RedactedValues: redactedEnvVars,
+	}, nil
+}
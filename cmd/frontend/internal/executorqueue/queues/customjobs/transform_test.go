@@ -0,0 +1,102 @@
+package customjobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	apiclient "github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestTransformRecord(t *testing.T) {
+	db := dbmocks.NewMockDB()
+
+	secs := dbmocks.NewMockExecutorSecretStore()
+	secs.ListFunc.SetDefaultHook(func(ctx context.Context, scope database.ExecutorSecretScope, opts database.ExecutorSecretsListOpts) ([]*database.ExecutorSecret, int, error) {
+		if scope != database.ExecutorSecretScopeCustomJobs {
+			t.Fatalf("unexpected secret scope %q", scope)
+		}
+		return []*database.ExecutorSecret{
+			database.NewMockExecutorSecret(&database.ExecutorSecret{
+				Key:       "TOKEN",
+				Scope:     database.ExecutorSecretScopeCustomJobs,
+				CreatorID: 1,
+			}, "hunter2"),
+		}, 1, nil
+	})
+	db.ExecutorSecretsFunc.SetDefaultReturn(secs)
+
+	sal := dbmocks.NewMockExecutorSecretAccessLogStore()
+	db.ExecutorSecretAccessLogsFunc.SetDefaultReturn(sal)
+
+	job := &customjobs.Job{
+		ID:             42,
+		Template:       "sbom",
+		RepositoryName: "github.com/sourcegraph/sourcegraph",
+		Commit:         "deadbeef",
+		UserID:         7,
+		Spec: schema.CustomJobTemplate{
+			Name:    "sbom",
+			Image:   "alpine:3",
+			Env:     []string{"FORMAT=json"},
+			Secrets: []string{"TOKEN"},
+			Steps: []*schema.CustomJobStep{
+				{Run: "syft . -o $FORMAT > sbom.json", Artifacts: []string{"sbom.json"}},
+				{Run: "upload sbom.json", Image: "curlimages/curl", Dir: "out", Env: []string{"FORMAT=spdx"}},
+			},
+			CacheVolumes: []*schema.CustomJobCacheVolume{
+				{Name: "npm", MountPath: "/root/.npm", KeyFiles: []string{"package-lock.json"}},
+			},
+		},
+	}
+
+	actual, err := transformRecord(context.Background(), db, job, handler.ResourceMetadata{})
+	if err != nil {
+		t.Fatalf("unexpected error transforming record: %s", err)
+	}
+
+	expected := apiclient.Job{
+		ID:             42,
+		Commit:         "deadbeef",
+		RepositoryName: "github.com/sourcegraph/sourcegraph",
+		ShallowClone:   true,
+		DockerSteps: []apiclient.DockerStep{
+			{
+				Key:       "step.0",
+				Image:     "alpine:3",
+				Commands:  []string{"syft . -o $FORMAT > sbom.json"},
+				Env:       []string{"FORMAT=json", "TOKEN=hunter2"},
+				Artifacts: []string{"sbom.json"},
+			},
+			{
+				Key:      "step.1",
+				Image:    "curlimages/curl",
+				Commands: []string{"upload sbom.json"},
+				Dir:      "out",
+				Env:      []string{"FORMAT=json", "FORMAT=spdx", "TOKEN=hunter2"},
+			},
+		},
+		CacheVolumes: []apiclient.CacheVolume{
+			{Name: "npm", MountPath: "/root/.npm", KeyFiles: []string{"package-lock.json"}},
+		},
+		RedactedValues: map[string]string{
+			"hunter2": "${{ secrets.TOKEN }}",
+		},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected job (-want +got):\n%s", diff)
+	}
+
+	if len(sal.CreateFunc.History()) != 1 {
+		t.Fatalf("expected one secret access log entry, got %d", len(sal.CreateFunc.History()))
+	}
+	if userID := sal.CreateFunc.History()[0].Arg1.UserID; userID == nil || *userID != 7 {
+		t.Errorf("expected secret access to be logged for user 7, got %v", userID)
+	}
+}
@@ -0,0 +1,130 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// auditLogsExportPageSize is the number of records fetched from the database
+// at once while exporting.
+const auditLogsExportPageSize = 1000
+
+// auditLogExportRecord is a single line of an audit log export.
+type auditLogExportRecord struct {
+	ID                int64           `json:"id"`
+	AuditID           string          `json:"auditId"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	Entity            string          `json:"entity"`
+	Action            string          `json:"action"`
+	ActorUID          int32           `json:"actorUID"`
+	AnonymousActorUID string          `json:"anonymousActorUID,omitempty"`
+	IP                string          `json:"ip,omitempty"`
+	UserAgent         string          `json:"userAgent,omitempty"`
+	ForwardedFor      string          `json:"forwardedFor,omitempty"`
+	Fields            json.RawMessage `json:"fields"`
+}
+
+// serveAuditLogsExport returns a HTTP handler that streams the audit log
+// records stored in the database matching the filters of the query string as
+// newline-delimited JSON, newest first.
+func serveAuditLogsExport(logger log.Logger, db database.DB) http.HandlerFunc {
+	logger = logger.Scoped("auditLogsExport", "streams audit log records stored in the database")
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		// 🚨 SECURITY: Only site admins may export audit logs.
+		if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		opts, err := parseAuditLogsExportOptions(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", `attachment; filename="audit-logs.ndjson"`)
+		w.WriteHeader(http.StatusOK)
+
+		flusher, _ := w.(http.Flusher)
+		enc := json.NewEncoder(w)
+		store := db.AuditLogs()
+		for {
+			logs, err := store.List(ctx, opts)
+			if err != nil {
+				// The status has been written already, so the best we can do is
+				// to cut the export short.
+				logger.Error("listing audit logs", log.Error(err))
+				return
+			}
+
+			for _, l := range logs {
+				if err := enc.Encode(auditLogExportRecord{
+					ID:                l.ID,
+					AuditID:           l.AuditID,
+					CreatedAt:         l.CreatedAt,
+					Entity:            l.Entity,
+					Action:            l.Action,
+					ActorUID:          l.ActorUID,
+					AnonymousActorUID: l.AnonymousActorUID,
+					IP:                l.IP,
+					UserAgent:         l.UserAgent,
+					ForwardedFor:      l.ForwardedFor,
+					Fields:            l.Fields,
+				}); err != nil {
+					// The client went away.
+					return
+				}
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+
+			if len(logs) < auditLogsExportPageSize {
+				return
+			}
+			opts.BeforeID = logs[len(logs)-1].ID
+		}
+	}
+}
+
+func parseAuditLogsExportOptions(r *http.Request) (database.AuditLogsListOptions, error) {
+	q := r.URL.Query()
+	opts := database.AuditLogsListOptions{
+		Entity:      q.Get("entity"),
+		Action:      q.Get("action"),
+		LimitOffset: &database.LimitOffset{Limit: auditLogsExportPageSize},
+	}
+
+	if v := q.Get("actor"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return opts, errors.Wrap(err, "parsing actor")
+		}
+		actor := int32(id)
+		opts.ActorUID = &actor
+	}
+
+	for name, dst := range map[string]**time.Time{"since": &opts.Since, "until": &opts.Until} {
+		if v := q.Get(name); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return opts, errors.Wrapf(err, "parsing %s", name)
+			}
+			*dst = &t
+		}
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,99 @@
+package httpapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestServeAuditLogsExport(t *testing.T) {
+	since := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultHook(func(ctx context.Context) (*types.User, error) {
+		return &types.User{ID: actor.FromContext(ctx).UID, SiteAdmin: actor.FromContext(ctx).UID == 1}, nil
+	})
+
+	// Return two full pages followed by a partial one.
+	var calls []database.AuditLogsListOptions
+	auditLogs := dbmocks.NewMockAuditLogStore()
+	auditLogs.ListFunc.SetDefaultHook(func(_ context.Context, opts database.AuditLogsListOptions) ([]*database.AuditLog, error) {
+		calls = append(calls, opts)
+		n := auditLogsExportPageSize
+		if len(calls) == 3 {
+			n = 2
+		}
+		start := int64(3*auditLogsExportPageSize) - int64(len(calls)-1)*auditLogsExportPageSize
+		logs := make([]*database.AuditLog, n)
+		for i := range logs {
+			logs[i] = &database.AuditLog{ID: start - int64(i), Entity: "security events", Action: "AccessGranted"}
+		}
+		return logs, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.AuditLogsFunc.SetDefaultReturn(auditLogs)
+
+	handler := serveAuditLogsExport(logtest.Scoped(t), db)
+
+	t.Run("non site admin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/audit-logs/export", nil)
+		req = req.WithContext(actor.WithActor(req.Context(), actor.FromUser(2)))
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/audit-logs/export?since=yesterday", nil)
+		req = req.WithContext(actor.WithActor(req.Context(), actor.FromUser(1)))
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("export", func(t *testing.T) {
+		calls = nil
+
+		req := httptest.NewRequest(http.MethodGet, "/audit-logs/export?actor=5&entity=security+events&since="+since.Format(time.RFC3339), nil)
+		req = req.WithContext(actor.WithActor(req.Context(), actor.FromUser(1)))
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+
+		var lines int
+		scanner := bufio.NewScanner(rec.Body)
+		for scanner.Scan() {
+			var record auditLogExportRecord
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+			lines++
+		}
+		assert.Equal(t, 2*auditLogsExportPageSize+2, lines)
+
+		require.Len(t, calls, 3)
+		for _, opts := range calls {
+			assert.Equal(t, int32(5), *opts.ActorUID)
+			assert.Equal(t, "security events", opts.Entity)
+			assert.Equal(t, since, *opts.Since)
+			assert.Nil(t, opts.Until)
+		}
+		assert.Equal(t, int64(0), calls[0].BeforeID)
+		assert.Equal(t, int64(2*auditLogsExportPageSize+1), calls[1].BeforeID)
+		assert.Equal(t, int64(auditLogsExportPageSize+1), calls[2].BeforeID)
+	})
+}
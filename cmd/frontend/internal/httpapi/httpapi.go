@@ -66,7 +66,8 @@ type Handlers struct {
 	SCIMHandler http.Handler
 
 	// Code intel
-	NewCodeIntelUploadHandler enterprise.NewCodeIntelUploadHandler
+	NewCodeIntelUploadHandler   enterprise.NewCodeIntelUploadHandler
+	SentinelBundleUploadHandler http.Handler
 
 	// Compute
 	NewComputeStreamHandler enterprise.NewComputeStreamHandler
@@ -78,6 +79,9 @@ type Handlers struct {
 	SearchJobsDataExportHandler http.Handler
 	SearchJobsLogsHandler       http.Handler
 
+	// Executors
+	ExecutorArtifactDownloadHandler http.Handler
+
 	// Dotcom license check
 	NewDotcomLicenseCheckHandler enterprise.NewDotcomLicenseCheckHandler
 
@@ -157,11 +161,13 @@ func NewHandler(
 	m.Get(apirouter.LSIFUpload).Handler(trace.Route(lsifDeprecationHandler))
 	m.Get(apirouter.SCIPUpload).Handler(trace.Route(handlers.NewCodeIntelUploadHandler(true)))
 	m.Get(apirouter.SCIPUploadExists).Handler(trace.Route(noopHandler))
+	m.Get(apirouter.SentinelBundleUpload).Handler(trace.Route(handlers.SentinelBundleUploadHandler))
 	m.Get(apirouter.ComputeStream).Handler(trace.Route(handlers.NewComputeStreamHandler()))
 	m.Get(apirouter.ChatCompletionsStream).Handler(trace.Route(handlers.NewChatCompletionsStreamHandler()))
 	m.Get(apirouter.CodeCompletions).Handler(trace.Route(handlers.NewCodeCompletionsHandler()))
 
 	m.Get(apirouter.CodeInsightsDataExport).Handler(trace.Route(handlers.CodeInsightsDataExportHandler))
+	m.Get(apirouter.AuditLogsExport).Handler(trace.Route(serveAuditLogsExport(logger, db)))
 
 	if envvar.SourcegraphDotComMode() {
 		m.Path("/app/check/update").Name(codyapp.RouteAppUpdateCheck).Handler(trace.Route(codyapp.AppUpdateHandler(logger)))
@@ -185,6 +191,8 @@ func NewHandler(
 	m.Get(apirouter.SearchJobResults).Handler(trace.Route(handlers.SearchJobsDataExportHandler))
 	m.Get(apirouter.SearchJobLogs).Handler(trace.Route(handlers.SearchJobsLogsHandler))
 
+	m.Get(apirouter.ExecutorArtifactDownload).Handler(trace.Route(handlers.ExecutorArtifactDownloadHandler))
+
 	// Return the minimum src-cli version that's compatible with this instance
 	m.Get(apirouter.SrcCli).Handler(trace.Route(newSrcCliVersionHandler(logger)))
 
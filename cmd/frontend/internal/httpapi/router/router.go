@@ -44,6 +44,12 @@ const (
 
 	CodeInsightsDataExport = "insights.data.export"
 
+	AuditLogsExport = "audit-logs.export"
+
+	ExecutorArtifactDownload = "executors.artifact.download"
+
+	SentinelBundleUpload = "sentinel.bundles.upload"
+
 	GitInfoRefs         = "internal.git.info-refs"
 	GitUploadPack       = "internal.git.upload-pack"
 	ReposIndex          = "internal.repos.index"
@@ -78,6 +84,7 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/lsif/upload").Methods("POST").Name(LSIFUpload)
 	base.Path("/scip/upload").Methods("POST").Name(SCIPUpload)
 	base.Path("/scip/upload").Methods("HEAD").Name(SCIPUploadExists)
+	base.Path("/sentinel/bundles").Methods("POST").Name(SentinelBundleUpload)
 	base.Path("/search/stream").Methods("GET").Name(SearchStream)
 	base.Path("/search/export/{id}.csv").Methods("GET").Name(SearchJobResults)
 	base.Path("/search/export/{id}.log").Methods("GET").Name(SearchJobLogs)
@@ -88,6 +95,8 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/insights/export/{id}").Methods("GET").Name(CodeInsightsDataExport)
 	base.Path("/completions/stream").Methods("POST").Name(ChatCompletionsStream)
 	base.Path("/completions/code").Methods("POST").Name(CodeCompletions)
+	base.Path("/audit-logs/export").Methods("GET").Name(AuditLogsExport)
+	base.Path("/executors/artifacts/{id:[0-9]+}").Methods("GET").Name(ExecutorArtifactDownload)
 
 	// repo contains routes that are NOT specific to a revision. In these routes, the URL may not contain a revspec after the repo (that is, no "github.com/foo/bar@myrevspec").
 	repoPath := `/repos/` + routevar.Repo
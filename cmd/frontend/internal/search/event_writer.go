@@ -2,6 +2,7 @@ package search
 
 import (
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
 	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
@@ -72,3 +73,50 @@ func (e *eventWriter) Alert(alert *search.Alert) error {
 		ProposedQueries: pqs,
 	})
 }
+
+func (e *eventWriter) Explain(plan string, profile *job.Profile) error {
+	ev := streamhttp.EventExplain{
+		Plan: plan,
+		Jobs: toEventExplainJobs(profile.Jobs()),
+	}
+	if indexed, unindexed, ok := profile.Repos(); ok {
+		ev.IndexedRepos = &indexed
+		ev.UnindexedRepos = &unindexed
+	}
+	return e.inner.Event("explain", ev)
+}
+
+func toEventExplainJobs(nodes []*job.ProfileNode) []streamhttp.EventExplainJob {
+	jobs := make([]streamhttp.EventExplainJob, 0, len(nodes))
+	for _, node := range nodes {
+		var attributes map[string]string
+		if len(node.Attributes) > 0 {
+			attributes = make(map[string]string, len(node.Attributes))
+			for _, attr := range node.Attributes {
+				attributes[string(attr.Key)] = attr.Value.Emit()
+			}
+		}
+
+		var errMessage string
+		if node.Err != nil {
+			errMessage = node.Err.Error()
+		}
+
+		var children []streamhttp.EventExplainJob
+		if len(node.Children) > 0 {
+			children = toEventExplainJobs(node.Children)
+		}
+
+		jobs = append(jobs, streamhttp.EventExplainJob{
+			Name:           node.Name,
+			Attributes:     attributes,
+			DurationMs:     node.Duration.Milliseconds(),
+			Results:        node.Results,
+			IndexedRepos:   node.IndexedRepos,
+			UnindexedRepos: node.UnindexedRepos,
+			Error:          errMessage,
+			Children:       children,
+		})
+	}
+	return jobs
+}
@@ -27,6 +27,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/printer"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	streamclient "github.com/sourcegraph/sourcegraph/internal/search/streaming/client"
@@ -115,6 +117,14 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr trace.Trace, eventWriter *
 		}
 	}
 
+	// In explain mode, record the jobs that run so that we can report the
+	// executed job tree with timings once the search completes.
+	var profile *job.Profile
+	if args.Explain {
+		profile = job.NewProfile()
+		ctx = job.WithProfile(ctx, profile)
+	}
+
 	// Display is the number of results we send down. If display is < 0 we
 	// want to send everything we find before hitting a limit. Otherwise we
 	// can only send up to limit results.
@@ -172,6 +182,11 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr trace.Trace, eventWriter *
 	if alert != nil {
 		eventWriter.Alert(alert)
 	}
+	if profile != nil {
+		if err := writeExplain(eventWriter, profile); err != nil {
+			h.logger.Warn("failed to write explain event", log.Error(err))
+		}
+	}
 	logSearch(ctx, h.logger, alert, err, time.Since(start), latency, inputs.OriginalQuery, progress)
 	return err
 }
@@ -209,6 +224,17 @@ func logSearch(ctx context.Context, logger log.Logger, alert *search.Alert, err
 	}
 }
 
+// writeExplain sends the executed job tree of the search along with the jobs
+// recorded in the given profile as the explain event.
+func writeExplain(eventWriter *eventWriter, profile *job.Profile) error {
+	var plan string
+	if planJob := profile.Plan(); planJob != nil {
+		plan = printer.SexpVerbose(planJob, job.VerbosityBasic, true)
+	}
+
+	return eventWriter.Explain(plan, profile)
+}
+
 type args struct {
 	Query              string
 	Version            string
@@ -216,6 +242,7 @@ type args struct {
 	Display            int
 	EnableChunkMatches bool
 	SearchMode         int
+	Explain            bool
 }
 
 func parseURLQuery(q url.Values) (*args, error) {
@@ -248,6 +275,11 @@ func parseURLQuery(q url.Values) (*args, error) {
 		return nil, errors.Errorf("chunk matches must be parseable as a boolean, got %q: %w", chunkMatches, err)
 	}
 
+	explain := get("explain", "f")
+	if a.Explain, err = strconv.ParseBool(explain); err != nil {
+		return nil, errors.Errorf("explain must be parseable as a boolean, got %q: %w", explain, err)
+	}
+
 	searchMode := get("sm", "0")
 	if a.SearchMode, err = strconv.Atoi(searchMode); err != nil {
 		return nil, errors.Errorf("search mode must be integer, got %q: %w", searchMode, err)
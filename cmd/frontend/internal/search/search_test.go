@@ -18,6 +18,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
@@ -25,6 +27,7 @@ import (
 	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
 	"github.com/sourcegraph/sourcegraph/internal/settings"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -128,6 +131,75 @@ func TestServeStream_chunkMatches(t *testing.T) {
 	require.Len(t, chunkMatches[0].Ranges, 1)
 }
 
+func TestServeStream_explain(t *testing.T) {
+	settings.MockCurrentUserFinal = &schema.Settings{}
+	t.Cleanup(func() { settings.MockCurrentUserFinal = nil })
+
+	mockJob := mockjob.NewMockJob()
+	mockJob.NameFunc.SetDefaultReturn("MockJob")
+
+	explain := func(t *testing.T, recordRepos bool) *streamhttp.EventExplain {
+		mock := client.NewMockSearchClient()
+		mock.PlanFunc.SetDefaultReturn(&search.Inputs{}, nil)
+		mock.ExecuteFunc.SetDefaultHook(func(ctx context.Context, s streaming.Sender, _ *search.Inputs) (alert *search.Alert, err error) {
+			job.RecordPlan(ctx, mockJob)
+			_, ctx, s, finish := job.StartSpan(ctx, s, mockJob)
+			defer func() { finish(alert, err) }()
+
+			if recordRepos {
+				job.RecordRepos(ctx, 2, 1)
+			}
+			s.Send(streaming.SearchEvent{Results: result.Matches{&result.FileMatch{File: result.File{Path: "testpath"}}}})
+			return nil, nil
+		})
+
+		ts := httptest.NewServer(&streamHandler{
+			logger:              logtest.Scoped(t),
+			db:                  dbmocks.NewMockDB(),
+			flushTickerInternal: 1 * time.Millisecond,
+			pingTickerInterval:  1 * time.Millisecond,
+			searchClient:        mock,
+		})
+		defer ts.Close()
+
+		res, err := http.Get(ts.URL + "?q=test&explain=t")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+
+		var explain *streamhttp.EventExplain
+		decoder := streamhttp.FrontendStreamDecoder{
+			OnExplain: func(ev *streamhttp.EventExplain) {
+				explain = ev
+			},
+		}
+		if err := decoder.ReadAll(res.Body); err != nil {
+			t.Fatal(err)
+		}
+
+		require.NotNil(t, explain)
+		require.Equal(t, "MOCK", explain.Plan)
+		require.Len(t, explain.Jobs, 1)
+		require.Equal(t, "MockJob", explain.Jobs[0].Name)
+		require.Equal(t, int64(1), explain.Jobs[0].Results)
+		return explain
+	}
+
+	t.Run("resolved repos", func(t *testing.T) {
+		ev := explain(t, true)
+		require.Equal(t, pointers.Ptr(2), ev.IndexedRepos)
+		require.Equal(t, pointers.Ptr(1), ev.UnindexedRepos)
+	})
+
+	t.Run("global search", func(t *testing.T) {
+		// Global searches don't resolve repositories, so the counts are unknown.
+		ev := explain(t, false)
+		require.Nil(t, ev.IndexedRepos)
+		require.Nil(t, ev.UnindexedRepos)
+	})
+}
+
 func TestDisplayLimit(t *testing.T) {
 	cases := []struct {
 		queryString         string
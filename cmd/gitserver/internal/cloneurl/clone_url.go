@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	ghauth "github.com/sourcegraph/sourcegraph/internal/extsvc/github/auth"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitmanifest"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/pagure"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
@@ -86,6 +87,10 @@ func cloneURL(ctx context.Context, logger log.Logger, db database.DB, kind strin
 		if r, ok := repo.Metadata.(*pagure.Project); ok {
 			return r.FullURL, nil
 		}
+	case *schema.GitManifestConnection:
+		if r, ok := repo.Metadata.(*gitmanifest.Repository); ok {
+			return r.CloneURL, nil
+		}
 	case *schema.OtherExternalServiceConnection:
 		if r, ok := repo.Metadata.(*extsvc.OtherRepoMetadata); ok {
 			return otherCloneURL(repo, r), nil
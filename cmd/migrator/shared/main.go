@@ -53,8 +53,11 @@ func Start(logger log.Logger, registerEnterpriseMigrators store.RegisterMigrator
 			cliutil.Drift(appName, newRunner, outputFactory, false, schemas.DefaultSchemaFactories...),
 			cliutil.AddLog(appName, newRunner, outputFactory),
 			cliutil.Upgrade(appName, newRunnerWithSchemas, outputFactory, registerMigrators, schemas.DefaultSchemaFactories...),
+			cliutil.Plan(appName, newRunnerWithSchemas, outputFactory),
+			cliutil.Restore(appName, newRunnerWithSchemas, outputFactory),
 			cliutil.Downgrade(appName, newRunnerWithSchemas, outputFactory, registerMigrators, schemas.DefaultSchemaFactories...),
 			cliutil.RunOutOfBandMigrations(appName, newRunner, outputFactory, registerMigrators),
+			cliutil.OutOfBandMigrations(appName, newRunner, outputFactory),
 		},
 	}
 
@@ -90,21 +90,7 @@ func (s *PermsSyncer) syncRepoPerms(ctx context.Context, repoID api.RepoID, noPe
 		}
 		return result, providerStates, errors.Wrap(err, "get repository")
 	}
-	var provider authz.Provider
-
-	// Only check authz provider for private repositories because we only need to
-	// fetch permissions for private repositories.
-	if repo.Private {
-		// Loop over repository's sources and see if matching any authz provider's URN.
-		providers := s.providersByURNs()
-		for urn := range repo.Sources {
-			p, ok := providers[urn]
-			if ok {
-				provider = p
-				break
-			}
-		}
-	}
+	provider := s.repoProvider(repo)
 
 	logger := s.logger.Scoped("syncRepoPerms", "processes permissions syncing request in a repo-centric way").With(
 		log.Object("repo",
@@ -272,7 +258,7 @@ func (s *PermsSyncer) syncUserPerms(ctx context.Context, userID int32, noPerms b
 	)
 	ctx = featureflag.WithFlags(ctx, s.db.FeatureFlags())
 
-	results, err := s.fetchUserPermsViaExternalAccounts(ctx, user, noPerms, fetchOpts)
+	results, err := s.fetchUserPermsViaExternalAccounts(ctx, user, noPerms, false, fetchOpts)
 	providerStates := results.providerStates
 	if err != nil {
 		return nil, providerStates, errors.Wrapf(err, "fetch permissions via external accounts for user %q (id: %d)", user.Username, user.ID)
@@ -338,6 +324,25 @@ func (s *PermsSyncer) syncUserPerms(ctx context.Context, userID int32, noPerms b
 	return result, providerStates, nil
 }
 
+// repoProvider returns the authz.Provider of the given repository, or nil if
+// there's none.
+func (s *PermsSyncer) repoProvider(repo *types.Repo) authz.Provider {
+	// Only check authz provider for private repositories because we only need to
+	// fetch permissions for private repositories.
+	if !repo.Private {
+		return nil
+	}
+
+	// Loop over repository's sources and see if matching any authz provider's URN.
+	providers := s.providersByURNs()
+	for urn := range repo.Sources {
+		if p, ok := providers[urn]; ok {
+			return p
+		}
+	}
+	return nil
+}
+
 // providersByServiceID returns a list of authz.Provider configured in the external services.
 // Keys are ServiceID, e.g. "https://github.com/".
 func (s *PermsSyncer) providersByServiceID() map[string]authz.Provider {
@@ -376,8 +381,9 @@ type fetchUserPermsViaExternalAccountsResults struct {
 // the given user.
 //
 // It returns a list of internal database repository IDs and is a noop when
-// `envvar.SourcegraphDotComMode()` is true.
-func (s *PermsSyncer) fetchUserPermsViaExternalAccounts(ctx context.Context, user *types.User, noPerms bool, fetchOpts authz.FetchPermsOptions) (results fetchUserPermsViaExternalAccountsResults, err error) {
+// `envvar.SourcegraphDotComMode()` is true. When `dryRun` is true, external
+// accounts are neither associated with the user nor updated.
+func (s *PermsSyncer) fetchUserPermsViaExternalAccounts(ctx context.Context, user *types.User, noPerms, dryRun bool, fetchOpts authz.FetchPermsOptions) (results fetchUserPermsViaExternalAccountsResults, err error) {
 	// NOTE: OAuth scope on sourcegraph.com does not grant access to read private
 	//  repositories, therefore it is no point wasting effort and code host API rate
 	//  limit quota on trying.
@@ -458,10 +464,12 @@ func (s *PermsSyncer) fetchUserPermsViaExternalAccounts(ctx context.Context, use
 		}
 		providerLogger.Debug("account found for provider", log.String("provider_urn", provider.URN()), log.Int32("user_id", user.ID), log.Int32("account_id", acct.ID))
 
-		err = accounts.AssociateUserAndSave(ctx, user.ID, acct.AccountSpec, acct.AccountData)
-		if err != nil {
-			providerLogger.Error("could not associate external account to user", log.Error(err))
-			continue
+		if !dryRun {
+			err = accounts.AssociateUserAndSave(ctx, user.ID, acct.AccountSpec, acct.AccountData)
+			if err != nil {
+				providerLogger.Error("could not associate external account to user", log.Error(err))
+				continue
+			}
 		}
 
 		accts = append(accts, acct)
@@ -499,8 +507,10 @@ func (s *PermsSyncer) fetchUserPermsViaExternalAccounts(ctx context.Context, use
 			if unauthorized || accountSuspended || forbidden {
 				// These are fatal errors that mean we should continue as if the account no
 				// longer has any access.
-				if err = accounts.TouchExpired(ctx, acct.ID); err != nil {
-					return results, errors.Wrapf(err, "set expired for external account ID %v", acct.ID)
+				if !dryRun {
+					if err = accounts.TouchExpired(ctx, acct.ID); err != nil {
+						return results, errors.Wrapf(err, "set expired for external account ID %v", acct.ID)
+					}
 				}
 
 				if unauthorized {
@@ -559,7 +569,7 @@ func (s *PermsSyncer) fetchUserPermsViaExternalAccounts(ctx context.Context, use
 				return results, errors.Wrapf(err, "fetch user permissions for external account %d", acct.ID)
 			}
 			acctLogger.Warn("proceedWithPartialResults", log.Error(err))
-		} else {
+		} else if !dryRun {
 			err = accounts.TouchLastValid(ctx, acct.ID)
 			if err != nil {
 				return results, errors.Wrapf(err, "set last valid for external account %d", acct.ID)
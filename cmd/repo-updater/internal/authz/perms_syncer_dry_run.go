@@ -0,0 +1,132 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/collections"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/oauthtoken"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DryRunUserPerms fetches the permissions of the given user from the code hosts
+// and compares them with the stored ones, without writing anything to the
+// database. The IDs in the result are repository IDs.
+func (s *PermsSyncer) DryRunUserPerms(ctx context.Context, userID int32, fetchOpts authz.FetchPermsOptions) (_ *protocol.PermsSyncDryRunResult, err error) {
+	ctx, save := s.observe(ctx, "PermsSyncer.DryRunUserPerms")
+	defer save(requestTypeUser, userID, &err)
+	ctx = oauthtoken.WithDryRun(ctx)
+
+	user, err := s.db.Users().GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get user")
+	}
+	ctx = featureflag.WithFlags(ctx, s.db.FeatureFlags())
+
+	results, err := s.fetchUserPermsViaExternalAccounts(ctx, user, false, true, fetchOpts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fetch permissions via external accounts for user %q (id: %d)", user.Username, user.ID)
+	}
+
+	stored, err := s.permsStore.LoadUserPermissions(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load permissions of user %q (id: %d)", user.Username, user.ID)
+	}
+
+	before := collections.NewSet[int32]()
+	after := collections.NewSet[int32]()
+	for _, p := range stored {
+		before.Add(int32(p.RepoID))
+		// A sync only replaces the permissions of the external accounts it
+		// fetched permissions for, and never those set via the API.
+		if _, synced := results.repoPerms[p.ExternalAccountID]; !synced || p.Source == authz.SourceAPI {
+			after.Add(int32(p.RepoID))
+		}
+	}
+	for _, repoIDs := range results.repoPerms {
+		after.Add(repoIDs...)
+	}
+
+	return diffPerms(before, after), nil
+}
+
+// DryRunRepoPerms fetches the permissions of the given repository from its code
+// host and compares them with the stored ones, without writing anything to the
+// database. The IDs in the result are user IDs.
+func (s *PermsSyncer) DryRunRepoPerms(ctx context.Context, repoID api.RepoID, fetchOpts authz.FetchPermsOptions) (_ *protocol.PermsSyncDryRunResult, err error) {
+	ctx, save := s.observe(ctx, "PermsSyncer.DryRunRepoPerms")
+	defer save(requestTypeRepo, int32(repoID), &err)
+	ctx = oauthtoken.WithDryRun(ctx)
+
+	repo, err := s.reposStore.RepoStore().Get(ctx, repoID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get repository")
+	}
+	provider := s.repoProvider(repo)
+	if provider == nil {
+		return nil, errors.Newf("repository %q (id: %d) has no authorization provider", repo.Name, repo.ID)
+	}
+
+	extAccountIDs, err := provider.FetchRepoPerms(ctx, &extsvc.Repository{
+		URI:              repo.URI,
+		ExternalRepoSpec: repo.ExternalRepo,
+	}, fetchOpts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fetch repository permissions for repository %q (id: %d)", repo.Name, repo.ID)
+	}
+
+	after := collections.NewSet[int32]()
+	if len(extAccountIDs) > 0 {
+		accountIDs := make([]string, len(extAccountIDs))
+		for i := range extAccountIDs {
+			accountIDs[i] = string(extAccountIDs[i])
+		}
+
+		accountIDsToUserIDs, err := s.permsStore.GetUserIDsByExternalAccounts(ctx, &extsvc.Accounts{
+			ServiceType: provider.ServiceType(),
+			ServiceID:   provider.ServiceID(),
+			AccountIDs:  accountIDs,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "get user IDs by external accounts for repository %q (id: %d)", repo.Name, repo.ID)
+		}
+		for _, perm := range accountIDsToUserIDs {
+			after.Add(perm.UserID)
+		}
+	}
+
+	stored, err := s.permsStore.LoadRepoPermissions(ctx, int32(repoID))
+	if err != nil && !errcode.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "load permissions of repository %q (id: %d)", repo.Name, repo.ID)
+	}
+
+	before := collections.NewSet[int32]()
+	for _, p := range stored {
+		// A zero user ID marks unrestricted repositories.
+		if p.UserID == 0 {
+			continue
+		}
+		before.Add(p.UserID)
+		// Permissions set via the API are not replaced by a sync.
+		if p.Source == authz.SourceAPI {
+			after.Add(p.UserID)
+		}
+	}
+
+	return diffPerms(before, after), nil
+}
+
+// diffPerms returns the IDs that are in after but not in before as added, and
+// the ones in before but not in after as removed.
+func diffPerms(before, after collections.Set[int32]) *protocol.PermsSyncDryRunResult {
+	return &protocol.PermsSyncDryRunResult{
+		Added:     after.Difference(before).Sorted(collections.NaturalCompare[int32]),
+		Removed:   before.Difference(after).Sorted(collections.NaturalCompare[int32]),
+		Unchanged: len(collections.Intersection(before, after)),
+	}
+}
@@ -0,0 +1,163 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	mockrequire "github.com/derision-test/go-mockgen/testutil/require"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestPermsSyncer_DryRunUserPerms(t *testing.T) {
+	p := &mockProvider{
+		id:          1,
+		serviceType: extsvc.TypeGitLab,
+		serviceID:   "https://gitlab.com/",
+		fetchUserPerms: func(context.Context, *extsvc.Account) (*authz.ExternalUserPermissions, error) {
+			return &authz.ExternalUserPermissions{Exacts: []extsvc.RepoID{"2", "3"}}, nil
+		},
+	}
+	authz.SetProviders(false, []authz.Provider{p})
+	t.Cleanup(func() {
+		authz.SetProviders(true, nil)
+	})
+
+	users := dbmocks.NewMockUserStore()
+	users.GetByIDFunc.SetDefaultHook(func(ctx context.Context, id int32) (*types.User, error) {
+		return &types.User{ID: id}, nil
+	})
+
+	mockRepos := dbmocks.NewMockRepoStore()
+	mockRepos.ListMinimalReposFunc.SetDefaultHook(func(ctx context.Context, opt database.ReposListOptions) ([]types.MinimalRepo, error) {
+		repos := make([]types.MinimalRepo, 0, len(opt.ExternalRepos))
+		for _, r := range opt.ExternalRepos {
+			switch r.ID {
+			case "2":
+				repos = append(repos, types.MinimalRepo{ID: 2})
+			case "3":
+				repos = append(repos, types.MinimalRepo{ID: 3})
+			}
+		}
+		return repos, nil
+	})
+
+	externalAccounts := dbmocks.NewMockUserExternalAccountsStore()
+	externalAccounts.ListFunc.SetDefaultHook(func(_ context.Context, opts database.ExternalAccountsListOptions) ([]*extsvc.Account, error) {
+		if opts.OnlyExpired {
+			return []*extsvc.Account{}, nil
+		}
+		return []*extsvc.Account{{
+			ID: 7,
+			AccountSpec: extsvc.AccountSpec{
+				ServiceType: p.ServiceType(),
+				ServiceID:   p.ServiceID(),
+			},
+		}}, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.ReposFunc.SetDefaultReturn(mockRepos)
+	db.UserEmailsFunc.SetDefaultReturn(dbmocks.NewMockUserEmailsStore())
+	db.UserExternalAccountsFunc.SetDefaultReturn(externalAccounts)
+	db.FeatureFlagsFunc.SetDefaultReturn(dbmocks.NewMockFeatureFlagStore())
+
+	reposStore := repos.NewMockStoreFrom(repos.NewStore(logtest.Scoped(t), db))
+	reposStore.RepoStoreFunc.SetDefaultReturn(mockRepos)
+
+	perms := dbmocks.NewMockPermsStore()
+	perms.LoadUserPermissionsFunc.SetDefaultReturn([]authz.Permission{
+		{UserID: 1, ExternalAccountID: 7, RepoID: 1, Source: authz.SourceUserSync},
+		{UserID: 1, ExternalAccountID: 7, RepoID: 2, Source: authz.SourceUserSync},
+		// Set via the API, so kept.
+		{UserID: 1, RepoID: 5, Source: authz.SourceAPI},
+		// Granted via an account that wasn't synced, so kept.
+		{UserID: 1, ExternalAccountID: 9, RepoID: 6, Source: authz.SourceRepoSync},
+	}, nil)
+
+	s := NewPermsSyncer(logtest.Scoped(t), db, reposStore, perms, timeutil.Now)
+
+	result, err := s.DryRunUserPerms(context.Background(), 1, authz.FetchPermsOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, &protocol.PermsSyncDryRunResult{
+		Added:     []int32{3},
+		Removed:   []int32{1},
+		Unchanged: 3,
+	}, result)
+
+	// Nothing is written.
+	mockrequire.NotCalled(t, perms.SetUserExternalAccountPermsFunc)
+	mockrequire.NotCalled(t, externalAccounts.TouchLastValidFunc)
+}
+
+func TestPermsSyncer_DryRunRepoPerms(t *testing.T) {
+	p := &mockProvider{
+		id:          1,
+		serviceType: extsvc.TypeGitLab,
+		serviceID:   "https://gitlab.com/",
+		fetchRepoPerms: func(ctx context.Context, repo *extsvc.Repository, opts authz.FetchPermsOptions) ([]extsvc.AccountID, error) {
+			return []extsvc.AccountID{"carol", "dave", "pending"}, nil
+		},
+	}
+	authz.SetProviders(false, []authz.Provider{p})
+	t.Cleanup(func() {
+		authz.SetProviders(true, nil)
+	})
+
+	mockRepos := dbmocks.NewMockRepoStore()
+	mockRepos.GetFunc.SetDefaultReturn(&types.Repo{
+		ID:      1,
+		Private: true,
+		ExternalRepo: api.ExternalRepoSpec{
+			ServiceID: p.ServiceID(),
+		},
+		Sources: map[string]*types.SourceInfo{
+			p.URN(): {},
+		},
+	}, nil)
+
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(mockRepos)
+
+	reposStore := repos.NewMockStoreFrom(repos.NewStore(logtest.Scoped(t), db))
+	reposStore.RepoStoreFunc.SetDefaultReturn(mockRepos)
+
+	perms := dbmocks.NewMockPermsStore()
+	perms.GetUserIDsByExternalAccountsFunc.SetDefaultReturn(map[string]authz.UserIDWithExternalAccountID{
+		"carol": {UserID: 3, ExternalAccountID: 30},
+		"dave":  {UserID: 4, ExternalAccountID: 40},
+	}, nil)
+	perms.LoadRepoPermissionsFunc.SetDefaultReturn([]authz.Permission{
+		{UserID: 1, RepoID: 1, Source: authz.SourceRepoSync},
+		// Set via the API, so kept.
+		{UserID: 2, RepoID: 1, Source: authz.SourceAPI},
+		{UserID: 3, RepoID: 1, Source: authz.SourceRepoSync},
+	}, nil)
+
+	s := NewPermsSyncer(logtest.Scoped(t), db, reposStore, perms, timeutil.Now)
+
+	result, err := s.DryRunRepoPerms(context.Background(), 1, authz.FetchPermsOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, &protocol.PermsSyncDryRunResult{
+		Added:     []int32{4},
+		Removed:   []int32{1},
+		Unchanged: 2,
+	}, result)
+
+	// Nothing is written.
+	mockrequire.NotCalled(t, perms.TransactFunc)
+	mockrequire.NotCalled(t, perms.SetRepoPermsFunc)
+	mockrequire.NotCalled(t, perms.SetRepoPendingPermissionsFunc)
+}
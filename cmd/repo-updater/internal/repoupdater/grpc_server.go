@@ -128,3 +128,11 @@ func (s *RepoUpdaterServiceServer) ExternalServiceRepositories(ctx context.Conte
 	logger := s.Server.Logger.With(log.String("ExternalServiceKind", req.Kind))
 	return s.Server.externalServiceRepositories(ctx, logger, req)
 }
+
+func (s *RepoUpdaterServiceServer) PermsSyncDryRun(ctx context.Context, req *proto.PermsSyncDryRunRequest) (*proto.PermsSyncDryRunResponse, error) {
+	res, err := s.Server.permsSyncDryRun(ctx, protocol.PermsSyncDryRunArgsFromProto(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.ToProto(), nil
+}
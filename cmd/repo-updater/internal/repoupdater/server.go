@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/batches/syncer"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -42,6 +43,10 @@ type Server struct {
 		ScheduleInfo(id api.RepoID) *protocol.RepoUpdateSchedulerInfoResult
 	}
 	ChangesetSyncRegistry syncer.ChangesetSyncRegistry
+	PermsSyncer           interface {
+		DryRunUserPerms(ctx context.Context, userID int32, fetchOpts authz.FetchPermsOptions) (*protocol.PermsSyncDryRunResult, error)
+		DryRunRepoPerms(ctx context.Context, repoID api.RepoID, fetchOpts authz.FetchPermsOptions) (*protocol.PermsSyncDryRunResult, error)
+	}
 }
 
 // Handler returns the http.Handler that should be used to serve requests.
@@ -57,6 +62,7 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("/enqueue-changeset-sync", trace.WithRouteName("enqueue-changeset-sync", s.handleEnqueueChangesetSync))
 	mux.HandleFunc("/external-service-namespaces", trace.WithRouteName("external-service-namespaces", s.handleExternalServiceNamespaces))
 	mux.HandleFunc("/external-service-repositories", trace.WithRouteName("external-service-repositories", s.handleExternalServiceRepositories))
+	mux.HandleFunc("/perms-sync-dry-run", trace.WithRouteName("perms-sync-dry-run", s.handlePermsSyncDryRun))
 	return mux
 }
 
@@ -339,6 +345,56 @@ func (s *Server) handleEnqueueChangesetSync(w http.ResponseWriter, r *http.Reque
 	s.respond(w, http.StatusOK, nil)
 }
 
+func (s *Server) handlePermsSyncDryRun(w http.ResponseWriter, r *http.Request) {
+	var args protocol.PermsSyncDryRunArgs
+	if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+		s.respond(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := s.permsSyncDryRun(r.Context(), &args)
+	if err != nil {
+		s.Logger.Error("server.perms-sync-dry-run", log.Error(err))
+		s.respond(w, grpcErrToStatus(err), &protocol.PermsSyncDryRunResult{Error: err.Error()})
+		return
+	}
+	s.respond(w, http.StatusOK, result)
+}
+
+// permsSyncDryRun fetches the permissions of the user or repository in args
+// from the code host and returns how they differ from the stored ones.
+func (s *Server) permsSyncDryRun(ctx context.Context, args *protocol.PermsSyncDryRunArgs) (*protocol.PermsSyncDryRunResult, error) {
+	if s.PermsSyncer == nil {
+		return nil, status.Error(codes.Unavailable, "permissions syncer is not configured")
+	}
+
+	// Don't invalidate caches: they are shared with regular syncs, and a dry
+	// run must not change any state.
+	fetchOpts := authz.FetchPermsOptions{}
+
+	var (
+		result *protocol.PermsSyncDryRunResult
+		err    error
+	)
+	switch {
+	case args.UserID != 0 && args.RepoID != 0:
+		return nil, status.Error(codes.InvalidArgument, "only one of user ID and repository ID may be set")
+	case args.UserID != 0:
+		result, err = s.PermsSyncer.DryRunUserPerms(ctx, args.UserID, fetchOpts)
+	case args.RepoID != 0:
+		result, err = s.PermsSyncer.DryRunRepoPerms(ctx, args.RepoID, fetchOpts)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either user ID or repository ID must be set")
+	}
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return result, nil
+}
+
 func (s *Server) handleExternalServiceNamespaces(w http.ResponseWriter, r *http.Request) {
 	var req protocol.ExternalServiceNamespacesArgs
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -139,6 +139,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		database.Perms(observationCtx.Logger, db, timeutil.Now),
 		timeutil.Now,
 	)
+	server.PermsSyncer = permsSyncer
 	repoWorkerStore := authz.MakeStore(observationCtx, db.Handle(), authz.SyncTypeRepo)
 	userWorkerStore := authz.MakeStore(observationCtx, db.Handle(), authz.SyncTypeUser)
 	permissionSyncJobStore := database.PermissionSyncJobsWith(observationCtx.Logger, db)
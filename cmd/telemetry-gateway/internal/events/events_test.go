@@ -1,34 +1,150 @@
 package events_test
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/sourcegraph/log/logtest"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/cmd/telemetry-gateway/internal/events"
+	connections "github.com/sourcegraph/sourcegraph/internal/database/connections/live"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/pubsub"
 	"github.com/sourcegraph/sourcegraph/internal/pubsub/pubsubtest"
 	telemetrygatewayv1 "github.com/sourcegraph/sourcegraph/internal/telemetrygateway/v1"
 )
 
 func TestPublish(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// newTopic returns the topic to publish to, and a function that
+		// returns the messages that were published to it.
+		newTopic func(t *testing.T) (pubsub.TopicClient, func() [][]byte)
+	}{
+		{
+			name: "memory",
+			newTopic: func(t *testing.T) (pubsub.TopicClient, func() [][]byte) {
+				memTopic := pubsubtest.NewMemoryTopicClient()
+				return memTopic, func() [][]byte { return memTopic.Messages }
+			},
+		},
+		{
+			name: "file",
+			newTopic: func(t *testing.T) (pubsub.TopicClient, func() [][]byte) {
+				path := filepath.Join(t.TempDir(), "events.jsonl")
+				fileTopic, err := pubsub.NewFileTopicClient(path, 1024*1024, 1)
+				require.NoError(t, err)
+				t.Cleanup(fileTopic.Stop)
+
+				return fileTopic, func() [][]byte {
+					f, err := os.Open(path)
+					require.NoError(t, err)
+					defer f.Close()
+
+					var messages [][]byte
+					scanner := bufio.NewScanner(f)
+					for scanner.Scan() {
+						messages = append(messages, bytes.Clone(scanner.Bytes()))
+					}
+					require.NoError(t, scanner.Err())
+					return messages
+				}
+			},
+		},
+		{
+			name: "http",
+			newTopic: func(t *testing.T) (pubsub.TopicClient, func() [][]byte) {
+				var mu sync.Mutex
+				var messages [][]byte
+				srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					scanner := bufio.NewScanner(r.Body)
+					mu.Lock()
+					defer mu.Unlock()
+					for scanner.Scan() {
+						messages = append(messages, bytes.Clone(scanner.Bytes()))
+					}
+				}))
+				t.Cleanup(srv.Close)
+
+				return pubsub.NewHTTPTopicClient(http.DefaultClient, srv.URL, 10, 10*time.Millisecond), func() [][]byte {
+					mu.Lock()
+					defer mu.Unlock()
+					return messages
+				}
+			},
+		},
+		{
+			name: "postgres",
+			newTopic: func(t *testing.T) (pubsub.TopicClient, func() [][]byte) {
+				db := dbtest.NewRawDB(logtest.Scoped(t), t)
+				require.NoError(t, connections.MigrateStandaloneDB(context.Background(), observation.TestContextTB(t), db, pubsub.PostgresSchema))
+				pgTopic := pubsub.NewPostgresTopicClient(db, "events")
+
+				return pgTopic, func() [][]byte {
+					rows, err := db.Query("SELECT data FROM pubsub_messages WHERE topic = 'events' ORDER BY id")
+					require.NoError(t, err)
+					defer rows.Close()
+
+					var messages [][]byte
+					for rows.Next() {
+						var data []byte
+						require.NoError(t, rows.Scan(&data))
+						messages = append(messages, data)
+					}
+					require.NoError(t, rows.Err())
+					return messages
+				}
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			topic, published := tc.newTopic(t)
+			testPublish(t, topic, published)
+		})
+	}
+}
+
+// blockingTopicClient calls prePublishHook before publishing messages to the
+// wrapped topic.
+type blockingTopicClient struct {
+	pubsub.TopicClient
+	prePublishHook func()
+}
+
+func (c *blockingTopicClient) Publish(ctx context.Context, messages ...[]byte) error {
+	c.prePublishHook()
+	return c.TopicClient.Publish(ctx, messages...)
+}
+
+func testPublish(t *testing.T, topic pubsub.TopicClient, published func() [][]byte) {
 	done := make(chan struct{})
-	memTopic := pubsubtest.NewMemoryTopicClient()
 
 	// Emulate semi-random blockage to emulate concurrency
 	var count atomic.Int32
-	memTopic.PrePublishHook = func() {
-		count.Add(1)
-		if count.Load()%2 == 0 {
-			<-done
-		}
+	topic = &blockingTopicClient{
+		TopicClient: topic,
+		prePublishHook: func() {
+			count.Add(1)
+			if count.Load()%2 == 0 {
+				<-done
+			}
+		},
 	}
 
-	publisher, err := events.NewPublisherForStream(memTopic, &telemetrygatewayv1.RecordEventsRequestMetadata{})
+	publisher, err := events.NewPublisherForStream(topic, &telemetrygatewayv1.RecordEventsRequestMetadata{})
 	require.NoError(t, err)
 
 	events := make([]*telemetrygatewayv1.Event, 100)
@@ -57,11 +173,12 @@ func TestPublish(t *testing.T) {
 
 	// Collect all the results we got
 	for _, r := range results {
+		require.NoError(t, r.PublishError)
 		eventResults[r.EventID] = true
 	}
 
 	// Collect all the messages we published
-	for _, m := range memTopic.Messages {
+	for _, m := range published() {
 		var payload map[string]json.RawMessage
 		require.NoError(t, json.Unmarshal(m, &payload))
 
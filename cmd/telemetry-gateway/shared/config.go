@@ -3,9 +3,11 @@ package shared
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/trace/policy"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type Config struct {
@@ -15,11 +17,32 @@ type Config struct {
 	DiagnosticsSecret string
 
 	Events struct {
+		// Backend is the topic events are published to, one of 'pubsub',
+		// 'file', 'postgres' and 'http'.
+		Backend string
+
 		PubSub struct {
 			Enabled   bool
 			ProjectID string
 			TopicID   string
 		}
+
+		File struct {
+			Path         string
+			MaxSizeBytes int64
+			MaxBackups   int
+		}
+
+		Postgres struct {
+			DSN     string
+			TopicID string
+		}
+
+		HTTP struct {
+			Endpoint     string
+			MaxBatchSize int
+			BatchDelay   time.Duration
+		}
 	}
 
 	OpenTelemetry OpenTelemetryConfig
@@ -35,6 +58,9 @@ func (c *Config) Load() {
 	c.DiagnosticsSecret = c.Get("DIAGNOSTICS_SECRET", "", "Secret for accessing diagnostics - "+
 		"should be used as 'Authorization: Bearer $secret' header when accessing diagnostics endpoints.")
 
+	c.Events.Backend = c.Get("TELEMETRY_GATEWAY_EVENTS_BACKEND", "pubsub",
+		"The topic to publish events to, one of 'pubsub', 'file', 'postgres' and 'http'.")
+
 	c.Events.PubSub.Enabled = c.GetBool("TELEMETRY_GATEWAY_EVENTS_PUBSUB_ENABLED", "true",
 		"If false, logs Pub/Sub messages instead of actually sending them")
 	c.Events.PubSub.ProjectID = c.GetOptional("TELEMETRY_GATEWAY_EVENTS_PUBSUB_PROJECT_ID",
@@ -42,6 +68,43 @@ func (c *Config) Load() {
 	c.Events.PubSub.TopicID = c.GetOptional("TELEMETRY_GATEWAY_EVENTS_PUBSUB_TOPIC_ID",
 		"The topic ID for the Pub/Sub.")
 
+	c.Events.File.Path = c.GetOptional("TELEMETRY_GATEWAY_EVENTS_FILE_PATH",
+		"The path of the JSON lines file for the 'file' events backend.")
+	c.Events.File.MaxSizeBytes = int64(c.GetInt("TELEMETRY_GATEWAY_EVENTS_FILE_MAX_SIZE_MB", "100",
+		"The size in megabytes after which the events file is rotated.")) * 1024 * 1024
+	c.Events.File.MaxBackups = c.GetInt("TELEMETRY_GATEWAY_EVENTS_FILE_MAX_BACKUPS", "5",
+		"The number of rotated events files to keep.")
+
+	c.Events.Postgres.DSN = c.GetOptional("TELEMETRY_GATEWAY_EVENTS_POSTGRES_DSN",
+		"The Postgres connection string for the 'postgres' events backend.")
+	c.Events.Postgres.TopicID = c.Get("TELEMETRY_GATEWAY_EVENTS_POSTGRES_TOPIC_ID", "telemetry-gateway-events",
+		"The topic of the events in the pubsub_messages table for the 'postgres' events backend.")
+
+	c.Events.HTTP.Endpoint = c.GetOptional("TELEMETRY_GATEWAY_EVENTS_HTTP_ENDPOINT",
+		"The URL that batches of events are sent to as POST requests for the 'http' events backend.")
+	c.Events.HTTP.MaxBatchSize = c.GetInt("TELEMETRY_GATEWAY_EVENTS_HTTP_MAX_BATCH_SIZE", "100",
+		"The maximum number of events sent in a single request.")
+	c.Events.HTTP.BatchDelay = c.GetInterval("TELEMETRY_GATEWAY_EVENTS_HTTP_BATCH_DELAY", "1s",
+		"How long to wait for more events before sending a batch that is not full.")
+
+	switch c.Events.Backend {
+	case "pubsub":
+	case "file":
+		if c.Events.File.Path == "" {
+			c.AddError(errors.New("must provide TELEMETRY_GATEWAY_EVENTS_FILE_PATH for the 'file' events backend"))
+		}
+	case "postgres":
+		if c.Events.Postgres.DSN == "" {
+			c.AddError(errors.New("must provide TELEMETRY_GATEWAY_EVENTS_POSTGRES_DSN for the 'postgres' events backend"))
+		}
+	case "http":
+		if c.Events.HTTP.Endpoint == "" {
+			c.AddError(errors.New("must provide TELEMETRY_GATEWAY_EVENTS_HTTP_ENDPOINT for the 'http' events backend"))
+		}
+	default:
+		c.AddError(errors.Newf("unknown events backend %q", c.Events.Backend))
+	}
+
 	c.OpenTelemetry.TracePolicy = policy.TracePolicy(c.Get("TELEMETRY_GATEWAY_TRACE_POLICY", "all", "Trace policy, one of 'all', 'selective', 'none'."))
 	c.OpenTelemetry.GCPProjectID = c.GetOptional("TELEMETRY_GATEWAY_OTEL_GCP_PROJECT_ID", "Google Cloud Traces project ID.")
 	if c.OpenTelemetry.GCPProjectID == "" {
@@ -11,9 +11,12 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 
+	connections "github.com/sourcegraph/sourcegraph/internal/database/connections/live"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbconn"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/defaults"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/httpserver"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/pubsub"
@@ -33,16 +36,11 @@ func Main(ctx context.Context, obctx *observation.Context, ready service.ReadyFu
 	}
 	defer shutdownOtel()
 
-	var eventsTopic pubsub.TopicClient
-	if !config.Events.PubSub.Enabled {
-		obctx.Logger.Warn("pub/sub events publishing disabled, logging messages instead")
-		eventsTopic = pubsub.NewLoggingTopicClient(obctx.Logger)
-	} else {
-		eventsTopic, err = pubsub.NewTopicClient(config.Events.PubSub.ProjectID, config.Events.PubSub.TopicID)
-		if err != nil {
-			return errors.Errorf("create Events Pub/Sub client: %v", err)
-		}
+	eventsTopic, err := newEventsTopic(ctx, obctx, config)
+	if err != nil {
+		return err
 	}
+	defer eventsTopic.Stop()
 
 	// Initialize our gRPC server
 	// TODO(@bobheadxi): Maybe don't use defaults.NewServer, which is geared
@@ -87,6 +85,47 @@ func Main(ctx context.Context, obctx *observation.Context, ready service.ReadyFu
 	return nil
 }
 
+// newEventsTopic creates the client for the configured events backend.
+func newEventsTopic(ctx context.Context, obctx *observation.Context, config *Config) (pubsub.TopicClient, error) {
+	switch config.Events.Backend {
+	case "file":
+		topic, err := pubsub.NewFileTopicClient(config.Events.File.Path, config.Events.File.MaxSizeBytes, config.Events.File.MaxBackups)
+		if err != nil {
+			return nil, errors.Wrap(err, "create Events file client")
+		}
+		return topic, nil
+
+	case "postgres":
+		db, err := dbconn.ConnectInternal(obctx.Logger, config.Events.Postgres.DSN, "telemetry-gateway", "")
+		if err != nil {
+			return nil, errors.Wrap(err, "connect to Events Postgres")
+		}
+		if err := connections.MigrateStandaloneDB(ctx, obctx, db, pubsub.PostgresSchema); err != nil {
+			_ = db.Close()
+			return nil, errors.Wrap(err, "migrate Events Postgres")
+		}
+		return pubsub.NewPostgresTopicClient(db, config.Events.Postgres.TopicID), nil
+
+	case "http":
+		httpClient, err := httpcli.UncachedExternalClientFactory.Doer()
+		if err != nil {
+			return nil, errors.Wrap(err, "create Events HTTP client")
+		}
+		return pubsub.NewHTTPTopicClient(httpClient, config.Events.HTTP.Endpoint, config.Events.HTTP.MaxBatchSize, config.Events.HTTP.BatchDelay), nil
+
+	default:
+		if !config.Events.PubSub.Enabled {
+			obctx.Logger.Warn("pub/sub events publishing disabled, logging messages instead")
+			return pubsub.NewLoggingTopicClient(obctx.Logger), nil
+		}
+		topic, err := pubsub.NewTopicClient(config.Events.PubSub.ProjectID, config.Events.PubSub.TopicID)
+		if err != nil {
+			return nil, errors.Errorf("create Events Pub/Sub client: %v", err)
+		}
+		return topic, nil
+	}
+}
+
 func initOpenTelemetry(ctx context.Context, logger log.Logger, config OpenTelemetryConfig) (func(), error) {
 	res, err := getOpenTelemetryResource(ctx)
 	if err != nil {
@@ -0,0 +1,48 @@
+package auditlogs
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+type handler struct {
+	store  database.AuditLogStore
+	logger log.Logger
+	clock  func() time.Time
+}
+
+var _ goroutine.Handler = &handler{}
+var _ goroutine.ErrorHandler = &handler{}
+
+func (h *handler) Handle(ctx context.Context) error {
+	cfg := conf.Get().SiteConfiguration
+	now := h.clock()
+
+	// Partitions are only needed while records are written to the database,
+	// but records written previously still expire.
+	if loc := audit.AuditLogLocation(cfg); loc == audit.Database || loc == audit.All {
+		if err := h.store.EnsurePartitions(ctx, now); err != nil {
+			return err
+		}
+	}
+
+	dropped, err := h.store.DeleteOlderThan(ctx, now.Add(-audit.DatabaseRetention(cfg)))
+	if err != nil {
+		return err
+	}
+	if dropped > 0 {
+		h.logger.Info("dropped expired audit log partitions", log.Int("count", dropped))
+	}
+	return nil
+}
+
+func (h *handler) HandleError(err error) {
+	h.logger.Error("error managing audit log partitions", log.Error(err))
+}
@@ -0,0 +1,63 @@
+package auditlogs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockassert "github.com/derision-test/go-mockgen/testutil/assert"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestHandler(t *testing.T) {
+	now := time.Date(2023, 10, 5, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	mockLocation := func(t *testing.T, location string, retentionDays int) {
+		conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+			Log: &schema.Log{AuditLog: &schema.AuditLog{Location: location, DatabaseRetentionDays: retentionDays}},
+		}})
+		t.Cleanup(func() { conf.Mock(nil) })
+	}
+
+	t.Run("database location", func(t *testing.T) {
+		mockLocation(t, "database", 30)
+
+		store := dbmocks.NewMockAuditLogStore()
+		h := &handler{store: store, logger: logtest.Scoped(t), clock: clock}
+
+		assert.NoError(t, h.Handle(context.Background()))
+		mockassert.CalledOnceWith(t, store.EnsurePartitionsFunc, mockassert.Values(mockassert.Skip, now))
+		mockassert.CalledOnceWith(t, store.DeleteOlderThanFunc, mockassert.Values(mockassert.Skip, now.Add(-30*24*time.Hour)))
+	})
+
+	t.Run("auditlog location", func(t *testing.T) {
+		mockLocation(t, "auditlog", 0)
+
+		store := dbmocks.NewMockAuditLogStore()
+		h := &handler{store: store, logger: logtest.Scoped(t), clock: clock}
+
+		assert.NoError(t, h.Handle(context.Background()))
+		mockassert.NotCalled(t, store.EnsurePartitionsFunc)
+		// Records written previously still expire, with the default retention.
+		mockassert.CalledOnceWith(t, store.DeleteOlderThanFunc, mockassert.Values(mockassert.Skip, now.Add(-180*24*time.Hour)))
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		mockLocation(t, "all", 0)
+
+		want := errors.New("error")
+		store := dbmocks.NewMockAuditLogStore()
+		store.EnsurePartitionsFunc.SetDefaultReturn(want)
+		h := &handler{store: store, logger: logtest.Scoped(t), clock: clock}
+
+		assert.ErrorIs(t, h.Handle(context.Background()), want)
+		mockassert.NotCalled(t, store.DeleteOlderThanFunc)
+	})
+}
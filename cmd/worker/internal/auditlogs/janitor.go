@@ -0,0 +1,51 @@
+package auditlogs
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// janitor is a worker responsible for creating the monthly partitions of the
+// audit logs table ahead of time, and for dropping the ones past retention.
+type janitor struct{}
+
+var _ job.Job = &janitor{}
+
+func NewJanitor() job.Job {
+	return &janitor{}
+}
+
+func (j *janitor) Description() string {
+	return "Manages the partitions and retention of audit logs stored in the database."
+}
+
+func (j *janitor) Config() []env.Config {
+	return nil
+}
+
+func (j *janitor) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []goroutine.BackgroundRoutine{
+		goroutine.NewPeriodicGoroutine(
+			context.Background(),
+			&handler{
+				store:  db.AuditLogs(),
+				logger: observationCtx.Logger.Scoped("AuditLogsJanitor", ""),
+				clock:  time.Now,
+			},
+			goroutine.WithName("auditlogs.janitor"),
+			goroutine.WithDescription(j.Description()),
+			goroutine.WithInterval(1*time.Hour),
+		),
+	}, nil
+}
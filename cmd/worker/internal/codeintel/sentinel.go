@@ -25,6 +25,7 @@ func (j *sentinelCVEScannerJob) Config() []env.Config {
 	return []env.Config{
 		sentinel.DownloaderConfigInst,
 		sentinel.MatcherConfigInst,
+		sentinel.ReachabilityConfigInst,
 	}
 }
 
@@ -34,5 +35,5 @@ func (j *sentinelCVEScannerJob) Routines(_ context.Context, observationCtx *obse
 		return nil, err
 	}
 
-	return sentinel.CVEScannerJob(observationCtx, services.SentinelService), nil
+	return sentinel.CVEScannerJob(observationCtx, services.SentinelService)
 }
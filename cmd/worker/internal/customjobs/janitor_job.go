@@ -0,0 +1,50 @@
+package customjobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/executorqueue"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
+)
+
+type janitorJob struct{}
+
+func NewJanitorJob() job.Job {
+	return &janitorJob{}
+}
+
+func (j *janitorJob) Description() string {
+	return "resets stalled custom jobs and reports the customjobs executor queue size"
+}
+
+func (j *janitorJob) Config() []env.Config {
+	return []env.Config{janitorConfigInst}
+}
+
+func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+	store := customjobs.NewWorkerStore(observationCtx, db.Handle())
+
+	executorMetricsReporter, err := executorqueue.NewMetricReporter(observationCtx, customjobs.QueueName, store, janitorConfigInst.MetricsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	resetter := dbworker.NewResetter(observationCtx.Logger, store, dbworker.ResetterOptions{
+		Name:     "custom_jobs_worker_resetter",
+		Interval: time.Minute, // Check for orphaned jobs every minute
+		Metrics:  dbworker.NewResetterMetrics(observationCtx, "custom_jobs_worker"),
+	})
+
+	return []goroutine.BackgroundRoutine{executorMetricsReporter, resetter}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing"
+	"github.com/sourcegraph/sourcegraph/internal/customjobs"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	executortypes "github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
@@ -43,12 +44,14 @@ func (j *multiqueueMetricsReporterJob) Routines(_ context.Context, observationCt
 	if err != nil {
 		return nil, err
 	}
+	customJobsStore := customjobs.NewWorkerStore(observationCtx, db.Handle())
 
 	multiqueueMetricsReporter, err := executorqueue.NewMultiqueueMetricReporter(
 		executortypes.ValidQueueNames,
 		configInst.MetricsConfig,
 		codeIntelStore.QueuedCount,
 		batchesStore.QueuedCount,
+		customJobsStore.QueuedCount,
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,73 @@
+package executors
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor/artifacts"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// artifactJanitorBatchSize is the maximum number of artifacts deleted per run.
+const artifactJanitorBatchSize = 1000
+
+type artifactJanitor struct {
+	store       artifacts.Store
+	uploadStore uploadstore.Store
+	maxAge      time.Duration
+	logger      log.Logger
+}
+
+var _ goroutine.Handler = &artifactJanitor{}
+
+// NewArtifactJanitor returns a PeriodicGoroutine that deletes executor job artifacts
+// that were uploaded longer than maxAge ago, along with their contents in the upload store.
+func NewArtifactJanitor(store artifacts.Store, uploadStore uploadstore.Store, maxAge time.Duration, cleanupInterval time.Duration) goroutine.BackgroundRoutine {
+	handler := &artifactJanitor{
+		store:       store,
+		uploadStore: uploadStore,
+		maxAge:      maxAge,
+		logger:      log.Scoped("artifact-janitor", "Periodically deletes expired executor job artifacts."),
+	}
+	return goroutine.NewPeriodicGoroutine(
+		context.Background(),
+		handler,
+		goroutine.WithName("executors.artifact-janitor"),
+		goroutine.WithDescription("deletes executor job artifacts older than the configured max age"),
+		goroutine.WithInterval(cleanupInterval),
+	)
+}
+
+// Handle deletes the contents of expired artifacts from the upload store, and then
+// their records. Records of artifacts whose contents could not be deleted are kept,
+// so that they are retried on the next run.
+func (j *artifactJanitor) Handle(ctx context.Context) error {
+	expired, err := j.store.ListExpired(ctx, j.maxAge, artifactJanitorBatchSize)
+	if err != nil {
+		return errors.Wrap(err, "listing expired artifacts")
+	}
+
+	var (
+		ids  = make([]int, 0, len(expired))
+		errs error
+	)
+	for _, artifact := range expired {
+		if err := j.uploadStore.Delete(ctx, artifact.ObjectKey); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "deleting contents of artifact %d", artifact.ID))
+			continue
+		}
+		ids = append(ids, artifact.ID)
+	}
+
+	if err := j.store.Delete(ctx, ids); err != nil {
+		errs = errors.Append(errs, errors.Wrap(err, "deleting expired artifacts"))
+	} else if len(ids) > 0 {
+		j.logger.Debug("Deleted expired artifacts", log.Int("count", len(ids)))
+	}
+
+	return errs
+}
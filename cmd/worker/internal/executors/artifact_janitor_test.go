@@ -0,0 +1,49 @@
+package executors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor/artifacts"
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestArtifactJanitor(t *testing.T) {
+	store := artifacts.NewMockStore()
+	store.ListExpiredFunc.SetDefaultReturn([]*artifacts.Artifact{
+		{ID: 1, ObjectKey: "customjobs/1/report.xml"},
+		{ID: 2, ObjectKey: "customjobs/1/build.log"},
+		{ID: 3, ObjectKey: "customjobs/2/report.xml"},
+	}, nil)
+
+	uploadStore := uploadstoremocks.NewMockStore()
+	uploadStore.DeleteFunc.SetDefaultHook(func(ctx context.Context, key string) error {
+		if key == "customjobs/1/build.log" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	janitor := &artifactJanitor{
+		store:       store,
+		uploadStore: uploadStore,
+		maxAge:      time.Hour,
+		logger:      logtest.Scoped(t),
+	}
+	err := janitor.Handle(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deleting contents of artifact 2")
+
+	require.Len(t, store.ListExpiredFunc.History(), 1)
+	assert.Equal(t, time.Hour, store.ListExpiredFunc.History()[0].Arg1)
+	assert.Len(t, uploadStore.DeleteFunc.History(), 3)
+	// The record of the artifact that could not be deleted is kept for the next run.
+	require.Len(t, store.DeleteFunc.History(), 1)
+	assert.Equal(t, []int{1, 3}, store.DeleteFunc.History()[0].Arg1)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
 	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/executor/artifacts"
 	executortypes "github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -23,15 +24,20 @@ func (j *janitorJob) Description() string {
 }
 
 func (j *janitorJob) Config() []env.Config {
-	return []env.Config{janitorConfigInst}
+	return []env.Config{janitorConfigInst, artifacts.ConfigInst}
 }
 
-func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+func (j *janitorJob) Routines(ctx context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
 	db, err := workerdb.InitDB(observationCtx)
 	if err != nil {
 		return nil, err
 	}
 
+	artifactUploadStore, err := artifacts.NewUploadStore(ctx, observationCtx, artifacts.ConfigInst)
+	if err != nil {
+		return nil, err
+	}
+
 	dequeueCache := rcache.New(executortypes.DequeueCachePrefix)
 
 	routines := []goroutine.BackgroundRoutine{
@@ -45,6 +51,7 @@ func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Con
 			goroutine.WithInterval(janitorConfigInst.CleanupTaskInterval),
 		),
 		NewMultiqueueCacheCleaner(executortypes.ValidQueueNames, dequeueCache, janitorConfigInst.CacheDequeueTtl, janitorConfigInst.CacheCleanupInterval),
+		NewArtifactJanitor(artifacts.NewStore(db.Handle()), artifactUploadStore, artifacts.ConfigInst.MaxAge, janitorConfigInst.CleanupTaskInterval),
 	}
 
 	return routines, nil
@@ -0,0 +1,175 @@
+package permissions
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/authz/subrepoperms"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var _ job.Job = (*subRepoPermsACLIngestor)(nil)
+
+// subRepoPermsACLIngestor is a worker responsible for storing the path ACL
+// files committed to private repositories, from which sub-repo permissions are
+// derived.
+type subRepoPermsACLIngestor struct{}
+
+func NewSubRepoPermsACLIngestor() job.Job {
+	return &subRepoPermsACLIngestor{}
+}
+
+func (s *subRepoPermsACLIngestor) Description() string {
+	return "Ingests path ACL files committed to private repositories for sub-repo permissions."
+}
+
+func (s *subRepoPermsACLIngestor) Config() []env.Config {
+	return nil
+}
+
+func (s *subRepoPermsACLIngestor) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "init DB")
+	}
+
+	h := &subRepoPermsACLHandler{
+		db:              db,
+		gitserverClient: gitserver.NewClient(),
+		logger:          observationCtx.Logger.Scoped("SubRepoPermsACLIngestor", ""),
+	}
+
+	return []goroutine.BackgroundRoutine{
+		goroutine.NewPeriodicGoroutine(
+			context.Background(),
+			h,
+			goroutine.WithName("auth.sub_repo_perms_acl_ingestor"),
+			goroutine.WithDescription(s.Description()),
+			goroutine.WithInterval(10*time.Minute),
+		),
+	}, nil
+}
+
+const subRepoPermsACLIngestorBatchSize = 500
+
+type subRepoPermsACLHandler struct {
+	db              database.DB
+	gitserverClient gitserver.Client
+	logger          log.Logger
+}
+
+var (
+	_ goroutine.Handler      = &subRepoPermsACLHandler{}
+	_ goroutine.ErrorHandler = &subRepoPermsACLHandler{}
+)
+
+// aclFilePath returns the configured path of committed ACL files, or an empty
+// string if they should not be ingested.
+func aclFilePath() string {
+	c := conf.Get().ExperimentalFeatures
+	if c == nil || c.SubRepoPermissions == nil || !c.SubRepoPermissions.Enabled {
+		return ""
+	}
+	return c.SubRepoPermissions.AclFile
+}
+
+func (h *subRepoPermsACLHandler) Handle(ctx context.Context) error {
+	path := aclFilePath()
+	if path == "" {
+		return nil
+	}
+
+	ctx = actor.WithInternalActor(ctx)
+
+	opts := database.ReposListOptions{
+		OnlyPrivate: true,
+		OnlyCloned:  true,
+		LimitOffset: &database.LimitOffset{Limit: subRepoPermsACLIngestorBatchSize},
+	}
+	for {
+		repos, err := h.db.Repos().ListMinimalRepos(ctx, opts)
+		if err != nil {
+			return errors.Wrap(err, "listing repos")
+		}
+
+		for _, repo := range repos {
+			if err := h.ingest(ctx, repo, path); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				h.logger.Warn("failed to ingest ACL file", log.String("repo", string(repo.Name)), log.Error(err))
+			}
+		}
+
+		if len(repos) < subRepoPermsACLIngestorBatchSize {
+			return nil
+		}
+		opts.Offset += subRepoPermsACLIngestorBatchSize
+	}
+}
+
+// ingest stores the ACL file at path on the default branch of repo, or deletes
+// the stored one if there's none.
+func (h *subRepoPermsACLHandler) ingest(ctx context.Context, repo types.MinimalRepo, path string) error {
+	store := h.db.SubRepoPerms()
+
+	existing, err := store.GetACL(ctx, repo.ID)
+	if err != nil && !errcode.IsNotFound(err) {
+		return err
+	}
+	if existing != nil && existing.Source == database.SubRepoPermsACLSourceUpload {
+		// Uploaded ACL files take precedence.
+		return nil
+	}
+
+	_, commit, err := h.gitserverClient.GetDefaultBranch(ctx, repo.Name, true)
+	if err != nil {
+		return errors.Wrap(err, "resolving default branch")
+	}
+	if commit == "" {
+		// Empty repository.
+		return store.DeleteACL(ctx, repo.ID, database.SubRepoPermsACLSourceRepository)
+	}
+	if existing != nil && existing.CommitID == commit {
+		return nil
+	}
+
+	contents, err := h.gitserverClient.ReadFile(ctx, authz.DefaultSubRepoPermsChecker, repo.Name, commit, path)
+	if os.IsNotExist(err) {
+		return store.DeleteACL(ctx, repo.ID, database.SubRepoPermsACLSourceRepository)
+	}
+	if err != nil {
+		return errors.Wrap(err, "reading ACL file")
+	}
+	if _, err := subrepoperms.ParseACL(contents); err != nil {
+		// Keep the previously ingested ACL file, if any, rather than
+		// opening up access to the repository.
+		return errors.Wrapf(err, "invalid ACL file at %s", commit)
+	}
+
+	return store.UpsertACL(ctx, &database.SubRepoPermsACL{
+		RepoID:   repo.ID,
+		Contents: string(contents),
+		Source:   database.SubRepoPermsACLSourceRepository,
+		CommitID: commit,
+	})
+}
+
+func (h *subRepoPermsACLHandler) HandleError(err error) {
+	h.logger.Error("error ingesting sub-repo permissions ACL files", log.Error(err))
+}
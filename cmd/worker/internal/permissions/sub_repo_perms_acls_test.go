@@ -0,0 +1,91 @@
+package permissions
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestSubRepoPermsACLHandler(t *testing.T) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		ExperimentalFeatures: &schema.ExperimentalFeatures{
+			SubRepoPermissions: &schema.SubRepoPermissions{Enabled: true, AclFile: ".sourcegraph/ACL"},
+		},
+	}})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	repos := dbmocks.NewMockRepoStore()
+	repos.ListMinimalReposFunc.SetDefaultReturn([]types.MinimalRepo{
+		{ID: 1, Name: "uploaded"},
+		{ID: 2, Name: "unchanged"},
+		{ID: 3, Name: "changed"},
+		{ID: 4, Name: "removed"},
+		{ID: 5, Name: "invalid"},
+	}, nil)
+
+	acls := map[api.RepoID]*database.SubRepoPermsACL{
+		1: {RepoID: 1, Contents: "* @alice", Source: database.SubRepoPermsACLSourceUpload},
+		2: {RepoID: 2, Contents: "* @alice", Source: database.SubRepoPermsACLSourceRepository, CommitID: "c2"},
+		3: {RepoID: 3, Contents: "* @alice", Source: database.SubRepoPermsACLSourceRepository, CommitID: "old"},
+		4: {RepoID: 4, Contents: "* @alice", Source: database.SubRepoPermsACLSourceRepository, CommitID: "old"},
+	}
+	store := dbmocks.NewMockSubRepoPermsStore()
+	store.GetACLFunc.SetDefaultHook(func(_ context.Context, id api.RepoID) (*database.SubRepoPermsACL, error) {
+		if acl, ok := acls[id]; ok {
+			return acl, nil
+		}
+		return nil, database.SubRepoPermsACLNotFoundError{}
+	})
+
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+	db.SubRepoPermsFunc.SetDefaultReturn(store)
+
+	gs := gitserver.NewMockClient()
+	gs.GetDefaultBranchFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, _ bool) (string, api.CommitID, error) {
+		if repo == "unchanged" {
+			return "main", "c2", nil
+		}
+		return "main", "new", nil
+	})
+	gs.ReadFileFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, repo api.RepoName, _ api.CommitID, name string) ([]byte, error) {
+		require.Equal(t, ".sourcegraph/ACL", name)
+		switch repo {
+		case "changed":
+			return []byte("/secrets/ @security\n"), nil
+		case "invalid":
+			return []byte("/secrets//foo @security\n"), nil
+		}
+		return nil, os.ErrNotExist
+	})
+
+	h := &subRepoPermsACLHandler{db: db, gitserverClient: gs, logger: logtest.Scoped(t)}
+	require.NoError(t, h.Handle(context.Background()))
+
+	// Only the changed ACL file is read and stored.
+	require.Len(t, gs.ReadFileFunc.History(), 3)
+	require.Len(t, store.UpsertACLFunc.History(), 1)
+	require.Equal(t, &database.SubRepoPermsACL{
+		RepoID:   3,
+		Contents: "/secrets/ @security\n",
+		Source:   database.SubRepoPermsACLSourceRepository,
+		CommitID: "new",
+	}, store.UpsertACLFunc.History()[0].Arg1)
+
+	// Only the removed ACL file is deleted.
+	require.Len(t, store.DeleteACLFunc.History(), 1)
+	require.Equal(t, api.RepoID(4), store.DeleteACLFunc.History()[0].Arg1)
+	require.Equal(t, database.SubRepoPermsACLSourceRepository, store.DeleteACLFunc.History()[0].Arg2)
+}
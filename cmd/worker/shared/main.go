@@ -13,11 +13,13 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/globals"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/auditlogs"
 	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/auth"
 	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/batches"
 	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/codeintel"
 	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/codygateway"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/customjobs"
 	repoembeddings "github.com/sourcegraph/sourcegraph/cmd/worker/internal/embeddings/repo"
 	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/encryption"
 	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/executormultiqueue"
@@ -74,6 +76,7 @@ func LoadConfig(registerEnterpriseMigrators oobmigration.RegisterMigratorsFunc)
 
 	builtins := map[string]workerjob.Job{
 		"webhook-log-janitor":                   webhooks.NewJanitor(),
+		"audit-logs-janitor":                    auditlogs.NewJanitor(),
 		"out-of-band-migrations":                workermigrations.NewMigrator(registerMigrators),
 		"gitserver-metrics":                     gitserver.NewMetricsJob(),
 		"record-encrypter":                      encryption.NewRecordEncrypterJob(),
@@ -95,10 +98,12 @@ func LoadConfig(registerEnterpriseMigrators oobmigration.RegisterMigratorsFunc)
 		"executors-janitor":                     executors.NewJanitorJob(),
 		"executors-metricsserver":               executors.NewMetricsServerJob(),
 		"executors-multiqueue-metrics-reporter": executormultiqueue.NewMultiqueueMetricsReporterJob(),
+		"customjobs-janitor":                    customjobs.NewJanitorJob(),
 		"codemonitors-job":                      codemonitors.NewCodeMonitorJob(),
 		"bitbucket-project-permissions":         permissions.NewBitbucketProjectPermissionsJob(),
 		"permission-sync-job-cleaner":           permissions.NewPermissionSyncJobCleaner(),
 		"permission-sync-job-scheduler":         permissions.NewPermissionSyncJobScheduler(),
+		"sub-repo-perms-acl-ingestor":           permissions.NewSubRepoPermsACLIngestor(),
 		"export-usage-telemetry":                telemetry.NewTelemetryJob(),
 		"telemetrygateway-exporter":             telemetrygatewayexporter.NewJob(),
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sourcegraph/log"
@@ -18,6 +19,10 @@ import (
 // Log creates an INFO log statement that will be a part of the audit log.
 // The audit log records comply with the following design: an actor takes an action on an entity within a context.
 // Refer to Record struct to see details about individual components.
+//
+// Depending on the configured audit log location, the record is written to the
+// registered Sink instead of, or in addition to, the logger. Without a
+// registered Sink, records are always logged.
 func Log(ctx context.Context, logger log.Logger, record Record) {
 	act := actor.FromContext(ctx)
 
@@ -39,22 +44,53 @@ func Log(ctx context.Context, logger log.Logger, record Record) {
 		auditId = record.auditIDGenerator()
 	}
 
+	loc := AuditLogLocation(siteConfig)
+	s := currentSink()
+	if s != nil && (loc == Database || loc == All) {
+		e := Entry{
+			AuditID:      auditId,
+			Time:         time.Now(),
+			Entity:       record.Entity,
+			Action:       record.Action,
+			AnonymousUID: act.AnonymousUID,
+			Fields:       encodeFields(record.Fields),
+		}
+		if act.UID > 0 {
+			e.ActorUID = act.UID
+		}
+		if client != nil {
+			e.IP = client.IP
+			e.UserAgent = client.UserAgent
+			e.ForwardedFor = client.ForwardedFor
+		}
+		s.Write(e)
+
+		if loc == Database {
+			return
+		}
+	}
+
+	logRecord(logger, auditId, record.Action, record.Entity, actorId(act), ip(client), userAgent(client), forwardedFor(client), record.Fields...)
+}
+
+// logRecord writes an audit log record to the logger.
+func logRecord(logger log.Logger, auditId, action, entity, actorUID, ip, userAgent, forwardedFor string, extraFields ...log.Field) {
 	var fields []log.Field
 
 	fields = append(fields, log.Object("audit",
 		log.String("auditId", auditId),
-		log.String("action", record.Action),
-		log.String("entity", record.Entity),
+		log.String("action", action),
+		log.String("entity", entity),
 		log.Object("actor",
-			log.String("actorUID", actorId(act)),
-			log.String("ip", ip(client)),
-			log.String("userAgent", userAgent(client)),
-			log.String("X-Forwarded-For", forwardedFor(client)))))
-	fields = append(fields, record.Fields...)
+			log.String("actorUID", actorUID),
+			log.String("ip", ip),
+			log.String("userAgent", userAgent),
+			log.String("X-Forwarded-For", forwardedFor))))
+	fields = append(fields, extraFields...)
 
 	loggerFunc := getLoggerFuncWithSeverity(logger)
 	// message string looks like: #{record.Action} (sampling immunity token: #{auditId})
-	loggerFunc(fmt.Sprintf("%s (sampling immunity token: %s)", record.Action, auditId), fields...)
+	loggerFunc(fmt.Sprintf("%s (sampling immunity token: %s)", action, auditId), fields...)
 }
 
 func actorId(act *actor.Actor) string {
@@ -0,0 +1,147 @@
+// Package dbsink implements an audit.Sink that stores audit log records in the
+// database.
+package dbsink
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+const (
+	// bufferSize is the number of records that are buffered before new
+	// records are logged instead.
+	bufferSize = 10000
+	// batchSize is the maximum number of records inserted at once.
+	batchSize = 500
+	// flushInterval is how often buffered records are inserted.
+	flushInterval = time.Second
+)
+
+// Sink buffers audit log records in memory and inserts them into the database
+// in batches, so that logging never blocks on the database. Records that can't
+// be buffered or inserted are logged instead, so that they are never lost. Once
+// started, it is registered as the audit.Sink of the process.
+type Sink struct {
+	store   database.AuditLogStore
+	logger  log.Logger
+	entries chan audit.Entry
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+var (
+	_ audit.Sink                  = &Sink{}
+	_ goroutine.BackgroundRoutine = &Sink{}
+)
+
+// New returns a new Sink writing to the audit logs of the given database.
+func New(logger log.Logger, db database.DB) *Sink {
+	return &Sink{
+		store:   db.AuditLogs(),
+		logger:  logger.Scoped("auditLogSink", "stores audit log records in the database"),
+		entries: make(chan audit.Entry, bufferSize),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Write buffers the given record. If the buffer is full, the record is logged
+// instead.
+func (s *Sink) Write(e audit.Entry) {
+	select {
+	case s.entries <- e:
+	default:
+		s.logger.Warn("audit log buffer is full, logging record instead", log.String("auditId", e.AuditID))
+		audit.LogEntry(s.logger, e)
+	}
+}
+
+func (s *Sink) Start() {
+	audit.SetSink(s)
+	defer close(s.done)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.flush()
+		case <-s.stop:
+			s.flush()
+			return
+		}
+	}
+}
+
+func (s *Sink) Stop() {
+	s.stopOnce.Do(func() {
+		audit.SetSink(nil)
+		close(s.stop)
+	})
+	<-s.done
+}
+
+// flush inserts all buffered records.
+func (s *Sink) flush() {
+	for {
+		batch := s.nextBatch()
+		if len(batch) == 0 {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		err := s.store.Insert(ctx, toAuditLogs(batch))
+		cancel()
+		if err != nil {
+			s.logger.Error("failed to store audit log records, logging them instead", log.Int("count", len(batch)), log.Error(err))
+			for _, e := range batch {
+				audit.LogEntry(s.logger, e)
+			}
+		}
+		if len(batch) < batchSize {
+			return
+		}
+	}
+}
+
+func (s *Sink) nextBatch() []audit.Entry {
+	var batch []audit.Entry
+	for len(batch) < batchSize {
+		select {
+		case e := <-s.entries:
+			batch = append(batch, e)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func toAuditLogs(entries []audit.Entry) []*database.AuditLog {
+	logs := make([]*database.AuditLog, 0, len(entries))
+	for _, e := range entries {
+		logs = append(logs, &database.AuditLog{
+			AuditID:           e.AuditID,
+			CreatedAt:         e.Time,
+			Entity:            e.Entity,
+			Action:            e.Action,
+			ActorUID:          e.ActorUID,
+			AnonymousActorUID: e.AnonymousUID,
+			IP:                e.IP,
+			UserAgent:         e.UserAgent,
+			ForwardedFor:      e.ForwardedFor,
+			Fields:            e.Fields,
+		})
+	}
+	return logs
+}
@@ -0,0 +1,88 @@
+package dbsink
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestSink(t *testing.T) {
+	store := dbmocks.NewMockAuditLogStore()
+	db := dbmocks.NewMockDB()
+	db.AuditLogsFunc.SetDefaultReturn(store)
+
+	s := New(logtest.Scoped(t), db)
+
+	now := time.Now()
+	for i := 0; i < batchSize+1; i++ {
+		s.Write(audit.Entry{AuditID: "id", Time: now, Entity: "entity", Action: "action", ActorUID: 1})
+	}
+
+	go s.Start()
+	s.Stop()
+
+	// The buffered records are flushed on stop, in batches.
+	history := store.InsertFunc.History()
+	require.Len(t, history, 2)
+	assert.Len(t, history[0].Arg1, batchSize)
+	assert.Len(t, history[1].Arg1, 1)
+
+	l := history[1].Arg1[0]
+	assert.Equal(t, "id", l.AuditID)
+	assert.Equal(t, now, l.CreatedAt)
+	assert.Equal(t, int32(1), l.ActorUID)
+}
+
+func TestSinkLogsWhenFull(t *testing.T) {
+	db := dbmocks.NewMockDB()
+	db.AuditLogsFunc.SetDefaultReturn(dbmocks.NewMockAuditLogStore())
+
+	logger, exportLogs := logtest.Captured(t)
+	s := New(logger, db)
+	for i := 0; i < bufferSize+1; i++ {
+		s.Write(audit.Entry{AuditID: fmt.Sprintf("id-%d", i), Action: "action"})
+	}
+	assert.Len(t, s.entries, bufferSize)
+
+	// The record that didn't fit into the buffer is logged instead.
+	logs := exportLogs().Filter(func(l logtest.CapturedLog) bool {
+		return strings.Contains(l.Message, fmt.Sprintf("sampling immunity token: id-%d", bufferSize))
+	})
+	assert.Len(t, logs, 1)
+}
+
+func TestSinkLogsWhenInsertFails(t *testing.T) {
+	store := dbmocks.NewMockAuditLogStore()
+	store.InsertFunc.SetDefaultReturn(errors.New("database is down"))
+	db := dbmocks.NewMockDB()
+	db.AuditLogsFunc.SetDefaultReturn(store)
+
+	logger, exportLogs := logtest.Captured(t)
+	s := New(logger, db)
+	// Fill the buffer, and overflow it by one record.
+	for i := 0; i < bufferSize+1; i++ {
+		s.Write(audit.Entry{AuditID: fmt.Sprintf("id-%d", i), Action: "action", ActorUID: 1})
+	}
+
+	go s.Start()
+	s.Stop()
+
+	require.Len(t, store.InsertFunc.History(), bufferSize/batchSize)
+	// None of the records are lost: the one that didn't fit into the buffer
+	// is logged right away, and the others once they fail to be inserted.
+	logs := exportLogs().Filter(func(l logtest.CapturedLog) bool {
+		return strings.HasPrefix(l.Message, "action (sampling immunity token: ")
+	})
+	require.Len(t, logs, bufferSize+1)
+	assert.Equal(t, fmt.Sprintf("action (sampling immunity token: id-%d)", bufferSize), logs[0].Message)
+	assert.Equal(t, "action (sampling immunity token: id-0)", logs[1].Message)
+}
@@ -0,0 +1,117 @@
+package audit
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// Entry is an audit log record as written to a Sink.
+type Entry struct {
+	AuditID string
+	Time    time.Time
+	Entity  string
+	Action  string
+
+	// ActorUID is the ID of the user that took the action, or 0 if the actor
+	// isn't a user.
+	ActorUID     int32
+	AnonymousUID string
+	IP           string
+	UserAgent    string
+	ForwardedFor string
+
+	// Fields holds the additional context of the record as a JSON object.
+	Fields json.RawMessage
+}
+
+// Sink durably stores audit log records, for example in the database.
+type Sink interface {
+	// Write stores the given entry. It must not block the caller; entries that
+	// can't be stored should be written with LogEntry instead, so that they
+	// are not lost.
+	Write(e Entry)
+}
+
+// LogEntry writes an entry to the logger in the same format as Log. Sinks use
+// it for entries they fail to store.
+func LogEntry(logger log.Logger, e Entry) {
+	actorUID := e.AnonymousUID
+	if e.ActorUID > 0 {
+		actorUID = strconv.FormatInt(int64(e.ActorUID), 10)
+	}
+	logRecord(logger, e.AuditID, e.Action, e.Entity,
+		orUnknown(actorUID), orUnknown(e.IP), orUnknown(e.UserAgent), orUnknown(e.ForwardedFor),
+		log.Time("time", e.Time),
+		log.String("fields", string(e.Fields)))
+}
+
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
+
+var sink atomic.Pointer[Sink]
+
+// SetSink registers the sink that audit log records are written to when the
+// audit log location is "database" or "all". Passing nil unregisters it.
+func SetSink(s Sink) {
+	if s == nil {
+		sink.Store(nil)
+		return
+	}
+	sink.Store(&s)
+}
+
+func currentSink() Sink {
+	if s := sink.Load(); s != nil {
+		return *s
+	}
+	return nil
+}
+
+// AuditLogLocation returns where audit log records are written to. It reuses
+// the locations of security events, with AuditLog as the default.
+func AuditLogLocation(cfg schema.SiteConfiguration) SecurityEventsLocation {
+	if auditCfg := getAuditCfg(cfg); auditCfg != nil {
+		switch auditCfg.Location {
+		case "database":
+			return Database
+		case "all":
+			return All
+		}
+	}
+	return AuditLog
+}
+
+// DatabaseRetention returns for how long audit log records written to the
+// database are kept.
+func DatabaseRetention(cfg schema.SiteConfiguration) time.Duration {
+	days := 180
+	if auditCfg := getAuditCfg(cfg); auditCfg != nil && auditCfg.DatabaseRetentionDays > 0 {
+		days = auditCfg.DatabaseRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// encodeFields encodes the given log fields as a JSON object.
+func encodeFields(fields []log.Field) json.RawMessage {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	b, err := json.Marshal(enc.Fields)
+	if err != nil {
+		// Fields that can't be encoded are dropped rather than the record.
+		return json.RawMessage("{}")
+	}
+	return b
+}
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type recordingSink struct {
+	entries []Entry
+}
+
+func (s *recordingSink) Write(e Entry) {
+	s.entries = append(s.entries, e)
+}
+
+func TestLogSink(t *testing.T) {
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+	ctx = requestclient.WithClient(ctx, &requestclient.Client{
+		IP:           "192.168.0.1",
+		ForwardedFor: "192.168.0.1",
+		UserAgent:    "Foobar",
+	})
+	record := Record{
+		Entity: "test entity",
+		Action: "test audit action",
+		Fields: []log.Field{log.String("additional", "stuff")},
+
+		auditIDGenerator: func() string { return "test-audit-id-1234" },
+	}
+
+	for _, tc := range []struct {
+		location    string
+		withSink    bool
+		wantLogs    int
+		wantEntries int
+	}{
+		{location: "auditlog", withSink: true, wantLogs: 1, wantEntries: 0},
+		{location: "database", withSink: true, wantLogs: 0, wantEntries: 1},
+		{location: "all", withSink: true, wantLogs: 1, wantEntries: 1},
+		// Records are never lost when no sink is registered in the process.
+		{location: "database", withSink: false, wantLogs: 1, wantEntries: 0},
+	} {
+		t.Run(tc.location, func(t *testing.T) {
+			conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				Log: &schema.Log{AuditLog: &schema.AuditLog{Location: tc.location}},
+			}})
+			t.Cleanup(func() { conf.Mock(nil) })
+
+			sink := &recordingSink{}
+			if tc.withSink {
+				SetSink(sink)
+				t.Cleanup(func() { SetSink(nil) })
+			}
+
+			logger, exportLogs := logtest.Captured(t)
+			Log(ctx, logger, record)
+
+			assert.Len(t, exportLogs(), tc.wantLogs)
+			require.Len(t, sink.entries, tc.wantEntries)
+			if tc.wantEntries == 0 {
+				return
+			}
+
+			e := sink.entries[0]
+			assert.Equal(t, "test-audit-id-1234", e.AuditID)
+			assert.Equal(t, "test entity", e.Entity)
+			assert.Equal(t, "test audit action", e.Action)
+			assert.Equal(t, int32(1), e.ActorUID)
+			assert.Equal(t, "192.168.0.1", e.IP)
+			assert.Equal(t, "Foobar", e.UserAgent)
+			assert.WithinDuration(t, time.Now(), e.Time, time.Minute)
+
+			var fields map[string]any
+			require.NoError(t, json.Unmarshal(e.Fields, &fields))
+			assert.Equal(t, map[string]any{"additional": "stuff"}, fields)
+		})
+	}
+}
+
+func TestDatabaseRetention(t *testing.T) {
+	assert.Equal(t, 180*24*time.Hour, DatabaseRetention(schema.SiteConfiguration{}))
+	assert.Equal(t, 30*24*time.Hour, DatabaseRetention(schema.SiteConfiguration{
+		Log: &schema.Log{AuditLog: &schema.AuditLog{DatabaseRetentionDays: 30}},
+	}))
+}
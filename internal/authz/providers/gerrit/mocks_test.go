@@ -22,6 +22,9 @@ type MockGerritClient struct {
 	// AbandonChangeFunc is an instance of a mock function object
 	// controlling the behavior of the method AbandonChange.
 	AbandonChangeFunc *GerritClientAbandonChangeFunc
+	// AddReviewerFunc is an instance of a mock function object controlling
+	// the behavior of the method AddReviewer.
+	AddReviewerFunc *GerritClientAddReviewerFunc
 	// AuthenticatorFunc is an instance of a mock function object
 	// controlling the behavior of the method Authenticator.
 	AuthenticatorFunc *GerritClientAuthenticatorFunc
@@ -56,6 +59,9 @@ type MockGerritClient struct {
 	// SetCommitMessageFunc is an instance of a mock function object
 	// controlling the behavior of the method SetCommitMessage.
 	SetCommitMessageFunc *GerritClientSetCommitMessageFunc
+	// SetHashtagsFunc is an instance of a mock function object controlling
+	// the behavior of the method SetHashtags.
+	SetHashtagsFunc *GerritClientSetHashtagsFunc
 	// SetReadyForReviewFunc is an instance of a mock function object
 	// controlling the behavior of the method SetReadyForReview.
 	SetReadyForReviewFunc *GerritClientSetReadyForReviewFunc
@@ -82,6 +88,11 @@ func NewMockGerritClient() *MockGerritClient {
 				return
 			},
 		},
+		AddReviewerFunc: &GerritClientAddReviewerFunc{
+			defaultHook: func(context.Context, string, gerrit.AddReviewerPayload) (r0 error) {
+				return
+			},
+		},
 		AuthenticatorFunc: &GerritClientAuthenticatorFunc{
 			defaultHook: func() (r0 auth.Authenticator) {
 				return
@@ -137,6 +148,11 @@ func NewMockGerritClient() *MockGerritClient {
 				return
 			},
 		},
+		SetHashtagsFunc: &GerritClientSetHashtagsFunc{
+			defaultHook: func(context.Context, string, gerrit.SetHashtagsPayload) (r0 error) {
+				return
+			},
+		},
 		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
 			defaultHook: func(context.Context, string) (r0 error) {
 				return
@@ -174,6 +190,11 @@ func NewStrictMockGerritClient() *MockGerritClient {
 				panic("unexpected invocation of MockGerritClient.AbandonChange")
 			},
 		},
+		AddReviewerFunc: &GerritClientAddReviewerFunc{
+			defaultHook: func(context.Context, string, gerrit.AddReviewerPayload) error {
+				panic("unexpected invocation of MockGerritClient.AddReviewer")
+			},
+		},
 		AuthenticatorFunc: &GerritClientAuthenticatorFunc{
 			defaultHook: func() auth.Authenticator {
 				panic("unexpected invocation of MockGerritClient.Authenticator")
@@ -229,6 +250,11 @@ func NewStrictMockGerritClient() *MockGerritClient {
 				panic("unexpected invocation of MockGerritClient.SetCommitMessage")
 			},
 		},
+		SetHashtagsFunc: &GerritClientSetHashtagsFunc{
+			defaultHook: func(context.Context, string, gerrit.SetHashtagsPayload) error {
+				panic("unexpected invocation of MockGerritClient.SetHashtags")
+			},
+		},
 		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
 			defaultHook: func(context.Context, string) error {
 				panic("unexpected invocation of MockGerritClient.SetReadyForReview")
@@ -265,6 +291,9 @@ func NewMockGerritClientFrom(i gerrit.Client) *MockGerritClient {
 		AbandonChangeFunc: &GerritClientAbandonChangeFunc{
 			defaultHook: i.AbandonChange,
 		},
+		AddReviewerFunc: &GerritClientAddReviewerFunc{
+			defaultHook: i.AddReviewer,
+		},
 		AuthenticatorFunc: &GerritClientAuthenticatorFunc{
 			defaultHook: i.Authenticator,
 		},
@@ -298,6 +327,9 @@ func NewMockGerritClientFrom(i gerrit.Client) *MockGerritClient {
 		SetCommitMessageFunc: &GerritClientSetCommitMessageFunc{
 			defaultHook: i.SetCommitMessage,
 		},
+		SetHashtagsFunc: &GerritClientSetHashtagsFunc{
+			defaultHook: i.SetHashtags,
+		},
 		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
 			defaultHook: i.SetReadyForReview,
 		},
@@ -424,6 +456,114 @@ func (c GerritClientAbandonChangeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GerritClientAddReviewerFunc describes the behavior when the AddReviewer
+// method of the parent MockGerritClient instance is invoked.
+type GerritClientAddReviewerFunc struct {
+	defaultHook func(context.Context, string, gerrit.AddReviewerPayload) error
+	hooks       []func(context.Context, string, gerrit.AddReviewerPayload) error
+	history     []GerritClientAddReviewerFuncCall
+	mutex       sync.Mutex
+}
+
+// AddReviewer delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) AddReviewer(v0 context.Context, v1 string, v2 gerrit.AddReviewerPayload) error {
+	r0 := m.AddReviewerFunc.nextHook()(v0, v1, v2)
+	m.AddReviewerFunc.appendCall(GerritClientAddReviewerFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the AddReviewer method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientAddReviewerFunc) SetDefaultHook(hook func(context.Context, string, gerrit.AddReviewerPayload) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AddReviewer method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientAddReviewerFunc) PushHook(hook func(context.Context, string, gerrit.AddReviewerPayload) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientAddReviewerFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, gerrit.AddReviewerPayload) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientAddReviewerFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, gerrit.AddReviewerPayload) error {
+		return r0
+	})
+}
+
+func (f *GerritClientAddReviewerFunc) nextHook() func(context.Context, string, gerrit.AddReviewerPayload) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientAddReviewerFunc) appendCall(r0 GerritClientAddReviewerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientAddReviewerFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientAddReviewerFunc) History() []GerritClientAddReviewerFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientAddReviewerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientAddReviewerFuncCall is an object that describes an invocation
+// of method AddReviewer on an instance of MockGerritClient.
+type GerritClientAddReviewerFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gerrit.AddReviewerPayload
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientAddReviewerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientAddReviewerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GerritClientAuthenticatorFunc describes the behavior when the
 // Authenticator method of the parent MockGerritClient instance is invoked.
 type GerritClientAuthenticatorFunc struct {
@@ -1599,6 +1739,114 @@ func (c GerritClientSetCommitMessageFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// GerritClientSetHashtagsFunc describes the behavior when the SetHashtags
+// method of the parent MockGerritClient instance is invoked.
+type GerritClientSetHashtagsFunc struct {
+	defaultHook func(context.Context, string, gerrit.SetHashtagsPayload) error
+	hooks       []func(context.Context, string, gerrit.SetHashtagsPayload) error
+	history     []GerritClientSetHashtagsFuncCall
+	mutex       sync.Mutex
+}
+
+// SetHashtags delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) SetHashtags(v0 context.Context, v1 string, v2 gerrit.SetHashtagsPayload) error {
+	r0 := m.SetHashtagsFunc.nextHook()(v0, v1, v2)
+	m.SetHashtagsFunc.appendCall(GerritClientSetHashtagsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetHashtags method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientSetHashtagsFunc) SetDefaultHook(hook func(context.Context, string, gerrit.SetHashtagsPayload) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetHashtags method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientSetHashtagsFunc) PushHook(hook func(context.Context, string, gerrit.SetHashtagsPayload) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientSetHashtagsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, gerrit.SetHashtagsPayload) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientSetHashtagsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, gerrit.SetHashtagsPayload) error {
+		return r0
+	})
+}
+
+func (f *GerritClientSetHashtagsFunc) nextHook() func(context.Context, string, gerrit.SetHashtagsPayload) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientSetHashtagsFunc) appendCall(r0 GerritClientSetHashtagsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientSetHashtagsFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientSetHashtagsFunc) History() []GerritClientSetHashtagsFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientSetHashtagsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientSetHashtagsFuncCall is an object that describes an invocation
+// of method SetHashtags on an instance of MockGerritClient.
+type GerritClientSetHashtagsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gerrit.SetHashtagsPayload
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientSetHashtagsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientSetHashtagsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GerritClientSetReadyForReviewFunc describes the behavior when the
 // SetReadyForReview method of the parent MockGerritClient instance is
 // invoked.
@@ -0,0 +1,140 @@
+package subrepoperms
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
+	"github.com/sourcegraph/sourcegraph/internal/paths"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ACL is a parsed path ACL file. ACL files use the CODEOWNERS syntax: each
+// line maps a path pattern to the users, emails and teams allowed to read the
+// matching files. As in CODEOWNERS files, the last matching rule wins. Paths
+// not matched by any rule are readable by everyone with access to the
+// repository.
+type ACL struct {
+	rules []aclRule
+}
+
+type aclRule struct {
+	// glob is the pattern of the rule translated to the glob syntax used by
+	// sub-repo permissions.
+	glob   string
+	owners []*codeownerspb.Owner
+}
+
+// ParseACL parses the contents of an ACL file.
+func ParseACL(contents []byte) (*ACL, error) {
+	file, err := codeowners.Parse(bytes.NewReader(contents))
+	if err != nil {
+		return nil, err
+	}
+
+	acl := &ACL{rules: make([]aclRule, 0, len(file.GetRule()))}
+	for _, r := range file.GetRule() {
+		if _, err := paths.Compile(r.GetPattern()); err != nil {
+			return nil, errors.Wrapf(err, "line %d: invalid pattern %q", r.GetLineNumber(), r.GetPattern())
+		}
+		acl.rules = append(acl.rules, aclRule{
+			glob:   aclGlob(r.GetPattern()),
+			owners: r.GetOwner(),
+		})
+	}
+	return acl, nil
+}
+
+// ACLIdentity describes a user as matched against the owners listed in an ACL
+// file.
+type ACLIdentity struct {
+	Username string
+	// Emails are the verified email addresses of the user.
+	Emails []string
+	// Teams are the names of the teams the user is a member of.
+	Teams []string
+}
+
+// PermissionsFor returns the sub-repo permissions the ACL grants to the user
+// with the given identity.
+func (a *ACL) PermissionsFor(id ACLIdentity) authz.SubRepoPermissions {
+	perms := authz.SubRepoPermissions{Paths: make([]string, 0, len(a.rules)+1)}
+	perms.Paths = append(perms.Paths, "/**")
+	for _, r := range a.rules {
+		if id.matchesAny(r.owners) {
+			perms.Paths = append(perms.Paths, r.glob)
+		} else {
+			perms.Paths = append(perms.Paths, "-"+r.glob)
+		}
+	}
+	return perms
+}
+
+func (id ACLIdentity) matchesAny(owners []*codeownerspb.Owner) bool {
+	for _, o := range owners {
+		if id.matches(o) {
+			return true
+		}
+	}
+	return false
+}
+
+func (id ACLIdentity) matches(o *codeownerspb.Owner) bool {
+	if email := o.GetEmail(); email != "" {
+		for _, e := range id.Emails {
+			if strings.EqualFold(e, email) {
+				return true
+			}
+		}
+		return false
+	}
+
+	handle := o.GetHandle()
+	if handle == "" {
+		return false
+	}
+	if id.Username != "" && strings.EqualFold(id.Username, handle) {
+		return true
+	}
+	// Teams may be referenced either by their name or, as on GitHub, by
+	// "org/team".
+	_, teamName, _ := strings.Cut(handle, "/")
+	for _, t := range id.Teams {
+		if strings.EqualFold(t, handle) || (teamName != "" && strings.EqualFold(t, teamName)) {
+			return true
+		}
+	}
+	return false
+}
+
+// aclGlob translates a CODEOWNERS pattern (see package paths) to the glob
+// syntax of sub-repo permission rules.
+func aclGlob(pattern string) string {
+	var b strings.Builder
+
+	// No leading "/" means the pattern matches at any depth.
+	if strings.HasPrefix(pattern, "/") {
+		b.WriteString("/")
+	} else {
+		b.WriteString("/**/")
+	}
+
+	body := strings.Trim(pattern, "/")
+	for _, c := range body {
+		// Characters that have a special meaning in globs but not in
+		// CODEOWNERS patterns are matched literally.
+		if strings.ContainsRune(`{}[]\`, c) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(c)
+	}
+
+	// A trailing "/" matches everything below a directory.
+	if strings.HasSuffix(pattern, "/") && !strings.HasSuffix(body, "**") {
+		b.WriteString("/**")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,108 @@
+package subrepoperms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const testACL = `
+# Everyone may read the repository, except for:
+/secrets/ @security alice@example.com
+*.key @security
+/secrets/README.md @everyone
+docs/internal/ @acme/writers
+`
+
+func TestParseACL(t *testing.T) {
+	acl, err := ParseACL([]byte(testACL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		id   ACLIdentity
+		want []string
+	}{
+		{
+			name: "no match",
+			id:   ACLIdentity{Username: "bob", Emails: []string{"bob@example.com"}},
+			want: []string{"/**", "-/secrets/**", "-/**/*.key", "-/secrets/README.md", "-/**/docs/internal/**"},
+		},
+		{
+			name: "email",
+			id:   ACLIdentity{Username: "alice", Emails: []string{"Alice@example.com"}},
+			want: []string{"/**", "/secrets/**", "-/**/*.key", "-/secrets/README.md", "-/**/docs/internal/**"},
+		},
+		{
+			name: "username",
+			id:   ACLIdentity{Username: "everyone"},
+			want: []string{"/**", "-/secrets/**", "-/**/*.key", "/secrets/README.md", "-/**/docs/internal/**"},
+		},
+		{
+			name: "teams",
+			id:   ACLIdentity{Username: "carol", Teams: []string{"security", "writers"}},
+			want: []string{"/**", "/secrets/**", "/**/*.key", "-/secrets/README.md", "/**/docs/internal/**"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, acl.PermissionsFor(tc.id).Paths); diff != "" {
+				t.Fatalf("unexpected paths (-want +have):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		if _, err := ParseACL([]byte("/foo//bar @alice")); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestACLPermissions(t *testing.T) {
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			ExperimentalFeatures: &schema.ExperimentalFeatures{
+				SubRepoPermissions: &schema.SubRepoPermissions{
+					Enabled: true,
+				},
+			},
+		},
+	})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	acl, err := ParseACL([]byte(testACL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checker, err := NewSimpleChecker("sample", acl.PermissionsFor(ACLIdentity{Username: "alice", Emails: []string{"alice@example.com"}}).Paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, want := range map[string]authz.Perms{
+		"README.md":              authz.Read,
+		"secrets/prod.yaml":      authz.Read,
+		"secrets/prod.key":       authz.None,
+		"secrets/README.md":      authz.None,
+		"src/keys/signing.key":   authz.None,
+		"docs/internal/setup.md": authz.None,
+		"docs/public/setup.md":   authz.Read,
+	} {
+		have, err := checker.Permissions(context.Background(), 1, authz.RepoContent{Repo: api.RepoName("sample"), Path: path})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have != want {
+			t.Errorf("%s: want %s, have %s", path, want, have)
+		}
+	}
+}
@@ -200,6 +200,10 @@ func (b *bulkProcessor) mergeChangeset(ctx context.Context, job *btypes.Changese
 		return nil, errcode.MakeNonRetryable(err)
 	}
 
+	if err := b.tx.EnqueueDependentChangesets(ctx, cs.Changeset); err != nil {
+		return nil, err
+	}
+
 	afterDone = func(s *store.Store) { b.enqueueWebhook(ctx, s, webhooks.ChangesetClose) }
 	return afterDone, nil
 }
@@ -236,6 +240,10 @@ func (b *bulkProcessor) closeChangeset(ctx context.Context) (afterDone func(*sto
 		return nil, errcode.MakeNonRetryable(err)
 	}
 
+	if err := b.tx.EnqueueDependentChangesets(ctx, cs.Changeset); err != nil {
+		return nil, err
+	}
+
 	afterDone = func(s *store.Store) { b.enqueueWebhook(ctx, s, webhooks.ChangesetClose) }
 	return afterDone, nil
 }
@@ -152,7 +152,16 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 
 	e.ch.PreviousFailureMessage = nil
 
-	return afterDone, e.tx.UpdateChangeset(ctx, e.ch)
+	if err := e.tx.UpdateChangeset(ctx, e.ch); err != nil {
+		return afterDone, err
+	}
+
+	// Changesets stacked on top of this changeset need to be rebased on its
+	// new commit or retargeted once it's closed.
+	if plan.Ops.Contains(btypes.ReconcilerOperationPush) || plan.Ops.Contains(btypes.ReconcilerOperationClose) {
+		return afterDone, e.tx.EnqueueDependentChangesets(ctx, e.ch)
+	}
+	return afterDone, nil
 }
 
 var errCannotPushToArchivedRepo = errcode.MakeNonRetryable(errors.New("cannot push to an archived repo"))
@@ -265,6 +274,7 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDon
 		Body:       body,
 		BaseRef:    e.spec.BaseRef,
 		HeadRef:    e.spec.HeadRef,
+		Attributes: changesetAttributes(e.spec),
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
 		Changeset:  e.ch,
@@ -401,6 +411,7 @@ func (e *executor) updateChangeset(ctx context.Context) (afterDone func(store *s
 		Body:       body,
 		BaseRef:    e.spec.BaseRef,
 		HeadRef:    e.spec.HeadRef,
+		Attributes: changesetAttributes(e.spec),
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
 		Changeset:  e.ch,
@@ -439,6 +450,7 @@ func (e *executor) reopenChangeset(ctx context.Context) (afterDone func(store *s
 		Body:       e.spec.Body,
 		BaseRef:    e.spec.BaseRef,
 		HeadRef:    e.spec.HeadRef,
+		Attributes: changesetAttributes(e.spec),
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
 		Changeset:  e.ch,
@@ -540,6 +552,7 @@ func (e *executor) undraftChangeset(ctx context.Context) (afterDone func(store *
 		Body:       e.spec.Body,
 		BaseRef:    e.spec.BaseRef,
 		HeadRef:    e.spec.HeadRef,
+		Attributes: changesetAttributes(e.spec),
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
 		Changeset:  e.ch,
@@ -845,3 +858,14 @@ func (e errNoPushCredentials) Error() string {
 }
 
 func (e errNoPushCredentials) NonRetryable() bool { return true }
+
+// changesetAttributes returns the optional code host attributes of the given
+// changeset spec.
+func changesetAttributes(spec *btypes.ChangesetSpec) sources.ChangesetAttributes {
+	return sources.ChangesetAttributes{
+		Reviewers: spec.Reviewers,
+		Assignees: spec.Assignees,
+		Labels:    spec.Labels,
+		Milestone: spec.Milestone,
+	}
+}
@@ -18,6 +18,7 @@ func (e mockMissingErr) Error() string {
 
 type FakeStore struct {
 	GetBatchChangeMock func(context.Context, store.GetBatchChangeOpts) (*btypes.BatchChange, error)
+	GetChangesetMock   func(context.Context, store.GetChangesetOpts) (*btypes.Changeset, error)
 }
 
 func (fs *FakeStore) GetBatchChange(ctx context.Context, opts store.GetBatchChangeOpts) (*btypes.BatchChange, error) {
@@ -26,3 +27,10 @@ func (fs *FakeStore) GetBatchChange(ctx context.Context, opts store.GetBatchChan
 	}
 	return nil, mockMissingErr{"GetBatchChange"}
 }
+
+func (fs *FakeStore) GetChangeset(ctx context.Context, opts store.GetChangesetOpts) (*btypes.Changeset, error) {
+	if fs.GetChangesetMock != nil {
+		return fs.GetChangesetMock(ctx, opts)
+	}
+	return nil, mockMissingErr{"GetChangeset"}
+}
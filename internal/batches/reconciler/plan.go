@@ -299,6 +299,23 @@ func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicatio
 	if previous.BaseRef != current.BaseRef {
 		delta.BaseRefChanged = true
 	}
+	// Code hosts only add reviewers, assignees and labels to changesets, so
+	// removing them from the spec doesn't require an update.
+	if hasNewStrings(previous.Reviewers, current.Reviewers) {
+		delta.ReviewersChanged = true
+	}
+	if hasNewStrings(previous.Assignees, current.Assignees) {
+		delta.AssigneesChanged = true
+	}
+	if hasNewStrings(previous.Labels, current.Labels) {
+		delta.LabelsChanged = true
+	}
+	if current.Milestone != "" && previous.Milestone != current.Milestone {
+		delta.MilestoneChanged = true
+	}
+	if previous.DependsOn != current.DependsOn {
+		delta.DependsOnChanged = true
+	}
 
 	// If was set to "draft" and now "true", need to undraft the changeset.
 	// We currently ignore going from "true" to "draft".
@@ -339,11 +356,32 @@ func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicatio
 	return delta
 }
 
+// hasNewStrings returns true if current contains a string that is not in
+// previous.
+func hasNewStrings(previous, current []string) bool {
+	seen := make(map[string]struct{}, len(previous))
+	for _, s := range previous {
+		seen[s] = struct{}{}
+	}
+	for _, s := range current {
+		if _, ok := seen[s]; !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 type ChangesetSpecDelta struct {
 	TitleChanged         bool
 	BodyChanged          bool
 	Undraft              bool
 	BaseRefChanged       bool
+	ReviewersChanged     bool
+	AssigneesChanged     bool
+	LabelsChanged        bool
+	MilestoneChanged     bool
+	DependsOnChanged     bool
 	DiffChanged          bool
 	CommitMessageChanged bool
 	AuthorNameChanged    bool
@@ -353,11 +391,15 @@ type ChangesetSpecDelta struct {
 func (d *ChangesetSpecDelta) String() string { return fmt.Sprintf("%#v", d) }
 
 func (d *ChangesetSpecDelta) NeedCommitUpdate() bool {
-	return d.DiffChanged || d.CommitMessageChanged || d.AuthorNameChanged || d.AuthorEmailChanged
+	return d.DiffChanged || d.CommitMessageChanged || d.AuthorNameChanged || d.AuthorEmailChanged ||
+		// The commit of a stacked changeset is based on its dependency.
+		d.DependsOnChanged
 }
 
 func (d *ChangesetSpecDelta) NeedCodeHostUpdate() bool {
-	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged
+	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged ||
+		d.ReviewersChanged || d.AssigneesChanged || d.LabelsChanged || d.MilestoneChanged ||
+		d.DependsOnChanged
 }
 
 func (d *ChangesetSpecDelta) AttributesChanged() bool {
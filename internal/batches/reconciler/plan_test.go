@@ -219,6 +219,42 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 			},
 			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
 		},
+		{
+			name:         "labels changed on published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true, Labels: []string{"a", "b"}},
+			currentSpec:  &bt.TestSpecOpts{Published: true, Labels: []string{"a", "c"}},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name:         "reviewers reordered on published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true, Reviewers: []string{"alice", "bob"}},
+			currentSpec:  &bt.TestSpecOpts{Published: true, Reviewers: []string{"bob", "alice"}},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{},
+		},
+		{
+			name:         "reviewers and labels removed on published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true, Reviewers: []string{"alice", "bob"}, Labels: []string{"a", "b"}},
+			currentSpec:  &bt.TestSpecOpts{Published: true, Reviewers: []string{"bob"}},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{},
+		},
+		{
+			name:         "dependsOn changed on published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true},
+			currentSpec:  &bt.TestSpecOpts{Published: true, DependsOn: "refs/heads/step-1"},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationUpdate},
+		},
 		{
 			name:         "title changed on read-only changeset",
 			previousSpec: &bt.TestSpecOpts{Published: true, Title: "Before"},
@@ -97,6 +97,12 @@ func (r *Reconciler) process(ctx context.Context, logger log.Logger, tx *store.S
 		return nil, err
 	}
 
+	// Changesets stacked on top of another changeset are based on and target
+	// the branch of that changeset.
+	if err := planStack(ctx, tx, plan); err != nil {
+		return nil, err
+	}
+
 	logger.Info("Reconciler processing changeset", log.Int64("changeset", ch.ID), log.String("operations", fmt.Sprintf("%+v", plan.Ops)))
 
 	return executePlan(
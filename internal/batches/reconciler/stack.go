@@ -0,0 +1,81 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/state"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// errDependencyNotPublished is returned when a stacked changeset should be
+// published before the changeset it depends on. It's retryable, so the
+// changeset is published once its dependency has been published.
+var errDependencyNotPublished = errors.New("the changeset this changeset depends on has not been published yet")
+
+// errDependencyOnFork is returned when a changeset is stacked on a changeset
+// that has been pushed to a fork, which code hosts can't target.
+var errDependencyOnFork = errcode.MakeNonRetryable(errors.New("cannot stack a changeset on a changeset that has been pushed to a fork"))
+
+type getChangesetter interface {
+	GetChangeset(ctx context.Context, opts store.GetChangesetOpts) (*btypes.Changeset, error)
+}
+
+// planStack adjusts the plan of a changeset that depends on another changeset
+// of the same batch change: while the dependency is open, the changeset is
+// based on and targets the branch of the dependency; once the dependency is
+// merged or closed, the changeset is retargeted to the base branch of its spec.
+func planStack(ctx context.Context, tx getChangesetter, plan *Plan) error {
+	ch, spec := plan.Changeset, plan.ChangesetSpec
+	if spec == nil || spec.DependsOn == "" || ch.OwnedByBatchChangeID == 0 {
+		return nil
+	}
+
+	dependency, err := tx.GetChangeset(ctx, store.GetChangesetOpts{
+		OwnedByBatchChangeID: ch.OwnedByBatchChangeID,
+		RepoID:               ch.RepoID,
+		SpecHeadRef:          spec.DependsOn,
+	})
+	if err != nil && err != store.ErrNoResults {
+		return errors.Wrap(err, "loading dependency")
+	}
+
+	target := spec.Clone()
+	switch state.ComputeStackState(dependency) {
+	case btypes.ChangesetStackStateWaiting:
+		if plan.Ops.Contains(btypes.ReconcilerOperationPublish) || plan.Ops.Contains(btypes.ReconcilerOperationPublishDraft) {
+			return errDependencyNotPublished
+		}
+		return nil
+
+	case btypes.ChangesetStackStateStacked:
+		if dependency.ExternalForkNamespace != "" {
+			return errDependencyOnFork
+		}
+		target.BaseRef = gitdomain.EnsureRefPrefix(dependency.ExternalBranch)
+		// Not every code host reports the head commit of a changeset. Without
+		// it, the commit is still based on the base revision of the spec.
+		if headRefOid, err := dependency.HeadRefOid(); err == nil && headRefOid != "" {
+			target.BaseRev = headRefOid
+		}
+	}
+	plan.ChangesetSpec = target
+
+	// Retarget changesets that are already open on the code host, unless
+	// they're being updated anyway.
+	if !ch.Published() || !ch.HasDiff() || plan.Ops.Contains(btypes.ReconcilerOperationUpdate) {
+		return nil
+	}
+	currentBaseRef, err := ch.BaseRef()
+	if err != nil {
+		return nil
+	}
+	if gitdomain.EnsureRefPrefix(currentBaseRef) != target.BaseRef {
+		plan.AddOp(btypes.ReconcilerOperationPush)
+		plan.AddOp(btypes.ReconcilerOperationUpdate)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+)
+
+func TestPlanStack(t *testing.T) {
+	ctx := context.Background()
+
+	spec := &btypes.ChangesetSpec{
+		HeadRef:   "refs/heads/step-2",
+		BaseRef:   "refs/heads/main",
+		BaseRev:   "base-rev",
+		DependsOn: "refs/heads/step-1",
+	}
+
+	published := func(baseRefName string) *btypes.Changeset {
+		return &btypes.Changeset{
+			RepoID:               2,
+			OwnedByBatchChangeID: 1,
+			PublicationState:     btypes.ChangesetPublicationStatePublished,
+			ExternalState:        btypes.ChangesetExternalStateOpen,
+			Metadata:             &github.PullRequest{BaseRefName: baseRefName},
+		}
+	}
+
+	openDependency := func() *btypes.Changeset {
+		return &btypes.Changeset{
+			ID:               3,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			ExternalBranch:   "refs/heads/step-1",
+			Metadata:         &github.PullRequest{HeadRefOid: "step-1-rev"},
+		}
+	}
+
+	fakeStore := func(dependency *btypes.Changeset) *FakeStore {
+		return &FakeStore{
+			GetChangesetMock: func(_ context.Context, opts store.GetChangesetOpts) (*btypes.Changeset, error) {
+				assert.Equal(t, int64(1), opts.OwnedByBatchChangeID)
+				assert.Equal(t, "refs/heads/step-1", opts.SpecHeadRef)
+				if dependency == nil {
+					return nil, store.ErrNoResults
+				}
+				return dependency, nil
+			},
+		}
+	}
+
+	t.Run("no dependency", func(t *testing.T) {
+		plan := &Plan{Changeset: published("main"), ChangesetSpec: &btypes.ChangesetSpec{BaseRef: "refs/heads/main"}}
+
+		require.NoError(t, planStack(ctx, &FakeStore{}, plan))
+		assert.True(t, plan.Ops.IsNone())
+	})
+
+	t.Run("publishing before the dependency", func(t *testing.T) {
+		ch := published("")
+		ch.PublicationState = btypes.ChangesetPublicationStateUnpublished
+		plan := &Plan{Changeset: ch, ChangesetSpec: spec, Ops: Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationPublish}}
+
+		dependency := openDependency()
+		dependency.PublicationState = btypes.ChangesetPublicationStateUnpublished
+
+		assert.Equal(t, errDependencyNotPublished, planStack(ctx, fakeStore(dependency), plan))
+	})
+
+	t.Run("publishing on top of an open dependency", func(t *testing.T) {
+		ch := published("")
+		ch.PublicationState = btypes.ChangesetPublicationStateUnpublished
+		plan := &Plan{Changeset: ch, ChangesetSpec: spec, Ops: Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationPublish}}
+
+		require.NoError(t, planStack(ctx, fakeStore(openDependency()), plan))
+		assert.Equal(t, "refs/heads/step-1", plan.ChangesetSpec.BaseRef)
+		assert.Equal(t, "step-1-rev", plan.ChangesetSpec.BaseRev)
+		assert.Equal(t, "refs/heads/main", spec.BaseRef, "spec must not be modified")
+		assert.True(t, plan.Ops.Equal(Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationPublish}))
+	})
+
+	t.Run("already stacked", func(t *testing.T) {
+		plan := &Plan{Changeset: published("step-1"), ChangesetSpec: spec}
+
+		require.NoError(t, planStack(ctx, fakeStore(openDependency()), plan))
+		assert.True(t, plan.Ops.IsNone())
+	})
+
+	t.Run("dependency on a fork", func(t *testing.T) {
+		plan := &Plan{Changeset: published("main"), ChangesetSpec: spec}
+
+		dependency := openDependency()
+		dependency.ExternalForkNamespace = "fork"
+
+		assert.Equal(t, errDependencyOnFork, planStack(ctx, fakeStore(dependency), plan))
+	})
+
+	for name, dependency := range map[string]*btypes.Changeset{
+		"dependency merged": {
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateMerged,
+			ExternalBranch:   "refs/heads/step-1",
+		},
+		"dependency removed": nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			plan := &Plan{Changeset: published("step-1"), ChangesetSpec: spec}
+
+			require.NoError(t, planStack(ctx, fakeStore(dependency), plan))
+			assert.Equal(t, "refs/heads/main", plan.ChangesetSpec.BaseRef)
+			assert.Equal(t, "base-rev", plan.ChangesetSpec.BaseRev)
+			assert.True(t, plan.Ops.Equal(Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationUpdate}))
+		})
+	}
+}
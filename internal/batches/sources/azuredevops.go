@@ -6,6 +6,8 @@ import (
 	"strconv"
 	"strings"
 
+	"golang.org/x/exp/slices"
+
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
@@ -104,12 +106,20 @@ func (s AzureDevOpsSource) LoadChangeset(ctx context.Context, cs *Changeset) err
 // CreateChangeset will create the Changeset on the source. If it already
 // exists, *Changeset will be populated and the return value will be true.
 func (s AzureDevOpsSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	if err := checkAzureDevOpsChangesetAttributes(cs); err != nil {
+		return false, err
+	}
+
 	input := s.changesetToPullRequestInput(cs)
 	return s.createChangeset(ctx, cs, input)
 }
 
 // CreateDraftChangeset creates the given changeset on the code host in draft mode.
 func (s AzureDevOpsSource) CreateDraftChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	if err := checkAzureDevOpsChangesetAttributes(cs); err != nil {
+		return false, err
+	}
+
 	input := s.changesetToPullRequestInput(cs)
 	input.IsDraft = true
 	return s.createChangeset(ctx, cs, input)
@@ -186,6 +196,10 @@ func (s AzureDevOpsSource) CloseChangeset(ctx context.Context, cs *Changeset) er
 
 // UpdateChangeset can update Changesets.
 func (s AzureDevOpsSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	if err := checkAzureDevOpsChangesetAttributes(cs); err != nil {
+		return err
+	}
+
 	repo := cs.TargetRepo.Metadata.(*azuredevops.Repository)
 	args, err := s.createCommonPullRequestArgs(*repo, *cs)
 	if err != nil {
@@ -215,9 +229,38 @@ func (s AzureDevOpsSource) UpdateChangeset(ctx context.Context, cs *Changeset) e
 		return errors.Wrap(err, "updating pull request")
 	}
 
+	// Reviewers and labels can't be set through the update endpoint, so we
+	// add the ones that are missing one by one.
+	for _, id := range cs.Attributes.Reviewers {
+		if slices.ContainsFunc(updated.Reviewers, func(r azuredevops.Reviewer) bool { return strings.EqualFold(r.ID, id) }) {
+			continue
+		}
+		reviewer, err := s.client.AddPullRequestReviewer(ctx, args, id)
+		if err != nil {
+			return errors.Wrapf(err, "adding reviewer %q", id)
+		}
+		updated.Reviewers = append(updated.Reviewers, reviewer)
+	}
+	for _, name := range cs.Attributes.Labels {
+		if slices.ContainsFunc(updated.Labels, func(l azuredevops.PullRequestLabel) bool { return strings.EqualFold(l.Name, name) }) {
+			continue
+		}
+		label, err := s.client.AddPullRequestLabel(ctx, args, name)
+		if err != nil {
+			return errors.Wrapf(err, "adding label %q", name)
+		}
+		updated.Labels = append(updated.Labels, label)
+	}
+
 	return errors.Wrap(s.setChangesetMetadata(ctx, repo, &updated, cs), "setting Azure DevOps changeset metadata")
 }
 
+// checkAzureDevOpsChangesetAttributes returns an error if the changeset
+// requests attributes that Azure DevOps pull requests don't have.
+func checkAzureDevOpsChangesetAttributes(cs *Changeset) error {
+	return checkChangesetAttributes("Azure DevOps", cs, ChangesetAttributeReviewers, ChangesetAttributeLabels)
+}
+
 // ReopenChangeset will reopen the Changeset on the source, if it's closed.
 // If not, it's a noop.
 func (s AzureDevOpsSource) ReopenChangeset(ctx context.Context, cs *Changeset) error {
@@ -440,6 +483,14 @@ func (s AzureDevOpsSource) changesetToPullRequestInput(cs *Changeset) azuredevop
 		},
 	}
 
+	// Reviewers are referenced by the ID of their Azure DevOps identity.
+	for _, id := range cs.Attributes.Reviewers {
+		input.Reviewers = append(input.Reviewers, azuredevops.Reviewer{ID: id})
+	}
+	for _, name := range cs.Attributes.Labels {
+		input.Labels = append(input.Labels, azuredevops.PullRequestLabel{Name: name})
+	}
+
 	// If we're forking, then we need to set the source repository as well.
 	if cs.RemoteRepo != cs.TargetRepo {
 		input.ForkSource = &azuredevops.ForkRef{
@@ -473,6 +473,47 @@ func TestAzureDevOpsSource_UpdateChangeset(t *testing.T) {
 		assert.Nil(t, err)
 		assertChangesetMatchesPullRequest(t, cs, pr)
 	})
+
+	t.Run("success with reviewers and labels", func(t *testing.T) {
+		cs, _ := mockAzureDevOpsChangeset()
+		cs.Attributes = ChangesetAttributes{
+			Reviewers: []string{"existing-reviewer", "new-reviewer"},
+			Labels:    []string{"automated"},
+		}
+		s, client := mockAzureDevOpsSource()
+		mockAzureDevOpsAnnotatePullRequestSuccess(client)
+
+		pr := mockAzureDevOpsPullRequest(&testRepository)
+		pr.Reviewers = []azuredevops.Reviewer{{ID: "existing-reviewer"}}
+		client.GetPullRequestFunc.SetDefaultReturn(*pr, nil)
+		client.UpdatePullRequestFunc.SetDefaultReturn(*pr, nil)
+		client.AddPullRequestReviewerFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, id string) (azuredevops.Reviewer, error) {
+			assert.Equal(t, testCommonPullRequestArgs, r)
+			assert.Equal(t, "new-reviewer", id)
+			return azuredevops.Reviewer{ID: id}, nil
+		})
+		client.AddPullRequestLabelFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, name string) (azuredevops.PullRequestLabel, error) {
+			assert.Equal(t, testCommonPullRequestArgs, r)
+			assert.Equal(t, "automated", name)
+			return azuredevops.PullRequestLabel{Name: name, Active: true}, nil
+		})
+
+		annotateChangesetWithPullRequest(cs, pr)
+		err := s.UpdateChangeset(ctx, cs)
+		assert.Nil(t, err)
+		assert.Len(t, client.AddPullRequestReviewerFunc.History(), 1)
+		assert.Len(t, client.AddPullRequestLabelFunc.History(), 1)
+	})
+
+	t.Run("unsupported attribute", func(t *testing.T) {
+		cs, _ := mockAzureDevOpsChangeset()
+		cs.Attributes = ChangesetAttributes{Assignees: []string{"alice"}}
+		s, client := mockAzureDevOpsSource()
+
+		err := s.UpdateChangeset(ctx, cs)
+		assert.Equal(t, UnsupportedChangesetAttributeError{CodeHost: "Azure DevOps", Attribute: ChangesetAttributeAssignees}, err)
+		assert.Empty(t, client.GetPullRequestFunc.History())
+	})
 }
 
 func TestAzureDevOpsSource_UndraftChangeset(t *testing.T) {
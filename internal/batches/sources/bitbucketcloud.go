@@ -3,6 +3,9 @@ package sources
 import (
 	"context"
 	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
 
 	bbcs "github.com/sourcegraph/sourcegraph/internal/batches/sources/bitbucketcloud"
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
@@ -108,7 +111,12 @@ func (s BitbucketCloudSource) LoadChangeset(ctx context.Context, cs *Changeset)
 // CreateChangeset will create the Changeset on the source. If it already
 // exists, *Changeset will be populated and the return value will be true.
 func (s BitbucketCloudSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	if err := checkChangesetAttributes("Bitbucket Cloud", cs, ChangesetAttributeReviewers); err != nil {
+		return false, err
+	}
+
 	opts := s.changesetToPullRequestInput(cs)
+	opts.Reviewers, opts.ReviewerAccountIDs = bitbucketCloudReviewers(nil, cs.Attributes.Reviewers)
 	targetRepo := cs.TargetRepo.Metadata.(*bitbucketcloud.Repo)
 
 	pr, err := s.client.CreatePullRequest(ctx, targetRepo, opts)
@@ -143,6 +151,10 @@ func (s BitbucketCloudSource) CloseChangeset(ctx context.Context, cs *Changeset)
 
 // UpdateChangeset can update Changesets.
 func (s BitbucketCloudSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	if err := checkChangesetAttributes("Bitbucket Cloud", cs, ChangesetAttributeReviewers); err != nil {
+		return err
+	}
+
 	opts := s.changesetToPullRequestInput(cs)
 	targetRepo := cs.TargetRepo.Metadata.(*bitbucketcloud.Repo)
 
@@ -150,7 +162,7 @@ func (s BitbucketCloudSource) UpdateChangeset(ctx context.Context, cs *Changeset
 	// The endpoint for updating a bitbucket pullrequest is a PUT endpoint which means if a field isn't provided
 	// it'll override it's value to it's empty value. We always want to retain the reviewers assigned to a pull
 	// request when updating a pull request.
-	opts.Reviewers = pr.Reviewers
+	opts.Reviewers, opts.ReviewerAccountIDs = bitbucketCloudReviewers(pr.Reviewers, cs.Attributes.Reviewers)
 
 	if conf.Get().BatchChangesAutoDeleteBranch {
 		opts.CloseSourceBranch = true
@@ -345,3 +357,23 @@ func (s BitbucketCloudSource) changesetToPullRequestInput(cs *Changeset) bitbuck
 
 	return opts
 }
+
+// bitbucketCloudReviewers returns the given reviewers with the requested
+// reviewers added, if they aren't reviewers already. Bitbucket Cloud doesn't
+// expose usernames, so reviewers are requested either by UUID, in the form
+// "{...}", or by account ID. The latter are returned separately, since we
+// can't tell whether they're reviewers already.
+func bitbucketCloudReviewers(reviewers []bitbucketcloud.Account, requested []string) ([]bitbucketcloud.Account, []string) {
+	var accountIDs []string
+	for _, id := range requested {
+		if !strings.HasPrefix(id, "{") {
+			accountIDs = append(accountIDs, id)
+			continue
+		}
+
+		if !slices.ContainsFunc(reviewers, func(r bitbucketcloud.Account) bool { return r.UUID == id }) {
+			reviewers = append(reviewers, bitbucketcloud.Account{UUID: id})
+		}
+	}
+	return reviewers, accountIDs
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/inconshreveable/log15"
+	"golang.org/x/exp/slices"
 
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 
@@ -100,10 +101,15 @@ func (s BitbucketServerSource) ValidateAuthenticator(ctx context.Context) error
 func (s BitbucketServerSource) CreateChangeset(ctx context.Context, c *Changeset) (bool, error) {
 	var exists bool
 
+	if err := checkChangesetAttributes("Bitbucket Server", c, ChangesetAttributeReviewers); err != nil {
+		return exists, err
+	}
+
 	remoteRepo := c.RemoteRepo.Metadata.(*bitbucketserver.Repo)
 	targetRepo := c.TargetRepo.Metadata.(*bitbucketserver.Repo)
 
 	pr := &bitbucketserver.PullRequest{Title: c.Title, Description: c.Body}
+	pr.Reviewers = bitbucketServerReviewers(nil, c.Attributes.Reviewers)
 
 	pr.ToRef.Repository.Slug = targetRepo.Slug
 	pr.ToRef.Repository.ID = targetRepo.ID
@@ -218,6 +224,10 @@ func (s BitbucketServerSource) UpdateChangeset(ctx context.Context, c *Changeset
 		return errors.New("Changeset is not a Bitbucket Server pull request")
 	}
 
+	if err := checkChangesetAttributes("Bitbucket Server", c, ChangesetAttributeReviewers); err != nil {
+		return err
+	}
+
 	update := &bitbucketserver.UpdatePullRequestInput{
 		PullRequestID: strconv.Itoa(pr.ID),
 		Title:         c.Title,
@@ -226,7 +236,7 @@ func (s BitbucketServerSource) UpdateChangeset(ctx context.Context, c *Changeset
 		// The endpoint for updating a bitbucket pullrequest is a PUT endpoint which means if a field isn't provided
 		// it'll override it's value to it's empty value. We always want to retain the reviewers assigned to a pull
 		// request when updating a pull request.
-		Reviewers: pr.Reviewers,
+		Reviewers: bitbucketServerReviewers(pr.Reviewers, c.Attributes.Reviewers),
 	}
 	update.ToRef.ID = c.BaseRef
 	update.ToRef.Repository.Slug = pr.ToRef.Repository.Slug
@@ -415,3 +425,16 @@ func (s BitbucketServerSource) checkAndCopy(targetRepo *types.Repo, fork *bitbuc
 
 	return forkRepo, nil
 }
+
+// bitbucketServerReviewers returns the given reviewers with the requested
+// usernames added, if they aren't reviewers already.
+func bitbucketServerReviewers(reviewers []bitbucketserver.Reviewer, usernames []string) []bitbucketserver.Reviewer {
+	for _, username := range usernames {
+		if !slices.ContainsFunc(reviewers, func(r bitbucketserver.Reviewer) bool {
+			return r.User != nil && r.User.Name == username
+		}) {
+			reviewers = append(reviewers, bitbucketserver.Reviewer{User: &bitbucketserver.User{Name: username}})
+		}
+	}
+	return reviewers
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
@@ -129,9 +131,73 @@ type Changeset struct {
 	// opened.
 	TargetRepo *types.Repo
 
+	// Attributes are the optional attributes, such as reviewers and labels,
+	// that should be set on the changeset on the code host.
+	Attributes ChangesetAttributes
+
 	*btypes.Changeset
 }
 
+// ChangesetAttributes are the optional attributes of a changeset that can be
+// requested in the changeset template of a batch spec. Reviewers and assignees
+// are code host usernames; labels and the milestone are referenced by name.
+type ChangesetAttributes struct {
+	Reviewers []string
+	Assignees []string
+	Labels    []string
+	Milestone string
+}
+
+const (
+	ChangesetAttributeReviewers = "reviewers"
+	ChangesetAttributeAssignees = "assignees"
+	ChangesetAttributeLabels    = "labels"
+	ChangesetAttributeMilestone = "milestone"
+)
+
+// requested returns the names of the attributes that are set.
+func (a ChangesetAttributes) requested() []string {
+	var names []string
+	if len(a.Reviewers) > 0 {
+		names = append(names, ChangesetAttributeReviewers)
+	}
+	if len(a.Assignees) > 0 {
+		names = append(names, ChangesetAttributeAssignees)
+	}
+	if len(a.Labels) > 0 {
+		names = append(names, ChangesetAttributeLabels)
+	}
+	if a.Milestone != "" {
+		names = append(names, ChangesetAttributeMilestone)
+	}
+	return names
+}
+
+// checkChangesetAttributes returns an UnsupportedChangesetAttributeError if the
+// changeset requests an attribute that is not in supported.
+func checkChangesetAttributes(codeHost string, cs *Changeset, supported ...string) error {
+	for _, name := range cs.Attributes.requested() {
+		if !slices.Contains(supported, name) {
+			return UnsupportedChangesetAttributeError{CodeHost: codeHost, Attribute: name}
+		}
+	}
+	return nil
+}
+
+// UnsupportedChangesetAttributeError is returned by CreateChangeset and
+// UpdateChangeset if the changeset template requests an attribute that the
+// code host does not support.
+type UnsupportedChangesetAttributeError struct {
+	CodeHost  string
+	Attribute string
+}
+
+func (e UnsupportedChangesetAttributeError) Error() string {
+	return fmt.Sprintf("%s does not support setting %s on changesets", e.CodeHost, e.Attribute)
+}
+
+func (e UnsupportedChangesetAttributeError) NonRetryable() bool { return true }
+
 // IsOutdated returns true when the attributes of the nested
 // batches.Changeset do not match the attributes (title, body, ...) set on
 // the Changeset.
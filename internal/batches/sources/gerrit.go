@@ -102,7 +102,15 @@ func (s GerritSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
 // CreateChangeset will create the Changeset on the source. If it already
 // exists, *Changeset will be populated and the return value will be true.
 func (s GerritSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	if err := checkGerritChangesetAttributes(cs); err != nil {
+		return false, err
+	}
+
 	changeID := GenerateGerritChangeID(*cs.Changeset)
+	if err := s.setChangeAttributes(ctx, changeID, cs); err != nil {
+		return false, err
+	}
+
 	// For Gerrit, the Change is created at `git push` time, so we just load it here to verify it
 	// was created successfully.
 	pr, err := s.client.GetChange(ctx, changeID)
@@ -121,6 +129,10 @@ func (s GerritSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool,
 
 // CreateDraftChangeset creates the given changeset on the code host in draft mode.
 func (s GerritSource) CreateDraftChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	if err := checkGerritChangesetAttributes(cs); err != nil {
+		return false, err
+	}
+
 	changeID := GenerateGerritChangeID(*cs.Changeset)
 
 	// For Gerrit, the Change is created at `git push` time, so we just call the API to mark it as WIP.
@@ -131,6 +143,10 @@ func (s GerritSource) CreateDraftChangeset(ctx context.Context, cs *Changeset) (
 		return false, errors.Wrap(err, "making change WIP")
 	}
 
+	if err := s.setChangeAttributes(ctx, changeID, cs); err != nil {
+		return false, err
+	}
+
 	pr, err := s.client.GetChange(ctx, changeID)
 	if err != nil {
 		if errcode.IsNotFound(err) {
@@ -179,6 +195,10 @@ func (s GerritSource) CloseChangeset(ctx context.Context, cs *Changeset) error {
 
 // UpdateChangeset can update Changesets.
 func (s GerritSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	if err := checkGerritChangesetAttributes(cs); err != nil {
+		return err
+	}
+
 	pr, err := s.client.GetChange(ctx, cs.ExternalID)
 	if err != nil {
 		// Route 1
@@ -200,6 +220,9 @@ func (s GerritSource) UpdateChangeset(ctx context.Context, cs *Changeset) error
 					return errors.Wrap(err, "setting updated change as WIP")
 				}
 			}
+			if err := s.setChangeAttributes(ctx, cs.ExternalID, cs); err != nil {
+				return err
+			}
 			return s.LoadChangeset(ctx, cs)
 		} else {
 			if errcode.IsNotFound(err) {
@@ -226,9 +249,37 @@ func (s GerritSource) UpdateChangeset(ctx context.Context, cs *Changeset) error
 			return errors.Wrap(err, "setting change commit message")
 		}
 	}
+	if err := s.setChangeAttributes(ctx, cs.ExternalID, cs); err != nil {
+		return err
+	}
 	return s.LoadChangeset(ctx, cs)
 }
 
+// checkGerritChangesetAttributes returns an error if the changeset requests
+// attributes that Gerrit changes don't have.
+func checkGerritChangesetAttributes(cs *Changeset) error {
+	return checkChangesetAttributes("Gerrit", cs, ChangesetAttributeReviewers, ChangesetAttributeLabels)
+}
+
+// setChangeAttributes adds the requested reviewers to the change. Gerrit
+// labels are votes rather than tags, so the requested labels are added to the
+// change as hashtags instead.
+func (s GerritSource) setChangeAttributes(ctx context.Context, changeID string, cs *Changeset) error {
+	for _, reviewer := range cs.Attributes.Reviewers {
+		if err := s.client.AddReviewer(ctx, changeID, gerrit.AddReviewerPayload{Reviewer: reviewer}); err != nil {
+			return errors.Wrapf(err, "adding reviewer %q", reviewer)
+		}
+	}
+
+	if len(cs.Attributes.Labels) > 0 {
+		if err := s.client.SetHashtags(ctx, changeID, gerrit.SetHashtagsPayload{Add: cs.Attributes.Labels}); err != nil {
+			return errors.Wrap(err, "setting hashtags")
+		}
+	}
+
+	return nil
+}
+
 // ReopenChangeset will reopen the Changeset on the source, if it's closed.
 // If not, it's a noop.
 func (s GerritSource) ReopenChangeset(ctx context.Context, cs *Changeset) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
@@ -196,6 +197,48 @@ func TestGerritSource_CreateChangeset(t *testing.T) {
 		assert.Nil(t, err)
 		assert.False(t, b)
 	})
+
+	t.Run("success with reviewers and labels", func(t *testing.T) {
+		cs, id, _ := mockGerritChangeset()
+		cs.Attributes = ChangesetAttributes{
+			Reviewers: []string{"alice", "reviewers-group"},
+			Labels:    []string{"automated"},
+		}
+		s, client := mockGerritSource()
+
+		change := mockGerritChange(&testProject, id)
+		client.GetURLFunc.SetDefaultReturn(&url.URL{})
+		client.GetChangeFunc.SetDefaultReturn(change, nil)
+		client.GetChangeReviewsFunc.SetDefaultReturn(&[]gerrit.Reviewer{}, nil)
+		var reviewers []string
+		client.AddReviewerFunc.SetDefaultHook(func(ctx context.Context, changeID string, payload gerrit.AddReviewerPayload) error {
+			assert.Equal(t, id, changeID)
+			reviewers = append(reviewers, payload.Reviewer)
+			return nil
+		})
+		client.SetHashtagsFunc.SetDefaultHook(func(ctx context.Context, changeID string, payload gerrit.SetHashtagsPayload) error {
+			assert.Equal(t, id, changeID)
+			assert.Equal(t, []string{"automated"}, payload.Add)
+			return nil
+		})
+
+		b, err := s.CreateChangeset(ctx, cs)
+		assert.Nil(t, err)
+		assert.False(t, b)
+		assert.Equal(t, []string{"alice", "reviewers-group"}, reviewers)
+		assert.Len(t, client.SetHashtagsFunc.History(), 1)
+	})
+
+	t.Run("unsupported attribute", func(t *testing.T) {
+		cs, _, _ := mockGerritChangeset()
+		cs.Attributes = ChangesetAttributes{Milestone: "v1"}
+		s, client := mockGerritSource()
+
+		_, err := s.CreateChangeset(ctx, cs)
+		assert.Equal(t, UnsupportedChangesetAttributeError{CodeHost: "Gerrit", Attribute: ChangesetAttributeMilestone}, err)
+		assert.True(t, errcode.IsNonRetryable(err))
+		assert.Empty(t, client.GetChangeFunc.History())
+	})
 }
 
 func TestGerritSource_CreateDraftChangeset(t *testing.T) {
@@ -223,6 +223,18 @@ func (s GitHubSource) createChangeset(ctx context.Context, c *Changeset, prInput
 		exists = true
 	}
 
+	if len(c.Attributes.requested()) > 0 {
+		pr, err = s.updatePullRequest(ctx, c, &github.UpdatePullRequestInput{
+			PullRequestID: pr.ID,
+			Title:         prInput.Title,
+			Body:          prInput.Body,
+			BaseRefName:   prInput.BaseRefName,
+		})
+		if err != nil {
+			return exists, err
+		}
+	}
+
 	if err := c.SetMetadata(pr); err != nil {
 		return false, errors.Wrap(err, "setting changeset metadata")
 	}
@@ -230,6 +242,56 @@ func (s GitHubSource) createChangeset(ctx context.Context, c *Changeset, prInput
 	return exists, nil
 }
 
+// updatePullRequest updates the pull request with the given input. The
+// requested labels and assignees are added to the existing ones first, so that
+// the updated pull request reflects them, and the milestone is set as part of
+// the update. Afterwards, reviews are requested from the requested reviewers.
+func (s GitHubSource) updatePullRequest(ctx context.Context, c *Changeset, input *github.UpdatePullRequestInput) (*github.PullRequest, error) {
+	ids := &github.PullRequestAttributeIDs{}
+	if len(c.Attributes.requested()) > 0 {
+		repo := c.TargetRepo.Metadata.(*github.Repository)
+		owner, name, err := github.SplitRepositoryNameWithOwner(repo.NameWithOwner)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting repo owner and name")
+		}
+
+		ids, err = s.client.ResolvePullRequestAttributes(ctx, owner, name, github.PullRequestAttributes{
+			Reviewers: c.Attributes.Reviewers,
+			Assignees: c.Attributes.Assignees,
+			Labels:    c.Attributes.Labels,
+			Milestone: c.Attributes.Milestone,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "resolving changeset attributes")
+		}
+	}
+
+	if len(ids.LabelIDs) > 0 {
+		if err := s.client.AddLabels(ctx, input.PullRequestID, ids.LabelIDs); err != nil {
+			return nil, errors.Wrap(err, "adding labels")
+		}
+	}
+	if len(ids.AssigneeIDs) > 0 {
+		if err := s.client.AddAssignees(ctx, input.PullRequestID, ids.AssigneeIDs); err != nil {
+			return nil, errors.Wrap(err, "adding assignees")
+		}
+	}
+
+	input.MilestoneID = ids.MilestoneID
+	pr, err := s.client.UpdatePullRequest(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids.ReviewerUserIDs) > 0 || len(ids.ReviewerTeamIDs) > 0 {
+		if err := s.client.RequestReviews(ctx, pr, ids.ReviewerUserIDs, ids.ReviewerTeamIDs); err != nil {
+			return nil, errors.Wrap(err, "requesting reviews")
+		}
+	}
+
+	return pr, nil
+}
+
 // CloseChangeset closes the given *Changeset on the code host and updates the
 // Metadata column in the *batches.Changeset to the newly closed pull request.
 func (s GitHubSource) CloseChangeset(ctx context.Context, c *Changeset) error {
@@ -306,7 +368,7 @@ func (s GitHubSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
 		return errors.New("Changeset is not a GitHub pull request")
 	}
 
-	updated, err := s.client.UpdatePullRequest(ctx, &github.UpdatePullRequestInput{
+	updated, err := s.updatePullRequest(ctx, c, &github.UpdatePullRequestInput{
 		PullRequestID: pr.ID,
 		Title:         c.Title,
 		Body:          c.Body,
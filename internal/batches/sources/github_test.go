@@ -3,6 +3,7 @@ package sources
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -23,6 +24,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/rcache"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -435,6 +437,86 @@ func TestGithubSource_UpdateChangeset(t *testing.T) {
 	}
 }
 
+func TestGithubSource_UpdateChangeset_Attributes(t *testing.T) {
+	github.SetupForTest(t)
+
+	var mutations []string
+	cli := httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		respond := func(body string) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"X-GitHub-Enterprise-Version": {"99"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		}
+		if req.Method == http.MethodGet {
+			// Version lookup
+			return respond("")
+		}
+
+		var body struct {
+			Query     string
+			Variables map[string]json.RawMessage
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		switch {
+		case strings.Contains(body.Query, "label0: label"):
+			return respond(`{"data": {"repository": {"label0": {"id": "LA_automated"}}}}`)
+		case strings.Contains(body.Query, "addLabelsToLabelable"):
+			mutations = append(mutations, "addLabelsToLabelable "+string(body.Variables["input"]))
+			return respond(`{"data": {"addLabelsToLabelable": {"clientMutationId": ""}}}`)
+		case strings.Contains(body.Query, "updatePullRequest"):
+			mutations = append(mutations, "updatePullRequest "+string(body.Variables["input"]))
+			return respond(`{"data": {"updatePullRequest": {"pullRequest": {
+				"id": "PR_1",
+				"title": "Updated title",
+				"labels": {"nodes": [{"name": "existing"}, {"name": "automated"}]},
+				"timelineItems": {"pageInfo": {"hasNextPage": false}, "nodes": []}
+			}}}}`)
+		}
+		return nil, errors.Errorf("unexpected query: %s", body.Query)
+	})
+
+	apiURL, err := url.Parse("https://fake.api.github.com")
+	require.NoError(t, err)
+	source := &GitHubSource{
+		client: github.NewV4Client("extsvc:github:0", apiURL, nil, cli),
+	}
+
+	repo := &types.Repo{
+		Metadata: &github.Repository{
+			ID:            "bLAhBLAh",
+			NameWithOwner: "some-org/some-repo",
+		},
+	}
+	cs := &Changeset{
+		Title:      "Updated title",
+		BaseRef:    "refs/heads/main",
+		RemoteRepo: repo,
+		TargetRepo: repo,
+		Attributes: ChangesetAttributes{Labels: []string{"automated"}},
+		Changeset: &btypes.Changeset{
+			Metadata: &github.PullRequest{
+				ID:     "PR_1",
+				Labels: struct{ Nodes []github.Label }{Nodes: []github.Label{{Name: "existing"}}},
+			},
+		},
+	}
+
+	require.NoError(t, source.UpdateChangeset(context.Background(), cs))
+
+	// The requested label is added, instead of the labels being replaced, so
+	// that labels added on the code host survive the update.
+	assert.Equal(t, []string{
+		`addLabelsToLabelable {"labelableId":"PR_1","labelIds":["LA_automated"]}`,
+		`updatePullRequest {"pullRequestId":"PR_1","baseRefName":"main","title":"Updated title","body":""}`,
+	}, mutations)
+	pr := cs.Changeset.Metadata.(*github.PullRequest)
+	assert.Equal(t, []github.Label{{Name: "existing"}, {Name: "automated"}}, pr.Labels.Nodes)
+}
+
 func TestGithubSource_LoadChangeset(t *testing.T) {
 	testCases := []struct {
 		name string
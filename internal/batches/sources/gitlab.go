@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/Masterminds/semver"
+	"golang.org/x/exp/slices"
 
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 
@@ -121,6 +122,11 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 	}
 	removeSource := conf.Get().BatchChangesAutoDeleteBranch
 
+	attrs, err := s.resolveChangesetAttributes(ctx, targetProject, c)
+	if err != nil {
+		return exists, err
+	}
+
 	// We have to create the merge request against the remote project, not the
 	// target project, because that's how GitLab's API works: you provide the
 	// target project ID as one of the parameters. Yes, this is weird.
@@ -134,6 +140,10 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 		Title:              c.Title,
 		Description:        c.Body,
 		RemoveSourceBranch: removeSource,
+		Labels:             attrs.labels,
+		AssigneeIDs:        attrs.assigneeIDs,
+		ReviewerIDs:        attrs.reviewerIDs,
+		MilestoneID:        attrs.milestoneID,
 	})
 	if err != nil {
 		if err == gitlab.ErrMergeRequestAlreadyExists {
@@ -143,6 +153,19 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 			if err != nil {
 				return exists, errors.Wrap(err, "retrieving an extant merge request")
 			}
+
+			// The attributes of the changeset have not been set on the extant
+			// merge request, so we have to update it. Title and TargetBranch
+			// are required, even though we're not actually changing them.
+			if !attrs.empty() {
+				opts := attrs.updateOpts(mr)
+				opts.Title = mr.Title
+				opts.TargetBranch = mr.TargetBranch
+				mr, err = s.client.UpdateMergeRequest(ctx, targetProject, mr, opts)
+				if err != nil {
+					return exists, errors.Wrap(err, "updating the attributes of an extant merge request")
+				}
+			}
 		} else {
 			return exists, errors.Wrap(err, "creating the merge request")
 		}
@@ -159,6 +182,83 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 	return exists, nil
 }
 
+// gitlabChangesetAttributes are the attributes of a changeset in the form
+// expected by the merge request API.
+type gitlabChangesetAttributes struct {
+	labels      string
+	assigneeIDs []int32
+	reviewerIDs []int32
+	milestoneID int
+}
+
+func (a gitlabChangesetAttributes) empty() bool {
+	return a.labels == "" && len(a.assigneeIDs) == 0 && len(a.reviewerIDs) == 0 && a.milestoneID == 0
+}
+
+// updateOpts returns the options to add the attributes to an existing merge
+// request. Labels are added to the existing ones by GitLab, but assignees and
+// reviewers are replaced, so the existing ones are kept by sending them along.
+func (a gitlabChangesetAttributes) updateOpts(mr *gitlab.MergeRequest) gitlab.UpdateMergeRequestOpts {
+	withExisting := func(users []gitlab.User, ids []int32) []int32 {
+		if len(ids) == 0 {
+			// Nothing is sent, so the existing users are kept as is.
+			return nil
+		}
+		merged := make([]int32, 0, len(users)+len(ids))
+		for _, u := range users {
+			merged = append(merged, u.ID)
+		}
+		for _, id := range ids {
+			if !slices.Contains(merged, id) {
+				merged = append(merged, id)
+			}
+		}
+		return merged
+	}
+
+	return gitlab.UpdateMergeRequestOpts{
+		AddLabels:   a.labels,
+		AssigneeIDs: withExisting(mr.Assignees, a.assigneeIDs),
+		ReviewerIDs: withExisting(mr.Reviewers, a.reviewerIDs),
+		MilestoneID: a.milestoneID,
+	}
+}
+
+// resolveChangesetAttributes looks up the IDs of the reviewers, assignees and
+// milestone requested for the changeset.
+func (s *GitLabSource) resolveChangesetAttributes(ctx context.Context, project *gitlab.Project, c *Changeset) (attrs gitlabChangesetAttributes, err error) {
+	attrs.labels = strings.Join(c.Attributes.Labels, ",")
+
+	userIDs := func(usernames []string) ([]int32, error) {
+		var ids []int32
+		for _, username := range usernames {
+			user, err := s.client.GetUserByUsername(ctx, username)
+			if err != nil {
+				return nil, errors.Wrapf(err, "looking up user %q", username)
+			}
+			ids = append(ids, user.ID)
+		}
+		return ids, nil
+	}
+
+	if attrs.assigneeIDs, err = userIDs(c.Attributes.Assignees); err != nil {
+		return attrs, err
+	}
+	if attrs.reviewerIDs, err = userIDs(c.Attributes.Reviewers); err != nil {
+		return attrs, err
+	}
+
+	if c.Attributes.Milestone != "" {
+		milestone, err := s.client.GetProjectMilestoneByTitle(ctx, project, c.Attributes.Milestone)
+		if err != nil {
+			return attrs, errors.Wrapf(err, "looking up milestone %q", c.Attributes.Milestone)
+		}
+		attrs.milestoneID = milestone.ID
+	}
+
+	return attrs, nil
+}
+
 // CreateDraftChangeset creates a GitLab merge request. If it already exists,
 // *Changeset will be populated and the return value will be true.
 func (s *GitLabSource) CreateDraftChangeset(ctx context.Context, c *Changeset) (bool, error) {
@@ -489,12 +589,17 @@ func (s *GitLabSource) UpdateChangeset(ctx context.Context, c *Changeset) error
 
 	removeSource := conf.Get().BatchChangesAutoDeleteBranch
 
-	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, gitlab.UpdateMergeRequestOpts{
-		Title:              title,
-		Description:        c.Body,
-		TargetBranch:       gitdomain.AbbreviateRef(c.BaseRef),
-		RemoveSourceBranch: removeSource,
-	})
+	attrs, err := s.resolveChangesetAttributes(ctx, project, c)
+	if err != nil {
+		return err
+	}
+
+	opts := attrs.updateOpts(mr)
+	opts.Title = title
+	opts.Description = c.Body
+	opts.TargetBranch = gitdomain.AbbreviateRef(c.BaseRef)
+	opts.RemoveSourceBranch = removeSource
+	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, opts)
 	if err != nil {
 		return errors.Wrap(err, "updating GitLab merge request")
 	}
@@ -195,6 +195,50 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 			}
 		})
 
+		t.Run("merge request with attributes", func(t *testing.T) {
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Attributes = ChangesetAttributes{
+				Reviewers: []string{"alice"},
+				Assignees: []string{"bob"},
+				Labels:    []string{"automated", "batch-changes"},
+				Milestone: "v1",
+			}
+			users := map[string]int32{"alice": 10, "bob": 11}
+			gitlab.MockGetUserByUsername = func(client *gitlab.Client, ctx context.Context, username string) (*gitlab.User, error) {
+				return &gitlab.User{ID: users[username], Username: username}, nil
+			}
+			gitlab.MockGetProjectMilestoneByTitle = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, title string) (*gitlab.Milestone, error) {
+				p.testCommonParams(ctx, client, project)
+				if title != "v1" {
+					t.Errorf("unexpected milestone title: %q", title)
+				}
+				return &gitlab.Milestone{ID: 42, Title: title}, nil
+			}
+			gitlab.MockCreateMergeRequest = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, opts gitlab.CreateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+				want := gitlab.CreateMergeRequestOpts{
+					SourceBranch: p.mr.SourceBranch,
+					TargetBranch: p.mr.TargetBranch,
+					Title:        p.changeset.Title,
+					Description:  p.changeset.Body,
+					Labels:       "automated,batch-changes",
+					AssigneeIDs:  []int32{11},
+					ReviewerIDs:  []int32{10},
+					MilestoneID:  42,
+				}
+				if diff := cmp.Diff(want, opts); diff != "" {
+					t.Errorf("unexpected options (-want +got):\n%s", diff)
+				}
+				return p.mr, nil
+			}
+			p.mockGetMergeRequestNotes(p.mr.IID, nil, 20, nil)
+			p.mockGetMergeRequestResourceStateEvents(p.mr.IID, nil, 20, nil)
+			p.mockGetMergeRequestPipelines(p.mr.IID, nil, 20, nil)
+
+			if _, err := p.source.CreateChangeset(p.ctx, p.changeset); err != nil {
+				t.Errorf("unexpected non-nil err: %+v", err)
+			}
+		})
+
 		t.Run("integration", func(t *testing.T) {
 			// Repository used: https://gitlab.com/batch-changes-testing/batch-changes-test-repo
 			// This repository does not have any project setting to delete source branches
@@ -789,6 +833,55 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 		})
 	})
 
+	t.Run("UpdateChangeset with attributes", func(t *testing.T) {
+		// Labels, assignees and reviewers added on the code host are kept.
+		in := &gitlab.MergeRequest{
+			IID:       2,
+			Labels:    []string{"existing"},
+			Assignees: []gitlab.User{{ID: 1}, {ID: 11}},
+			Reviewers: []gitlab.User{{ID: 2}},
+		}
+		out := &gitlab.MergeRequest{}
+
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Changeset.Metadata = in
+		p.changeset.Attributes = ChangesetAttributes{
+			Reviewers: []string{"alice"},
+			Assignees: []string{"bob"},
+			Labels:    []string{"automated"},
+		}
+		users := map[string]int32{"alice": 10, "bob": 11}
+		gitlab.MockGetUserByUsername = func(client *gitlab.Client, ctx context.Context, username string) (*gitlab.User, error) {
+			return &gitlab.User{ID: users[username], Username: username}, nil
+		}
+		oldMock := gitlab.MockUpdateMergeRequest
+		t.Cleanup(func() { gitlab.MockUpdateMergeRequest = oldMock })
+		gitlab.MockUpdateMergeRequest = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, opts gitlab.UpdateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+			want := gitlab.UpdateMergeRequestOpts{
+				Title:        p.changeset.Title,
+				Description:  p.changeset.Body,
+				TargetBranch: "base",
+				AddLabels:    "automated",
+				AssigneeIDs:  []int32{1, 11},
+				ReviewerIDs:  []int32{2, 10},
+			}
+			if diff := cmp.Diff(want, opts); diff != "" {
+				t.Errorf("unexpected options (-want +got):\n%s", diff)
+			}
+			return out, nil
+		}
+		p.mockGetMergeRequestNotes(in.IID, nil, 20, nil)
+		p.mockGetMergeRequestResourceStateEvents(in.IID, nil, 20, nil)
+		p.mockGetMergeRequestPipelines(in.IID, nil, 20, nil)
+
+		if err := p.source.UpdateChangeset(p.ctx, p.changeset); err != nil {
+			t.Errorf("unexpected non-nil error: %+v", err)
+		}
+		if p.changeset.Changeset.Metadata != out {
+			t.Errorf("metadata not correctly updated: have %+v; want %+v", p.changeset.Changeset.Metadata, out)
+		}
+	})
+
 	t.Run("UpdateChangeset draft", func(t *testing.T) {
 		t.Run("GitLab version is greater than 14.0.0", func(t *testing.T) {
 			// We won't test the full set of UpdateChangeset scenarios; instead
@@ -1267,6 +1360,8 @@ func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockGetOpenMergeRequestByRefs = nil
 	gitlab.MockUpdateMergeRequest = nil
 	gitlab.MockCreateMergeRequestNote = nil
+	gitlab.MockGetUserByUsername = nil
+	gitlab.MockGetProjectMilestoneByTitle = nil
 
 	versions.MockGetVersions = nil
 }
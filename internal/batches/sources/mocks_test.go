@@ -6190,6 +6190,12 @@ type MockAzureDevOpsClient struct {
 	// AbandonPullRequestFunc is an instance of a mock function object
 	// controlling the behavior of the method AbandonPullRequest.
 	AbandonPullRequestFunc *AzureDevOpsClientAbandonPullRequestFunc
+	// AddPullRequestLabelFunc is an instance of a mock function object
+	// controlling the behavior of the method AddPullRequestLabel.
+	AddPullRequestLabelFunc *AzureDevOpsClientAddPullRequestLabelFunc
+	// AddPullRequestReviewerFunc is an instance of a mock function object
+	// controlling the behavior of the method AddPullRequestReviewer.
+	AddPullRequestReviewerFunc *AzureDevOpsClientAddPullRequestReviewerFunc
 	// AuthenticatorFunc is an instance of a mock function object
 	// controlling the behavior of the method Authenticator.
 	AuthenticatorFunc *AzureDevOpsClientAuthenticatorFunc
@@ -6258,6 +6264,16 @@ func NewMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				return
 			},
 		},
+		AddPullRequestLabelFunc: &AzureDevOpsClientAddPullRequestLabelFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string) (r0 azuredevops.PullRequestLabel, r1 error) {
+				return
+			},
+		},
+		AddPullRequestReviewerFunc: &AzureDevOpsClientAddPullRequestReviewerFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string) (r0 azuredevops.Reviewer, r1 error) {
+				return
+			},
+		},
 		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
 			defaultHook: func() (r0 auth.Authenticator) {
 				return
@@ -6360,6 +6376,16 @@ func NewStrictMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				panic("unexpected invocation of MockAzureDevOpsClient.AbandonPullRequest")
 			},
 		},
+		AddPullRequestLabelFunc: &AzureDevOpsClientAddPullRequestLabelFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.PullRequestLabel, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.AddPullRequestLabel")
+			},
+		},
+		AddPullRequestReviewerFunc: &AzureDevOpsClientAddPullRequestReviewerFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.AddPullRequestReviewer")
+			},
+		},
 		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
 			defaultHook: func() auth.Authenticator {
 				panic("unexpected invocation of MockAzureDevOpsClient.Authenticator")
@@ -6461,6 +6487,12 @@ func NewMockAzureDevOpsClientFrom(i azuredevops.Client) *MockAzureDevOpsClient {
 		AbandonPullRequestFunc: &AzureDevOpsClientAbandonPullRequestFunc{
 			defaultHook: i.AbandonPullRequest,
 		},
+		AddPullRequestLabelFunc: &AzureDevOpsClientAddPullRequestLabelFunc{
+			defaultHook: i.AddPullRequestLabel,
+		},
+		AddPullRequestReviewerFunc: &AzureDevOpsClientAddPullRequestReviewerFunc{
+			defaultHook: i.AddPullRequestReviewer,
+		},
 		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
 			defaultHook: i.Authenticator,
 		},
@@ -6629,6 +6661,236 @@ func (c AzureDevOpsClientAbandonPullRequestFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// AzureDevOpsClientAddPullRequestLabelFunc describes the behavior when the
+// AddPullRequestLabel method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientAddPullRequestLabelFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.PullRequestLabel, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.PullRequestLabel, error)
+	history     []AzureDevOpsClientAddPullRequestLabelFuncCall
+	mutex       sync.Mutex
+}
+
+// AddPullRequestLabel delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) AddPullRequestLabel(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 string) (azuredevops.PullRequestLabel, error) {
+	r0, r1 := m.AddPullRequestLabelFunc.nextHook()(v0, v1, v2)
+	m.AddPullRequestLabelFunc.appendCall(AzureDevOpsClientAddPullRequestLabelFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the AddPullRequestLabel
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientAddPullRequestLabelFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.PullRequestLabel, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AddPullRequestLabel method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientAddPullRequestLabelFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.PullRequestLabel, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientAddPullRequestLabelFunc) SetDefaultReturn(r0 azuredevops.PullRequestLabel, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.PullRequestLabel, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientAddPullRequestLabelFunc) PushReturn(r0 azuredevops.PullRequestLabel, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.PullRequestLabel, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientAddPullRequestLabelFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.PullRequestLabel, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientAddPullRequestLabelFunc) appendCall(r0 AzureDevOpsClientAddPullRequestLabelFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientAddPullRequestLabelFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientAddPullRequestLabelFunc) History() []AzureDevOpsClientAddPullRequestLabelFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientAddPullRequestLabelFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientAddPullRequestLabelFuncCall is an object that describes
+// an invocation of method AddPullRequestLabel on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientAddPullRequestLabelFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequestLabel
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientAddPullRequestLabelFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientAddPullRequestLabelFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientAddPullRequestReviewerFunc describes the behavior when
+// the AddPullRequestReviewer method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientAddPullRequestReviewerFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error)
+	history     []AzureDevOpsClientAddPullRequestReviewerFuncCall
+	mutex       sync.Mutex
+}
+
+// AddPullRequestReviewer delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) AddPullRequestReviewer(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 string) (azuredevops.Reviewer, error) {
+	r0, r1 := m.AddPullRequestReviewerFunc.nextHook()(v0, v1, v2)
+	m.AddPullRequestReviewerFunc.appendCall(AzureDevOpsClientAddPullRequestReviewerFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// AddPullRequestReviewer method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AddPullRequestReviewer method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) SetDefaultReturn(r0 azuredevops.Reviewer, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) PushReturn(r0 azuredevops.Reviewer, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) appendCall(r0 AzureDevOpsClientAddPullRequestReviewerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientAddPullRequestReviewerFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) History() []AzureDevOpsClientAddPullRequestReviewerFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientAddPullRequestReviewerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientAddPullRequestReviewerFuncCall is an object that
+// describes an invocation of method AddPullRequestReviewer on an instance
+// of MockAzureDevOpsClient.
+type AzureDevOpsClientAddPullRequestReviewerFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Reviewer
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientAddPullRequestReviewerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientAddPullRequestReviewerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // AzureDevOpsClientAuthenticatorFunc describes the behavior when the
 // Authenticator method of the parent MockAzureDevOpsClient instance is
 // invoked.
@@ -8613,6 +8875,9 @@ type MockGerritClient struct {
 	// AbandonChangeFunc is an instance of a mock function object
 	// controlling the behavior of the method AbandonChange.
 	AbandonChangeFunc *GerritClientAbandonChangeFunc
+	// AddReviewerFunc is an instance of a mock function object controlling
+	// the behavior of the method AddReviewer.
+	AddReviewerFunc *GerritClientAddReviewerFunc
 	// AuthenticatorFunc is an instance of a mock function object
 	// controlling the behavior of the method Authenticator.
 	AuthenticatorFunc *GerritClientAuthenticatorFunc
@@ -8647,6 +8912,9 @@ type MockGerritClient struct {
 	// SetCommitMessageFunc is an instance of a mock function object
 	// controlling the behavior of the method SetCommitMessage.
 	SetCommitMessageFunc *GerritClientSetCommitMessageFunc
+	// SetHashtagsFunc is an instance of a mock function object controlling
+	// the behavior of the method SetHashtags.
+	SetHashtagsFunc *GerritClientSetHashtagsFunc
 	// SetReadyForReviewFunc is an instance of a mock function object
 	// controlling the behavior of the method SetReadyForReview.
 	SetReadyForReviewFunc *GerritClientSetReadyForReviewFunc
@@ -8673,6 +8941,11 @@ func NewMockGerritClient() *MockGerritClient {
 				return
 			},
 		},
+		AddReviewerFunc: &GerritClientAddReviewerFunc{
+			defaultHook: func(context.Context, string, gerrit.AddReviewerPayload) (r0 error) {
+				return
+			},
+		},
 		AuthenticatorFunc: &GerritClientAuthenticatorFunc{
 			defaultHook: func() (r0 auth.Authenticator) {
 				return
@@ -8728,6 +9001,11 @@ func NewMockGerritClient() *MockGerritClient {
 				return
 			},
 		},
+		SetHashtagsFunc: &GerritClientSetHashtagsFunc{
+			defaultHook: func(context.Context, string, gerrit.SetHashtagsPayload) (r0 error) {
+				return
+			},
+		},
 		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
 			defaultHook: func(context.Context, string) (r0 error) {
 				return
@@ -8765,6 +9043,11 @@ func NewStrictMockGerritClient() *MockGerritClient {
 				panic("unexpected invocation of MockGerritClient.AbandonChange")
 			},
 		},
+		AddReviewerFunc: &GerritClientAddReviewerFunc{
+			defaultHook: func(context.Context, string, gerrit.AddReviewerPayload) error {
+				panic("unexpected invocation of MockGerritClient.AddReviewer")
+			},
+		},
 		AuthenticatorFunc: &GerritClientAuthenticatorFunc{
 			defaultHook: func() auth.Authenticator {
 				panic("unexpected invocation of MockGerritClient.Authenticator")
@@ -8820,6 +9103,11 @@ func NewStrictMockGerritClient() *MockGerritClient {
 				panic("unexpected invocation of MockGerritClient.SetCommitMessage")
 			},
 		},
+		SetHashtagsFunc: &GerritClientSetHashtagsFunc{
+			defaultHook: func(context.Context, string, gerrit.SetHashtagsPayload) error {
+				panic("unexpected invocation of MockGerritClient.SetHashtags")
+			},
+		},
 		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
 			defaultHook: func(context.Context, string) error {
 				panic("unexpected invocation of MockGerritClient.SetReadyForReview")
@@ -8856,6 +9144,9 @@ func NewMockGerritClientFrom(i gerrit.Client) *MockGerritClient {
 		AbandonChangeFunc: &GerritClientAbandonChangeFunc{
 			defaultHook: i.AbandonChange,
 		},
+		AddReviewerFunc: &GerritClientAddReviewerFunc{
+			defaultHook: i.AddReviewer,
+		},
 		AuthenticatorFunc: &GerritClientAuthenticatorFunc{
 			defaultHook: i.Authenticator,
 		},
@@ -8889,6 +9180,9 @@ func NewMockGerritClientFrom(i gerrit.Client) *MockGerritClient {
 		SetCommitMessageFunc: &GerritClientSetCommitMessageFunc{
 			defaultHook: i.SetCommitMessage,
 		},
+		SetHashtagsFunc: &GerritClientSetHashtagsFunc{
+			defaultHook: i.SetHashtags,
+		},
 		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
 			defaultHook: i.SetReadyForReview,
 		},
@@ -9015,6 +9309,114 @@ func (c GerritClientAbandonChangeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GerritClientAddReviewerFunc describes the behavior when the AddReviewer
+// method of the parent MockGerritClient instance is invoked.
+type GerritClientAddReviewerFunc struct {
+	defaultHook func(context.Context, string, gerrit.AddReviewerPayload) error
+	hooks       []func(context.Context, string, gerrit.AddReviewerPayload) error
+	history     []GerritClientAddReviewerFuncCall
+	mutex       sync.Mutex
+}
+
+// AddReviewer delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) AddReviewer(v0 context.Context, v1 string, v2 gerrit.AddReviewerPayload) error {
+	r0 := m.AddReviewerFunc.nextHook()(v0, v1, v2)
+	m.AddReviewerFunc.appendCall(GerritClientAddReviewerFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the AddReviewer method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientAddReviewerFunc) SetDefaultHook(hook func(context.Context, string, gerrit.AddReviewerPayload) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AddReviewer method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientAddReviewerFunc) PushHook(hook func(context.Context, string, gerrit.AddReviewerPayload) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientAddReviewerFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, gerrit.AddReviewerPayload) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientAddReviewerFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, gerrit.AddReviewerPayload) error {
+		return r0
+	})
+}
+
+func (f *GerritClientAddReviewerFunc) nextHook() func(context.Context, string, gerrit.AddReviewerPayload) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientAddReviewerFunc) appendCall(r0 GerritClientAddReviewerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientAddReviewerFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientAddReviewerFunc) History() []GerritClientAddReviewerFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientAddReviewerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientAddReviewerFuncCall is an object that describes an invocation
+// of method AddReviewer on an instance of MockGerritClient.
+type GerritClientAddReviewerFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gerrit.AddReviewerPayload
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientAddReviewerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientAddReviewerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GerritClientAuthenticatorFunc describes the behavior when the
 // Authenticator method of the parent MockGerritClient instance is invoked.
 type GerritClientAuthenticatorFunc struct {
@@ -10190,6 +10592,114 @@ func (c GerritClientSetCommitMessageFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// GerritClientSetHashtagsFunc describes the behavior when the SetHashtags
+// method of the parent MockGerritClient instance is invoked.
+type GerritClientSetHashtagsFunc struct {
+	defaultHook func(context.Context, string, gerrit.SetHashtagsPayload) error
+	hooks       []func(context.Context, string, gerrit.SetHashtagsPayload) error
+	history     []GerritClientSetHashtagsFuncCall
+	mutex       sync.Mutex
+}
+
+// SetHashtags delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) SetHashtags(v0 context.Context, v1 string, v2 gerrit.SetHashtagsPayload) error {
+	r0 := m.SetHashtagsFunc.nextHook()(v0, v1, v2)
+	m.SetHashtagsFunc.appendCall(GerritClientSetHashtagsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetHashtags method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientSetHashtagsFunc) SetDefaultHook(hook func(context.Context, string, gerrit.SetHashtagsPayload) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetHashtags method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientSetHashtagsFunc) PushHook(hook func(context.Context, string, gerrit.SetHashtagsPayload) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientSetHashtagsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, gerrit.SetHashtagsPayload) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientSetHashtagsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, gerrit.SetHashtagsPayload) error {
+		return r0
+	})
+}
+
+func (f *GerritClientSetHashtagsFunc) nextHook() func(context.Context, string, gerrit.SetHashtagsPayload) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientSetHashtagsFunc) appendCall(r0 GerritClientSetHashtagsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientSetHashtagsFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientSetHashtagsFunc) History() []GerritClientSetHashtagsFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientSetHashtagsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientSetHashtagsFuncCall is an object that describes an invocation
+// of method SetHashtags on an instance of MockGerritClient.
+type GerritClientSetHashtagsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gerrit.SetHashtagsPayload
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientSetHashtagsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientSetHashtagsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GerritClientSetReadyForReviewFunc describes the behavior when the
 // SetReadyForReview method of the parent MockGerritClient instance is
 // invoked.
@@ -105,6 +105,10 @@ func (s PerforceSource) LoadChangeset(ctx context.Context, cs *Changeset) error
 // CreateChangeset will create the Changeset on the source. If it already
 // exists, *Changeset will be populated and the return value will be true.
 func (s PerforceSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	if err := checkChangesetAttributes("Perforce", cs); err != nil {
+		return false, err
+	}
+
 	return false, s.LoadChangeset(ctx, cs)
 }
 
@@ -0,0 +1,72 @@
+package state
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/batches/types/scheduler/window"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// EvaluateAutoMerge checks whether the derived state of the given changeset,
+// as computed by SetDerivedState, satisfies the auto-merge policy at the given
+// time. If it doesn't, the returned reason explains why.
+func EvaluateAutoMerge(policy *batcheslib.AutoMerge, c *btypes.Changeset, now time.Time) (merge bool, reason string, err error) {
+	switch c.ExternalState {
+	case btypes.ChangesetExternalStateOpen:
+	case btypes.ChangesetExternalStateDraft:
+		return false, "changeset is a draft", nil
+	default:
+		return false, fmt.Sprintf("changeset is %s", strings.ToLower(string(c.ExternalState))), nil
+	}
+
+	if policy.RequiredChecks() == batcheslib.AutoMergeChecksPassed && c.ExternalCheckState != btypes.ChangesetCheckStatePassed {
+		return false, fmt.Sprintf("checks have not passed (check state: %s)", c.ExternalCheckState), nil
+	}
+
+	if policy.RequiredReviews() == batcheslib.AutoMergeReviewsApproved && c.ExternalReviewState != btypes.ChangesetReviewStateApproved {
+		return false, fmt.Sprintf("changeset is not approved (review state: %s)", c.ExternalReviewState), nil
+	}
+
+	windows, err := autoMergeWindows(policy)
+	if err != nil {
+		return false, "", err
+	}
+	if !windows.IsOpen(now.UTC()) {
+		return false, "outside of the merge windows", nil
+	}
+
+	return true, fmt.Sprintf("policy satisfied, merging with method %q", autoMergeMethod(policy)), nil
+}
+
+// autoMergeWindows parses the merge windows of the policy. Merge windows share
+// the format of rollout windows, without a rate: changesets can be merged at
+// any rate while a window is open.
+func autoMergeWindows(policy *batcheslib.AutoMerge) (*window.Configuration, error) {
+	raw := make([]*schema.BatchChangeRolloutWindow, 0, len(policy.Windows))
+	for _, w := range policy.Windows {
+		raw = append(raw, &schema.BatchChangeRolloutWindow{
+			Days:  w.Days,
+			Start: w.Start,
+			End:   w.End,
+			Rate:  "unlimited",
+		})
+	}
+
+	cfg, err := window.NewConfiguration(&raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing merge windows")
+	}
+	return cfg, nil
+}
+
+func autoMergeMethod(policy *batcheslib.AutoMerge) string {
+	if policy.Squash() {
+		return batcheslib.AutoMergeMethodSquash
+	}
+	return batcheslib.AutoMergeMethodMerge
+}
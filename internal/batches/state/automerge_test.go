@@ -0,0 +1,124 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestEvaluateAutoMerge(t *testing.T) {
+	t.Parallel()
+
+	// 2023-10-09 is a Monday.
+	monday := time.Date(2023, 10, 9, 10, 0, 0, 0, time.UTC)
+
+	mergeable := func() *btypes.Changeset {
+		return &btypes.Changeset{
+			ExternalState:       btypes.ChangesetExternalStateOpen,
+			ExternalCheckState:  btypes.ChangesetCheckStatePassed,
+			ExternalReviewState: btypes.ChangesetReviewStateApproved,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		policy     *batcheslib.AutoMerge
+		changeset  func(*btypes.Changeset)
+		now        time.Time
+		wantMerge  bool
+		wantReason string
+		wantErr    bool
+	}{
+		"default policy satisfied": {
+			policy:     &batcheslib.AutoMerge{},
+			now:        monday,
+			wantMerge:  true,
+			wantReason: `policy satisfied, merging with method "merge"`,
+		},
+		"squash": {
+			policy:     &batcheslib.AutoMerge{Method: batcheslib.AutoMergeMethodSquash},
+			now:        monday,
+			wantMerge:  true,
+			wantReason: `policy satisfied, merging with method "squash"`,
+		},
+		"draft": {
+			policy:     &batcheslib.AutoMerge{},
+			changeset:  func(c *btypes.Changeset) { c.ExternalState = btypes.ChangesetExternalStateDraft },
+			now:        monday,
+			wantReason: "changeset is a draft",
+		},
+		"closed": {
+			policy:     &batcheslib.AutoMerge{},
+			changeset:  func(c *btypes.Changeset) { c.ExternalState = btypes.ChangesetExternalStateClosed },
+			now:        monday,
+			wantReason: "changeset is closed",
+		},
+		"checks pending": {
+			policy:     &batcheslib.AutoMerge{},
+			changeset:  func(c *btypes.Changeset) { c.ExternalCheckState = btypes.ChangesetCheckStatePending },
+			now:        monday,
+			wantReason: "checks have not passed (check state: PENDING)",
+		},
+		"checks pending but ignored": {
+			policy:     &batcheslib.AutoMerge{Checks: batcheslib.AutoMergeChecksAny},
+			changeset:  func(c *btypes.Changeset) { c.ExternalCheckState = btypes.ChangesetCheckStatePending },
+			now:        monday,
+			wantMerge:  true,
+			wantReason: `policy satisfied, merging with method "merge"`,
+		},
+		"changes requested": {
+			policy:     &batcheslib.AutoMerge{},
+			changeset:  func(c *btypes.Changeset) { c.ExternalReviewState = btypes.ChangesetReviewStateChangesRequested },
+			now:        monday,
+			wantReason: "changeset is not approved (review state: CHANGES_REQUESTED)",
+		},
+		"reviews ignored": {
+			policy:     &batcheslib.AutoMerge{Reviews: batcheslib.AutoMergeReviewsAny},
+			changeset:  func(c *btypes.Changeset) { c.ExternalReviewState = btypes.ChangesetReviewStatePending },
+			now:        monday,
+			wantMerge:  true,
+			wantReason: `policy satisfied, merging with method "merge"`,
+		},
+		"inside of window": {
+			policy: &batcheslib.AutoMerge{Windows: []batcheslib.AutoMergeWindow{
+				{Days: []string{"mon"}, Start: "09:00", End: "17:00"},
+			}},
+			now:        monday,
+			wantMerge:  true,
+			wantReason: `policy satisfied, merging with method "merge"`,
+		},
+		"outside of window": {
+			policy: &batcheslib.AutoMerge{Windows: []batcheslib.AutoMergeWindow{
+				{Days: []string{"mon"}, Start: "09:00", End: "17:00"},
+			}},
+			now:        monday.Add(8 * time.Hour),
+			wantReason: "outside of the merge windows",
+		},
+		"invalid window": {
+			policy: &batcheslib.AutoMerge{Windows: []batcheslib.AutoMergeWindow{
+				{Start: "25:00", End: "26:00"},
+			}},
+			now:     monday,
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := mergeable()
+			if tc.changeset != nil {
+				tc.changeset(c)
+			}
+
+			merge, reason, err := EvaluateAutoMerge(tc.policy, c, tc.now)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantMerge, merge)
+			assert.Equal(t, tc.wantReason, reason)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package state
+
+import (
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+)
+
+// ComputeStackState returns the stack state of a changeset that depends on the
+// given changeset. A nil dependency means the dependency isn't part of the
+// batch change anymore, in which case the changeset is retargeted to its own
+// base branch.
+func ComputeStackState(dependency *btypes.Changeset) btypes.ChangesetStackState {
+	if dependency == nil {
+		return btypes.ChangesetStackStateRetargeted
+	}
+	if !dependency.Published() {
+		return btypes.ChangesetStackStateWaiting
+	}
+
+	switch dependency.ExternalState {
+	case btypes.ChangesetExternalStateOpen, btypes.ChangesetExternalStateDraft:
+		return btypes.ChangesetStackStateStacked
+	default:
+		return btypes.ChangesetStackStateRetargeted
+	}
+}
@@ -0,0 +1,51 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+)
+
+func TestComputeStackState(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		dependency *btypes.Changeset
+		want       btypes.ChangesetStackState
+	}{
+		"no dependency": {
+			dependency: nil,
+			want:       btypes.ChangesetStackStateRetargeted,
+		},
+		"unpublished": {
+			dependency: &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStateUnpublished},
+			want:       btypes.ChangesetStackStateWaiting,
+		},
+		"open": {
+			dependency: &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateOpen},
+			want:       btypes.ChangesetStackStateStacked,
+		},
+		"draft": {
+			dependency: &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateDraft},
+			want:       btypes.ChangesetStackStateStacked,
+		},
+		"merged": {
+			dependency: &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateMerged},
+			want:       btypes.ChangesetStackStateRetargeted,
+		},
+		"closed": {
+			dependency: &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateClosed},
+			want:       btypes.ChangesetStackStateRetargeted,
+		},
+		"deleted": {
+			dependency: &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateDeleted},
+			want:       btypes.ChangesetStackStateRetargeted,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ComputeStackState(tc.dependency))
+		})
+	}
+}
@@ -45,6 +45,11 @@ var changesetSpecInsertColumns = []string{
 	"commit_author_name",
 	"commit_author_email",
 	"type",
+	"reviewers",
+	"assignees",
+	"labels",
+	"milestone",
+	"depends_on",
 }
 
 // changesetSpecColumns are used by the changeset spec related Store methods to
@@ -72,6 +77,11 @@ var changesetSpecColumns = SQLColumns{
 	"changeset_specs.commit_author_name",
 	"changeset_specs.commit_author_email",
 	"changeset_specs.type",
+	"changeset_specs.reviewers",
+	"changeset_specs.assignees",
+	"changeset_specs.labels",
+	"changeset_specs.milestone",
+	"changeset_specs.depends_on",
 }
 
 var oneGigabyte = 1000000000
@@ -114,6 +124,16 @@ func (s *Store) CreateChangesetSpec(ctx context.Context, cs ...*btypes.Changeset
 				return errors.Errorf("The changeset patch generated is over the size limit. You can make use of [transformChanges](%s) to break down the changesets into smaller pieces.", link)
 			}
 
+			if c.Reviewers == nil {
+				c.Reviewers = []string{}
+			}
+			if c.Assignees == nil {
+				c.Assignees = []string{}
+			}
+			if c.Labels == nil {
+				c.Labels = []string{}
+			}
+
 			if err := inserter.Insert(
 				ctx,
 				c.RandID,
@@ -137,6 +157,11 @@ func (s *Store) CreateChangesetSpec(ctx context.Context, cs ...*btypes.Changeset
 				dbutil.NewNullString(c.CommitAuthorName),
 				dbutil.NewNullString(c.CommitAuthorEmail),
 				c.Type,
+				pq.Array(c.Reviewers),
+				pq.Array(c.Assignees),
+				pq.Array(c.Labels),
+				dbutil.NewNullString(c.Milestone),
+				dbutil.NewNullString(c.DependsOn),
 			); err != nil {
 				return err
 			}
@@ -563,6 +588,11 @@ func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 		&dbutil.NullString{S: &c.CommitAuthorName},
 		&dbutil.NullString{S: &c.CommitAuthorEmail},
 		&typ,
+		pq.Array(&c.Reviewers),
+		pq.Array(&c.Assignees),
+		pq.Array(&c.Labels),
+		&dbutil.NullString{S: &c.Milestone},
+		&dbutil.NullString{S: &c.DependsOn},
 	)
 	if err != nil {
 		return errors.Wrap(err, "scanning changeset spec")
@@ -494,6 +494,12 @@ type GetChangesetOpts struct {
 	ExternalBranch      string
 	ReconcilerState     btypes.ReconcilerState
 	PublicationState    btypes.ChangesetPublicationState
+
+	// OwnedByBatchChangeID and SpecHeadRef narrow the search down to the
+	// changeset of a batch change whose current spec has the given head ref.
+	// They are used to resolve the dependency of a stacked changeset.
+	OwnedByBatchChangeID int64
+	SpecHeadRef          string
 }
 
 // GetChangeset gets a changeset matching the given options.
@@ -551,6 +557,12 @@ func getChangesetQuery(opts *GetChangesetOpts) *sqlf.Query {
 	if opts.PublicationState != "" {
 		preds = append(preds, sqlf.Sprintf("changesets.publication_state = %s", opts.PublicationState))
 	}
+	if opts.OwnedByBatchChangeID != 0 {
+		preds = append(preds, sqlf.Sprintf("changesets.owned_by_batch_change_id = %s", opts.OwnedByBatchChangeID))
+	}
+	if opts.SpecHeadRef != "" {
+		preds = append(preds, sqlf.Sprintf("EXISTS (SELECT 1 FROM changeset_specs WHERE changeset_specs.id = changesets.current_spec_id AND changeset_specs.head_ref = %s)", opts.SpecHeadRef))
+	}
 
 	return sqlf.Sprintf(
 		getChangesetsQueryFmtstr,
@@ -841,6 +853,63 @@ func (s *Store) enqueueChangesetQuery(cs *btypes.Changeset, resetState, currentS
 	)
 }
 
+// EnqueueDependentChangesets re-enqueues the changesets that are stacked on top
+// of the given changeset, so that the reconciler can push and retarget them
+// after the given changeset has been published, updated, merged or closed.
+//
+// Only dependents that aren't currently being processed are enqueued. Failed
+// dependents are enqueued too, since they may have run out of retries while
+// waiting for the given changeset to be published.
+func (s *Store) EnqueueDependentChangesets(ctx context.Context, cs *btypes.Changeset) (err error) {
+	ctx, _, endObservation := s.operations.enqueueDependentChangesets.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(cs.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if cs.OwnedByBatchChangeID == 0 || cs.CurrentSpecID == 0 {
+		return nil
+	}
+
+	return s.Exec(ctx, s.enqueueDependentChangesetsQuery(cs))
+}
+
+var enqueueDependentChangesetsQueryFmtstr = `
+UPDATE changesets
+SET
+	reconciler_state = %s,
+	num_resets = 0,
+	num_failures = 0,
+	updated_at = %s
+WHERE
+	changesets.owned_by_batch_change_id = %s
+	AND changesets.repo_id = %s
+	AND changesets.id != %s
+	AND changesets.reconciler_state IN (%s, %s, %s)
+	AND EXISTS (
+		SELECT 1
+		FROM changeset_specs dependent
+		JOIN changeset_specs dependency ON dependency.id = %s
+		WHERE
+			dependent.id = changesets.current_spec_id
+			AND dependent.depends_on = dependency.head_ref
+	)
+`
+
+func (s *Store) enqueueDependentChangesetsQuery(cs *btypes.Changeset) *sqlf.Query {
+	return sqlf.Sprintf(
+		enqueueDependentChangesetsQueryFmtstr,
+		btypes.ReconcilerStateQueued.ToDB(),
+		s.now(),
+		cs.OwnedByBatchChangeID,
+		cs.RepoID,
+		cs.ID,
+		btypes.ReconcilerStateCompleted.ToDB(),
+		btypes.ReconcilerStateErrored.ToDB(),
+		btypes.ReconcilerStateFailed.ToDB(),
+		cs.CurrentSpecID,
+	)
+}
+
 // UpdateChangeset updates the given Changeset.
 func (s *Store) UpdateChangeset(ctx context.Context, cs *btypes.Changeset) (err error) {
 	ctx, _, endObservation := s.operations.updateChangeset.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
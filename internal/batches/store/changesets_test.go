@@ -2642,6 +2642,79 @@ func TestEnqueueChangesetsToClose(t *testing.T) {
 	}
 }
 
+func TestEnqueueDependentChangesets(t *testing.T) {
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	s := New(db, &observation.TestContext, nil)
+
+	user := bt.CreateTestUser(t, db, true)
+	spec := bt.CreateBatchSpec(t, ctx, s, "test-batch-change", user.ID, 0)
+	batchChange := bt.CreateBatchChange(t, ctx, s, "test-batch-change", user.ID, spec.ID)
+	repo, _ := bt.CreateTestRepo(t, ctx, db)
+
+	createChangeset := func(headRef, dependsOn string, state btypes.ReconcilerState) *btypes.Changeset {
+		changesetSpec := bt.CreateChangesetSpec(t, ctx, s, bt.TestSpecOpts{
+			User:      user.ID,
+			Repo:      repo.ID,
+			BatchSpec: spec.ID,
+			HeadRef:   headRef,
+			DependsOn: dependsOn,
+		})
+		opts := bt.TestChangesetOpts{
+			Repo:               repo.ID,
+			BatchChange:        batchChange.ID,
+			OwnedByBatchChange: batchChange.ID,
+			CurrentSpec:        changesetSpec.ID,
+			ReconcilerState:    state,
+			PublicationState:   btypes.ChangesetPublicationStateUnpublished,
+		}
+		if state == btypes.ReconcilerStateFailed || state == btypes.ReconcilerStateErrored {
+			opts.FailureMessage = "the changeset this changeset depends on has not been published yet"
+			opts.NumFailures = 5
+		}
+		return bt.CreateChangeset(t, ctx, s, opts)
+	}
+
+	dependency := createChangeset("refs/heads/step-1", "", btypes.ReconcilerStateCompleted)
+	enqueued := []*btypes.Changeset{
+		createChangeset("refs/heads/step-2a", "refs/heads/step-1", btypes.ReconcilerStateCompleted),
+		createChangeset("refs/heads/step-2b", "refs/heads/step-1", btypes.ReconcilerStateErrored),
+		createChangeset("refs/heads/step-2c", "refs/heads/step-1", btypes.ReconcilerStateFailed),
+	}
+	notEnqueued := []*btypes.Changeset{
+		createChangeset("refs/heads/step-2d", "refs/heads/step-1", btypes.ReconcilerStateProcessing),
+		createChangeset("refs/heads/step-3", "refs/heads/step-2a", btypes.ReconcilerStateCompleted),
+	}
+
+	if err := s.EnqueueDependentChangesets(ctx, dependency); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range enqueued {
+		have, err := s.GetChangeset(ctx, GetChangesetOpts{ID: c.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have.ReconcilerState != btypes.ReconcilerStateQueued {
+			t.Errorf("changeset %d: want reconciler state %s, have %s", c.ID, btypes.ReconcilerStateQueued, have.ReconcilerState)
+		}
+		if have.NumFailures != 0 {
+			t.Errorf("changeset %d: want no failures, have %d", c.ID, have.NumFailures)
+		}
+	}
+	for _, c := range notEnqueued {
+		have, err := s.GetChangeset(ctx, GetChangesetOpts{ID: c.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have.ReconcilerState != c.ReconcilerState {
+			t.Errorf("changeset %d: want reconciler state %s, have %s", c.ID, c.ReconcilerState, have.ReconcilerState)
+		}
+	}
+}
+
 func TestCleanDetachedChangesets(t *testing.T) {
 	logger := logtest.Scoped(t)
 	ctx := context.Background()
@@ -241,6 +241,7 @@ type operations struct {
 	listChangesetSyncData             *observation.Operation
 	listChangesets                    *observation.Operation
 	enqueueChangeset                  *observation.Operation
+	enqueueDependentChangesets        *observation.Operation
 	updateChangeset                   *observation.Operation
 	updateChangesetBatchChanges       *observation.Operation
 	updateChangesetUIPublicationState *observation.Operation
@@ -386,6 +387,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 			listChangesetSyncData:             op("ListChangesetSyncData"),
 			listChangesets:                    op("ListChangesets"),
 			enqueueChangeset:                  op("EnqueueChangeset"),
+			enqueueDependentChangesets:        op("EnqueueDependentChangesets"),
 			updateChangeset:                   op("UpdateChangeset"),
 			updateChangesetBatchChanges:       op("UpdateChangesetBatchChanges"),
 			updateChangesetUIPublicationState: op("UpdateChangesetUIPublicationState"),
@@ -0,0 +1,176 @@
+package syncer
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/sources"
+	"github.com/sourcegraph/sourcegraph/internal/batches/state"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// AutoMergeChangeset evaluates the auto-merge policy of the batch change that
+// owns the given, freshly synced changeset and merges the changeset on the
+// code host if it satisfies the policy. The decision is recorded as a
+// changeset event.
+//
+// Changesets that aren't owned by a batch change, whose batch change is closed
+// or doesn't define a policy, or that aren't open anymore are skipped without
+// recording anything.
+func AutoMergeChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Client, source sources.ChangesetSource, repo *types.Repo, c *btypes.Changeset) (err error) {
+	if c.OwnedByBatchChangeID == 0 || c.IsDeleted() {
+		return nil
+	}
+	if c.ExternalState != btypes.ChangesetExternalStateOpen && c.ExternalState != btypes.ChangesetExternalStateDraft {
+		return nil
+	}
+
+	batchChange, err := syncStore.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: c.OwnedByBatchChangeID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil
+		}
+		return errors.Wrap(err, "getting batch change")
+	}
+	if batchChange.Closed() {
+		return nil
+	}
+
+	batchSpec, err := syncStore.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch spec")
+	}
+	policy := batchSpec.Spec.AutoMerge
+	if policy == nil {
+		return nil
+	}
+
+	now := syncStore.Clock()()
+	event := &btypes.AutoMergeEvent{Decision: btypes.AutoMergeDecisionWaiting, CreatedAt: now}
+
+	merge, reason, err := state.EvaluateAutoMerge(policy, c, now)
+	switch {
+	case err != nil:
+		event.Decision = btypes.AutoMergeDecisionFailed
+		event.Reason = err.Error()
+	case merge:
+		previous, err := previousAutoMergeFailure(ctx, syncStore, c)
+		if err != nil {
+			return err
+		}
+		if previous != nil && now.Before(previous.RetryAt) {
+			// The last attempt failed recently, so we back off instead of
+			// retrying on every sync.
+			return nil
+		}
+
+		event.Decision = btypes.AutoMergeDecisionMerged
+		event.Reason = reason
+		if err := mergeChangeset(ctx, source, repo, c, policy.Squash()); err != nil {
+			event.Decision = btypes.AutoMergeDecisionFailed
+			event.Reason = err.Error()
+			event.Failures = 1
+			if previous != nil {
+				event.Failures = previous.Failures + 1
+			}
+			event.RetryAt = now.Add(autoMergeRetryBackoff(event.Failures))
+		} else if err := storeMergedChangeset(ctx, syncStore, client, c); err != nil {
+			return err
+		}
+	default:
+		event.Reason = reason
+	}
+
+	return syncStore.UpsertChangesetEvents(ctx, &btypes.ChangesetEvent{
+		ChangesetID: c.ID,
+		Kind:        btypes.ChangesetEventKindBatchesAutoMerge,
+		Key:         event.Key(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Metadata:    event,
+	})
+}
+
+const (
+	autoMergeMinRetryBackoff = 5 * time.Minute
+	autoMergeMaxRetryBackoff = 6 * time.Hour
+)
+
+// autoMergeRetryBackoff returns how long to wait before attempting to merge a
+// changeset again after the given number of consecutive failures. The delay
+// doubles with every failure, up to autoMergeMaxRetryBackoff.
+func autoMergeRetryBackoff(failures int) time.Duration {
+	backoff := autoMergeMinRetryBackoff
+	for i := 1; i < failures && backoff < autoMergeMaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > autoMergeMaxRetryBackoff {
+		return autoMergeMaxRetryBackoff
+	}
+	return backoff
+}
+
+// previousAutoMergeFailure returns the event recording the last failed attempt
+// to merge the changeset, if any.
+func previousAutoMergeFailure(ctx context.Context, syncStore SyncStore, c *btypes.Changeset) (*btypes.AutoMergeEvent, error) {
+	ev, err := syncStore.GetChangesetEvent(ctx, store.GetChangesetEventOpts{
+		ChangesetID: c.ID,
+		Kind:        btypes.ChangesetEventKindBatchesAutoMerge,
+		Key:         string(btypes.AutoMergeDecisionFailed),
+	})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "getting previous auto-merge failure")
+	}
+
+	event, ok := ev.Metadata.(*btypes.AutoMergeEvent)
+	if !ok {
+		return nil, nil
+	}
+	return event, nil
+}
+
+// mergeChangeset merges the changeset on the code host.
+func mergeChangeset(ctx context.Context, source sources.ChangesetSource, repo *types.Repo, c *btypes.Changeset, squash bool) error {
+	remoteRepo, err := sources.GetRemoteRepo(ctx, source, repo, c, nil)
+	if err != nil {
+		return errors.Wrap(err, "loading remote repo")
+	}
+
+	cs := &sources.Changeset{
+		Changeset:  c,
+		TargetRepo: repo,
+		RemoteRepo: remoteRepo,
+	}
+	return source.MergeChangeset(ctx, cs, squash)
+}
+
+// storeMergedChangeset stores the state of a changeset after it has been
+// merged, the same way a bulk merge does.
+func storeMergedChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Client, c *btypes.Changeset) (err error) {
+	events, err := c.Events()
+	if err != nil {
+		return err
+	}
+	state.SetDerivedState(ctx, syncStore.Repos(), client, c, events)
+
+	tx, err := syncStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.UpdateChangesetCodeHostState(ctx, c); err != nil {
+		return err
+	}
+	if err := tx.EnqueueDependentChangesets(ctx, c); err != nil {
+		return err
+	}
+	return tx.UpsertChangesetEvents(ctx, events...)
+}
@@ -0,0 +1,170 @@
+package syncer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	stesting "github.com/sourcegraph/sourcegraph/internal/batches/sources/testing"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestAutoMergeChangeset(t *testing.T) {
+	ctx := context.Background()
+	repo := &types.Repo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+
+	newStore := func(policy *batcheslib.AutoMerge, closed bool) *MockSyncStore {
+		s := newTestStore()
+		s.GetBatchChangeFunc.SetDefaultHook(func(_ context.Context, opts store.GetBatchChangeOpts) (*btypes.BatchChange, error) {
+			bc := &btypes.BatchChange{ID: opts.ID, BatchSpecID: 2}
+			if closed {
+				bc.ClosedAt = s.Clock()()
+			}
+			return bc, nil
+		})
+		s.GetBatchSpecFunc.SetDefaultHook(func(_ context.Context, opts store.GetBatchSpecOpts) (*btypes.BatchSpec, error) {
+			assert.Equal(t, int64(2), opts.ID)
+			return &btypes.BatchSpec{ID: 2, Spec: &batcheslib.BatchSpec{AutoMerge: policy}}, nil
+		})
+		s.GetChangesetEventFunc.SetDefaultReturn(nil, store.ErrNoResults)
+		return s
+	}
+
+	newChangeset := func() *btypes.Changeset {
+		return &btypes.Changeset{
+			ID:                   3,
+			OwnedByBatchChangeID: 1,
+			ExternalState:        btypes.ChangesetExternalStateOpen,
+			ExternalCheckState:   btypes.ChangesetCheckStatePassed,
+			ExternalReviewState:  btypes.ChangesetReviewStatePending,
+		}
+	}
+
+	recordedEvent := func(t *testing.T, s *MockSyncStore) *btypes.AutoMergeEvent {
+		t.Helper()
+		require.Len(t, s.UpsertChangesetEventsFunc.History(), 1)
+		events := s.UpsertChangesetEventsFunc.History()[0].Arg1
+		require.Len(t, events, 1)
+		assert.Equal(t, btypes.ChangesetEventKindBatchesAutoMerge, events[0].Kind)
+		assert.Equal(t, int64(3), events[0].ChangesetID)
+		return events[0].Metadata.(*btypes.AutoMergeEvent)
+	}
+
+	t.Run("not owned by a batch change", func(t *testing.T) {
+		s := newStore(&batcheslib.AutoMerge{}, false)
+		c := newChangeset()
+		c.OwnedByBatchChangeID = 0
+
+		require.NoError(t, AutoMergeChangeset(ctx, s, nil, &stesting.FakeChangesetSource{}, repo, c))
+		assert.Empty(t, s.GetBatchChangeFunc.History())
+		assert.Empty(t, s.UpsertChangesetEventsFunc.History())
+	})
+
+	t.Run("no policy", func(t *testing.T) {
+		s := newStore(nil, false)
+		source := &stesting.FakeChangesetSource{}
+
+		require.NoError(t, AutoMergeChangeset(ctx, s, nil, source, repo, newChangeset()))
+		assert.False(t, source.MergeChangesetCalled)
+		assert.Empty(t, s.UpsertChangesetEventsFunc.History())
+	})
+
+	t.Run("closed batch change", func(t *testing.T) {
+		s := newStore(&batcheslib.AutoMerge{}, true)
+		source := &stesting.FakeChangesetSource{}
+
+		require.NoError(t, AutoMergeChangeset(ctx, s, nil, source, repo, newChangeset()))
+		assert.False(t, source.MergeChangesetCalled)
+		assert.Empty(t, s.UpsertChangesetEventsFunc.History())
+	})
+
+	t.Run("waiting", func(t *testing.T) {
+		s := newStore(&batcheslib.AutoMerge{}, false)
+		source := &stesting.FakeChangesetSource{}
+
+		require.NoError(t, AutoMergeChangeset(ctx, s, nil, source, repo, newChangeset()))
+		assert.False(t, source.MergeChangesetCalled)
+
+		event := recordedEvent(t, s)
+		assert.Equal(t, btypes.AutoMergeDecisionWaiting, event.Decision)
+		assert.Equal(t, "changeset is not approved (review state: PENDING)", event.Reason)
+	})
+
+	t.Run("merge failed", func(t *testing.T) {
+		s := newStore(&batcheslib.AutoMerge{Reviews: batcheslib.AutoMergeReviewsAny}, false)
+		source := &stesting.FakeChangesetSource{Err: errors.New("not mergeable")}
+
+		require.NoError(t, AutoMergeChangeset(ctx, s, nil, source, repo, newChangeset()))
+		assert.True(t, source.MergeChangesetCalled)
+
+		event := recordedEvent(t, s)
+		assert.Equal(t, btypes.AutoMergeDecisionFailed, event.Decision)
+		assert.Equal(t, "not mergeable", event.Reason)
+		assert.Equal(t, 1, event.Failures)
+		assert.Equal(t, event.CreatedAt.Add(autoMergeMinRetryBackoff), event.RetryAt)
+		assert.Equal(t, "FAILED", s.UpsertChangesetEventsFunc.History()[0].Arg1[0].Key)
+	})
+
+	previousFailure := func(s *MockSyncStore, retryAt time.Time) {
+		s.GetChangesetEventFunc.SetDefaultHook(func(_ context.Context, opts store.GetChangesetEventOpts) (*btypes.ChangesetEvent, error) {
+			assert.Equal(t, store.GetChangesetEventOpts{ChangesetID: 3, Kind: btypes.ChangesetEventKindBatchesAutoMerge, Key: "FAILED"}, opts)
+			return &btypes.ChangesetEvent{Metadata: &btypes.AutoMergeEvent{
+				Decision: btypes.AutoMergeDecisionFailed,
+				Failures: 2,
+				RetryAt:  retryAt,
+			}}, nil
+		})
+	}
+
+	t.Run("backing off after failure", func(t *testing.T) {
+		s := newStore(&batcheslib.AutoMerge{Reviews: batcheslib.AutoMergeReviewsAny}, false)
+		previousFailure(s, s.Clock()().Add(time.Hour))
+		source := &stesting.FakeChangesetSource{}
+
+		require.NoError(t, AutoMergeChangeset(ctx, s, nil, source, repo, newChangeset()))
+		assert.False(t, source.MergeChangesetCalled)
+		assert.Empty(t, s.UpsertChangesetEventsFunc.History())
+	})
+
+	t.Run("retrying after failure", func(t *testing.T) {
+		s := newStore(&batcheslib.AutoMerge{Reviews: batcheslib.AutoMergeReviewsAny}, false)
+		previousFailure(s, s.Clock()().Add(-time.Minute))
+		source := &stesting.FakeChangesetSource{Err: errors.New("not mergeable")}
+
+		require.NoError(t, AutoMergeChangeset(ctx, s, nil, source, repo, newChangeset()))
+		assert.True(t, source.MergeChangesetCalled)
+
+		event := recordedEvent(t, s)
+		assert.Equal(t, btypes.AutoMergeDecisionFailed, event.Decision)
+		assert.Equal(t, 3, event.Failures)
+		assert.Equal(t, event.CreatedAt.Add(4*autoMergeMinRetryBackoff), event.RetryAt)
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		s := newStore(&batcheslib.AutoMerge{
+			Reviews: batcheslib.AutoMergeReviewsAny,
+			Windows: []batcheslib.AutoMergeWindow{{Start: "25:00", End: "26:00"}},
+		}, false)
+		source := &stesting.FakeChangesetSource{}
+
+		require.NoError(t, AutoMergeChangeset(ctx, s, nil, source, repo, newChangeset()))
+		assert.False(t, source.MergeChangesetCalled)
+
+		event := recordedEvent(t, s)
+		assert.Equal(t, btypes.AutoMergeDecisionFailed, event.Decision)
+	})
+}
+
+func TestAutoMergeRetryBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Minute, autoMergeRetryBackoff(1))
+	assert.Equal(t, 10*time.Minute, autoMergeRetryBackoff(2))
+	assert.Equal(t, 40*time.Minute, autoMergeRetryBackoff(4))
+	assert.Equal(t, autoMergeMaxRetryBackoff, autoMergeRetryBackoff(100))
+}
@@ -15,6 +15,7 @@ type SyncStore interface {
 	ListChangesets(ctx context.Context, opts store.ListChangesetsOpts) (btypes.Changesets, int64, error)
 	ListChangesetSyncData(context.Context, store.ListChangesetSyncDataOpts) ([]*btypes.ChangesetSyncData, error)
 	GetChangeset(context.Context, store.GetChangesetOpts) (*btypes.Changeset, error)
+	GetChangesetEvent(ctx context.Context, opts store.GetChangesetEventOpts) (*btypes.ChangesetEvent, error)
 	UpdateChangesetCodeHostState(ctx context.Context, cs *btypes.Changeset) error
 	UpsertChangesetEvents(ctx context.Context, cs ...*btypes.ChangesetEvent) error
 	GetSiteCredential(ctx context.Context, opts store.GetSiteCredentialOpts) (*btypes.SiteCredential, error)
@@ -26,5 +27,6 @@ type SyncStore interface {
 	GetExternalServiceIDs(ctx context.Context, opts store.GetExternalServiceIDsOpts) ([]int64, error)
 	UserCredentials() database.UserCredentialsStore
 	GetBatchChange(ctx context.Context, opts store.GetBatchChangeOpts) (*btypes.BatchChange, error)
+	GetBatchSpec(ctx context.Context, opts store.GetBatchSpecOpts) (*btypes.BatchSpec, error)
 	GitHubAppsStore() ghastore.GitHubAppsStore
 }
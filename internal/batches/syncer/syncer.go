@@ -495,7 +495,14 @@ func (s *changesetSyncer) SyncChangeset(ctx context.Context, id int64) error {
 		return err
 	}
 
-	return SyncChangeset(ctx, s.syncStore, gitserver.NewClient(), source, repo, cs)
+	client := gitserver.NewClient()
+	if err := SyncChangeset(ctx, s.syncStore, client, source, repo, cs); err != nil {
+		return err
+	}
+
+	// Now that the changeset is in sync with the code host, we can check
+	// whether it should be merged automatically.
+	return AutoMergeChangeset(ctx, s.syncStore, client, source, repo, cs)
 }
 
 // SyncChangeset refreshes the metadata of the given changeset and
@@ -518,6 +525,8 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 		}
 	}
 
+	wasComplete := c.Complete()
+
 	events, err := c.Events()
 	if err != nil {
 		return err
@@ -538,5 +547,13 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 		return err
 	}
 
+	// Changesets stacked on top of a changeset that has just been merged,
+	// closed or deleted need to be retargeted.
+	if !wasComplete && c.Complete() {
+		if err := tx.EnqueueDependentChangesets(ctx, c); err != nil {
+			return err
+		}
+	}
+
 	return tx.UpsertChangesetEvents(ctx, events...)
 }
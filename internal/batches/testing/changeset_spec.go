@@ -36,9 +36,14 @@ type TestSpecOpts struct {
 	CommitAuthorEmail string
 	CommitAuthorName  string
 
+	Reviewers []string
+	Labels    []string
+
 	BaseRev string
 	BaseRef string
 
+	DependsOn string
+
 	Typ btypes.ChangesetSpecType
 }
 
@@ -72,6 +77,9 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 		Diff:              opts.CommitDiff,
 		CommitAuthorEmail: opts.CommitAuthorEmail,
 		CommitAuthorName:  opts.CommitAuthorName,
+		Reviewers:         opts.Reviewers,
+		Labels:            opts.Labels,
+		DependsOn:         opts.DependsOn,
 		DiffStatAdded:     TestChangsetSpecDiffStat.Added,
 		DiffStatDeleted:   TestChangsetSpecDiffStat.Deleted,
 		Type:              opts.Typ,
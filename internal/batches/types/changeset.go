@@ -189,6 +189,36 @@ func (s ChangesetExternalState) Valid() bool {
 	}
 }
 
+// ChangesetStackState defines the possible states of a changeset that is
+// stacked on top of another changeset of the same batch change.
+type ChangesetStackState string
+
+// ChangesetStackState constants.
+const (
+	// ChangesetStackStateWaiting means the dependency hasn't been published
+	// yet, so the changeset can't be published either.
+	ChangesetStackStateWaiting ChangesetStackState = "WAITING"
+	// ChangesetStackStateStacked means the changeset targets the branch of its
+	// open dependency.
+	ChangesetStackStateStacked ChangesetStackState = "STACKED"
+	// ChangesetStackStateRetargeted means the dependency has been merged,
+	// closed or deleted, so the changeset targets the base branch of its spec
+	// again.
+	ChangesetStackStateRetargeted ChangesetStackState = "RETARGETED"
+)
+
+// Valid returns true if the given ChangesetStackState is valid.
+func (s ChangesetStackState) Valid() bool {
+	switch s {
+	case ChangesetStackStateWaiting,
+		ChangesetStackStateStacked,
+		ChangesetStackStateRetargeted:
+		return true
+	default:
+		return false
+	}
+}
+
 // ChangesetLabel represents a label applied to a changeset
 type ChangesetLabel struct {
 	Name        string
@@ -1548,6 +1578,8 @@ func NewChangesetEventMetadata(k ChangesetEventKind) (any, error) {
 			ChangesetEventKindGerritChangeBuildSucceeded:
 			return new(gerrit.Reviewer), nil
 		}
+	case k == ChangesetEventKindBatchesAutoMerge:
+		return new(AutoMergeEvent), nil
 	}
 	return nil, errors.Errorf("changeset event metadata unknown changeset event kind %q", k)
 }
@@ -125,9 +125,51 @@ const (
 	ChangesetEventKindGerritChangeBuildFailed             ChangesetEventKind = "gerrit:change:build_failed"
 	ChangesetEventKindGerritChangeBuildPending            ChangesetEventKind = "gerrit:change:build_pending"
 
+	// ChangesetEventKindBatchesAutoMerge events aren't created by a code host,
+	// but record the decisions taken when evaluating the auto-merge policy of
+	// the batch change that owns the changeset.
+	ChangesetEventKindBatchesAutoMerge ChangesetEventKind = "batches:auto_merge"
+
 	ChangesetEventKindInvalid ChangesetEventKind = "invalid"
 )
 
+// AutoMergeDecision is the outcome of evaluating an auto-merge policy for a
+// changeset.
+type AutoMergeDecision string
+
+const (
+	// AutoMergeDecisionWaiting means the changeset doesn't satisfy the policy
+	// yet.
+	AutoMergeDecisionWaiting AutoMergeDecision = "WAITING"
+	// AutoMergeDecisionMerged means the changeset satisfied the policy and was
+	// merged.
+	AutoMergeDecisionMerged AutoMergeDecision = "MERGED"
+	// AutoMergeDecisionFailed means the changeset satisfied the policy, but
+	// the code host refused to merge it.
+	AutoMergeDecisionFailed AutoMergeDecision = "FAILED"
+)
+
+// AutoMergeEvent is the metadata of a ChangesetEventKindBatchesAutoMerge
+// event.
+type AutoMergeEvent struct {
+	Decision  AutoMergeDecision `json:"decision"`
+	Reason    string            `json:"reason"`
+	CreatedAt time.Time         `json:"createdAt"`
+
+	// Failures is the number of consecutive failed attempts to merge the
+	// changeset, and RetryAt the time after which it will be attempted again.
+	// They are only set if Decision is AutoMergeDecisionFailed.
+	Failures int       `json:"failures,omitempty"`
+	RetryAt  time.Time `json:"retryAt,omitempty"`
+}
+
+// Key returns the deduplication key of the event, so that evaluating the
+// policy repeatedly with the same outcome updates the existing event instead
+// of creating new ones.
+func (e *AutoMergeEvent) Key() string {
+	return string(e.Decision)
+}
+
 // A ChangesetEvent is an event that happened in the lifetime
 // and context of a Changeset.
 type ChangesetEvent struct {
@@ -370,6 +412,8 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		t = ev.CreatedDate
 	case *azuredevops.PullRequestMergedEvent:
 		t = ev.CreatedDate
+	case *AutoMergeEvent:
+		t = ev.CreatedAt
 	}
 
 	return t
@@ -913,6 +957,10 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 	case *azuredevops.PullRequestRejectedEvent:
 		o := o.Metadata.(*azuredevops.PullRequestRejectedEvent)
 		*e = *o
+
+	case *AutoMergeEvent:
+		o := o.Metadata.(*AutoMergeEvent)
+		*e = *o
 	default:
 		return errors.Errorf("unknown changeset event metadata %T", e)
 	}
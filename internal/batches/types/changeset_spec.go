@@ -35,6 +35,11 @@ func NewChangesetSpecFromSpec(spec *batcheslib.ChangesetSpec) (*ChangesetSpec, e
 		ExternalID: spec.ExternalID,
 		Title:      spec.Title,
 		Body:       spec.Body,
+		Reviewers:  spec.Reviewers,
+		Assignees:  spec.Assignees,
+		Labels:     spec.Labels,
+		Milestone:  spec.Milestone,
+		DependsOn:  spec.DependsOn,
 		Published:  spec.Published,
 	}
 
@@ -115,6 +120,18 @@ type ChangesetSpec struct {
 	CommitAuthorName  string
 	CommitAuthorEmail string
 
+	// Reviewers, Assignees, Labels and Milestone are the optional attributes
+	// of the changeset on the code host. Not every code host supports all of
+	// them.
+	Reviewers []string
+	Assignees []string
+	Labels    []string
+	Milestone string
+
+	// DependsOn is the fully qualified head ref of another changeset in the
+	// same batch change that this changeset is stacked on top of.
+	DependsOn string
+
 	ForkNamespace *string
 }
 
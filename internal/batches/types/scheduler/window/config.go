@@ -82,6 +82,17 @@ func (cfg *Configuration) HasRolloutWindows() bool {
 	return len(cfg.windows) != 0
 }
 
+// IsOpen returns true if there are no windows, or if a window that allows
+// changesets to be processed is open at the given time.
+func (cfg *Configuration) IsOpen(at time.Time) bool {
+	if !cfg.HasRolloutWindows() {
+		return true
+	}
+
+	window, _ := cfg.windowFor(at)
+	return window != nil && (window.rate.IsUnlimited() || window.rate.n > 0)
+}
+
 // Schedule returns the currently active schedule.
 func (cfg *Configuration) Schedule() *Schedule {
 	// If there are no rollout windows, then we return an unlimited schedule and
@@ -197,6 +197,57 @@ func TestConfiguration_Schedule(t *testing.T) {
 	}
 }
 
+func TestConfiguration_IsOpen(t *testing.T) {
+	// 2021-04-05 is a Monday.
+	monday := time.Date(2021, 4, 5, 10, 0, 0, 0, time.UTC)
+	tuesday := monday.Add(24 * time.Hour)
+
+	for name, tc := range map[string]struct {
+		cfg  *Configuration
+		at   time.Time
+		want bool
+	}{
+		"no windows": {
+			cfg:  &Configuration{},
+			at:   monday,
+			want: true,
+		},
+		"open window": {
+			cfg: &Configuration{
+				windows: []Window{
+					{days: newWeekdaySet(time.Monday), start: timeOfDayPtr(9, 0), end: timeOfDayPtr(17, 0), rate: makeUnlimitedRate()},
+				},
+			},
+			at:   monday,
+			want: true,
+		},
+		"outside of window": {
+			cfg: &Configuration{
+				windows: []Window{
+					{days: newWeekdaySet(time.Monday), start: timeOfDayPtr(9, 0), end: timeOfDayPtr(17, 0), rate: makeUnlimitedRate()},
+				},
+			},
+			at:   tuesday,
+			want: false,
+		},
+		"zero rate window": {
+			cfg: &Configuration{
+				windows: []Window{
+					{days: newWeekdaySet(), rate: rate{n: 0}},
+				},
+			},
+			at:   monday,
+			want: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := tc.cfg.IsOpen(tc.at); have != tc.want {
+				t.Errorf("unexpected result: have=%v want=%v", have, tc.want)
+			}
+		})
+	}
+}
+
 func TestConfiguration_currentFor(t *testing.T) {
 	// Let's set up some common windows to simplify defining the test cases.
 
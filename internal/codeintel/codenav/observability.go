@@ -13,6 +13,7 @@ import (
 
 type operations struct {
 	getReferences          *observation.Operation
+	getSymbolReferences    *observation.Operation
 	getImplementations     *observation.Operation
 	getPrototypes          *observation.Operation
 	getDiagnostics         *observation.Operation
@@ -47,6 +48,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 
 	return &operations{
 		getReferences:          op("getReferences"),
+		getSymbolReferences:    op("GetSymbolReferences"),
 		getImplementations:     op("getImplementations"),
 		getPrototypes:          op("getPrototypes"),
 		getDiagnostics:         op("getDiagnostics"),
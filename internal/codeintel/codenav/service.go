@@ -818,6 +818,40 @@ func (s *Service) GetStencil(ctx context.Context, args PositionalRequestArgs, re
 	return dedupeRanges(sortedRanges), nil
 }
 
+// GetSymbolReferences returns up to limit locations within the given upload that reference any of
+// the given SCIP symbols, along with the total number of such locations. Unlike GetReferences, the
+// symbols are identified by name rather than by a position in a document. Location paths are
+// relative to the root of the repository.
+func (s *Service) GetSymbolReferences(ctx context.Context, uploadID int, symbolNames []string, limit int) (_ []shared.Location, _ int, err error) {
+	ctx, _, endObservation := s.operations.getSymbolReferences.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+		attribute.StringSlice("symbolNames", symbolNames),
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	dumps, err := s.GetDumpsByIDs(ctx, []int{uploadID})
+	if err != nil || len(dumps) == 0 {
+		return nil, 0, err
+	}
+
+	monikers := make([]precise.MonikerData, 0, len(symbolNames))
+	for _, symbolName := range symbolNames {
+		monikers = append(monikers, precise.MonikerData{Kind: "import", Scheme: "scip", Identifier: symbolName})
+	}
+
+	locations, totalCount, err := s.lsifstore.GetBulkMonikerLocations(ctx, "references", []int{uploadID}, monikers, limit, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range locations {
+		locations[i].Path = dumps[0].Root + locations[i].Path
+	}
+
+	return locations, totalCount, nil
+}
+
 // TODO(#48681) - do not proxy this
 func (s *Service) GetDumpsByIDs(ctx context.Context, ids []int) ([]uploadsshared.Dump, error) {
 	return s.uploadSvc.GetDumpsByIDs(ctx, ids)
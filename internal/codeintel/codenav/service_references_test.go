@@ -1,7 +1,16 @@
 package codenav
 
 import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
 
 var (
@@ -15,3 +24,46 @@ var (
 	mockPath   = "s1/main.go"
 	mockCommit = "deadbeef"
 )
+
+func TestGetSymbolReferences(t *testing.T) {
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	mockUploadSvc.GetDumpsByIDsFunc.SetDefaultReturn([]uploadsshared.Dump{{ID: 42, Commit: mockCommit, Root: "sub1/"}}, nil)
+	mockLsifStore.GetBulkMonikerLocationsFunc.PushReturn([]shared.Location{
+		{DumpID: 42, Path: "a.go", Range: testRange1},
+		{DumpID: 42, Path: "b.go", Range: testRange2},
+	}, 3, nil)
+
+	symbolName := "scip-go gomod github.com/go-nacelle/config v1.2.5 `github.com/go-nacelle/config`/Load()."
+	locations, totalCount, err := svc.GetSymbolReferences(context.Background(), 42, []string{symbolName}, 2)
+	if err != nil {
+		t.Fatalf("unexpected error querying symbol references: %s", err)
+	}
+	if totalCount != 3 {
+		t.Errorf("unexpected total count. want=%d have=%d", 3, totalCount)
+	}
+
+	expectedLocations := []shared.Location{
+		{DumpID: 42, Path: "sub1/a.go", Range: testRange1},
+		{DumpID: 42, Path: "sub1/b.go", Range: testRange2},
+	}
+	if diff := cmp.Diff(expectedLocations, locations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	history := mockLsifStore.GetBulkMonikerLocationsFunc.History()
+	if len(history) != 1 {
+		t.Fatalf("unexpected number of calls. want=%d have=%d", 1, len(history))
+	}
+	if history[0].Arg1 != "references" {
+		t.Errorf("unexpected table name. want=%q have=%q", "references", history[0].Arg1)
+	}
+	expectedMonikers := []precise.MonikerData{{Kind: "import", Scheme: "scip", Identifier: symbolName}}
+	if diff := cmp.Diff(expectedMonikers, history[0].Arg3); diff != "" {
+		t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+	}
+}
@@ -32,6 +32,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       *string
 	Language       *string
 	RepositoryName *string
+	Reachability   *string
 }
 
 type VulnerabilityResolver interface {
@@ -51,6 +52,7 @@ type VulnerabilityResolver interface {
 	Published() gqlutil.DateTime
 	Modified() *gqlutil.DateTime
 	Withdrawn() *gqlutil.DateTime
+	Provenance() string
 	AffectedPackages() []VulnerabilityAffectedPackageResolver
 }
 
@@ -74,6 +76,14 @@ type VulnerabilityMatchResolver interface {
 	Vulnerability(ctx context.Context) (VulnerabilityResolver, error)
 	AffectedPackage(ctx context.Context) (VulnerabilityAffectedPackageResolver, error)
 	PreciseIndex(ctx context.Context) (PreciseIndexResolver, error)
+	Reachability() string
+	CallSites() []VulnerabilityCallSiteResolver
+}
+
+type VulnerabilityCallSiteResolver interface {
+	Symbol() string
+	Path() string
+	Range() RangeResolver
 }
 
 type VulnerabilityMatchesSummaryCountResolver interface {
@@ -0,0 +1,11 @@
+package sentinel
+
+import (
+	"context"
+
+	codenavshared "github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+)
+
+type CodeNavService interface {
+	GetSymbolReferences(ctx context.Context, uploadID int, symbolNames []string, limit int) (_ []codenavshared.Location, _ int, err error)
+}
@@ -0,0 +1,159 @@
+package downloader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/lsifuploadstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+)
+
+// bundle identifies an OSV bundle within a bundleSource. The version changes whenever
+// the contents of the bundle change, and is used to skip bundles that have already
+// been ingested.
+type bundle struct {
+	name    string
+	version string
+}
+
+// bundleSource lists and reads OSV bundles from a location reachable without access
+// to the internet.
+type bundleSource interface {
+	// Name identifies the source, and is recorded alongside ingested bundles.
+	Name() string
+	List(ctx context.Context) ([]bundle, error)
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// newBundleSource returns the bundle source described by the given config, or nil
+// if vulnerabilities should be read from the GitHub Advisory Database instead.
+func newBundleSource(ctx context.Context, observationCtx *observation.Context, config *Config) (bundleSource, error) {
+	switch config.Source {
+	case SourceLocal:
+		return newLocalBundleSource(config.BundlePath), nil
+
+	case SourceUploadStore:
+		store, err := lsifuploadstore.New(ctx, observationCtx, config.LSIFUploadStoreConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		return &uploadStoreBundleSource{store: store}, nil
+	}
+
+	return nil, nil
+}
+
+type localBundleSource struct {
+	path string
+}
+
+func newLocalBundleSource(path string) *localBundleSource {
+	return &localBundleSource{path: path}
+}
+
+func (s *localBundleSource) Name() string { return SourceLocal }
+
+// List returns the bundle at the configured path or, if the path is a directory, every
+// .zip and .json file beneath it. Bundle names are relative to the configured path.
+// Versions are derived from the modification time and size of each file.
+func (s *localBundleSource) List(ctx context.Context) ([]bundle, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []bundle{{name: filepath.Base(s.path), version: fileVersion(info)}}, nil
+	}
+
+	var bundles []bundle
+	if err := filepath.WalkDir(s.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !isBundleFile(path) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(s.path, path)
+		if err != nil {
+			return err
+		}
+
+		bundles = append(bundles, bundle{name: filepath.ToSlash(name), version: fileVersion(info)})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return bundles, nil
+}
+
+func (s *localBundleSource) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return os.Open(s.path)
+	}
+
+	return os.Open(filepath.Join(s.path, filepath.FromSlash(name)))
+}
+
+func isBundleFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".zip", ".json":
+		return true
+	}
+
+	return false
+}
+
+func fileVersion(info fs.FileInfo) string {
+	return fmt.Sprintf("%d-%d", info.ModTime().UnixNano(), info.Size())
+}
+
+type uploadStoreBundleSource struct {
+	store uploadstore.Store
+}
+
+func (s *uploadStoreBundleSource) Name() string { return SourceUploadStore }
+
+// List returns the bundles uploaded by site admins. Uploaded objects are never
+// overwritten (each upload is written to a new key), so the key doubles as the version.
+func (s *uploadStoreBundleSource) List(ctx context.Context) ([]bundle, error) {
+	it, err := s.store.List(ctx, shared.BundleUploadPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var bundles []bundle
+	for it.Next() {
+		key := it.Current()
+		bundles = append(bundles, bundle{name: strings.TrimPrefix(key, shared.BundleUploadPrefix), version: key})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	// Keys are prefixed by their upload time, so this ingests bundles in upload order
+	sort.Slice(bundles, func(i, j int) bool { return bundles[i].name < bundles[j].name })
+	return bundles, nil
+}
+
+func (s *uploadStoreBundleSource) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	return s.store.Get(ctx, shared.BundleUploadPrefix+name)
+}
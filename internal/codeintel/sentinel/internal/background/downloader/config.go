@@ -1,17 +1,56 @@
 package downloader
 
 import (
+	"strings"
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/lsifuploadstore"
 	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// SourceGitHub reads advisories from the public GitHub Advisory Database.
+	SourceGitHub = "github"
+
+	// SourceLocal reads OSV bundles from a path on disk.
+	SourceLocal = "local"
+
+	// SourceUploadStore reads OSV bundles from the precise code intel upload bucket,
+	// where they are stored by the site-admin bundle upload endpoint.
+	SourceUploadStore = "uploadstore"
 )
 
 type Config struct {
 	env.BaseConfig
 
-	DownloaderInterval time.Duration
+	DownloaderInterval    time.Duration
+	Source                string
+	BundlePath            string
+	LSIFUploadStoreConfig *lsifuploadstore.Config
 }
 
 func (c *Config) Load() {
 	c.DownloaderInterval = c.GetInterval("CODEINTEL_SENTINEL_DOWNLOADER_INTERVAL", "1h", "How frequently to sync the vulnerability database.")
+	c.Source = strings.ToLower(c.Get("CODEINTEL_SENTINEL_VULNERABILITY_SOURCE", SourceGitHub, "Where to read vulnerabilities from: github (the public GitHub Advisory Database), local (OSV bundles on disk), or uploadstore (OSV bundles uploaded by a site admin)."))
+
+	switch c.Source {
+	case SourceGitHub:
+	case SourceLocal:
+		c.BundlePath = c.Get("CODEINTEL_SENTINEL_VULNERABILITY_BUNDLE_PATH", "", "The path to an OSV bundle, or a directory of OSV bundles, to read vulnerabilities from.")
+	case SourceUploadStore:
+		c.LSIFUploadStoreConfig = &lsifuploadstore.Config{}
+		c.LSIFUploadStoreConfig.Load()
+	default:
+		c.AddError(errors.Errorf("invalid value %q for CODEINTEL_SENTINEL_VULNERABILITY_SOURCE: must be github, local, or uploadstore", c.Source))
+	}
+}
+
+func (c *Config) Validate() error {
+	var errs error
+	errs = errors.Append(errs, c.BaseConfig.Validate())
+	if c.LSIFUploadStoreConfig != nil {
+		errs = errors.Append(errs, c.LSIFUploadStoreConfig.Validate())
+	}
+	return errs
 }
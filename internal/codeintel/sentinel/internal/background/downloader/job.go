@@ -10,18 +10,37 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func NewCVEDownloader(store store.Store, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
+func NewCVEDownloader(store store.Store, observationCtx *observation.Context, config *Config) (goroutine.BackgroundRoutine, error) {
+	ctx := actor.WithInternalActor(context.Background())
+
+	bundles, err := newBundleSource(ctx, observationCtx, config)
+	if err != nil {
+		return nil, err
+	}
+
 	cveParser := &CVEParser{
 		store:  store,
 		logger: log.Scoped("sentinel.parser", ""),
 	}
 	metrics := newMetrics(observationCtx)
 
+	description := "Periodically syncs GitHub advisory records into Postgres."
+	if bundles != nil {
+		description = "Periodically syncs OSV bundles into Postgres."
+	}
+
 	return goroutine.NewPeriodicGoroutine(
-		actor.WithInternalActor(context.Background()),
+		ctx,
 		goroutine.HandlerFunc(func(ctx context.Context) error {
+			if bundles != nil {
+				numVulnerabilitiesInserted, err := cveParser.ingestBundles(ctx, bundles)
+				metrics.numVulnerabilitiesInserted.Add(float64(numVulnerabilitiesInserted))
+				return err
+			}
+
 			vulnerabilities, err := cveParser.handle(ctx)
 			if err != nil {
 				return err
@@ -36,9 +55,9 @@ func NewCVEDownloader(store store.Store, observationCtx *observation.Context, co
 			return nil
 		}),
 		goroutine.WithName("codeintel.sentinel-cve-downloader"),
-		goroutine.WithDescription("Periodically syncs GitHub advisory records into Postgres."),
+		goroutine.WithDescription(description),
 		goroutine.WithInterval(config.DownloaderInterval),
-	)
+	), nil
 }
 
 type CVEParser struct {
@@ -53,5 +72,78 @@ func NewCVEParser() *CVEParser {
 }
 
 func (parser *CVEParser) handle(ctx context.Context) ([]shared.Vulnerability, error) {
-	return parser.ReadGitHubAdvisoryDB(ctx, false)
+	vulnerabilities, err := parser.ReadGitHubAdvisoryDB(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range vulnerabilities {
+		vulnerabilities[i].Provenance = advisoryDatabaseURL
+	}
+
+	return vulnerabilities, nil
+}
+
+// ingestBundles inserts the vulnerabilities of every bundle in the given source that has
+// been added or changed since it was last ingested. A bundle that fails to ingest does not
+// prevent the remaining bundles from being ingested, and is retried on the next run.
+func (parser *CVEParser) ingestBundles(ctx context.Context, source bundleSource) (numVulnerabilitiesInserted int, err error) {
+	bundles, err := source.List(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list bundles")
+	}
+
+	ingestedVersions, err := parser.store.GetIngestedBundles(ctx, source.Name())
+	if err != nil {
+		return 0, err
+	}
+
+	var errs error
+	for _, b := range bundles {
+		if version, ok := ingestedVersions[b.name]; ok && version == b.version {
+			continue
+		}
+
+		n, err := parser.ingestBundle(ctx, source, b)
+		if err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "failed to ingest bundle %q", b.name))
+			continue
+		}
+
+		numVulnerabilitiesInserted += n
+	}
+
+	return numVulnerabilitiesInserted, errs
+}
+
+func (parser *CVEParser) ingestBundle(ctx context.Context, source bundleSource, b bundle) (int, error) {
+	rc, err := source.Open(ctx, b.name)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	vulnerabilities, err := parser.ParseOSVBundle(rc, source.Name()+":"+b.name)
+	if err != nil {
+		return 0, err
+	}
+
+	numVulnerabilitiesInserted, err := parser.store.InsertVulnerabilities(ctx, vulnerabilities)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := parser.store.MarkBundleIngested(ctx, source.Name(), b.name, b.version, len(vulnerabilities)); err != nil {
+		return 0, err
+	}
+
+	parser.logger.Info(
+		"ingested vulnerability bundle",
+		log.String("source", source.Name()),
+		log.String("bundle", b.name),
+		log.Int("numVulnerabilities", len(vulnerabilities)),
+		log.Int("numVulnerabilitiesInserted", numVulnerabilitiesInserted),
+	)
+
+	return numVulnerabilitiesInserted, nil
 }
@@ -0,0 +1,98 @@
+package downloader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+)
+
+func TestIngestBundles(t *testing.T) {
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	writeFile := func(name, contents string) {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatalf("unexpected error creating directory: %s", err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatalf("unexpected error writing file: %s", err)
+		}
+	}
+	writeFile("go/GO-2023-0001.json", testGoRecord)
+	writeFile("pypi.json", "["+testPyPIRecord+"]")
+	writeFile("README.md", "not a bundle")
+
+	fakeStore := &fakeBundleStore{ingested: map[string]string{}}
+	parser := &CVEParser{store: fakeStore, logger: logtest.Scoped(t)}
+	source := newLocalBundleSource(dir)
+
+	if _, err := parser.ingestBundles(ctx, source); err != nil {
+		t.Fatalf("unexpected error ingesting bundles: %s", err)
+	}
+
+	expectedProvenances := []string{"local:go/GO-2023-0001.json", "local:pypi.json"}
+	if diff := cmp.Diff(expectedProvenances, fakeStore.provenances()); diff != "" {
+		t.Errorf("unexpected provenances (-want +got):\n%s", diff)
+	}
+	if len(fakeStore.ingested) != 2 {
+		t.Fatalf("unexpected number of ingested bundles. want=%d have=%d", 2, len(fakeStore.ingested))
+	}
+
+	// Unchanged bundles are skipped
+	fakeStore.inserted = nil
+	if _, err := parser.ingestBundles(ctx, source); err != nil {
+		t.Fatalf("unexpected error ingesting bundles: %s", err)
+	}
+	if len(fakeStore.inserted) != 0 {
+		t.Errorf("expected unchanged bundles to be skipped, inserted %d vulnerabilities", len(fakeStore.inserted))
+	}
+
+	// Changed bundles are ingested again
+	writeFile("pypi.json", "["+testPyPIRecord+", "+testGHSARecord+"]")
+	if _, err := parser.ingestBundles(ctx, source); err != nil {
+		t.Fatalf("unexpected error ingesting bundles: %s", err)
+	}
+	expectedProvenances = []string{"local:pypi.json", "local:pypi.json"}
+	if diff := cmp.Diff(expectedProvenances, fakeStore.provenances()); diff != "" {
+		t.Errorf("unexpected provenances (-want +got):\n%s", diff)
+	}
+}
+
+type fakeBundleStore struct {
+	store.Store
+	inserted []shared.Vulnerability
+	ingested map[string]string
+}
+
+func (s *fakeBundleStore) InsertVulnerabilities(ctx context.Context, vulnerabilities []shared.Vulnerability) (int, error) {
+	s.inserted = append(s.inserted, vulnerabilities...)
+	return len(vulnerabilities), nil
+}
+
+func (s *fakeBundleStore) GetIngestedBundles(ctx context.Context, source string) (map[string]string, error) {
+	versions := map[string]string{}
+	for name, version := range s.ingested {
+		versions[name] = version
+	}
+	return versions, nil
+}
+
+func (s *fakeBundleStore) MarkBundleIngested(ctx context.Context, source, name, version string, numVulnerabilities int) error {
+	s.ingested[name] = version
+	return nil
+}
+
+func (s *fakeBundleStore) provenances() (provenances []string) {
+	for _, v := range s.inserted {
+		provenances = append(provenances, v.Provenance)
+	}
+	return provenances
+}
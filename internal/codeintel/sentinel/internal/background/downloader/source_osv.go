@@ -178,8 +178,7 @@ func (parser *CVEParser) osvToVuln(o OSV, dataSourceHandler DataSourceHandler) (
 					"unexpected number of affected versions (>1)",
 					log.String("type", "dataWarning"),
 					log.String("sourceID", v.SourceID),
-					log.String("actual", v.CVSSVector),
-					log.String("err", err.Error()),
+					log.String("actualCount", fmt.Sprint(len(affected.Versions))),
 				)
 			}
 			ap.VersionConstraint = append(ap.VersionConstraint, "="+affected.Versions[0])
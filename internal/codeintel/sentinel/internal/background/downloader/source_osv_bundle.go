@@ -0,0 +1,150 @@
+package downloader
+
+// Parse vulnerabilities from OSV bundles exported for use without internet access, such
+// as the per-ecosystem archives published at https://osv-vulnerabilities.storage.googleapis.com.
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+)
+
+// ParseOSVBundle converts an OSV bundle to the internal Vulnerability format. A bundle
+// is either a zip archive of OSV JSON files, or a JSON file containing a single OSV
+// record or an array of OSV records. Every vulnerability is marked with the given
+// provenance.
+func (parser *CVEParser) ParseOSVBundle(r io.Reader, provenance string) (vulns []shared.Vulnerability, err error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []OSV
+	if bytes.HasPrefix(content, []byte("PK\x03\x04")) {
+		zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range zr.File {
+			if filepath.Ext(f.Name) != ".json" {
+				continue
+			}
+
+			fileRecords, err := readOSVFile(f)
+			if err != nil {
+				parser.logger.Warn(
+					"skipping unreadable OSV file",
+					log.String("type", "dataWarning"),
+					log.String("provenance", provenance),
+					log.String("file", f.Name),
+					log.Error(err),
+				)
+				continue
+			}
+
+			records = append(records, fileRecords...)
+		}
+	} else {
+		records, err = decodeOSVRecords(content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, o := range records {
+		if o.ID == "" {
+			// Not an OSV record (e.g., an index or schema file shipped alongside the records)
+			continue
+		}
+
+		convertedVuln, err := parser.osvToVuln(o, osvHandlerForID(o.ID))
+		if err != nil {
+			if _, ok := err.(GHSAUnreviewedError); ok {
+				continue
+			}
+			return nil, err
+		}
+
+		convertedVuln.Provenance = provenance
+		vulns = append(vulns, convertedVuln)
+	}
+
+	return vulns, nil
+}
+
+func readOSVFile(f *zip.File) ([]OSV, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeOSVRecords(content)
+}
+
+func decodeOSVRecords(content []byte) ([]OSV, error) {
+	content = bytes.TrimSpace(content)
+
+	if bytes.HasPrefix(content, []byte("[")) {
+		var records []OSV
+		if err := json.Unmarshal(content, &records); err != nil {
+			return nil, err
+		}
+		return records, nil
+	}
+
+	var record OSV
+	if err := json.Unmarshal(content, &record); err != nil {
+		return nil, err
+	}
+	return []OSV{record}, nil
+}
+
+// osvHandlerForID returns the handler for the database that published the OSV record
+// with the given ID. The GHSA and Govulndb handlers understand their own extensions;
+// records from any other database are parsed with the generic OSV handler.
+func osvHandlerForID(id string) DataSourceHandler {
+	switch {
+	case strings.HasPrefix(id, "GHSA-"):
+		return GHSA(0)
+	case strings.HasPrefix(id, "GO-"):
+		return Govulndb(0)
+	default:
+		return OSVDatabase(0)
+	}
+}
+
+//
+// Generic OSV handlers
+//
+
+type OSVDatabase int64
+
+func (o OSVDatabase) topLevelHandler(osv OSV, v *shared.Vulnerability) error {
+	v.DataSource = "https://osv.dev/vulnerability/" + osv.ID
+
+	// No database_specific data is shared across databases
+	return nil
+}
+
+func (o OSVDatabase) affectedHandler(a OSVAffected, affectedPackage *shared.AffectedPackage) error {
+	if language := githubEcosystemToLanguage(a.Package.Ecosystem); language != "" {
+		affectedPackage.Language = language
+	}
+	affectedPackage.Namespace = "osv:" + a.Package.Ecosystem
+
+	return nil
+}
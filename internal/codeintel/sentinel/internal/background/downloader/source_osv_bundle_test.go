@@ -0,0 +1,139 @@
+package downloader
+
+import (
+	"archive/zip"
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+)
+
+const testGHSARecord = `{
+	"id": "GHSA-xxxx-yyyy-zzzz",
+	"modified": "2023-05-01T00:00:00Z",
+	"published": "2023-04-01T00:00:00Z",
+	"summary": "reviewed advisory",
+	"affected": [{"package": {"ecosystem": "npm", "name": "left-pad"}, "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.3.0"}]}]}],
+	"database_specific": {"severity": "HIGH", "github_reviewed": true, "github_reviewed_at": "2023-04-02T00:00:00Z"}
+}`
+
+const testUnreviewedGHSARecord = `{
+	"id": "GHSA-unre-view-edxx",
+	"modified": "2023-05-01T00:00:00Z",
+	"published": "2023-04-01T00:00:00Z",
+	"database_specific": {"github_reviewed": false}
+}`
+
+const testGoRecord = `{
+	"id": "GO-2023-0001",
+	"modified": "2023-05-01T00:00:00Z",
+	"published": "2023-04-01T00:00:00Z",
+	"affected": [{"package": {"ecosystem": "Go", "name": "github.com/go-nacelle/config"}, "ecosystem_specific": {"imports": [{"path": "github.com/go-nacelle/config", "symbols": ["Load"]}]}}]
+}`
+
+const testPyPIRecord = `{
+	"id": "PYSEC-2023-1",
+	"modified": "2023-05-01T00:00:00Z",
+	"published": "2023-04-01T00:00:00Z",
+	"affected": [{"package": {"ecosystem": "PyPI", "name": "requests"}, "versions": ["2.0.0", "2.0.1"]}]
+}`
+
+func TestParseOSVBundle(t *testing.T) {
+	parser := &CVEParser{logger: logtest.Scoped(t)}
+
+	zipBundle := func(files map[string]string) []byte {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for name, contents := range files {
+			w, err := zw.Create(name)
+			if err != nil {
+				t.Fatalf("unexpected error creating zip entry: %s", err)
+			}
+			if _, err := w.Write([]byte(contents)); err != nil {
+				t.Fatalf("unexpected error writing zip entry: %s", err)
+			}
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatalf("unexpected error closing zip: %s", err)
+		}
+		return buf.Bytes()
+	}
+
+	type result struct {
+		SourceID   string
+		DataSource string
+		Provenance string
+		Language   string
+		Namespace  string
+	}
+
+	testCases := []struct {
+		name     string
+		bundle   []byte
+		expected []result
+	}{
+		{
+			name: "zip",
+			bundle: zipBundle(map[string]string{
+				"GHSA-xxxx-yyyy-zzzz.json": testGHSARecord,
+				"GHSA-unre-view-edxx.json": testUnreviewedGHSARecord,
+				"GO-2023-0001.json":        testGoRecord,
+				"README.md":                "not a record",
+				"broken.json":              "{",
+			}),
+			expected: []result{
+				{SourceID: "GHSA-xxxx-yyyy-zzzz", DataSource: "https://github.com/advisories/GHSA-xxxx-yyyy-zzzz", Provenance: "test", Language: "Javascript", Namespace: "github:npm"},
+				{SourceID: "GO-2023-0001", DataSource: "https://pkg.go.dev/vuln/GO-2023-0001", Provenance: "test", Language: "Go", Namespace: "govulndb"},
+			},
+		},
+		{
+			name:   "array",
+			bundle: []byte("[" + testPyPIRecord + "," + testGoRecord + "]"),
+			expected: []result{
+				{SourceID: "GO-2023-0001", DataSource: "https://pkg.go.dev/vuln/GO-2023-0001", Provenance: "test", Language: "Go", Namespace: "govulndb"},
+				{SourceID: "PYSEC-2023-1", DataSource: "https://osv.dev/vulnerability/PYSEC-2023-1", Provenance: "test", Language: "python", Namespace: "osv:PyPI"},
+			},
+		},
+		{
+			name:   "single record",
+			bundle: []byte(testPyPIRecord),
+			expected: []result{
+				{SourceID: "PYSEC-2023-1", DataSource: "https://osv.dev/vulnerability/PYSEC-2023-1", Provenance: "test", Language: "python", Namespace: "osv:PyPI"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			vulns, err := parser.ParseOSVBundle(bytes.NewReader(testCase.bundle), "test")
+			if err != nil {
+				t.Fatalf("unexpected error parsing bundle: %s", err)
+			}
+
+			var results []result
+			for _, v := range vulns {
+				r := result{SourceID: v.SourceID, DataSource: v.DataSource, Provenance: v.Provenance}
+				if len(v.AffectedPackages) > 0 {
+					r.Language = v.AffectedPackages[0].Language
+					r.Namespace = v.AffectedPackages[0].Namespace
+				}
+				results = append(results, r)
+			}
+			sort.Slice(results, func(i, j int) bool { return results[i].SourceID < results[j].SourceID })
+
+			if diff := cmp.Diff(testCase.expected, results); diff != "" {
+				t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseOSVBundleInvalid(t *testing.T) {
+	parser := &CVEParser{logger: logtest.Scoped(t)}
+
+	if _, err := parser.ParseOSVBundle(bytes.NewReader([]byte("not json")), "test"); err == nil {
+		t.Fatalf("expected error parsing invalid bundle")
+	}
+}
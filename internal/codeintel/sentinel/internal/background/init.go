@@ -5,6 +5,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/downloader"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/matcher"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/reachability"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -13,15 +14,23 @@ import (
 func CVEScannerJob(
 	observationCtx *observation.Context,
 	store store.Store,
+	codenavSvc reachability.CodeNavService,
 	downloaderConfig *downloader.Config,
 	matcherConfig *matcher.Config,
-) []goroutine.BackgroundRoutine {
+	reachabilityConfig *reachability.Config,
+) ([]goroutine.BackgroundRoutine, error) {
 	if os.Getenv("RUN_EXPERIMENTAL_SENTINEL_JOBS") != "true" {
-		return nil
+		return nil, nil
+	}
+
+	cveDownloader, err := downloader.NewCVEDownloader(store, observationCtx, downloaderConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	return []goroutine.BackgroundRoutine{
-		downloader.NewCVEDownloader(store, observationCtx, downloaderConfig),
+		cveDownloader,
 		matcher.NewCVEMatcher(store, observationCtx, matcherConfig),
-	}
+		reachability.NewReachabilityAnalyzer(store, codenavSvc, observationCtx, reachabilityConfig),
+	}, nil
 }
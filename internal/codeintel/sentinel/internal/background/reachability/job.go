@@ -0,0 +1,167 @@
+package reachability
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func NewReachabilityAnalyzer(store store.Store, codenavSvc CodeNavService, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
+	metrics := newMetrics(observationCtx)
+	logger := observationCtx.Logger.Scoped("reachability", "sentinel reachability analyzer")
+
+	return goroutine.NewPeriodicGoroutine(
+		actor.WithInternalActor(context.Background()),
+		goroutine.HandlerFunc(func(ctx context.Context) error {
+			return checkReachability(ctx, store, codenavSvc, logger, metrics, config)
+		}),
+		goroutine.WithName("codeintel.sentinel-reachability-analyzer"),
+		goroutine.WithDescription("Checks whether symbols affected by matched vulnerabilities are referenced from indexed code."),
+		goroutine.WithInterval(config.Interval),
+	)
+}
+
+// checkReachability determines the reachability of a batch of unchecked vulnerability matches.
+func checkReachability(ctx context.Context, store store.Store, codenavSvc CodeNavService, logger log.Logger, metrics *metrics, config *Config) error {
+	candidates, err := store.GetReachabilityCandidates(ctx, config.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		reachability, callSites, err := analyze(ctx, codenavSvc, candidate, config.MaxCallSites)
+		if err != nil {
+			// Record the match as checked so that it doesn't hold up the
+			// candidates after it on every run.
+			logger.Warn("failed to check reachability of vulnerability match",
+				log.Int("matchID", candidate.MatchID),
+				log.Int("uploadID", candidate.UploadID),
+				log.Error(err),
+			)
+			metrics.numMatchesErrored.Inc()
+			reachability, callSites = shared.ReachabilityUnknown, nil
+		}
+
+		if err := store.UpdateVulnerabilityMatchReachability(ctx, candidate.MatchID, reachability, callSites); err != nil {
+			return err
+		}
+
+		metrics.numMatchesChecked.Inc()
+		if reachability == shared.ReachabilityReachable {
+			metrics.numMatchesReachable.Inc()
+		}
+	}
+
+	return nil
+}
+
+// analyze determines whether any of the symbols affected by the vulnerability of the given
+// candidate is referenced from the candidate's upload. A match is reported as unreachable only
+// when every affected symbol could be checked; vulnerabilities that do not list the symbols they
+// affect (or whose package reference cannot be resolved) are reported as unknown.
+func analyze(ctx context.Context, codenavSvc CodeNavService, candidate shared.ReachabilityCandidate, maxCallSites int) (shared.Reachability, []shared.CallSite, error) {
+	if len(candidate.AffectedSymbols) == 0 || len(candidate.References) == 0 {
+		return shared.ReachabilityUnknown, nil, nil
+	}
+
+	complete := true
+	var callSites []shared.CallSite
+
+outer:
+	for _, affectedSymbol := range candidate.AffectedSymbols {
+		if len(affectedSymbol.Symbols) == 0 {
+			// The entire path is affected; we can't tell which symbols to look for
+			complete = false
+			continue
+		}
+
+		for _, symbol := range affectedSymbol.Symbols {
+			var symbolNames []string
+			for _, reference := range candidate.References {
+				symbolNames = append(symbolNames, scipSymbolNames(reference, affectedSymbol.Path, symbol)...)
+			}
+
+			locations, _, err := codenavSvc.GetSymbolReferences(ctx, candidate.UploadID, symbolNames, maxCallSites-len(callSites))
+			if err != nil {
+				return "", nil, err
+			}
+
+			for _, location := range locations {
+				callSites = append(callSites, shared.CallSite{
+					Symbol:         affectedSymbol.Path + "." + symbol,
+					Path:           location.Path,
+					StartLine:      location.Range.Start.Line,
+					StartCharacter: location.Range.Start.Character,
+					EndLine:        location.Range.End.Line,
+					EndCharacter:   location.Range.End.Character,
+				})
+			}
+
+			if len(callSites) >= maxCallSites {
+				break outer
+			}
+		}
+	}
+
+	if len(callSites) > 0 {
+		return shared.ReachabilityReachable, callSites, nil
+	}
+	if !complete {
+		return shared.ReachabilityUnknown, nil, nil
+	}
+
+	return shared.ReachabilityUnreachable, nil, nil
+}
+
+// scipSymbolNames returns the SCIP symbol names that may identify the given symbol defined
+// within the given path of the referenced package. Symbols of the form `T.M` are treated as
+// members of the type `T`. Both a method and a term descriptor are returned for the final
+// component as advisories do not distinguish between functions and variables.
+func scipSymbolNames(reference shared.PackageReference, path, symbol string) []string {
+	prefix := strings.Join([]string{
+		escapePackageField(reference.Scheme),
+		escapePackageField(reference.Manager),
+		escapePackageField(reference.Name),
+		escapePackageField(reference.Version),
+	}, " ") + " " + escapeDescriptorName(path) + "/"
+
+	parts := strings.Split(symbol, ".")
+	for _, part := range parts[:len(parts)-1] {
+		prefix += escapeDescriptorName(part) + "#"
+	}
+	name := escapeDescriptorName(parts[len(parts)-1])
+
+	return []string{
+		prefix + name + "().",
+		prefix + name + ".",
+	}
+}
+
+func escapePackageField(field string) string {
+	if field == "" {
+		return "."
+	}
+
+	return strings.ReplaceAll(field, " ", "  ")
+}
+
+func escapeDescriptorName(name string) string {
+	for _, r := range name {
+		if !isSimpleIdentifierCharacter(r) {
+			return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+		}
+	}
+
+	return name
+}
+
+func isSimpleIdentifierCharacter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '+' || r == '-' || r == '$'
+}
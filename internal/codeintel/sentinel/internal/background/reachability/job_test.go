@@ -0,0 +1,235 @@
+package reachability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	codenavshared "github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestAnalyze(t *testing.T) {
+	reference := shared.PackageReference{Scheme: "scip-go", Manager: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"}
+	codenavSvc := &fakeCodeNavService{
+		locations: map[string][]codenavshared.Location{
+			"scip-go gomod github.com/go-nacelle/config v1.2.3 `github.com/go-nacelle/config`/Config#Load().": {
+				{DumpID: 42, Path: "cmd/main.go", Range: newRange(10, 4, 10, 8)},
+				{DumpID: 42, Path: "cmd/init.go", Range: newRange(20, 2, 20, 6)},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		affectedSymbols      []shared.AffectedSymbol
+		references           []shared.PackageReference
+		expectedReachability shared.Reachability
+		expectedCallSites    []shared.CallSite
+	}{
+		{
+			name:                 "reachable",
+			affectedSymbols:      []shared.AffectedSymbol{{Path: "github.com/go-nacelle/config", Symbols: []string{"Parse", "Config.Load"}}},
+			references:           []shared.PackageReference{reference},
+			expectedReachability: shared.ReachabilityReachable,
+			expectedCallSites: []shared.CallSite{
+				{Symbol: "github.com/go-nacelle/config.Config.Load", Path: "cmd/main.go", StartLine: 10, StartCharacter: 4, EndLine: 10, EndCharacter: 8},
+				{Symbol: "github.com/go-nacelle/config.Config.Load", Path: "cmd/init.go", StartLine: 20, StartCharacter: 2, EndLine: 20, EndCharacter: 6},
+			},
+		},
+		{
+			name:                 "unreachable",
+			affectedSymbols:      []shared.AffectedSymbol{{Path: "github.com/go-nacelle/config", Symbols: []string{"Parse"}}},
+			references:           []shared.PackageReference{reference},
+			expectedReachability: shared.ReachabilityUnreachable,
+		},
+		{
+			name:                 "no affected symbols",
+			references:           []shared.PackageReference{reference},
+			expectedReachability: shared.ReachabilityUnknown,
+		},
+		{
+			name:                 "whole path affected",
+			affectedSymbols:      []shared.AffectedSymbol{{Path: "github.com/go-nacelle/config"}},
+			references:           []shared.PackageReference{reference},
+			expectedReachability: shared.ReachabilityUnknown,
+		},
+		{
+			name:                 "no references",
+			affectedSymbols:      []shared.AffectedSymbol{{Path: "github.com/go-nacelle/config", Symbols: []string{"Config.Load"}}},
+			expectedReachability: shared.ReachabilityUnknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			candidate := shared.ReachabilityCandidate{
+				MatchID:         1,
+				UploadID:        42,
+				AffectedSymbols: testCase.affectedSymbols,
+				References:      testCase.references,
+			}
+
+			reachability, callSites, err := analyze(context.Background(), codenavSvc, candidate, 5)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if reachability != testCase.expectedReachability {
+				t.Errorf("unexpected reachability. want=%q have=%q", testCase.expectedReachability, reachability)
+			}
+			if diff := cmp.Diff(testCase.expectedCallSites, callSites); diff != "" {
+				t.Errorf("unexpected call sites (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyzeMaxCallSites(t *testing.T) {
+	codenavSvc := &fakeCodeNavService{
+		locations: map[string][]codenavshared.Location{
+			"scip-go gomod github.com/go-nacelle/config v1.2.3 `github.com/go-nacelle/config`/Load().": {
+				{DumpID: 42, Path: "a.go", Range: newRange(1, 0, 1, 4)},
+				{DumpID: 42, Path: "b.go", Range: newRange(2, 0, 2, 4)},
+				{DumpID: 42, Path: "c.go", Range: newRange(3, 0, 3, 4)},
+			},
+		},
+	}
+	candidate := shared.ReachabilityCandidate{
+		MatchID:         1,
+		UploadID:        42,
+		AffectedSymbols: []shared.AffectedSymbol{{Path: "github.com/go-nacelle/config", Symbols: []string{"Load", "Parse"}}},
+		References:      []shared.PackageReference{{Scheme: "scip-go", Manager: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"}},
+	}
+
+	reachability, callSites, err := analyze(context.Background(), codenavSvc, candidate, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if reachability != shared.ReachabilityReachable {
+		t.Errorf("unexpected reachability. want=%q have=%q", shared.ReachabilityReachable, reachability)
+	}
+	if len(callSites) != 2 {
+		t.Errorf("unexpected number of call sites. want=%d have=%d", 2, len(callSites))
+	}
+	if len(codenavSvc.calls) != 1 {
+		t.Errorf("expected remaining symbols to be skipped once the call site limit is reached. have=%d calls", len(codenavSvc.calls))
+	}
+}
+
+func TestCheckReachabilityError(t *testing.T) {
+	reference := shared.PackageReference{Scheme: "scip-go", Manager: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"}
+	affectedSymbols := []shared.AffectedSymbol{{Path: "github.com/go-nacelle/config", Symbols: []string{"Load"}}}
+	codenavSvc := &fakeCodeNavService{
+		locations: map[string][]codenavshared.Location{
+			"scip-go gomod github.com/go-nacelle/config v1.2.3 `github.com/go-nacelle/config`/Load().": {
+				{DumpID: 43, Path: "main.go", Range: newRange(1, 0, 1, 4)},
+			},
+		},
+		errs: map[int]error{42: errors.New("upload is gone")},
+	}
+	store := &fakeStore{
+		candidates: []shared.ReachabilityCandidate{
+			{MatchID: 1, UploadID: 42, AffectedSymbols: affectedSymbols, References: []shared.PackageReference{reference}},
+			{MatchID: 2, UploadID: 43, AffectedSymbols: affectedSymbols, References: []shared.PackageReference{reference}},
+		},
+		updates: map[int]shared.Reachability{},
+	}
+
+	if err := checkReachability(context.Background(), store, codenavSvc, logtest.Scoped(t), newMetrics(&observation.TestContext), &Config{BatchSize: 10, MaxCallSites: 10}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The failing match is recorded as unknown, and doesn't prevent the next one from being checked.
+	expectedUpdates := map[int]shared.Reachability{
+		1: shared.ReachabilityUnknown,
+		2: shared.ReachabilityReachable,
+	}
+	if diff := cmp.Diff(expectedUpdates, store.updates); diff != "" {
+		t.Errorf("unexpected updates (-want +got):\n%s", diff)
+	}
+}
+
+type fakeStore struct {
+	store.Store
+	candidates []shared.ReachabilityCandidate
+	updates    map[int]shared.Reachability
+}
+
+func (s *fakeStore) GetReachabilityCandidates(ctx context.Context, batchSize int) ([]shared.ReachabilityCandidate, error) {
+	return s.candidates, nil
+}
+
+func (s *fakeStore) UpdateVulnerabilityMatchReachability(ctx context.Context, matchID int, reachability shared.Reachability, callSites []shared.CallSite) error {
+	s.updates[matchID] = reachability
+	return nil
+}
+
+func TestSCIPSymbolNames(t *testing.T) {
+	testCases := []struct {
+		reference shared.PackageReference
+		path      string
+		symbol    string
+		expected  []string
+	}{
+		{
+			reference: shared.PackageReference{Scheme: "scip-go", Manager: "gomod", Name: "golang.org/x/net", Version: "v0.7.0"},
+			path:      "golang.org/x/net/html",
+			symbol:    "Parse",
+			expected: []string{
+				"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Parse().",
+				"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Parse.",
+			},
+		},
+		{
+			reference: shared.PackageReference{Scheme: "scip-go", Name: "golang.org/x/net", Version: "v0.7.0"},
+			path:      "golang.org/x/net/html",
+			symbol:    "Tokenizer.Next",
+			expected: []string{
+				"scip-go . golang.org/x/net v0.7.0 `golang.org/x/net/html`/Tokenizer#Next().",
+				"scip-go . golang.org/x/net v0.7.0 `golang.org/x/net/html`/Tokenizer#Next.",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		if diff := cmp.Diff(testCase.expected, scipSymbolNames(testCase.reference, testCase.path, testCase.symbol)); diff != "" {
+			t.Errorf("unexpected symbol names for %s (-want +got):\n%s", testCase.symbol, diff)
+		}
+	}
+}
+
+type fakeCodeNavService struct {
+	locations map[string][]codenavshared.Location
+	errs      map[int]error
+	calls     [][]string
+}
+
+func (s *fakeCodeNavService) GetSymbolReferences(ctx context.Context, uploadID int, symbolNames []string, limit int) ([]codenavshared.Location, int, error) {
+	s.calls = append(s.calls, symbolNames)
+	if err, ok := s.errs[uploadID]; ok {
+		return nil, 0, err
+	}
+
+	var locations []codenavshared.Location
+	for _, name := range symbolNames {
+		locations = append(locations, s.locations[name]...)
+	}
+	totalCount := len(locations)
+	if len(locations) > limit {
+		locations = locations[:limit]
+	}
+
+	return locations, totalCount, nil
+}
+
+func newRange(startLine, startCharacter, endLine, endCharacter int) codenavshared.Range {
+	return codenavshared.Range{
+		Start: codenavshared.Position{Line: startLine, Character: startCharacter},
+		End:   codenavshared.Position{Line: endLine, Character: endCharacter},
+	}
+}
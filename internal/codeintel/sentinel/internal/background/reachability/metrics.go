@@ -0,0 +1,44 @@
+package reachability
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type metrics struct {
+	numMatchesChecked   prometheus.Counter
+	numMatchesReachable prometheus.Counter
+	numMatchesErrored   prometheus.Counter
+}
+
+func newMetrics(observationCtx *observation.Context) *metrics {
+	counter := func(name, help string) prometheus.Counter {
+		counter := prometheus.NewCounter(prometheus.CounterOpts{
+			Name: name,
+			Help: help,
+		})
+
+		observationCtx.Registerer.MustRegister(counter)
+		return counter
+	}
+
+	numMatchesChecked := counter(
+		"src_codeintel_sentinel_num_matches_checked_for_reachability_total",
+		"The total number of vulnerability matches checked for reachability.",
+	)
+	numMatchesReachable := counter(
+		"src_codeintel_sentinel_num_reachable_matches_total",
+		"The total number of vulnerability matches found to be reachable.",
+	)
+	numMatchesErrored := counter(
+		"src_codeintel_sentinel_num_matches_reachability_errors_total",
+		"The total number of vulnerability matches whose reachability could not be checked due to an error.",
+	)
+
+	return &metrics{
+		numMatchesChecked:   numMatchesChecked,
+		numMatchesReachable: numMatchesReachable,
+		numMatchesErrored:   numMatchesErrored,
+	}
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// GetIngestedBundles returns a map from bundle name to the version of that bundle that
+// was last ingested from the given source.
+func (s *store) GetIngestedBundles(ctx context.Context, source string) (_ map[string]string, err error) {
+	ctx, _, endObservation := s.operations.getIngestedBundles.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("source", source),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanBundleVersions(s.db.Query(ctx, sqlf.Sprintf(getIngestedBundlesQuery, source)))
+}
+
+const getIngestedBundlesQuery = `
+SELECT name, version
+FROM vulnerability_bundles
+WHERE source = %s
+`
+
+var scanBundleVersions = basestore.NewMapScanner(func(s dbutil.Scanner) (name, version string, _ error) {
+	err := s.Scan(&name, &version)
+	return name, version, err
+})
+
+// MarkBundleIngested records that the given version of a bundle has been ingested from
+// the given source.
+func (s *store) MarkBundleIngested(ctx context.Context, source, name, version string, numVulnerabilities int) (err error) {
+	ctx, _, endObservation := s.operations.markBundleIngested.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("source", source),
+		attribute.String("name", name),
+		attribute.String("version", version),
+		attribute.Int("numVulnerabilities", numVulnerabilities),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.db.Exec(ctx, sqlf.Sprintf(markBundleIngestedQuery, source, name, version, numVulnerabilities))
+}
+
+const markBundleIngestedQuery = `
+INSERT INTO vulnerability_bundles (source, name, version, num_vulnerabilities)
+VALUES (%s, %s, %s, %s)
+ON CONFLICT (source, name) DO UPDATE SET
+	version = EXCLUDED.version,
+	num_vulnerabilities = EXCLUDED.num_vulnerabilities,
+	ingested_at = NOW()
+`
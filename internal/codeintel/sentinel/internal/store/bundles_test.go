@@ -0,0 +1,45 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestIngestedBundles(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	if err := store.MarkBundleIngested(ctx, "local", "osv-go.zip", "v1", 10); err != nil {
+		t.Fatalf("unexpected error marking bundle ingested: %s", err)
+	}
+	if err := store.MarkBundleIngested(ctx, "local", "osv-npm.zip", "v1", 20); err != nil {
+		t.Fatalf("unexpected error marking bundle ingested: %s", err)
+	}
+	if err := store.MarkBundleIngested(ctx, "local", "osv-go.zip", "v2", 15); err != nil {
+		t.Fatalf("unexpected error marking bundle ingested: %s", err)
+	}
+	if err := store.MarkBundleIngested(ctx, "uploadstore", "osv-go.zip", "v3", 15); err != nil {
+		t.Fatalf("unexpected error marking bundle ingested: %s", err)
+	}
+
+	versions, err := store.GetIngestedBundles(ctx, "local")
+	if err != nil {
+		t.Fatalf("unexpected error getting ingested bundles: %s", err)
+	}
+	expected := map[string]string{
+		"osv-go.zip":  "v2",
+		"osv-npm.zip": "v1",
+	}
+	if diff := cmp.Diff(expected, versions); diff != "" {
+		t.Errorf("unexpected versions (-want +got):\n%s", diff)
+	}
+}
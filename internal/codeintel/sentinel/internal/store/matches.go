@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"sort"
 	"strings"
 
@@ -45,6 +46,8 @@ SELECT
 	vas.path,
 	vas.symbols,
 	vul.severity,
+	m.reachability,
+	m.reachability_call_sites,
 	0 AS count
 FROM vulnerability_matches m
 LEFT JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
@@ -60,6 +63,7 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 		attribute.String("severity", args.Severity),
 		attribute.String("language", args.Language),
 		attribute.String("repositoryName", args.RepositoryName),
+		attribute.String("reachability", string(args.Reachability)),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -73,6 +77,9 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 	if args.RepositoryName != "" {
 		conds = append(conds, sqlf.Sprintf("r.name = %s", args.RepositoryName))
 	}
+	if args.Reachability != "" {
+		conds = append(conds, sqlf.Sprintf("m.reachability = %s", string(args.Reachability)))
+	}
 	if len(conds) == 0 {
 		conds = append(conds, sqlf.Sprintf("TRUE"))
 	}
@@ -85,7 +92,9 @@ WITH limited_matches AS (
 	SELECT
 		m.id,
 		m.upload_id,
-		m.vulnerability_affected_package_id
+		m.vulnerability_affected_package_id,
+		m.reachability,
+		m.reachability_call_sites
 	FROM vulnerability_matches m
 	ORDER BY id
 )
@@ -102,6 +111,8 @@ SELECT
 	vas.path,
 	vas.symbols,
 	vul.severity,
+	m.reachability,
+	m.reachability_call_sites,
 	COUNT(*) OVER() AS count
 FROM limited_matches m
 LEFT JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
@@ -358,10 +369,11 @@ SELECT COUNT(*) FROM ins
 var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error) ([]shared.VulnerabilityMatch, int, error) {
 	matches, totalCount, err := basestore.NewSliceWithCountScanner(func(s dbutil.Scanner) (match shared.VulnerabilityMatch, count int, _ error) {
 		var (
-			vap     shared.AffectedPackage
-			vas     shared.AffectedSymbol
-			vul     shared.Vulnerability
-			fixedIn string
+			vap       shared.AffectedPackage
+			vas       shared.AffectedSymbol
+			vul       shared.Vulnerability
+			fixedIn   string
+			callSites []byte
 		)
 
 		if err := s.Scan(
@@ -376,13 +388,19 @@ var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error)
 			&dbutil.NullBool{B: &vap.Fixed},
 			&dbutil.NullString{S: &fixedIn},
 			&dbutil.NullString{S: &vas.Path},
-			pq.Array(vas.Symbols),
+			pq.Array(&vas.Symbols),
 			&dbutil.NullString{S: &vul.Severity},
+			&match.Reachability,
+			&callSites,
 			&count,
 		); err != nil {
 			return shared.VulnerabilityMatch{}, 0, err
 		}
 
+		if err := json.Unmarshal(callSites, &match.CallSites); err != nil {
+			return shared.VulnerabilityMatch{}, 0, err
+		}
+
 		if fixedIn != "" {
 			vap.FixedIn = &fixedIn
 		}
@@ -49,6 +49,8 @@ func TestVulnerabilityMatchByID(t *testing.T) {
 		UploadID:        52,
 		VulnerabilityID: 1,
 		AffectedPackage: badConfig,
+		Reachability:    shared.ReachabilityUnknown,
+		CallSites:       []shared.CallSite{},
 	}
 	if diff := cmp.Diff(expectedMatch, match); diff != "" {
 		t.Errorf("unexpected vulnerability match (-want +got):\n%s", diff)
@@ -12,11 +12,15 @@ type operations struct {
 	getVulnerabilitiesByIDs                  *observation.Operation
 	getVulnerabilities                       *observation.Operation
 	insertVulnerabilities                    *observation.Operation
+	getIngestedBundles                       *observation.Operation
+	markBundleIngested                       *observation.Operation
 	vulnerabilityMatchByID                   *observation.Operation
 	getVulnerabilityMatches                  *observation.Operation
 	getVulnerabilityMatchesSummaryCount      *observation.Operation
 	getVulnerabilityMatchesCountByRepository *observation.Operation
 	scanMatches                              *observation.Operation
+	getReachabilityCandidates                *observation.Operation
+	updateVulnerabilityMatchReachability     *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -44,10 +48,14 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getVulnerabilitiesByIDs:                  op("GetVulnerabilitiesByIDs"),
 		getVulnerabilities:                       op("GetVulnerabilities"),
 		insertVulnerabilities:                    op("InsertVulnerabilities"),
+		getIngestedBundles:                       op("GetIngestedBundles"),
+		markBundleIngested:                       op("MarkBundleIngested"),
 		vulnerabilityMatchByID:                   op("VulnerabilityMatchByID"),
 		getVulnerabilityMatches:                  op("GetVulnerabilityMatches"),
 		getVulnerabilityMatchesSummaryCount:      op("GetVulnerabilityMatchesSummaryCount"),
 		getVulnerabilityMatchesCountByRepository: op("GetVulnerabilityMatchesCountByRepository"),
 		scanMatches:                              op("ScanMatches"),
+		getReachabilityCandidates:                op("GetReachabilityCandidates"),
+		updateVulnerabilityMatchReachability:     op("UpdateVulnerabilityMatchReachability"),
 	}
 }
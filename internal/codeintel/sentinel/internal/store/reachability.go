@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func (s *store) GetReachabilityCandidates(ctx context.Context, batchSize int) (_ []shared.ReachabilityCandidate, err error) {
+	ctx, _, endObservation := s.operations.getReachabilityCandidates.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchSize", batchSize),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	rows, err := s.db.Query(ctx, sqlf.Sprintf(getReachabilityCandidatesQuery, batchSize))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var (
+		candidates []shared.ReachabilityCandidate
+		indexes    = map[int]int{}
+		paths      = map[int]map[string]struct{}{}
+		references = map[int]map[shared.PackageReference]struct{}{}
+	)
+
+	for rows.Next() {
+		var (
+			candidate shared.ReachabilityCandidate
+			vas       shared.AffectedSymbol
+			reference shared.PackageReference
+		)
+
+		if err := rows.Scan(
+			&candidate.MatchID,
+			&candidate.UploadID,
+			// RHS(s) of left joins (may be null)
+			&dbutil.NullString{S: &vas.Path},
+			pq.Array(&vas.Symbols),
+			&dbutil.NullString{S: &reference.Scheme},
+			&dbutil.NullString{S: &reference.Manager},
+			&dbutil.NullString{S: &reference.Name},
+			&dbutil.NullString{S: &reference.Version},
+		); err != nil {
+			return nil, err
+		}
+
+		index, ok := indexes[candidate.MatchID]
+		if !ok {
+			index = len(candidates)
+			indexes[candidate.MatchID] = index
+			paths[candidate.MatchID] = map[string]struct{}{}
+			references[candidate.MatchID] = map[shared.PackageReference]struct{}{}
+			candidates = append(candidates, candidate)
+		}
+
+		if _, ok := paths[candidate.MatchID][vas.Path]; !ok && vas.Path != "" {
+			paths[candidate.MatchID][vas.Path] = struct{}{}
+			candidates[index].AffectedSymbols = append(candidates[index].AffectedSymbols, vas)
+		}
+		if _, ok := references[candidate.MatchID][reference]; !ok && reference.Name != "" {
+			references[candidate.MatchID][reference] = struct{}{}
+			candidates[index].References = append(candidates[index].References, reference)
+		}
+	}
+
+	return candidates, nil
+}
+
+const getReachabilityCandidatesQuery = `
+WITH candidates AS (
+	SELECT m.id, m.upload_id, m.vulnerability_affected_package_id
+	FROM vulnerability_matches m
+	WHERE m.reachability_checked_at IS NULL
+	ORDER BY m.id
+	LIMIT %s
+)
+SELECT
+	c.id,
+	c.upload_id,
+	vas.path,
+	vas.symbols,
+	r.scheme,
+	r.manager,
+	r.name,
+	r.version
+FROM candidates c
+JOIN vulnerability_affected_packages vap ON vap.id = c.vulnerability_affected_package_id
+LEFT JOIN vulnerability_affected_symbols vas ON vas.vulnerability_affected_package_id = vap.id
+-- NOTE: This mirrors the package name matching done in ScanMatches so that we
+-- query references for the same packages that caused the match in the first place.
+LEFT JOIN lsif_references r ON r.dump_id = c.upload_id AND r.name LIKE '%%' || vap.package_name || '%%'
+ORDER BY c.id, vas.id, r.id
+`
+
+func (s *store) UpdateVulnerabilityMatchReachability(ctx context.Context, matchID int, reachability shared.Reachability, callSites []shared.CallSite) (err error) {
+	ctx, _, endObservation := s.operations.updateVulnerabilityMatchReachability.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("matchID", matchID),
+		attribute.String("reachability", string(reachability)),
+		attribute.Int("numCallSites", len(callSites)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if callSites == nil {
+		callSites = []shared.CallSite{}
+	}
+	serialized, err := json.Marshal(callSites)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Exec(ctx, sqlf.Sprintf(updateVulnerabilityMatchReachabilityQuery, reachability, serialized, matchID))
+}
+
+const updateVulnerabilityMatchReachabilityQuery = `
+UPDATE vulnerability_matches
+SET
+	reachability = %s,
+	reachability_call_sites = %s,
+	reachability_checked_at = NOW()
+WHERE id = %s
+`
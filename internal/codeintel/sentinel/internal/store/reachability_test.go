@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestReachability(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+
+	affectedSymbols := []shared.AffectedSymbol{
+		{Path: "github.com/go-nacelle/config", Symbols: []string{"Load", "Config.Init"}},
+	}
+	vulnerabilities := []shared.Vulnerability{
+		{
+			ID:       1,
+			SourceID: "CVE-ABC",
+			Severity: "HIGH",
+			AffectedPackages: []shared.AffectedPackage{{
+				Language:          "go",
+				PackageName:       "go-nacelle/config",
+				VersionConstraint: []string{"<= v1.2.5"},
+				AffectedSymbols:   affectedSymbols,
+			}},
+		},
+	}
+	if _, err := store.InsertVulnerabilities(ctx, vulnerabilities); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if _, _, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning matches: %s", err)
+	}
+
+	candidates, err := store.GetReachabilityCandidates(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting reachability candidates: %s", err)
+	}
+
+	expectedCandidates := []shared.ReachabilityCandidate{
+		{MatchID: 1, UploadID: 50, AffectedSymbols: affectedSymbols, References: []shared.PackageReference{{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"}}},
+		{MatchID: 2, UploadID: 51, AffectedSymbols: affectedSymbols, References: []shared.PackageReference{{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.4"}}},
+		{MatchID: 3, UploadID: 52, AffectedSymbols: affectedSymbols, References: []shared.PackageReference{{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.5"}}},
+	}
+	if diff := cmp.Diff(expectedCandidates, candidates); diff != "" {
+		t.Fatalf("unexpected candidates (-want +got):\n%s", diff)
+	}
+
+	callSites := []shared.CallSite{
+		{Symbol: "github.com/go-nacelle/config.Load", Path: "main.go", StartLine: 10, StartCharacter: 4, EndLine: 10, EndCharacter: 8},
+	}
+	if err := store.UpdateVulnerabilityMatchReachability(ctx, 1, shared.ReachabilityReachable, callSites); err != nil {
+		t.Fatalf("unexpected error updating reachability: %s", err)
+	}
+	if err := store.UpdateVulnerabilityMatchReachability(ctx, 2, shared.ReachabilityUnreachable, nil); err != nil {
+		t.Fatalf("unexpected error updating reachability: %s", err)
+	}
+
+	candidates, err = store.GetReachabilityCandidates(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting reachability candidates: %s", err)
+	}
+	if diff := cmp.Diff(expectedCandidates[2:], candidates); diff != "" {
+		t.Errorf("unexpected candidates after update (-want +got):\n%s", diff)
+	}
+
+	match, _, err := store.VulnerabilityMatchByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability match: %s", err)
+	}
+	if match.Reachability != shared.ReachabilityReachable {
+		t.Errorf("unexpected reachability. want=%q have=%q", shared.ReachabilityReachable, match.Reachability)
+	}
+	if diff := cmp.Diff(callSites, match.CallSites); diff != "" {
+		t.Errorf("unexpected call sites (-want +got):\n%s", diff)
+	}
+
+	reachable, _, err := store.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{Limit: 10, Reachability: shared.ReachabilityReachable})
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability matches: %s", err)
+	}
+	if len(reachable) != 1 || reachable[0].ID != 1 {
+		t.Errorf("unexpected reachable matches: %v", reachable)
+	}
+}
@@ -18,12 +18,20 @@ type Store interface {
 	GetVulnerabilities(ctx context.Context, args shared.GetVulnerabilitiesArgs) (_ []shared.Vulnerability, _ int, err error)
 	InsertVulnerabilities(ctx context.Context, vulnerabilities []shared.Vulnerability) (_ int, err error)
 
+	// Vulnerability bundles
+	GetIngestedBundles(ctx context.Context, source string) (_ map[string]string, err error)
+	MarkBundleIngested(ctx context.Context, source, name, version string, numVulnerabilities int) (err error)
+
 	// Vulnerability matches
 	VulnerabilityMatchByID(ctx context.Context, id int) (shared.VulnerabilityMatch, bool, error)
 	GetVulnerabilityMatches(ctx context.Context, args shared.GetVulnerabilityMatchesArgs) ([]shared.VulnerabilityMatch, int, error)
 	GetVulnerabilityMatchesSummaryCount(ctx context.Context) (counts shared.GetVulnerabilityMatchesSummaryCounts, err error)
 	GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) (_ []shared.VulnerabilityMatchesByRepository, _ int, err error)
 	ScanMatches(ctx context.Context, batchSize int) (numReferencesScanned int, numVulnerabilityMatches int, _ error)
+
+	// Vulnerability match reachability
+	GetReachabilityCandidates(ctx context.Context, batchSize int) (_ []shared.ReachabilityCandidate, err error)
+	UpdateVulnerabilityMatchReachability(ctx context.Context, matchID int, reachability shared.Reachability, callSites []shared.CallSite) (err error)
 }
 
 type store struct {
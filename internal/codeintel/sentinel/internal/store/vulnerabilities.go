@@ -58,7 +58,8 @@ const vulnerabilityFields = `
 	v.cvss_score,
 	v.published_at,
 	v.modified_at,
-	v.withdrawn_at
+	v.withdrawn_at,
+	v.provenance
 `
 
 const vulnerabilityAffectedPackageFields = `
@@ -167,6 +168,7 @@ func (s *store) InsertVulnerabilities(ctx context.Context, vulnerabilities []sha
 				"published_at",
 				"modified_at",
 				"withdrawn_at",
+				"provenance",
 			},
 			func(inserter *batch.Inserter) error {
 				for _, v := range vulnerabilities {
@@ -187,6 +189,7 @@ func (s *store) InsertVulnerabilities(ctx context.Context, vulnerabilities []sha
 						v.PublishedAt,
 						dbutil.NullTime{Time: v.ModifiedAt},
 						dbutil.NullTime{Time: v.WithdrawnAt},
+						v.Provenance,
 					); err != nil {
 						return err
 					}
@@ -275,7 +278,8 @@ CREATE TEMPORARY TABLE t_vulnerabilities (
 	cvss_score    TEXT NOT NULL,
 	published_at  TIMESTAMP WITH TIME ZONE NOT NULL,
 	modified_at   TIMESTAMP WITH TIME ZONE,
-	withdrawn_at  TIMESTAMP WITH TIME ZONE
+	withdrawn_at  TIMESTAMP WITH TIME ZONE,
+	provenance    TEXT NOT NULL
 ) ON COMMIT DROP
 `
 
@@ -309,7 +313,8 @@ WITH ins AS (
 		cvss_score,
 		published_at,
 		modified_at,
-		withdrawn_at
+		withdrawn_at,
+		provenance
 	)
 	SELECT
 		source_id,
@@ -326,11 +331,44 @@ WITH ins AS (
 		cvss_score,
 		published_at,
 		modified_at,
-		withdrawn_at
+		withdrawn_at,
+		provenance
 	FROM t_vulnerabilities
-	-- TODO - we'd prefer to update rather than keep first write
-	ON CONFLICT DO NOTHING
-	RETURNING 1
+	-- Only overwrite advisories that were modified since they were ingested
+	ON CONFLICT (source_id) DO UPDATE SET
+		summary = EXCLUDED.summary,
+		details = EXCLUDED.details,
+		cpes = EXCLUDED.cpes,
+		cwes = EXCLUDED.cwes,
+		aliases = EXCLUDED.aliases,
+		related = EXCLUDED.related,
+		data_source = EXCLUDED.data_source,
+		urls = EXCLUDED.urls,
+		severity = EXCLUDED.severity,
+		cvss_vector = EXCLUDED.cvss_vector,
+		cvss_score = EXCLUDED.cvss_score,
+		published_at = EXCLUDED.published_at,
+		modified_at = EXCLUDED.modified_at,
+		withdrawn_at = EXCLUDED.withdrawn_at,
+		provenance = EXCLUDED.provenance
+	WHERE
+		EXCLUDED.modified_at > vulnerabilities.modified_at OR
+		(vulnerabilities.modified_at IS NULL AND EXCLUDED.modified_at IS NOT NULL)
+	RETURNING id
+),
+-- The affected symbols of modified advisories may have changed, so check the
+-- reachability of their existing matches again
+reset_reachability AS (
+	UPDATE vulnerability_matches m
+	SET
+		reachability = 'unknown',
+		reachability_call_sites = '[]',
+		reachability_checked_at = NULL
+	FROM vulnerability_affected_packages vap
+	WHERE
+		vap.id = m.vulnerability_affected_package_id AND
+		vap.vulnerability_id IN (SELECT id FROM ins) AND
+		m.reachability_checked_at IS NOT NULL
 )
 SELECT COUNT(*) FROM ins
 `
@@ -354,8 +392,16 @@ SELECT
 	fixed,
 	fixed_in
 FROM t_vulnerability_affected_packages vap
--- TODO - we'd prefer to update rather than keep first write
-ON CONFLICT DO NOTHING
+-- Update affected packages in place, so that existing matches are kept
+ON CONFLICT (vulnerability_id, package_name) DO UPDATE SET
+	language = EXCLUDED.language,
+	namespace = EXCLUDED.namespace,
+	version_constraint = EXCLUDED.version_constraint,
+	fixed = EXCLUDED.fixed,
+	fixed_in = EXCLUDED.fixed_in
+WHERE
+	(vulnerability_affected_packages.language, vulnerability_affected_packages.namespace, vulnerability_affected_packages.version_constraint, vulnerability_affected_packages.fixed, vulnerability_affected_packages.fixed_in) IS DISTINCT FROM
+	(EXCLUDED.language, EXCLUDED.namespace, EXCLUDED.version_constraint, EXCLUDED.fixed, EXCLUDED.fixed_in)
 `
 
 const insertVulnerabilitiesAffectedSymbolsUpdateQuery = `
@@ -379,8 +425,10 @@ candidates AS (
 )
 INSERT INTO vulnerability_affected_symbols(vulnerability_affected_package_id, path, symbols)
 SELECT c.id, c.path, c.symbols FROM candidates c
--- TODO - we'd prefer to update rather than keep first write
-ON CONFLICT DO NOTHING
+ON CONFLICT (vulnerability_affected_package_id, path) DO UPDATE SET
+	symbols = EXCLUDED.symbols
+WHERE
+	vulnerability_affected_symbols.symbols IS DISTINCT FROM EXCLUDED.symbols
 `
 
 //
@@ -410,6 +458,7 @@ var scanSingleVulnerabilityAndCount = func(s dbutil.Scanner) (v shared.Vulnerabi
 		&v.PublishedAt,
 		&v.ModifiedAt,
 		&v.WithdrawnAt,
+		&v.Provenance,
 		// RHS(s) of left join (may be null)
 		&dbutil.NullString{S: &vap.PackageName},
 		&dbutil.NullString{S: &vap.Language},
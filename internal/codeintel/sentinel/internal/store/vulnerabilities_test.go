@@ -4,6 +4,7 @@ import (
 	"context"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/log/logtest"
@@ -135,3 +136,47 @@ func TestGetVulnerabilities(t *testing.T) {
 		}
 	}
 }
+
+func TestInsertVulnerabilitiesUpdatesModified(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	older := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	v := shared.Vulnerability{ID: 1, SourceID: "CVE-ABC", Summary: "original", ModifiedAt: &older, Provenance: "bundle-1", AffectedPackages: []shared.AffectedPackage{badConfig}}
+	if _, err := store.InsertVulnerabilities(ctx, []shared.Vulnerability{v}); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+
+	// Same modification time: not overwritten
+	stale := v
+	stale.Summary = "stale"
+	stale.Provenance = "bundle-2"
+	if n, err := store.InsertVulnerabilities(ctx, []shared.Vulnerability{stale}); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	} else if n != 0 {
+		t.Fatalf("unexpected number of updated vulnerabilities. want=%d have=%d", 0, n)
+	}
+
+	// Newer modification time: overwritten
+	updated := v
+	updated.Summary = "updated"
+	updated.ModifiedAt = &newer
+	updated.Provenance = "bundle-3"
+	if n, err := store.InsertVulnerabilities(ctx, []shared.Vulnerability{updated}); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	} else if n != 1 {
+		t.Fatalf("unexpected number of updated vulnerabilities. want=%d have=%d", 1, n)
+	}
+
+	vulnerability, _, err := store.VulnerabilityByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to get vulnerability by id: %s", err)
+	}
+	if vulnerability.Summary != "updated" || vulnerability.Provenance != "bundle-3" {
+		t.Errorf("unexpected vulnerability. want summary=%q provenance=%q, have summary=%q provenance=%q", "updated", "bundle-3", vulnerability.Summary, vulnerability.Provenance)
+	}
+}
@@ -10,15 +10,18 @@ import (
 
 type Service struct {
 	store      store.Store
+	codenavSvc CodeNavService
 	operations *operations
 }
 
 func newService(
 	observationCtx *observation.Context,
 	store store.Store,
+	codenavSvc CodeNavService,
 ) *Service {
 	return &Service{
 		store:      store,
+		codenavSvc: codenavSvc,
 		operations: newOperations(observationCtx),
 	}
 }
@@ -0,0 +1,5 @@
+package shared
+
+// BundleUploadPrefix is the prefix of the keys under which OSV bundles uploaded by a
+// site admin are stored in the precise code intel upload bucket.
+const BundleUploadPrefix = "sentinel-bundles/"
@@ -23,6 +23,10 @@ type Vulnerability struct {
 	ModifiedAt       *time.Time
 	WithdrawnAt      *time.Time
 	AffectedPackages []AffectedPackage
+
+	// Provenance describes where the advisory was ingested from, such as the
+	// URL or the bundle it was read from.
+	Provenance string
 }
 
 func (v Vulnerability) RecordID() int {
@@ -56,6 +60,49 @@ type VulnerabilityMatch struct {
 	UploadID        int
 	VulnerabilityID int
 	AffectedPackage AffectedPackage
+	Reachability    Reachability
+	CallSites       []CallSite
+}
+
+// Reachability describes whether a symbol affected by a vulnerability is referenced from the
+// code of the upload matching the vulnerability.
+type Reachability string
+
+const (
+	// ReachabilityUnknown is used when the match has not been checked yet, or when the
+	// vulnerability does not list the symbols it affects.
+	ReachabilityUnknown     Reachability = "unknown"
+	ReachabilityReachable   Reachability = "reachable"
+	ReachabilityUnreachable Reachability = "unreachable"
+)
+
+// CallSite is a location in an upload that references a symbol affected by a vulnerability.
+// The path is relative to the root of the repository.
+type CallSite struct {
+	Symbol         string `json:"symbol"`
+	Path           string `json:"path"`
+	StartLine      int    `json:"startLine"`
+	StartCharacter int    `json:"startCharacter"`
+	EndLine        int    `json:"endLine"`
+	EndCharacter   int    `json:"endCharacter"`
+}
+
+// ReachabilityCandidate is a vulnerability match whose reachability has not been checked,
+// along with the affected symbols and the package references of the upload that may
+// reference them.
+type ReachabilityCandidate struct {
+	MatchID         int
+	UploadID        int
+	AffectedSymbols []AffectedSymbol
+	References      []PackageReference
+}
+
+// PackageReference is a package that is referenced from an upload.
+type PackageReference struct {
+	Scheme  string
+	Manager string
+	Name    string
+	Version string
 }
 
 type GetVulnerabilitiesArgs struct {
@@ -69,6 +116,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       string
 	Language       string
 	RepositoryName string
+	Reachability   Reachability
 }
 
 type GetVulnerabilityMatchesSummaryCounts struct {
@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"context"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"go.opentelemetry.io/otel/attribute"
@@ -99,12 +100,18 @@ func (r *rootResolver) VulnerabilityMatches(ctx context.Context, args resolverst
 		repositoryName = *args.RepositoryName
 	}
 
+	var reachability shared.Reachability
+	if args.Reachability != nil {
+		reachability = shared.Reachability(strings.ToLower(*args.Reachability))
+	}
+
 	matches, totalCount, err := r.sentinelSvc.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{
 		Limit:          int(limit),
 		Offset:         int(offset),
 		Language:       language,
 		Severity:       severity,
 		RepositoryName: repositoryName,
+		Reachability:   reachability,
 	})
 	if err != nil {
 		return nil, err
@@ -274,6 +281,10 @@ func (r *vulnerabilityResolver) Withdrawn() *gqlutil.DateTime {
 	return gqlutil.DateTimeOrNil(r.v.WithdrawnAt)
 }
 
+func (r *vulnerabilityResolver) Provenance() string {
+	return r.v.Provenance
+}
+
 func (r *vulnerabilityResolver) AffectedPackages() []resolverstubs.VulnerabilityAffectedPackageResolver {
 	var resolvers []resolverstubs.VulnerabilityAffectedPackageResolver
 	for _, p := range r.v.AffectedPackages {
@@ -352,6 +363,54 @@ func (r *vulnerabilityMatchResolver) PreciseIndex(ctx context.Context) (resolver
 	return r.preciseIndexResolverFactory.Create(ctx, r.uploadLoader, r.indexLoader, r.locationResolver, r.errTracer, &upload, nil)
 }
 
+func (r *vulnerabilityMatchResolver) Reachability() string {
+	if r.m.Reachability == "" {
+		return strings.ToUpper(string(shared.ReachabilityUnknown))
+	}
+
+	return strings.ToUpper(string(r.m.Reachability))
+}
+
+func (r *vulnerabilityMatchResolver) CallSites() []resolverstubs.VulnerabilityCallSiteResolver {
+	resolvers := make([]resolverstubs.VulnerabilityCallSiteResolver, 0, len(r.m.CallSites))
+	for _, callSite := range r.m.CallSites {
+		resolvers = append(resolvers, &vulnerabilityCallSiteResolver{c: callSite})
+	}
+
+	return resolvers
+}
+
+type vulnerabilityCallSiteResolver struct {
+	c shared.CallSite
+}
+
+func (r *vulnerabilityCallSiteResolver) Symbol() string { return r.c.Symbol }
+func (r *vulnerabilityCallSiteResolver) Path() string   { return r.c.Path }
+
+func (r *vulnerabilityCallSiteResolver) Range() resolverstubs.RangeResolver {
+	return &callSiteRangeResolver{c: r.c}
+}
+
+type callSiteRangeResolver struct {
+	c shared.CallSite
+}
+
+func (r *callSiteRangeResolver) Start() resolverstubs.PositionResolver {
+	return &callSitePositionResolver{line: r.c.StartLine, character: r.c.StartCharacter}
+}
+
+func (r *callSiteRangeResolver) End() resolverstubs.PositionResolver {
+	return &callSitePositionResolver{line: r.c.EndLine, character: r.c.EndCharacter}
+}
+
+type callSitePositionResolver struct {
+	line      int
+	character int
+}
+
+func (r *callSitePositionResolver) Line() int32      { return int32(r.line) }
+func (r *callSitePositionResolver) Character() int32 { return int32(r.character) }
+
 //
 //
 
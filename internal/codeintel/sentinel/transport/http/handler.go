@@ -0,0 +1,76 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+)
+
+// NewBundleUploadHandler returns a handler that stores an OSV bundle sent in the request
+// body in the upload store, from which it is ingested by the sentinel downloader when
+// CODEINTEL_SENTINEL_VULNERABILITY_SOURCE is set to "uploadstore".
+func NewBundleUploadHandler(db database.DB, uploadStore uploadstore.Store) http.Handler {
+	logger := log.Scoped("sentinel.bundleUploadHandler", "stores uploaded OSV bundles")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		// 🚨 SECURITY: Only site admins may upload vulnerability bundles.
+		if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		name := sanitizeBundleName(r.URL.Query().Get("name"))
+		if name == "" {
+			http.Error(w, "name must be the file name of a .zip or .json OSV bundle", http.StatusBadRequest)
+			return
+		}
+
+		// Prefix the key with the upload time so that uploads never overwrite one another
+		key := fmt.Sprintf("%s%d-%s", shared.BundleUploadPrefix, time.Now().UnixNano(), name)
+
+		size, err := uploadStore.Upload(ctx, key, r.Body)
+		if err != nil {
+			logger.Error("failed to upload vulnerability bundle", log.String("key", key), log.Error(err))
+			http.Error(w, "failed to upload vulnerability bundle", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(struct {
+			Key  string `json:"key"`
+			Size int64  `json:"size"`
+		}{
+			Key:  key,
+			Size: size,
+		})
+	})
+}
+
+var unsafeBundleNameCharacters = lazyregexp.New(`[^A-Za-z0-9._-]+`)
+
+// sanitizeBundleName returns the base name of the given file name with any characters
+// unsafe for an object key replaced, or an empty string if the name is not a bundle.
+func sanitizeBundleName(name string) string {
+	name = unsafeBundleNameCharacters.ReplaceAllString(path.Base(strings.ReplaceAll(name, "\\", "/")), "-")
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".zip", ".json":
+		return name
+	}
+
+	return ""
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+)
+
+func TestBundleUploadHandler(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultHook(func(ctx context.Context) (*types.User, error) {
+		return &types.User{ID: actor.FromContext(ctx).UID, SiteAdmin: actor.FromContext(ctx).UID == 1}, nil
+	})
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	uploaded := map[string]string{}
+	uploadStore := uploadstoremocks.NewMockStore()
+	uploadStore.UploadFunc.SetDefaultHook(func(_ context.Context, key string, r io.Reader) (int64, error) {
+		contents, err := io.ReadAll(r)
+		uploaded[key] = string(contents)
+		return int64(len(contents)), err
+	})
+
+	handler := NewBundleUploadHandler(db, uploadStore)
+
+	t.Run("non site admin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/sentinel/bundles?name=osv.zip", strings.NewReader("bundle"))
+		req = req.WithContext(actor.WithActor(req.Context(), actor.FromUser(2)))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Empty(t, uploaded)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/sentinel/bundles?name=osv.tar.gz", strings.NewReader("bundle"))
+		req = req.WithContext(actor.WithActor(req.Context(), actor.FromUser(1)))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Empty(t, uploaded)
+	})
+
+	t.Run("upload", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/sentinel/bundles?name=../npm+advisories.zip", strings.NewReader("bundle"))
+		req = req.WithContext(actor.WithActor(req.Context(), actor.FromUser(1)))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp struct {
+			Key  string `json:"key"`
+			Size int64  `json:"size"`
+		}
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		assert.True(t, strings.HasPrefix(resp.Key, shared.BundleUploadPrefix), "unexpected key %q", resp.Key)
+		assert.True(t, strings.HasSuffix(resp.Key, "-npm-advisories.zip"), "unexpected key %q", resp.Key)
+		assert.Equal(t, int64(6), resp.Size)
+		assert.Equal(t, map[string]string{resp.Key: "bundle"}, uploaded)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/completions/client/codygateway"
 	"github.com/sourcegraph/sourcegraph/internal/completions/client/fireworks"
 	"github.com/sourcegraph/sourcegraph/internal/completions/client/openai"
+	"github.com/sourcegraph/sourcegraph/internal/completions/client/openaicompatible"
 	"github.com/sourcegraph/sourcegraph/internal/completions/types"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
@@ -14,14 +15,31 @@ import (
 )
 
 func Get(endpoint string, provider conftypes.CompletionsProviderName, accessToken string) (types.CompletionsClient, error) {
-	client, err := getBasic(endpoint, provider, accessToken)
+	client, err := getBasic(endpoint, provider, accessToken, nil)
 	if err != nil {
 		return nil, err
 	}
 	return newObservedClient(client), nil
 }
 
-func getBasic(endpoint string, provider conftypes.CompletionsProviderName, accessToken string) (types.CompletionsClient, error) {
+// GetFromConfig returns a client for the given completions configuration. If
+// fallback providers are configured, requests are routed across the primary
+// provider and the fallbacks.
+func GetFromConfig(c *conftypes.CompletionsConfig) (types.CompletionsClient, error) {
+	var client types.CompletionsClient
+	var err error
+	if c.Routing == nil {
+		client, err = getBasic(c.Endpoint, c.Provider, c.AccessToken, c.OpenAICompatibleModels)
+	} else {
+		client, err = newRoutingClient(c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newObservedClient(client), nil
+}
+
+func getBasic(endpoint string, provider conftypes.CompletionsProviderName, accessToken string, openAICompatibleModels []conftypes.OpenAICompatibleModel) (types.CompletionsClient, error) {
 	switch provider {
 	case conftypes.CompletionsProviderNameAnthropic:
 		return anthropic.NewClient(httpcli.ExternalDoer, endpoint, accessToken), nil
@@ -35,6 +53,8 @@ func getBasic(endpoint string, provider conftypes.CompletionsProviderName, acces
 		return fireworks.NewClient(httpcli.ExternalDoer, endpoint, accessToken), nil
 	case conftypes.CompletionsProviderNameAWSBedrock:
 		return awsbedrock.NewClient(httpcli.ExternalDoer, endpoint, accessToken), nil
+	case conftypes.CompletionsProviderNameOpenAICompatible:
+		return openaicompatible.NewClient(httpcli.ExternalDoer, endpoint, accessToken, openAICompatibleModels), nil
 	default:
 		return nil, errors.Newf("unknown completion stream provider: %s", provider)
 	}
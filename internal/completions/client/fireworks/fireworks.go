@@ -54,6 +54,10 @@ func (c *fireworksClient) Complete(
 	return &types.CompletionResponse{
 		Completion: response.Choices[0].Text,
 		StopReason: response.Choices[0].FinishReason,
+		Usage: &types.CompletionUsage{
+			PromptTokens:     response.Usage.PromptTokens,
+			CompletionTokens: response.Usage.CompletionTokens,
+		},
 	}, nil
 }
 
@@ -56,6 +56,10 @@ func (c *openAIChatCompletionStreamClient) Complete(
 	return &types.CompletionResponse{
 		Completion: response.Choices[0].Content,
 		StopReason: response.Choices[0].FinishReason,
+		Usage: &types.CompletionUsage{
+			PromptTokens:     response.Usage.PromptTokens,
+			CompletionTokens: response.Usage.CompletionTokens,
+		},
 	}, nil
 }
 
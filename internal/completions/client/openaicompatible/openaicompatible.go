@@ -0,0 +1,259 @@
+package openaicompatible
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/completions/client/openai"
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewClient returns a client for servers that implement the OpenAI chat
+// completions and completions APIs, such as vLLM or llama.cpp. endpoint is the
+// base URL of the API, for example http://localhost:8000/v1. accessToken may
+// be empty for servers that don't require authentication.
+func NewClient(cli httpcli.Doer, endpoint, accessToken string, models []conftypes.OpenAICompatibleModel) types.CompletionsClient {
+	return &openAICompatibleClient{
+		cli:         cli,
+		accessToken: accessToken,
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		models:      models,
+	}
+}
+
+type openAICompatibleClient struct {
+	cli         httpcli.Doer
+	accessToken string
+	endpoint    string
+	models      []conftypes.OpenAICompatibleModel
+}
+
+func (c *openAICompatibleClient) Complete(
+	ctx context.Context,
+	feature types.CompletionsFeature,
+	requestParams types.CompletionRequestParameters,
+) (*types.CompletionResponse, error) {
+	resp, err := c.makeRequest(ctx, feature, requestParams, false)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var response completionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	if len(response.Choices) == 0 {
+		// Empty response.
+		return &types.CompletionResponse{}, nil
+	}
+
+	completion := &types.CompletionResponse{
+		Completion: response.Choices[0].text(),
+		StopReason: response.Choices[0].FinishReason,
+	}
+	// Not every OpenAI-compatible server reports usage.
+	if response.Usage != nil {
+		completion.Usage = &types.CompletionUsage{
+			PromptTokens:     response.Usage.PromptTokens,
+			CompletionTokens: response.Usage.CompletionTokens,
+		}
+	}
+	return completion, nil
+}
+
+func (c *openAICompatibleClient) Stream(
+	ctx context.Context,
+	feature types.CompletionsFeature,
+	requestParams types.CompletionRequestParameters,
+	sendEvent types.SendCompletionEvent,
+) error {
+	resp, err := c.makeRequest(ctx, feature, requestParams, true)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	dec := openai.NewDecoder(resp.Body)
+	var content string
+	for dec.Scan() {
+		if ctx.Err() != nil && ctx.Err() == context.Canceled {
+			return nil
+		}
+
+		data := dec.Data()
+		// Gracefully skip over any data that isn't JSON-like.
+		if !bytes.HasPrefix(data, []byte("{")) {
+			continue
+		}
+
+		var event completionsResponse
+		if err := json.Unmarshal(data, &event); err != nil {
+			return errors.Errorf("failed to decode event payload: %w - body: %s", err, string(data))
+		}
+
+		if len(event.Choices) > 0 {
+			content += event.Choices[0].text()
+			ev := types.CompletionResponse{
+				Completion: content,
+				StopReason: event.Choices[0].FinishReason,
+			}
+			err = sendEvent(ev)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return dec.Err()
+}
+
+// getModel returns the catalogue entry of the given model. If the catalogue is
+// empty, any model is accepted and uses the OpenAI chat API as-is.
+func (c *openAICompatibleClient) getModel(name string) (conftypes.OpenAICompatibleModel, error) {
+	if len(c.models) == 0 {
+		return conftypes.OpenAICompatibleModel{Name: name, ChatTemplate: chatTemplateOpenAI}, nil
+	}
+	for _, m := range c.models {
+		if strings.EqualFold(m.Name, name) {
+			return m, nil
+		}
+	}
+	return conftypes.OpenAICompatibleModel{}, errors.Newf("model %q is not in the model catalogue", name)
+}
+
+func (c *openAICompatibleClient) makeRequest(ctx context.Context, feature types.CompletionsFeature, requestParams types.CompletionRequestParameters, stream bool) (*http.Response, error) {
+	model, err := c.getModel(requestParams.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	if requestParams.TopP < 0 {
+		requestParams.TopP = 0
+	}
+
+	payload := completionsRequest{
+		Model:       model.Name,
+		Temperature: requestParams.Temperature,
+		TopP:        requestParams.TopP,
+		N:           1,
+		Stream:      stream,
+		MaxTokens:   requestParams.MaxTokensToSample,
+		Stop:        append(append([]string{}, requestParams.StopSequences...), model.StopSequences...),
+	}
+
+	var url, promptText string
+	if model.ChatTemplate == chatTemplateOpenAI && !(feature == types.CompletionsFeatureCode && len(requestParams.Messages) == 1) {
+		url = c.endpoint + "/chat/completions"
+		for _, m := range requestParams.Messages {
+			// Skip the empty assistant message our clients send to
+			// prompt the model.
+			if m.Speaker == types.ASISSTANT_MESSAGE_SPEAKER && m.Text == "" {
+				continue
+			}
+			payload.Messages = append(payload.Messages, message{Role: role(m), Content: m.Text})
+			promptText += m.Text
+		}
+	} else {
+		url = c.endpoint + "/completions"
+		prompt, stopSequences, err := getPrompt(feature, model.ChatTemplate, requestParams.Messages)
+		if err != nil {
+			return nil, err
+		}
+		payload.Prompt = prompt
+		payload.Stop = append(payload.Stop, stopSequences...)
+		promptText = prompt
+	}
+
+	if model.ContextWindow > 0 {
+		promptTokens, err := countTokens(model.Tokenizer, promptText)
+		if err != nil {
+			return nil, err
+		}
+		if promptTokens >= model.ContextWindow {
+			return nil, errors.Newf("prompt of about %d tokens exceeds the context window of %d tokens of model %q", promptTokens, model.ContextWindow, model.Name)
+		}
+		// Reduce the number of tokens to sample so the request fits.
+		if remaining := model.ContextWindow - promptTokens; payload.MaxTokens == 0 || payload.MaxTokens > remaining {
+			payload.MaxTokens = remaining
+		}
+	}
+
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, types.NewErrStatusNotOK("OpenAI-compatible", resp)
+	}
+
+	return resp, nil
+}
+
+// completionsRequest is the request body of both the chat completions and the
+// completions APIs. Exactly one of Messages and Prompt is set.
+type completionsRequest struct {
+	Model       string    `json:"model"`
+	Messages    []message `json:"messages,omitempty"`
+	Prompt      string    `json:"prompt,omitempty"`
+	Temperature float32   `json:"temperature,omitempty"`
+	TopP        float32   `json:"top_p,omitempty"`
+	N           int       `json:"n,omitempty"`
+	Stream      bool      `json:"stream,omitempty"`
+	Stop        []string  `json:"stop,omitempty"`
+	MaxTokens   int       `json:"max_tokens,omitempty"`
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// completionsResponse is the response body, or streamed event, of both the
+// chat completions and the completions APIs.
+type completionsResponse struct {
+	Choices []choice `json:"choices"`
+	Usage   *usage   `json:"usage"`
+}
+
+type usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+type choice struct {
+	// Text is set by the completions API.
+	Text string `json:"text"`
+	// Message is set by the chat completions API for non-streaming requests.
+	Message message `json:"message"`
+	// Delta is set by the chat completions API for streaming requests.
+	Delta        message `json:"delta"`
+	FinishReason string  `json:"finish_reason"`
+}
+
+func (c choice) text() string {
+	return c.Text + c.Message.Content + c.Delta.Content
+}
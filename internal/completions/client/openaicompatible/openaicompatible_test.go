@@ -0,0 +1,201 @@
+package openaicompatible
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+)
+
+// newStubServer returns a server that implements the OpenAI APIs and records
+// the requests it receives.
+func newStubServer(t *testing.T) (*httptest.Server, *[]*http.Request, *[]completionsRequest) {
+	var requests []*http.Request
+	var payloads []completionsRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload completionsRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		requests = append(requests, r)
+		payloads = append(payloads, payload)
+
+		if payload.Model == "overloaded" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var c choice
+		if r.URL.Path == "/v1/chat/completions" {
+			c.Message.Content = "chat response"
+		} else {
+			c.Text = "completion response"
+		}
+		c.FinishReason = "stop"
+
+		if !payload.Stream {
+			_ = json.NewEncoder(w).Encode(completionsResponse{Choices: []choice{c}})
+			return
+		}
+		for _, text := range []string{"stream ", "response"} {
+			event, _ := json.Marshal(completionsResponse{Choices: []choice{{Text: text}}})
+			fmt.Fprintf(w, "data: %s\n\n", event)
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests, &payloads
+}
+
+var chatMessages = []types.Message{
+	{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "hello"},
+	{Speaker: types.ASISSTANT_MESSAGE_SPEAKER, Text: ""},
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("chat API without catalogue", func(t *testing.T) {
+		srv, requests, payloads := newStubServer(t)
+		client := NewClient(http.DefaultClient, srv.URL+"/v1/", "", nil)
+
+		resp, err := client.Complete(ctx, types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:    "any-model",
+			Messages: chatMessages,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "chat response", resp.Completion)
+
+		require.Len(t, *requests, 1)
+		assert.Equal(t, "/v1/chat/completions", (*requests)[0].URL.Path)
+		// No access token, no auth header.
+		assert.Empty(t, (*requests)[0].Header.Get("Authorization"))
+		assert.Equal(t, []message{{Role: "user", Content: "hello"}}, (*payloads)[0].Messages)
+	})
+
+	t.Run("chat template", func(t *testing.T) {
+		srv, requests, payloads := newStubServer(t)
+		client := NewClient(http.DefaultClient, srv.URL+"/v1", "secret", []conftypes.OpenAICompatibleModel{{
+			Name:          "mistral",
+			ChatTemplate:  chatTemplateChatML,
+			StopSequences: []string{"<|endoftext|>"},
+		}})
+
+		resp, err := client.Complete(ctx, types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:         "Mistral",
+			Messages:      chatMessages,
+			StopSequences: []string{"\n\nHuman:"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "completion response", resp.Completion)
+
+		require.Len(t, *requests, 1)
+		assert.Equal(t, "/v1/completions", (*requests)[0].URL.Path)
+		assert.Equal(t, "Bearer secret", (*requests)[0].Header.Get("Authorization"))
+		assert.Equal(t, "mistral", (*payloads)[0].Model)
+		assert.Equal(t, "<|im_start|>user\nhello<|im_end|>\n<|im_start|>assistant\n", (*payloads)[0].Prompt)
+		assert.Equal(t, []string{"\n\nHuman:", "<|endoftext|>", "<|im_end|>"}, (*payloads)[0].Stop)
+	})
+
+	t.Run("code completions use raw prompt", func(t *testing.T) {
+		srv, requests, payloads := newStubServer(t)
+		client := NewClient(http.DefaultClient, srv.URL+"/v1", "", []conftypes.OpenAICompatibleModel{{
+			Name:         "starcoder",
+			ChatTemplate: chatTemplateOpenAI,
+		}})
+
+		var events []types.CompletionResponse
+		err := client.Stream(ctx, types.CompletionsFeatureCode, types.CompletionRequestParameters{
+			Model:    "starcoder",
+			Messages: []types.Message{{Text: "func main() {"}},
+		}, func(event types.CompletionResponse) error {
+			events = append(events, event)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, "stream response", events[1].Completion)
+
+		assert.Equal(t, "/v1/completions", (*requests)[0].URL.Path)
+		assert.Equal(t, "func main() {", (*payloads)[0].Prompt)
+		assert.True(t, (*payloads)[0].Stream)
+	})
+
+	t.Run("context window", func(t *testing.T) {
+		srv, _, payloads := newStubServer(t)
+		client := NewClient(http.DefaultClient, srv.URL+"/v1", "", []conftypes.OpenAICompatibleModel{{
+			Name:          "small",
+			ChatTemplate:  chatTemplateOpenAI,
+			ContextWindow: 10,
+			Tokenizer:     tokenizerCharacters,
+		}})
+
+		_, err := client.Complete(ctx, types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:             "small",
+			Messages:          chatMessages,
+			MaxTokensToSample: 100,
+		})
+		require.NoError(t, err)
+		// "hello" is 5 tokens, leaving 5 tokens to sample.
+		assert.Equal(t, 5, (*payloads)[0].MaxTokens)
+
+		_, err = client.Complete(ctx, types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:    "small",
+			Messages: []types.Message{{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "this prompt is too long"}},
+		})
+		assert.ErrorContains(t, err, "exceeds the context window")
+	})
+
+	t.Run("unknown model", func(t *testing.T) {
+		srv, requests, _ := newStubServer(t)
+		client := NewClient(http.DefaultClient, srv.URL+"/v1", "", []conftypes.OpenAICompatibleModel{{Name: "known"}})
+
+		_, err := client.Complete(ctx, types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:    "unknown",
+			Messages: chatMessages,
+		})
+		assert.ErrorContains(t, err, "not in the model catalogue")
+		assert.Empty(t, *requests)
+	})
+
+	t.Run("ErrStatusNotOK", func(t *testing.T) {
+		srv, _, _ := newStubServer(t)
+		client := NewClient(http.DefaultClient, srv.URL+"/v1", "", nil)
+
+		_, err := client.Complete(ctx, types.CompletionsFeatureChat, types.CompletionRequestParameters{
+			Model:    "overloaded",
+			Messages: chatMessages,
+		})
+		errNotOK, ok := types.IsErrStatusNotOK(err)
+		require.True(t, ok)
+		assert.True(t, errNotOK.IsRetryable())
+	})
+}
+
+func TestGetPrompt(t *testing.T) {
+	messages := []types.Message{
+		{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "hi"},
+		{Speaker: types.ASISSTANT_MESSAGE_SPEAKER, Text: "hello"},
+		{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "bye"},
+		{Speaker: types.ASISSTANT_MESSAGE_SPEAKER, Text: ""},
+	}
+
+	for template, want := range map[string]string{
+		chatTemplateChatML: "<|im_start|>user\nhi<|im_end|>\n<|im_start|>assistant\nhello<|im_end|>\n<|im_start|>user\nbye<|im_end|>\n<|im_start|>assistant\n",
+		chatTemplateLlama2: "<s>[INST] hi [/INST] hello </s><s>[INST] bye [/INST]",
+		chatTemplateAlpaca: "### Instruction:\nhi\n\n### Response:\nhello\n\n### Instruction:\nbye\n\n### Response:\n",
+	} {
+		prompt, _, err := getPrompt(types.CompletionsFeatureChat, template, messages)
+		require.NoError(t, err)
+		assert.Equal(t, want, prompt, template)
+	}
+
+	_, _, err := getPrompt(types.CompletionsFeatureChat, "unknown", messages)
+	assert.Error(t, err)
+}
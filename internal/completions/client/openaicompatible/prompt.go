@@ -0,0 +1,98 @@
+package openaicompatible
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// chatTemplateOpenAI sends messages to the chat completions API as-is and
+	// leaves applying the prompt template to the server.
+	chatTemplateOpenAI = "openai"
+	chatTemplateChatML = "chatml"
+	chatTemplateLlama2 = "llama2"
+	chatTemplateAlpaca = "alpaca"
+)
+
+// chatTemplate renders chat messages into a single prompt for models whose
+// server does not apply the right prompt template itself.
+type chatTemplate struct {
+	// render renders the messages into a prompt that ends with the start of
+	// the assistant's turn.
+	render func(messages []types.Message) string
+	// stopSequences are the sequences that end the assistant's turn.
+	stopSequences []string
+}
+
+var chatTemplates = map[string]chatTemplate{
+	chatTemplateChatML: {
+		render: func(messages []types.Message) string {
+			var b strings.Builder
+			for _, m := range messages {
+				if m.Speaker == types.ASISSTANT_MESSAGE_SPEAKER && m.Text == "" {
+					continue
+				}
+				b.WriteString("<|im_start|>" + role(m) + "\n" + m.Text + "<|im_end|>\n")
+			}
+			b.WriteString("<|im_start|>assistant\n")
+			return b.String()
+		},
+		stopSequences: []string{"<|im_end|>"},
+	},
+	chatTemplateLlama2: {
+		render: func(messages []types.Message) string {
+			var b strings.Builder
+			for _, m := range messages {
+				switch {
+				case m.Speaker == types.HUMAN_MESSAGE_SPEAKER:
+					b.WriteString("<s>[INST] " + m.Text + " [/INST]")
+				case m.Text != "":
+					b.WriteString(" " + m.Text + " </s>")
+				}
+			}
+			return b.String()
+		},
+		stopSequences: []string{"</s>", "[INST]"},
+	},
+	chatTemplateAlpaca: {
+		render: func(messages []types.Message) string {
+			var b strings.Builder
+			for _, m := range messages {
+				switch {
+				case m.Speaker == types.HUMAN_MESSAGE_SPEAKER:
+					b.WriteString("### Instruction:\n" + m.Text + "\n\n")
+				case m.Text != "":
+					b.WriteString("### Response:\n" + m.Text + "\n\n")
+				}
+			}
+			b.WriteString("### Response:\n")
+			return b.String()
+		},
+		stopSequences: []string{"### Instruction:"},
+	},
+}
+
+func role(m types.Message) string {
+	if m.Speaker == types.ASISSTANT_MESSAGE_SPEAKER {
+		return "assistant"
+	}
+	return "user"
+}
+
+// getPrompt returns the prompt for a request to the completions API, and the
+// stop sequences of the template used to render it.
+func getPrompt(feature types.CompletionsFeature, template string, messages []types.Message) (string, []string, error) {
+	// For compatibility reasons with other models, we expect to find the
+	// prompt of code completions in the first and only message.
+	if feature == types.CompletionsFeatureCode && len(messages) == 1 {
+		return messages[0].Text, nil, nil
+	}
+
+	t, ok := chatTemplates[template]
+	if !ok {
+		return "", nil, errors.Newf("unknown chat template %q", template)
+	}
+	return t.render(messages), t.stopSequences, nil
+}
@@ -0,0 +1,29 @@
+package openaicompatible
+
+import (
+	"unicode/utf8"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	tokenizerApproximate = "approximate"
+	tokenizerCharacters  = "characters"
+)
+
+// countTokens estimates the number of tokens in text with the given tokenizer.
+// We cannot know the actual tokenizer of a self-hosted model, so the count is
+// only used to keep requests within the model's context window.
+func countTokens(tokenizer, text string) (int, error) {
+	n := utf8.RuneCountInString(text)
+	switch tokenizer {
+	case tokenizerApproximate, "":
+		// Most BPE tokenizers average about 4 characters per token for
+		// English text and code.
+		return (n + 3) / 4, nil
+	case tokenizerCharacters:
+		return n, nil
+	default:
+		return 0, errors.Newf("unknown tokenizer %q", tokenizer)
+	}
+}
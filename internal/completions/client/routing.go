@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var routedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "src",
+	Subsystem: "completions",
+	Name:      "routed_requests_total",
+	Help:      "Completions requests by the provider that handled them and the outcome.",
+}, []string{"provider", "feature", "outcome"})
+
+const (
+	outcomeSuccess = "success"
+	// outcomeFailover is recorded when a provider failed with a retryable
+	// error and the request was handed to the next provider, if any.
+	outcomeFailover = "failover"
+	outcomeError    = "error"
+)
+
+// backendHealth is shared across all routing clients, since a new client is
+// constructed for every request.
+var backendHealth = newHealthTracker()
+
+// routedBackend is a single provider a routingClient can send requests to.
+type routedBackend struct {
+	// name is the provider name, used as a metric label.
+	name string
+	// key identifies the backend for health tracking.
+	key    string
+	client types.CompletionsClient
+	// models maps the models of the primary provider to the models to use
+	// with this backend instead.
+	models map[string]string
+	weight int
+}
+
+func (b *routedBackend) params(params types.CompletionRequestParameters) types.CompletionRequestParameters {
+	if model, ok := b.models[params.Model]; ok {
+		params.Model = model
+	}
+	return params
+}
+
+// routingClient is a types.CompletionsClient that routes requests across
+// multiple providers. Providers that fail with a retryable error or can't be
+// reached are skipped in favour of the next one, and providers that fail repeatedly are
+// considered unhealthy and only tried as a last resort until their cooldown
+// has passed.
+type routingClient struct {
+	backends         []*routedBackend
+	strategy         conftypes.CompletionsRoutingStrategy
+	failureThreshold int
+	cooldown         time.Duration
+	health           *healthTracker
+	intn             func(n int) int
+}
+
+var _ types.CompletionsClient = (*routingClient)(nil)
+
+func newRoutingClient(c *conftypes.CompletionsConfig) (*routingClient, error) {
+	primary, err := getBasic(c.Endpoint, c.Provider, c.AccessToken, c.OpenAICompatibleModels)
+	if err != nil {
+		return nil, err
+	}
+	backends := []*routedBackend{{
+		name:   string(c.Provider),
+		key:    string(c.Provider) + " " + c.Endpoint,
+		client: primary,
+		weight: c.Routing.Weight,
+	}}
+
+	for _, fallback := range c.Routing.Fallbacks {
+		client, err := getBasic(fallback.Endpoint, fallback.Provider, fallback.AccessToken, c.OpenAICompatibleModels)
+		if err != nil {
+			return nil, err
+		}
+		backends = append(backends, &routedBackend{
+			name:   string(fallback.Provider),
+			key:    string(fallback.Provider) + " " + fallback.Endpoint,
+			client: client,
+			// Chat takes precedence if the primary provider uses the same
+			// model for multiple features.
+			models: map[string]string{
+				c.CompletionModel: fallback.CompletionModel,
+				c.FastChatModel:   fallback.FastChatModel,
+				c.ChatModel:       fallback.ChatModel,
+			},
+			weight: fallback.Weight,
+		})
+	}
+
+	return &routingClient{
+		backends:         backends,
+		strategy:         c.Routing.Strategy,
+		failureThreshold: c.Routing.FailureThreshold,
+		cooldown:         c.Routing.Cooldown,
+		health:           backendHealth,
+		intn:             rand.Intn,
+	}, nil
+}
+
+func (c *routingClient) Stream(ctx context.Context, feature types.CompletionsFeature, params types.CompletionRequestParameters, send types.SendCompletionEvent) (err error) {
+	for _, b := range c.order() {
+		sent := false
+		err = b.client.Stream(ctx, feature, b.params(params), func(event types.CompletionResponse) error {
+			sent = true
+			return send(event)
+		})
+		// Once events have been sent to the caller, we cannot hand the request
+		// to another provider anymore.
+		if !c.shouldFailover(ctx, b, feature, err) || sent {
+			return err
+		}
+	}
+	return err
+}
+
+func (c *routingClient) Complete(ctx context.Context, feature types.CompletionsFeature, params types.CompletionRequestParameters) (resp *types.CompletionResponse, err error) {
+	for _, b := range c.order() {
+		resp, err = b.client.Complete(ctx, feature, b.params(params))
+		if !c.shouldFailover(ctx, b, feature, err) {
+			return resp, err
+		}
+	}
+	return nil, err
+}
+
+// shouldFailover records the outcome of a request to the given backend and
+// returns whether the request should be retried with the next backend.
+func (c *routingClient) shouldFailover(ctx context.Context, b *routedBackend, feature types.CompletionsFeature, err error) bool {
+	if err == nil {
+		c.health.recordSuccess(b.key)
+		routedRequests.WithLabelValues(b.name, string(feature), outcomeSuccess).Inc()
+		return false
+	}
+
+	if !isRetryable(err) || ctx.Err() != nil {
+		routedRequests.WithLabelValues(b.name, string(feature), outcomeError).Inc()
+		return false
+	}
+
+	c.health.recordFailure(b.key, c.failureThreshold, c.cooldown)
+	routedRequests.WithLabelValues(b.name, string(feature), outcomeFailover).Inc()
+	return true
+}
+
+// isRetryable returns whether a request that failed with the given error may
+// be retried with another backend: either the backend responded with a
+// retryable status, or it could not be reached at all, for example because the
+// connection was refused or its name could not be resolved.
+func isRetryable(err error) bool {
+	if errNotOK, ok := types.IsErrStatusNotOK(err); ok {
+		return errNotOK.IsRetryable()
+	}
+	if errors.IsContextError(err) {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// order returns the backends in the order they should be tried for a single
+// request. Healthy backends always come before unhealthy ones.
+func (c *routingClient) order() []*routedBackend {
+	var ordered []*routedBackend
+	if c.strategy == conftypes.CompletionsRoutingStrategyWeighted {
+		ordered = c.weightedOrder()
+	} else {
+		ordered = c.backends
+	}
+
+	healthy := make([]*routedBackend, 0, len(ordered))
+	var unhealthy []*routedBackend
+	for _, b := range ordered {
+		if c.health.isHealthy(b.key) {
+			healthy = append(healthy, b)
+		} else {
+			unhealthy = append(unhealthy, b)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// weightedOrder returns the backends in random order, where each backend is
+// picked next with a probability proportional to its weight.
+func (c *routingClient) weightedOrder() []*routedBackend {
+	remaining := make([]*routedBackend, len(c.backends))
+	copy(remaining, c.backends)
+
+	ordered := make([]*routedBackend, 0, len(remaining))
+	for len(remaining) > 0 {
+		total := 0
+		for _, b := range remaining {
+			total += b.weight
+		}
+		pick := c.intn(total)
+		for i, b := range remaining {
+			if pick < b.weight {
+				ordered = append(ordered, b)
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+			pick -= b.weight
+		}
+	}
+	return ordered
+}
+
+// healthTracker tracks consecutive retryable failures per backend.
+type healthTracker struct {
+	mu       sync.Mutex
+	backends map[string]*healthState
+	now      func() time.Time
+}
+
+type healthState struct {
+	consecutiveFailures int
+	unhealthyUntil      time.Time
+}
+
+func newHealthTracker() *healthTracker {
+	return &healthTracker{
+		backends: make(map[string]*healthState),
+		now:      time.Now,
+	}
+}
+
+func (h *healthTracker) isHealthy(key string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	state, ok := h.backends[key]
+	return !ok || !h.now().Before(state.unhealthyUntil)
+}
+
+func (h *healthTracker) recordSuccess(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.backends, key)
+}
+
+func (h *healthTracker) recordFailure(key string, threshold int, cooldown time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	state, ok := h.backends[key]
+	if !ok {
+		state = &healthState{}
+		h.backends[key] = state
+	}
+	state.consecutiveFailures++
+	if state.consecutiveFailures >= threshold {
+		state.unhealthyUntil = h.now().Add(cooldown)
+		// Give the backend a fresh start once the cooldown has passed.
+		state.consecutiveFailures = 0
+	}
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+)
+
+type fakeClient struct {
+	// statusCode is the status code to fail with, or 0 to succeed.
+	statusCode int
+	// failErr is returned instead of a status code error, if set.
+	failErr error
+	models  []string
+}
+
+func (c *fakeClient) Stream(_ context.Context, _ types.CompletionsFeature, params types.CompletionRequestParameters, send types.SendCompletionEvent) error {
+	c.models = append(c.models, params.Model)
+	if c.statusCode != 0 || c.failErr != nil {
+		return c.err()
+	}
+	return send(types.CompletionResponse{Completion: params.Model})
+}
+
+func (c *fakeClient) Complete(_ context.Context, _ types.CompletionsFeature, params types.CompletionRequestParameters) (*types.CompletionResponse, error) {
+	c.models = append(c.models, params.Model)
+	if c.statusCode != 0 || c.failErr != nil {
+		return nil, c.err()
+	}
+	return &types.CompletionResponse{Completion: params.Model}, nil
+}
+
+func (c *fakeClient) err() error {
+	if c.failErr != nil {
+		return c.failErr
+	}
+	rec := httptest.NewRecorder()
+	rec.WriteHeader(c.statusCode)
+	return types.NewErrStatusNotOK("fake", rec.Result())
+}
+
+func newTestRoutingClient(strategy conftypes.CompletionsRoutingStrategy, clients ...*fakeClient) *routingClient {
+	c := &routingClient{
+		strategy:         strategy,
+		failureThreshold: 2,
+		cooldown:         time.Minute,
+		health:           newHealthTracker(),
+		intn:             func(int) int { return 0 },
+	}
+	for i, client := range clients {
+		name := string(rune('a' + i))
+		c.backends = append(c.backends, &routedBackend{
+			name:   name,
+			key:    name,
+			client: client,
+			models: map[string]string{"chat": "chat-" + name},
+			weight: 1,
+		})
+	}
+	return c
+}
+
+func TestRoutingClient(t *testing.T) {
+	ctx := context.Background()
+	params := types.CompletionRequestParameters{Model: "chat"}
+
+	t.Run("uses primary if healthy", func(t *testing.T) {
+		primary, fallback := &fakeClient{}, &fakeClient{}
+		c := newTestRoutingClient(conftypes.CompletionsRoutingStrategyOrdered, primary, fallback)
+
+		resp, err := c.Complete(ctx, types.CompletionsFeatureChat, params)
+		require.NoError(t, err)
+		assert.Equal(t, "chat-a", resp.Completion)
+		assert.Empty(t, fallback.models)
+	})
+
+	t.Run("fails over on retryable errors", func(t *testing.T) {
+		primary, fallback := &fakeClient{statusCode: http.StatusTooManyRequests}, &fakeClient{}
+		c := newTestRoutingClient(conftypes.CompletionsRoutingStrategyOrdered, primary, fallback)
+
+		resp, err := c.Complete(ctx, types.CompletionsFeatureChat, params)
+		require.NoError(t, err)
+		assert.Equal(t, "chat-b", resp.Completion)
+	})
+
+	t.Run("fails over on transport errors", func(t *testing.T) {
+		dialErr := &url.Error{Op: "Post", URL: "https://a", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}
+		primary, fallback := &fakeClient{failErr: dialErr}, &fakeClient{}
+		c := newTestRoutingClient(conftypes.CompletionsRoutingStrategyOrdered, primary, fallback)
+
+		for i := 0; i < 2; i++ {
+			resp, err := c.Complete(ctx, types.CompletionsFeatureChat, params)
+			require.NoError(t, err)
+			assert.Equal(t, "chat-b", resp.Completion)
+		}
+		// The failures count towards the health of the primary.
+		assert.False(t, c.health.isHealthy("a"))
+	})
+
+	t.Run("does not fail over on context errors", func(t *testing.T) {
+		primary, fallback := &fakeClient{failErr: &url.Error{Op: "Post", URL: "https://a", Err: context.DeadlineExceeded}}, &fakeClient{}
+		c := newTestRoutingClient(conftypes.CompletionsRoutingStrategyOrdered, primary, fallback)
+
+		_, err := c.Complete(ctx, types.CompletionsFeatureChat, params)
+		require.Error(t, err)
+		assert.Empty(t, fallback.models)
+		assert.True(t, c.health.isHealthy("a"))
+	})
+
+	t.Run("does not fail over on other errors", func(t *testing.T) {
+		primary, fallback := &fakeClient{statusCode: http.StatusBadRequest}, &fakeClient{}
+		c := newTestRoutingClient(conftypes.CompletionsRoutingStrategyOrdered, primary, fallback)
+
+		_, err := c.Complete(ctx, types.CompletionsFeatureChat, params)
+		errNotOK, ok := types.IsErrStatusNotOK(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, errNotOK.StatusCode())
+		assert.Empty(t, fallback.models)
+	})
+
+	t.Run("returns last error if all providers fail", func(t *testing.T) {
+		primary, fallback := &fakeClient{statusCode: http.StatusServiceUnavailable}, &fakeClient{statusCode: http.StatusTooManyRequests}
+		c := newTestRoutingClient(conftypes.CompletionsRoutingStrategyOrdered, primary, fallback)
+
+		err := c.Stream(ctx, types.CompletionsFeatureChat, params, func(types.CompletionResponse) error { return nil })
+		errNotOK, ok := types.IsErrStatusNotOK(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusTooManyRequests, errNotOK.StatusCode())
+	})
+
+	t.Run("skips unhealthy providers until cooldown passed", func(t *testing.T) {
+		primary, fallback := &fakeClient{statusCode: http.StatusBadGateway}, &fakeClient{}
+		c := newTestRoutingClient(conftypes.CompletionsRoutingStrategyOrdered, primary, fallback)
+		now := time.Now()
+		c.health.now = func() time.Time { return now }
+
+		for i := 0; i < 3; i++ {
+			_, err := c.Complete(ctx, types.CompletionsFeatureChat, params)
+			require.NoError(t, err)
+		}
+		// The primary provider is unhealthy after the second failure.
+		assert.Len(t, primary.models, 2)
+		assert.Len(t, fallback.models, 3)
+
+		now = now.Add(2 * time.Minute)
+		primary.statusCode = 0
+		resp, err := c.Complete(ctx, types.CompletionsFeatureChat, params)
+		require.NoError(t, err)
+		assert.Equal(t, "chat-a", resp.Completion)
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		primary, fallback := &fakeClient{}, &fakeClient{}
+		c := newTestRoutingClient(conftypes.CompletionsRoutingStrategyWeighted, primary, fallback)
+		c.backends[1].weight = 3
+		// Picks within the weight of the second backend.
+		c.intn = func(n int) int { return n - 1 }
+
+		resp, err := c.Complete(ctx, types.CompletionsFeatureChat, params)
+		require.NoError(t, err)
+		assert.Equal(t, "chat-b", resp.Completion)
+		assert.Empty(t, primary.models)
+	})
+
+	t.Run("passes through unknown models", func(t *testing.T) {
+		primary := &fakeClient{}
+		c := newTestRoutingClient(conftypes.CompletionsRoutingStrategyOrdered, primary)
+
+		resp, err := c.Complete(ctx, types.CompletionsFeatureCode, types.CompletionRequestParameters{Model: "other"})
+		require.NoError(t, err)
+		assert.Equal(t, "other", resp.Completion)
+	})
+}
@@ -71,28 +71,31 @@ func newCompletionsHandler(
 			Build()
 		defer done()
 
-		completionClient, err := client.Get(
-			completionsConfig.Endpoint,
-			completionsConfig.Provider,
-			completionsConfig.AccessToken,
-		)
+		completionClient, err := client.GetFromConfig(completionsConfig)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 
 		// Check rate limit.
-		err = rl.TryAcquire(ctx)
+		commit, err := rl.TryAcquire(ctx)
 		if err != nil {
 			if unwrap, ok := err.(RateLimitExceededError); ok {
 				respondRateLimited(w, unwrap)
 				return
 			}
+			if unwrap, ok := err.(UsageBudgetExceededError); ok {
+				respondBudgetExceeded(w, unwrap)
+				return
+			}
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 
-		responseHandler(ctx, requestParams.CompletionRequestParameters, completionClient, w)
+		completion := responseHandler(ctx, requestParams.CompletionRequestParameters, completionClient, w)
+		if err := commit(requestParams.CompletionRequestParameters, completion); err != nil {
+			trace.Logger(ctx, logger).Error("failed to record completions usage", log.Error(err))
+		}
 	})
 }
 
@@ -104,6 +107,11 @@ func respondRateLimited(w http.ResponseWriter, err RateLimitExceededError) {
 	http.Error(w, err.Error(), http.StatusTooManyRequests)
 }
 
+func respondBudgetExceeded(w http.ResponseWriter, err UsageBudgetExceededError) {
+	w.Header().Set("retry-after", err.RetryAfter.Format(time.RFC1123))
+	http.Error(w, err.Error(), http.StatusTooManyRequests)
+}
+
 func max(a, b int) int {
 	if a > b {
 		return a
@@ -113,26 +121,26 @@ func max(a, b int) int {
 
 // newSwitchingResponseHandler handles requests to an LLM provider, and wraps the correct
 // handler based on the requestParams.Stream flag.
-func newSwitchingResponseHandler(logger log.Logger, feature types.CompletionsFeature) func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) {
+func newSwitchingResponseHandler(logger log.Logger, feature types.CompletionsFeature) func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) *types.CompletionResponse {
 	nonStreamer := newNonStreamingResponseHandler(logger, feature)
 	streamer := newStreamingResponseHandler(logger, feature)
-	return func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) {
+	return func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) *types.CompletionResponse {
 		if requestParams.IsStream(feature) {
-			streamer(ctx, requestParams, cc, w)
-		} else {
-			nonStreamer(ctx, requestParams, cc, w)
+			return streamer(ctx, requestParams, cc, w)
 		}
+		return nonStreamer(ctx, requestParams, cc, w)
 	}
 }
 
 // newStreamingResponseHandler handles streaming requests to an LLM provider,
-// It writes events to an SSE stream as they come in.
-func newStreamingResponseHandler(logger log.Logger, feature types.CompletionsFeature) func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) {
-	return func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) {
+// It writes events to an SSE stream as they come in, and returns the last
+// event received.
+func newStreamingResponseHandler(logger log.Logger, feature types.CompletionsFeature) func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) *types.CompletionResponse {
+	return func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) *types.CompletionResponse {
 		eventWriter, err := streamhttp.NewWriter(w)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+			return nil
 		}
 
 		// Always send a final done event so clients know the stream is shutting down.
@@ -140,8 +148,10 @@ func newStreamingResponseHandler(logger log.Logger, feature types.CompletionsFea
 			_ = eventWriter.Event("done", map[string]any{})
 		}()
 
+		var last *types.CompletionResponse
 		err = cc.Stream(ctx, feature, requestParams,
 			func(event types.CompletionResponse) error {
+				last = &event
 				return eventWriter.Event("completion", event)
 			})
 		if err != nil {
@@ -164,16 +174,16 @@ func newStreamingResponseHandler(logger log.Logger, feature types.CompletionsFea
 			if err := eventWriter.Event("error", map[string]string{"error": err.Error()}); err != nil {
 				l.Error("error reporting streaming completion error", log.Error(err))
 			}
-			return
 		}
+		return last
 	}
 }
 
 // newNonStreamingResponseHandler handles non-streaming requests to an LLM provider,
 // awaiting the complete response before writing it back in a structured JSON response
-// to the client.
-func newNonStreamingResponseHandler(logger log.Logger, feature types.CompletionsFeature) func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) {
-	return func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) {
+// to the client. It returns the completion, or nil if the request failed.
+func newNonStreamingResponseHandler(logger log.Logger, feature types.CompletionsFeature) func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) *types.CompletionResponse {
+	return func(ctx context.Context, requestParams types.CompletionRequestParameters, cc types.CompletionsClient, w http.ResponseWriter) *types.CompletionResponse {
 		completion, err := cc.Complete(ctx, feature, requestParams)
 		if err != nil {
 			logFields := []log.Field{log.Error(err)}
@@ -192,14 +202,15 @@ func newNonStreamingResponseHandler(logger log.Logger, feature types.Completions
 			_, _ = w.Write([]byte(err.Error()))
 
 			trace.Logger(ctx, logger).Error("error on completion", logFields...)
-			return
+			return nil
 		}
 
 		completionBytes, err := json.Marshal(completion)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+			return completion
 		}
 		_, _ = w.Write(completionBytes)
+		return completion
 	}
 }
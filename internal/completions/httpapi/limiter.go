@@ -11,6 +11,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/completions/types"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/redispool"
 	"github.com/sourcegraph/sourcegraph/internal/requestclient"
@@ -18,7 +19,10 @@ import (
 )
 
 type RateLimiter interface {
-	TryAcquire(ctx context.Context) error
+	// TryAcquire checks the request rate limit and the usage budgets of the
+	// current actor. If the request is allowed, it returns a commit function
+	// that must be called once the request has completed to record its usage.
+	TryAcquire(ctx context.Context) (UsageCommitFunc, error)
 }
 
 type RateLimitExceededError struct {
@@ -42,7 +46,69 @@ type rateLimiter struct {
 	db     database.DB
 }
 
-func (r *rateLimiter) TryAcquire(ctx context.Context) (err error) {
+func (r *rateLimiter) TryAcquire(ctx context.Context) (UsageCommitFunc, error) {
+	// Budgets are checked first, as they don't consume anything.
+	commit, err := r.tryAcquireUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.tryAcquireRequest(ctx); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+// tryAcquireUsage checks the token and cost budgets of the current actor, and
+// returns a function that records the usage of the request once it completed.
+func (r *rateLimiter) tryAcquireUsage(ctx context.Context) (UsageCommitFunc, error) {
+	if actor.FromContext(ctx).IsInternal() {
+		return func(types.CompletionRequestParameters, *types.CompletionResponse) error { return nil }, nil
+	}
+
+	cfg := conf.GetCompletionsConfig(conf.Get().SiteConfig())
+	var (
+		budgets []conftypes.CompletionsUsageBudget
+		prices  map[string]conftypes.CompletionsModelPrice
+		reports bool
+	)
+	if cfg != nil {
+		budgets, prices, reports = cfg.UsageBudgets, cfg.ModelPrices, cfg.UsageReports
+	}
+
+	// Usage only needs to be counted if it's limited or reported on.
+	if len(budgets) == 0 && !reports {
+		return func(types.CompletionRequestParameters, *types.CompletionResponse) error { return nil }, nil
+	}
+
+	withOrgs := reports
+	for _, b := range budgets {
+		if b.Scope == conftypes.CompletionsUsageBudgetScopeOrg {
+			withOrgs = true
+		}
+	}
+	subjects, err := usageSubjects(ctx, r.db, withOrgs)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUsageBudgets(r.rstore.WithContext(ctx), budgets, subjects, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return func(requestParams types.CompletionRequestParameters, completion *types.CompletionResponse) error {
+		if completion == nil {
+			return nil
+		}
+		usage := completionUsage(requestParams, completion)
+		cost := usageCostMicroUSD(prices, requestParams.Model, usage)
+		// The request context may already be cancelled when the client went
+		// away, but the provider still charged for the request.
+		return recordUsage(r.rstore, subjects, usage.TotalTokens(), cost, time.Now())
+	}, nil
+}
+
+// tryAcquireRequest checks and increments the daily request count of the
+// current actor.
+func (r *rateLimiter) tryAcquireRequest(ctx context.Context) (err error) {
 	limit, err := getConfiguredLimit(ctx, r.db, r.scope)
 	if err != nil {
 		return errors.Wrap(err, "failed to read configured rate limit")
@@ -61,17 +127,7 @@ func (r *rateLimiter) TryAcquire(ctx context.Context) (err error) {
 	key := userKey(a.UID, r.scope)
 	if !a.IsAuthenticated() {
 		// Fall back to the IP address, if provided in context (ie. this is a request handler).
-		req := requestclient.FromContext(ctx)
-		var ip string
-		if req != nil {
-			ip = req.IP
-			// Note: ForwardedFor header in general can be spoofed. For
-			// Sourcegraph.com we use a trusted value for this so this is a
-			// reliable value to rate limit with.
-			if req.ForwardedFor != "" {
-				ip = req.ForwardedFor
-			}
-		}
+		ip := actorIP(ctx)
 		if ip == "" {
 			return errors.Wrap(auth.ErrNotAuthenticated, "cannot claim rate limit for unauthenticated user without request context")
 		}
@@ -146,6 +202,22 @@ func (r *rateLimiter) TryAcquire(ctx context.Context) (err error) {
 	return nil
 }
 
+// actorIP returns the IP address of the client making the request, or an empty
+// string if it is not known.
+func actorIP(ctx context.Context) string {
+	req := requestclient.FromContext(ctx)
+	if req == nil {
+		return ""
+	}
+	// Note: ForwardedFor header in general can be spoofed. For
+	// Sourcegraph.com we use a trusted value for this so this is a
+	// reliable value to rate limit with.
+	if req.ForwardedFor != "" {
+		return req.ForwardedFor
+	}
+	return req.IP
+}
+
 func userKey(userID int32, scope types.CompletionsFeature) string {
 	return fmt.Sprintf("user:%d:%s_requests", userID, scope)
 }
@@ -0,0 +1,474 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// UsageCommitFunc records the usage of a completed completions request against
+// the configured usage budgets. completion is the final, or last streamed,
+// response. Nothing is recorded if it is nil, which means the request failed
+// before producing any output.
+type UsageCommitFunc func(requestParams types.CompletionRequestParameters, completion *types.CompletionResponse) error
+
+// UsageBudgetExceededError is returned by RateLimiter.TryAcquire when a token
+// or cost budget has been used up.
+type UsageBudgetExceededError struct {
+	Scope      conftypes.CompletionsUsageBudgetScope
+	Period     conftypes.CompletionsUsageBudgetPeriod
+	Unit       string
+	Limit      float64
+	Used       float64
+	RetryAfter time.Time
+}
+
+func (e UsageBudgetExceededError) Error() string {
+	return fmt.Sprintf("you exceeded the %s completions budget of %s %s per %s. Current usage: %s %s. Retry after %s",
+		e.Scope, formatBudgetAmount(e.Limit), e.Unit, e.Period, formatBudgetAmount(e.Used), e.Unit, e.RetryAfter.Truncate(time.Second))
+}
+
+func formatBudgetAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+const (
+	usageUnitTokens = "tokens"
+	usageUnitUSD    = "USD"
+)
+
+// usageSubject is a user, org or the whole site whose usage is counted.
+type usageSubject struct {
+	scope conftypes.CompletionsUsageBudgetScope
+	// id is the user or org ID, "anon:<ip>" for anonymous users, or empty for
+	// the site.
+	id string
+}
+
+// usageSubjects returns all the subjects the usage of the current actor is
+// counted against. The organizations of the actor are only included if
+// withOrgs is true.
+func usageSubjects(ctx context.Context, db database.DB, withOrgs bool) ([]usageSubject, error) {
+	subjects := []usageSubject{{scope: conftypes.CompletionsUsageBudgetScopeSite}}
+
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		if ip := actorIP(ctx); ip != "" {
+			subjects = append(subjects, usageSubject{scope: conftypes.CompletionsUsageBudgetScopeUser, id: "anon:" + ip})
+		}
+		return subjects, nil
+	}
+
+	subjects = append(subjects, usageSubject{scope: conftypes.CompletionsUsageBudgetScopeUser, id: strconv.Itoa(int(a.UID))})
+	if !withOrgs {
+		return subjects, nil
+	}
+	orgs, err := db.Orgs().GetByUserID(ctx, a.UID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list organizations of user")
+	}
+	for _, org := range orgs {
+		subjects = append(subjects, usageSubject{scope: conftypes.CompletionsUsageBudgetScopeOrg, id: strconv.Itoa(int(org.ID))})
+	}
+	return subjects, nil
+}
+
+var usagePeriods = []conftypes.CompletionsUsageBudgetPeriod{
+	conftypes.CompletionsUsageBudgetPeriodDay,
+	conftypes.CompletionsUsageBudgetPeriodMonth,
+}
+
+// usagePeriodBounds returns the start and end of the calendar period, in UTC,
+// that contains now.
+func usagePeriodBounds(period conftypes.CompletionsUsageBudgetPeriod, now time.Time) (start, end time.Time) {
+	now = now.UTC()
+	if period == conftypes.CompletionsUsageBudgetPeriodMonth {
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	}
+	start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 0, 1)
+}
+
+// usageIndexKey is the key of the set of subjects of a scope that have usage
+// in the given period.
+func usageIndexKey(period conftypes.CompletionsUsageBudgetPeriod, start time.Time, scope conftypes.CompletionsUsageBudgetScope) string {
+	return fmt.Sprintf("completions_usage:%s:%s:%s", period, start.Format("2006-01-02"), scope)
+}
+
+func usageTokensKey(period conftypes.CompletionsUsageBudgetPeriod, start time.Time, subject usageSubject) string {
+	return fmt.Sprintf("%s:%s:tokens", usageIndexKey(period, start, subject.scope), subject.id)
+}
+
+// usageCostKey is the key of the cost counter of a subject, in micro-USD so
+// that it can be incremented atomically as an integer.
+func usageCostKey(period conftypes.CompletionsUsageBudgetPeriod, start time.Time, subject usageSubject) string {
+	return fmt.Sprintf("%s:%s:cost", usageIndexKey(period, start, subject.scope), subject.id)
+}
+
+const microUSD = 1_000_000
+
+// checkUsageBudgets returns a UsageBudgetExceededError if any of the budgets
+// that apply to the given subjects is used up.
+func checkUsageBudgets(rstore redispool.KeyValue, budgets []conftypes.CompletionsUsageBudget, subjects []usageSubject, now time.Time) error {
+	type usageLimit struct {
+		budget conftypes.CompletionsUsageBudget
+		end    time.Time
+		unit   string
+	}
+
+	// Collect all the counters first, so that they can be read at once.
+	var (
+		limits []usageLimit
+		keys   []string
+	)
+	for _, budget := range budgets {
+		start, end := usagePeriodBounds(budget.Period, now)
+		for _, subject := range subjects {
+			if subject.scope != budget.Scope {
+				continue
+			}
+			if budget.MaxTokens > 0 {
+				limits = append(limits, usageLimit{budget: budget, end: end, unit: usageUnitTokens})
+				keys = append(keys, usageTokensKey(budget.Period, start, subject))
+			}
+			if budget.MaxCostUSD > 0 {
+				limits = append(limits, usageLimit{budget: budget, end: end, unit: usageUnitUSD})
+				keys = append(keys, usageCostKey(budget.Period, start, subject))
+			}
+		}
+	}
+
+	used, err := getUsageCounters(rstore, keys)
+	if err != nil {
+		return err
+	}
+
+	for i, l := range limits {
+		var limit, usage float64
+		if l.unit == usageUnitTokens {
+			limit, usage = float64(l.budget.MaxTokens), float64(used[i])
+		} else {
+			limit, usage = l.budget.MaxCostUSD, float64(used[i])/microUSD
+		}
+		if usage >= limit {
+			return UsageBudgetExceededError{
+				Scope:      l.budget.Scope,
+				Period:     l.budget.Period,
+				Unit:       l.unit,
+				Limit:      limit,
+				Used:       usage,
+				RetryAfter: l.end,
+			}
+		}
+	}
+	return nil
+}
+
+func getUsageCounter(rstore redispool.KeyValue, key string) (int, error) {
+	v, err := rstore.Get(key).Int()
+	if err != nil && err != redis.ErrNil {
+		return 0, errors.Wrap(err, "failed to read usage counter")
+	}
+	return v, nil
+}
+
+// getUsageCounters returns the values of the given counters, reading them in
+// a single round trip if rstore is backed by redis.
+func getUsageCounters(rstore redispool.KeyValue, keys []string) ([]int, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	pool, ok := rstore.Pool()
+	if !ok {
+		values := make([]int, len(keys))
+		for i, key := range keys {
+			v, err := getUsageCounter(rstore, key)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		return values, nil
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	args := make([]any, len(keys))
+	for i, key := range keys {
+		args[i] = key
+	}
+	replies, err := redis.Values(conn.Do("MGET", args...))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read usage counters")
+	}
+	values := make([]int, len(keys))
+	for i, reply := range replies {
+		if reply == nil {
+			continue
+		}
+		if values[i], err = redis.Int(reply, nil); err != nil {
+			return nil, errors.Wrap(err, "failed to read usage counter")
+		}
+	}
+	return values, nil
+}
+
+// recordUsage adds the given usage to the counters of all subjects, for both
+// the daily and monthly periods.
+func recordUsage(rstore redispool.KeyValue, subjects []usageSubject, tokens, costMicroUSD int, now time.Time) error {
+	var (
+		increments []usageIncrement
+		entries    []usageIndexEntry
+	)
+	for _, period := range usagePeriods {
+		start, end := usagePeriodBounds(period, now)
+		// Keep the counters around until the period has ended. Since the
+		// expiry only depends on the end of the period, it can be set on every
+		// update.
+		ttl := int(end.Sub(now) / time.Second)
+		if ttl <= 0 {
+			ttl = 1
+		}
+
+		for _, subject := range subjects {
+			increments = append(increments,
+				usageIncrement{key: usageTokensKey(period, start, subject), value: tokens, ttl: ttl},
+				usageIncrement{key: usageCostKey(period, start, subject), value: costMicroUSD, ttl: ttl},
+			)
+
+			// Remember the subject so that it shows up in usage reports.
+			if subject.id != "" {
+				entries = append(entries, usageIndexEntry{key: usageIndexKey(period, start, subject.scope), id: subject.id, ttl: ttl})
+			}
+		}
+	}
+
+	return writeUsage(rstore, increments, entries)
+}
+
+type usageIncrement struct {
+	key   string
+	value int
+	ttl   int
+}
+
+type usageIndexEntry struct {
+	key string
+	id  string
+	ttl int
+}
+
+// writeUsage applies the given increments and index entries, in a single
+// transaction if rstore is backed by redis.
+func writeUsage(rstore redispool.KeyValue, increments []usageIncrement, entries []usageIndexEntry) error {
+	pool, ok := rstore.Pool()
+	if !ok {
+		for _, inc := range increments {
+			if _, err := rstore.Incrby(inc.key, inc.value); err != nil {
+				return errors.Wrap(err, "failed to increment usage counter")
+			}
+			if err := rstore.Expire(inc.key, inc.ttl); err != nil {
+				return errors.Wrap(err, "failed to set expiry for usage counter")
+			}
+		}
+		for _, e := range entries {
+			if err := rstore.HSet(e.key, e.id, 1); err != nil {
+				return errors.Wrap(err, "failed to update usage index")
+			}
+			if err := rstore.Expire(e.key, e.ttl); err != nil {
+				return errors.Wrap(err, "failed to set expiry for usage index")
+			}
+		}
+		return nil
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	if err := conn.Send("MULTI"); err != nil {
+		return errors.Wrap(err, "failed to record usage")
+	}
+	for _, inc := range increments {
+		if err := conn.Send("INCRBY", inc.key, inc.value); err != nil {
+			return errors.Wrap(err, "failed to record usage")
+		}
+		if err := conn.Send("EXPIRE", inc.key, inc.ttl); err != nil {
+			return errors.Wrap(err, "failed to record usage")
+		}
+	}
+	for _, e := range entries {
+		if err := conn.Send("HSET", e.key, e.id, 1); err != nil {
+			return errors.Wrap(err, "failed to record usage")
+		}
+		if err := conn.Send("EXPIRE", e.key, e.ttl); err != nil {
+			return errors.Wrap(err, "failed to record usage")
+		}
+	}
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return errors.Wrap(err, "failed to record usage")
+	}
+	for _, reply := range replies {
+		if err, ok := reply.(redis.Error); ok {
+			return errors.Wrap(err, "failed to record usage")
+		}
+	}
+	return nil
+}
+
+// completionUsage returns the usage reported by the provider for the given
+// completion, or an estimate based on the length of the prompt and completion
+// if the provider did not report it.
+func completionUsage(requestParams types.CompletionRequestParameters, completion *types.CompletionResponse) types.CompletionUsage {
+	if completion != nil && completion.Usage != nil {
+		return *completion.Usage
+	}
+
+	var usage types.CompletionUsage
+	usage.PromptTokens = estimateTokens(requestParams.Prompt)
+	for _, m := range requestParams.Messages {
+		usage.PromptTokens += estimateTokens(m.Text)
+	}
+	if completion != nil {
+		usage.CompletionTokens = estimateTokens(completion.Completion)
+	}
+	return usage
+}
+
+// estimateTokens approximates the number of tokens in s, assuming 4 characters
+// per token on average.
+func estimateTokens(s string) int {
+	return (len(s) + 3) / 4
+}
+
+// usageCostMicroUSD returns the cost of the given usage of model in micro-USD.
+func usageCostMicroUSD(prices map[string]conftypes.CompletionsModelPrice, model string, usage types.CompletionUsage) int {
+	price, ok := prices[strings.ToLower(model)]
+	if !ok {
+		return 0
+	}
+	cost := float64(usage.PromptTokens)/1000*price.InputPer1KTokensUSD + float64(usage.CompletionTokens)/1000*price.OutputPer1KTokensUSD
+	return int(math.Round(cost * microUSD))
+}
+
+// UsageReport is the completions usage of the site, and of every user and org
+// with usage, in the current period.
+type UsageReport struct {
+	Period      conftypes.CompletionsUsageBudgetPeriod
+	PeriodStart time.Time
+	Site        Usage
+	Users       []SubjectUsage
+	Orgs        []SubjectUsage
+}
+
+// Usage is the usage of a subject in a period, and the budgets that apply to
+// it. A budget of 0 means no budget is configured.
+type Usage struct {
+	Tokens        int
+	CostUSD       float64
+	TokenBudget   int
+	CostBudgetUSD float64
+}
+
+// SubjectUsage is the usage of a single user or org.
+type SubjectUsage struct {
+	ID int32
+	Usage
+}
+
+// GetUsageReport returns the completions usage in the current day or month.
+// Usage of anonymous users is only included in the site usage. Users and orgs
+// are sorted by descending token usage.
+func GetUsageReport(ctx context.Context, rstore redispool.KeyValue, period conftypes.CompletionsUsageBudgetPeriod, now time.Time) (*UsageReport, error) {
+	rstore = rstore.WithContext(ctx)
+	start, _ := usagePeriodBounds(period, now)
+
+	var budgets []conftypes.CompletionsUsageBudget
+	if cfg := conf.GetCompletionsConfig(conf.Get().SiteConfig()); cfg != nil {
+		budgets = cfg.UsageBudgets
+	}
+
+	getUsage := func(subject usageSubject) (Usage, error) {
+		tokens, err := getUsageCounter(rstore, usageTokensKey(period, start, subject))
+		if err != nil {
+			return Usage{}, err
+		}
+		cost, err := getUsageCounter(rstore, usageCostKey(period, start, subject))
+		if err != nil {
+			return Usage{}, err
+		}
+		usage := Usage{Tokens: tokens, CostUSD: float64(cost) / microUSD}
+		for _, b := range budgets {
+			if b.Scope == subject.scope && b.Period == period {
+				usage.TokenBudget = b.MaxTokens
+				usage.CostBudgetUSD = b.MaxCostUSD
+			}
+		}
+		return usage, nil
+	}
+
+	getSubjects := func(scope conftypes.CompletionsUsageBudgetScope) ([]SubjectUsage, error) {
+		ids, err := rstore.HGetAll(usageIndexKey(period, start, scope)).StringMap()
+		if err != nil && err != redis.ErrNil {
+			return nil, errors.Wrap(err, "failed to read usage index")
+		}
+		var usages []SubjectUsage
+		for id := range ids {
+			numericID, err := strconv.ParseInt(id, 10, 32)
+			if err != nil {
+				// Anonymous users.
+				continue
+			}
+			usage, err := getUsage(usageSubject{scope: scope, id: id})
+			if err != nil {
+				return nil, err
+			}
+			usages = append(usages, SubjectUsage{ID: int32(numericID), Usage: usage})
+		}
+		sort.Slice(usages, func(i, j int) bool {
+			if usages[i].Tokens != usages[j].Tokens {
+				return usages[i].Tokens > usages[j].Tokens
+			}
+			return usages[i].ID < usages[j].ID
+		})
+		return usages, nil
+	}
+
+	site, err := getUsage(usageSubject{scope: conftypes.CompletionsUsageBudgetScopeSite})
+	if err != nil {
+		return nil, err
+	}
+	users, err := getSubjects(conftypes.CompletionsUsageBudgetScopeUser)
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := getSubjects(conftypes.CompletionsUsageBudgetScopeOrg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsageReport{
+		Period:      period,
+		PeriodStart: start,
+		Site:        site,
+		Users:       users,
+		Orgs:        orgs,
+	}, nil
+}
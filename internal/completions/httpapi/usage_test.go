@@ -0,0 +1,148 @@
+package httpapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+)
+
+func TestUsageBudgets(t *testing.T) {
+	rstore := redispool.MemoryKeyValue()
+	now := time.Date(2023, 10, 17, 15, 0, 0, 0, time.UTC)
+
+	user := usageSubject{scope: conftypes.CompletionsUsageBudgetScopeUser, id: "1"}
+	org := usageSubject{scope: conftypes.CompletionsUsageBudgetScopeOrg, id: "2"}
+	site := usageSubject{scope: conftypes.CompletionsUsageBudgetScopeSite}
+
+	budgets := []conftypes.CompletionsUsageBudget{
+		{Scope: conftypes.CompletionsUsageBudgetScopeUser, Period: conftypes.CompletionsUsageBudgetPeriodDay, MaxTokens: 1000},
+		{Scope: conftypes.CompletionsUsageBudgetScopeOrg, Period: conftypes.CompletionsUsageBudgetPeriodMonth, MaxCostUSD: 1},
+	}
+
+	require.NoError(t, checkUsageBudgets(rstore, budgets, []usageSubject{site, user, org}, now))
+
+	// Use up the daily token budget of the user.
+	require.NoError(t, recordUsage(rstore, []usageSubject{site, user, org}, 1000, 500_000, now))
+	err := checkUsageBudgets(rstore, budgets, []usageSubject{site, user, org}, now)
+	require.Error(t, err)
+	budgetErr, ok := err.(UsageBudgetExceededError)
+	require.True(t, ok)
+	assert.Equal(t, conftypes.CompletionsUsageBudgetScopeUser, budgetErr.Scope)
+	assert.Equal(t, usageUnitTokens, budgetErr.Unit)
+	assert.Equal(t, time.Date(2023, 10, 18, 0, 0, 0, 0, time.UTC), budgetErr.RetryAfter)
+
+	// Another member of the org is not affected by the user budget.
+	otherUser := usageSubject{scope: conftypes.CompletionsUsageBudgetScopeUser, id: "3"}
+	require.NoError(t, checkUsageBudgets(rstore, budgets, []usageSubject{site, otherUser, org}, now))
+
+	// On the next day, the user budget is reset but the org budget is counted
+	// for the whole month.
+	tomorrow := now.Add(24 * time.Hour)
+	require.NoError(t, checkUsageBudgets(rstore, budgets, []usageSubject{site, user, org}, tomorrow))
+	require.NoError(t, recordUsage(rstore, []usageSubject{site, otherUser, org}, 10, 500_000, tomorrow))
+	err = checkUsageBudgets(rstore, budgets, []usageSubject{site, user, org}, tomorrow)
+	require.Error(t, err)
+	budgetErr, ok = err.(UsageBudgetExceededError)
+	require.True(t, ok)
+	assert.Equal(t, conftypes.CompletionsUsageBudgetScopeOrg, budgetErr.Scope)
+	assert.Equal(t, usageUnitUSD, budgetErr.Unit)
+	assert.Equal(t, float64(1), budgetErr.Used)
+	assert.Equal(t, time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC), budgetErr.RetryAfter)
+}
+
+func TestUsageSkippedWithoutBudgets(t *testing.T) {
+	conf.Mock(&conf.Unified{})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	// The strict mock fails the test if the organizations of the user are
+	// looked up.
+	db := dbmocks.NewStrictMockDB()
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+	r := &rateLimiter{db: db, rstore: redispool.MemoryKeyValue()}
+	commit, err := r.tryAcquireUsage(ctx)
+	require.NoError(t, err)
+	require.NoError(t, commit(types.CompletionRequestParameters{}, &types.CompletionResponse{Completion: "hello"}))
+
+	subjects, err := usageSubjects(ctx, db, false)
+	require.NoError(t, err)
+	assert.Equal(t, []usageSubject{
+		{scope: conftypes.CompletionsUsageBudgetScopeSite},
+		{scope: conftypes.CompletionsUsageBudgetScopeUser, id: "1"},
+	}, subjects)
+}
+
+func TestCompletionUsage(t *testing.T) {
+	params := types.CompletionRequestParameters{
+		Messages: []types.Message{
+			{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: "12345678"},
+			{Speaker: types.ASISSTANT_MESSAGE_SPEAKER, Text: "1234"},
+		},
+	}
+
+	t.Run("reported by provider", func(t *testing.T) {
+		usage := completionUsage(params, &types.CompletionResponse{
+			Completion: "1234",
+			Usage:      &types.CompletionUsage{PromptTokens: 10, CompletionTokens: 20},
+		})
+		assert.Equal(t, types.CompletionUsage{PromptTokens: 10, CompletionTokens: 20}, usage)
+	})
+
+	t.Run("estimated", func(t *testing.T) {
+		usage := completionUsage(params, &types.CompletionResponse{Completion: "12345"})
+		assert.Equal(t, types.CompletionUsage{PromptTokens: 3, CompletionTokens: 2}, usage)
+	})
+}
+
+func TestUsageCostMicroUSD(t *testing.T) {
+	prices := map[string]conftypes.CompletionsModelPrice{
+		"gpt-4": {InputPer1KTokensUSD: 0.03, OutputPer1KTokensUSD: 0.06},
+	}
+	usage := types.CompletionUsage{PromptTokens: 1000, CompletionTokens: 500}
+
+	assert.Equal(t, 60_000, usageCostMicroUSD(prices, "GPT-4", usage))
+	assert.Equal(t, 0, usageCostMicroUSD(prices, "claude-2", usage))
+}
+
+func TestGetUsageReport(t *testing.T) {
+	rstore := redispool.MemoryKeyValue()
+	now := time.Now()
+
+	site := usageSubject{scope: conftypes.CompletionsUsageBudgetScopeSite}
+	require.NoError(t, recordUsage(rstore, []usageSubject{
+		site,
+		{scope: conftypes.CompletionsUsageBudgetScopeUser, id: "1"},
+		{scope: conftypes.CompletionsUsageBudgetScopeOrg, id: "5"},
+	}, 100, 1_500_000, now))
+	require.NoError(t, recordUsage(rstore, []usageSubject{
+		site,
+		{scope: conftypes.CompletionsUsageBudgetScopeUser, id: "2"},
+	}, 300, 0, now))
+	// Anonymous users only count towards the site usage.
+	require.NoError(t, recordUsage(rstore, []usageSubject{
+		site,
+		{scope: conftypes.CompletionsUsageBudgetScopeUser, id: "anon:127.0.0.1"},
+	}, 50, 0, now))
+
+	report, err := GetUsageReport(context.Background(), rstore, conftypes.CompletionsUsageBudgetPeriodMonth, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, Usage{Tokens: 450, CostUSD: 1.5}, report.Site)
+	assert.Equal(t, []SubjectUsage{
+		{ID: 2, Usage: Usage{Tokens: 300}},
+		{ID: 1, Usage: Usage{Tokens: 100, CostUSD: 1.5}},
+	}, report.Users)
+	assert.Equal(t, []SubjectUsage{
+		{ID: 5, Usage: Usage{Tokens: 100, CostUSD: 1.5}},
+	}, report.Orgs)
+}
@@ -70,6 +70,27 @@ func NewErrStatusNotOK(source string, resp *http.Response) error {
 	}
 }
 
+// StatusCode returns the status code the server responded with.
+func (e *ErrStatusNotOK) StatusCode() int { return e.statusCode }
+
+// IsRetryable indicates whether the request that produced this error is safe
+// to retry, possibly against a different provider. This is the case for rate
+// limits and transient upstream failures, but not for errors caused by the
+// request itself, such as authentication or validation failures.
+func (e *ErrStatusNotOK) IsRetryable() bool {
+	switch e.statusCode {
+	case http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
 func IsErrStatusNotOK(err error) (*ErrStatusNotOK, bool) {
 	if err == nil {
 		return nil, false
@@ -76,3 +76,23 @@ func TestErrStatusNotOKWriteHeader503(t *testing.T) {
 	assert.Equal(t, http.StatusServiceUnavailable, writtenResp.StatusCode)
 	assert.Equal(t, resp.Header, writtenResp.Header)
 }
+
+func TestErrStatusNotOKIsRetryable(t *testing.T) {
+	for code, want := range map[int]bool{
+		http.StatusTooManyRequests:     true,
+		http.StatusBadGateway:          true,
+		http.StatusServiceUnavailable:  true,
+		http.StatusGatewayTimeout:      true,
+		http.StatusInternalServerError: true,
+		http.StatusBadRequest:          false,
+		http.StatusUnauthorized:        false,
+		http.StatusNotFound:            false,
+	} {
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(code)
+		errNotOK, ok := IsErrStatusNotOK(NewErrStatusNotOK(t.Name(), rec.Result()))
+		require.True(t, ok)
+		assert.Equal(t, code, errNotOK.StatusCode())
+		assert.Equal(t, want, errNotOK.IsRetryable(), "status code %d", code)
+	}
+}
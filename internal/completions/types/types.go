@@ -100,6 +100,21 @@ func (p *CompletionRequestParameters) Attrs(feature CompletionsFeature) []attrib
 type CompletionResponse struct {
 	Completion string `json:"completion"`
 	StopReason string `json:"stopReason"`
+	// Usage is the number of tokens used by the request, as reported by the
+	// provider. It is nil if the provider does not report usage. It is not
+	// sent to clients.
+	Usage *CompletionUsage `json:"-"`
+}
+
+// CompletionUsage is the number of tokens consumed by a completions request.
+type CompletionUsage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// TotalTokens returns the total number of tokens consumed.
+func (u CompletionUsage) TotalTokens() int {
+	return u.PromptTokens + u.CompletionTokens
 }
 
 type SendCompletionEvent func(event CompletionResponse) error
@@ -620,6 +620,199 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 		completionsConfig.ChatModel = completionsConfig.Model
 	}
 
+	if !applyCompletionsProviderDefaults(completionsConfig, siteConfig) {
+		return nil
+	}
+
+	if completionsConfig.Provider == string(conftypes.CompletionsProviderNameOpenAICompatible) {
+		// Default the limits to the context window from the model catalogue.
+		for _, m := range []struct {
+			model     string
+			maxTokens *int
+		}{
+			{completionsConfig.ChatModel, &completionsConfig.ChatModelMaxTokens},
+			{completionsConfig.FastChatModel, &completionsConfig.FastChatModelMaxTokens},
+			{completionsConfig.CompletionModel, &completionsConfig.CompletionModelMaxTokens},
+		} {
+			if *m.maxTokens == 0 {
+				*m.maxTokens = openAICompatibleContextWindow(completionsConfig.OpenAICompatibleModels, m.model)
+			}
+		}
+	}
+
+	if completionsConfig.ChatModelMaxTokens == 0 {
+		completionsConfig.ChatModelMaxTokens = defaultMaxPromptTokens(conftypes.CompletionsProviderName(completionsConfig.Provider), completionsConfig.ChatModel)
+	}
+
+	if completionsConfig.FastChatModelMaxTokens == 0 {
+		completionsConfig.FastChatModelMaxTokens = defaultMaxPromptTokens(conftypes.CompletionsProviderName(completionsConfig.Provider), completionsConfig.FastChatModel)
+	}
+
+	if completionsConfig.CompletionModelMaxTokens == 0 {
+		completionsConfig.CompletionModelMaxTokens = defaultMaxPromptTokens(conftypes.CompletionsProviderName(completionsConfig.Provider), completionsConfig.CompletionModel)
+	}
+
+	computedConfig := &conftypes.CompletionsConfig{
+		Provider:                         conftypes.CompletionsProviderName(completionsConfig.Provider),
+		AccessToken:                      completionsConfig.AccessToken,
+		ChatModel:                        completionsConfig.ChatModel,
+		ChatModelMaxTokens:               completionsConfig.ChatModelMaxTokens,
+		FastChatModel:                    completionsConfig.FastChatModel,
+		FastChatModelMaxTokens:           completionsConfig.FastChatModelMaxTokens,
+		CompletionModel:                  completionsConfig.CompletionModel,
+		CompletionModelMaxTokens:         completionsConfig.CompletionModelMaxTokens,
+		Endpoint:                         completionsConfig.Endpoint,
+		PerUserDailyLimit:                completionsConfig.PerUserDailyLimit,
+		PerUserCodeCompletionsDailyLimit: completionsConfig.PerUserCodeCompletionsDailyLimit,
+		Routing:                          getCompletionsRoutingConfig(completionsConfig.Routing, siteConfig),
+		OpenAICompatibleModels:           getOpenAICompatibleModels(completionsConfig.OpenAICompatibleModels),
+		UsageBudgets:                     getCompletionsUsageBudgets(completionsConfig.UsageBudgets),
+		ModelPrices:                      getCompletionsModelPrices(completionsConfig.ModelPrices),
+		UsageReports:                     completionsConfig.UsageReports,
+	}
+
+	return computedConfig
+}
+
+func getCompletionsUsageBudgets(budgets []*schema.CompletionsUsageBudget) []conftypes.CompletionsUsageBudget {
+	var computed []conftypes.CompletionsUsageBudget
+	for _, b := range budgets {
+		// Budgets without any limit have no effect.
+		if b == nil || (b.MaxTokens <= 0 && b.MaxCostUSD <= 0) {
+			continue
+		}
+		computed = append(computed, conftypes.CompletionsUsageBudget{
+			Scope:      conftypes.CompletionsUsageBudgetScope(b.Scope),
+			Period:     conftypes.CompletionsUsageBudgetPeriod(b.Period),
+			MaxTokens:  b.MaxTokens,
+			MaxCostUSD: b.MaxCostUSD,
+		})
+	}
+	return computed
+}
+
+func getCompletionsModelPrices(prices []*schema.CompletionsModelPrice) map[string]conftypes.CompletionsModelPrice {
+	if len(prices) == 0 {
+		return nil
+	}
+	computed := make(map[string]conftypes.CompletionsModelPrice, len(prices))
+	for _, p := range prices {
+		if p == nil {
+			continue
+		}
+		// Models are always treated case-insensitive.
+		computed[strings.ToLower(p.Model)] = conftypes.CompletionsModelPrice{
+			InputPer1KTokensUSD:  p.InputPer1KTokensUSD,
+			OutputPer1KTokensUSD: p.OutputPer1KTokensUSD,
+		}
+	}
+	return computed
+}
+
+func getOpenAICompatibleModels(models []*schema.OpenAICompatibleModel) []conftypes.OpenAICompatibleModel {
+	var computed []conftypes.OpenAICompatibleModel
+	for _, m := range models {
+		if m == nil {
+			continue
+		}
+		model := conftypes.OpenAICompatibleModel{
+			// Models are always treated case-insensitive.
+			Name:          strings.ToLower(m.Name),
+			ChatTemplate:  m.ChatTemplate,
+			StopSequences: m.StopSequences,
+			ContextWindow: m.ContextWindow,
+			Tokenizer:     m.Tokenizer,
+		}
+		if model.ChatTemplate == "" {
+			model.ChatTemplate = "openai"
+		}
+		if model.Tokenizer == "" {
+			model.Tokenizer = "approximate"
+		}
+		computed = append(computed, model)
+	}
+	return computed
+}
+
+// openAICompatibleContextWindow returns the context window of the given model
+// in the catalogue, or 0 if it is unknown.
+func openAICompatibleContextWindow(models []*schema.OpenAICompatibleModel, model string) int {
+	for _, m := range models {
+		if m != nil && strings.EqualFold(m.Name, model) {
+			return m.ContextWindow
+		}
+	}
+	return 0
+}
+
+// getCompletionsRoutingConfig evaluates the routing configuration of the
+// completions providers. Fallbacks that are misconfigured are skipped, and nil
+// is returned if no usable fallback remains.
+func getCompletionsRoutingConfig(routing *schema.CompletionsRouting, siteConfig schema.SiteConfiguration) *conftypes.CompletionsRoutingConfig {
+	if routing == nil {
+		return nil
+	}
+
+	computed := &conftypes.CompletionsRoutingConfig{
+		Strategy:         conftypes.CompletionsRoutingStrategy(routing.Strategy),
+		Weight:           routing.Weight,
+		FailureThreshold: routing.FailureThreshold,
+		Cooldown:         time.Duration(routing.CooldownSeconds) * time.Second,
+	}
+	if computed.Strategy == "" {
+		computed.Strategy = conftypes.CompletionsRoutingStrategyOrdered
+	}
+	if computed.Weight <= 0 {
+		computed.Weight = 1
+	}
+	if computed.FailureThreshold <= 0 {
+		computed.FailureThreshold = 3
+	}
+	if computed.Cooldown <= 0 {
+		computed.Cooldown = 30 * time.Second
+	}
+
+	for _, fallback := range routing.Fallbacks {
+		if fallback == nil {
+			continue
+		}
+		backendConfig := &schema.Completions{
+			Provider:        fallback.Provider,
+			Endpoint:        fallback.Endpoint,
+			AccessToken:     fallback.AccessToken,
+			ChatModel:       fallback.ChatModel,
+			FastChatModel:   fallback.FastChatModel,
+			CompletionModel: fallback.CompletionModel,
+		}
+		if !applyCompletionsProviderDefaults(backendConfig, siteConfig) {
+			continue
+		}
+		weight := fallback.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		computed.Fallbacks = append(computed.Fallbacks, conftypes.CompletionsBackendConfig{
+			Provider:        conftypes.CompletionsProviderName(backendConfig.Provider),
+			Endpoint:        backendConfig.Endpoint,
+			AccessToken:     backendConfig.AccessToken,
+			ChatModel:       backendConfig.ChatModel,
+			FastChatModel:   backendConfig.FastChatModel,
+			CompletionModel: backendConfig.CompletionModel,
+			Weight:          weight,
+		})
+	}
+	if len(computed.Fallbacks) == 0 {
+		return nil
+	}
+
+	return computed
+}
+
+// applyCompletionsProviderDefaults fills in the provider specific default
+// endpoint, access token and models of the given completions configuration. It
+// returns false if the configuration is incomplete and the provider cannot be
+// used.
+func applyCompletionsProviderDefaults(completionsConfig *schema.Completions, siteConfig schema.SiteConfiguration) bool {
 	if completionsConfig.Provider == string(conftypes.CompletionsProviderNameSourcegraph) {
 		// If no endpoint is configured, use a default value.
 		if completionsConfig.Endpoint == "" {
@@ -631,7 +824,7 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 		// If we weren't able to generate an access token of some sort, authing with
 		// Cody Gateway is not possible and we cannot use completions.
 		if completionsConfig.AccessToken == "" {
-			return nil
+			return false
 		}
 
 		// Set a default chat model.
@@ -656,7 +849,7 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 
 		// If not access token is set, we cannot talk to OpenAI. Bail.
 		if completionsConfig.AccessToken == "" {
-			return nil
+			return false
 		}
 
 		// Set a default chat model.
@@ -681,7 +874,7 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 
 		// If not access token is set, we cannot talk to Anthropic. Bail.
 		if completionsConfig.AccessToken == "" {
-			return nil
+			return false
 		}
 
 		// Set a default chat model.
@@ -701,17 +894,17 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 	} else if completionsConfig.Provider == string(conftypes.CompletionsProviderNameAzureOpenAI) {
 		// If no endpoint is configured, this provider is misconfigured.
 		if completionsConfig.Endpoint == "" {
-			return nil
+			return false
 		}
 
 		// If not access token is set, we cannot talk to Azure OpenAI. Bail.
 		if completionsConfig.AccessToken == "" {
-			return nil
+			return false
 		}
 
 		// If not chat model is set, we cannot talk to Azure OpenAI. Bail.
 		if completionsConfig.ChatModel == "" {
-			return nil
+			return false
 		}
 
 		// If not fast chat model is set, we fall back to the Chat Model.
@@ -721,7 +914,7 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 
 		// If not completions model is set, we cannot talk to Azure OpenAI. Bail.
 		if completionsConfig.CompletionModel == "" {
-			return nil
+			return false
 		}
 	} else if completionsConfig.Provider == string(conftypes.CompletionsProviderNameFireworks) {
 		// If no endpoint is configured, use a default value.
@@ -731,7 +924,7 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 
 		// If not access token is set, we cannot talk to Fireworks. Bail.
 		if completionsConfig.AccessToken == "" {
-			return nil
+			return false
 		}
 
 		// Set a default chat model.
@@ -748,10 +941,30 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 		if completionsConfig.CompletionModel == "" {
 			completionsConfig.CompletionModel = "accounts/fireworks/models/starcoder-7b-w8a16"
 		}
+	} else if completionsConfig.Provider == string(conftypes.CompletionsProviderNameOpenAICompatible) {
+		// If no endpoint is configured, this provider is misconfigured. No
+		// access token is required, since self-hosted servers often don't
+		// require authentication.
+		if completionsConfig.Endpoint == "" {
+			return false
+		}
+
+		// If no chat model is set, we cannot know which model to use. Bail.
+		if completionsConfig.ChatModel == "" {
+			return false
+		}
+
+		// If no fast chat or completions model is set, fall back to the chat model.
+		if completionsConfig.FastChatModel == "" {
+			completionsConfig.FastChatModel = completionsConfig.ChatModel
+		}
+		if completionsConfig.CompletionModel == "" {
+			completionsConfig.CompletionModel = completionsConfig.ChatModel
+		}
 	} else if completionsConfig.Provider == string(conftypes.CompletionsProviderNameAWSBedrock) {
 		// If no endpoint is configured, no default available.
 		if completionsConfig.Endpoint == "" {
-			return nil
+			return false
 		}
 
 		// Set a default chat model.
@@ -778,36 +991,10 @@ func GetCompletionsConfig(siteConfig schema.SiteConfiguration) (c *conftypes.Com
 	// If after trying to set default we still have not all models configured, completions are
 	// not available.
 	if completionsConfig.ChatModel == "" || completionsConfig.FastChatModel == "" || completionsConfig.CompletionModel == "" {
-		return nil
-	}
-
-	if completionsConfig.ChatModelMaxTokens == 0 {
-		completionsConfig.ChatModelMaxTokens = defaultMaxPromptTokens(conftypes.CompletionsProviderName(completionsConfig.Provider), completionsConfig.ChatModel)
-	}
-
-	if completionsConfig.FastChatModelMaxTokens == 0 {
-		completionsConfig.FastChatModelMaxTokens = defaultMaxPromptTokens(conftypes.CompletionsProviderName(completionsConfig.Provider), completionsConfig.FastChatModel)
-	}
-
-	if completionsConfig.CompletionModelMaxTokens == 0 {
-		completionsConfig.CompletionModelMaxTokens = defaultMaxPromptTokens(conftypes.CompletionsProviderName(completionsConfig.Provider), completionsConfig.CompletionModel)
-	}
-
-	computedConfig := &conftypes.CompletionsConfig{
-		Provider:                         conftypes.CompletionsProviderName(completionsConfig.Provider),
-		AccessToken:                      completionsConfig.AccessToken,
-		ChatModel:                        completionsConfig.ChatModel,
-		ChatModelMaxTokens:               completionsConfig.ChatModelMaxTokens,
-		FastChatModel:                    completionsConfig.FastChatModel,
-		FastChatModelMaxTokens:           completionsConfig.FastChatModelMaxTokens,
-		CompletionModel:                  completionsConfig.CompletionModel,
-		CompletionModelMaxTokens:         completionsConfig.CompletionModelMaxTokens,
-		Endpoint:                         completionsConfig.Endpoint,
-		PerUserDailyLimit:                completionsConfig.PerUserDailyLimit,
-		PerUserCodeCompletionsDailyLimit: completionsConfig.PerUserCodeCompletionsDailyLimit,
+		return false
 	}
 
-	return computedConfig
+	return true
 }
 
 const embeddingsMaxFileSizeBytes = 1000000
@@ -1112,6 +1299,9 @@ func defaultMaxPromptTokens(provider conftypes.CompletionsProviderName, model st
 		// We cannot know based on the model name what model is actually used,
 		// this is a sane default for GPT in general.
 		return 8_000
+	case conftypes.CompletionsProviderNameOpenAICompatible:
+		// Self-hosted models commonly have a context window of 4k tokens.
+		return 4_000
 	case conftypes.CompletionsProviderNameAWSBedrock:
 		if strings.HasPrefix(model, "anthropic.") {
 			return anthropicDefaultMaxPromptTokens(strings.TrimPrefix(model, "anthropic."))
@@ -654,6 +654,147 @@ func TestGetCompletionsConfig(t *testing.T) {
 				Endpoint:                 "https://api.anthropic.com/v1/complete",
 			},
 		},
+		{
+			name: "openai-compatible",
+			siteConfig: schema.SiteConfiguration{
+				CodyEnabled: pointers.Ptr(true),
+				LicenseKey:  licenseKey,
+				Completions: &schema.Completions{
+					Provider:  "openai-compatible",
+					Endpoint:  "http://vllm:8000/v1",
+					ChatModel: "Mistral",
+					OpenAICompatibleModels: []*schema.OpenAICompatibleModel{
+						{
+							Name:          "mistral",
+							ChatTemplate:  "chatml",
+							ContextWindow: 8000,
+						},
+					},
+				},
+			},
+			wantConfig: &conftypes.CompletionsConfig{
+				ChatModel:                "mistral",
+				ChatModelMaxTokens:       8000,
+				FastChatModel:            "mistral",
+				FastChatModelMaxTokens:   8000,
+				CompletionModel:          "mistral",
+				CompletionModelMaxTokens: 8000,
+				Provider:                 "openai-compatible",
+				Endpoint:                 "http://vllm:8000/v1",
+				OpenAICompatibleModels: []conftypes.OpenAICompatibleModel{
+					{
+						Name:          "mistral",
+						ChatTemplate:  "chatml",
+						ContextWindow: 8000,
+						Tokenizer:     "approximate",
+					},
+				},
+			},
+		},
+		{
+			name: "openai-compatible without chat model",
+			siteConfig: schema.SiteConfiguration{
+				CodyEnabled: pointers.Ptr(true),
+				LicenseKey:  licenseKey,
+				Completions: &schema.Completions{
+					Provider: "openai-compatible",
+					Endpoint: "http://vllm:8000/v1",
+				},
+			},
+			wantDisabled: true,
+		},
+		{
+			name: "anthropic with fallbacks",
+			siteConfig: schema.SiteConfiguration{
+				CodyEnabled: pointers.Ptr(true),
+				LicenseKey:  licenseKey,
+				Completions: &schema.Completions{
+					Provider:    "anthropic",
+					AccessToken: "asdf",
+					Routing: &schema.CompletionsRouting{
+						Strategy: "weighted",
+						Weight:   3,
+						Fallbacks: []*schema.CompletionsBackend{
+							{
+								Provider:    "openai",
+								AccessToken: "qwer",
+								ChatModel:   "GPT-4",
+							},
+							{
+								// Misconfigured fallbacks are skipped.
+								Provider: "azure-openai",
+							},
+						},
+					},
+				},
+			},
+			wantConfig: &conftypes.CompletionsConfig{
+				ChatModel:                "claude-2",
+				ChatModelMaxTokens:       12000,
+				FastChatModel:            "claude-instant-1",
+				FastChatModelMaxTokens:   9000,
+				CompletionModel:          "claude-instant-1",
+				CompletionModelMaxTokens: 9000,
+				AccessToken:              "asdf",
+				Provider:                 "anthropic",
+				Endpoint:                 "https://api.anthropic.com/v1/complete",
+				Routing: &conftypes.CompletionsRoutingConfig{
+					Strategy:         conftypes.CompletionsRoutingStrategyWeighted,
+					Weight:           3,
+					FailureThreshold: 3,
+					Cooldown:         30 * time.Second,
+					Fallbacks: []conftypes.CompletionsBackendConfig{
+						{
+							Provider:        "openai",
+							Endpoint:        "https://api.openai.com/v1/chat/completions",
+							AccessToken:     "qwer",
+							ChatModel:       "gpt-4",
+							FastChatModel:   "gpt-3.5-turbo",
+							CompletionModel: "gpt-3.5-turbo",
+							Weight:          1,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "anthropic with usage budgets",
+			siteConfig: schema.SiteConfiguration{
+				CodyEnabled: pointers.Ptr(true),
+				LicenseKey:  licenseKey,
+				Completions: &schema.Completions{
+					Provider:    "anthropic",
+					AccessToken: "asdf",
+					UsageBudgets: []*schema.CompletionsUsageBudget{
+						{Scope: "user", Period: "day", MaxTokens: 100000},
+						{Scope: "site", Period: "month", MaxCostUSD: 500},
+						// Budgets without a limit are skipped.
+						{Scope: "org", Period: "day"},
+					},
+					ModelPrices: []*schema.CompletionsModelPrice{
+						{Model: "Claude-2", InputPer1KTokensUSD: 0.008, OutputPer1KTokensUSD: 0.024},
+					},
+				},
+			},
+			wantConfig: &conftypes.CompletionsConfig{
+				ChatModel:                "claude-2",
+				ChatModelMaxTokens:       12000,
+				FastChatModel:            "claude-instant-1",
+				FastChatModelMaxTokens:   9000,
+				CompletionModel:          "claude-instant-1",
+				CompletionModelMaxTokens: 9000,
+				AccessToken:              "asdf",
+				Provider:                 "anthropic",
+				Endpoint:                 "https://api.anthropic.com/v1/complete",
+				UsageBudgets: []conftypes.CompletionsUsageBudget{
+					{Scope: conftypes.CompletionsUsageBudgetScopeUser, Period: conftypes.CompletionsUsageBudgetPeriodDay, MaxTokens: 100000},
+					{Scope: conftypes.CompletionsUsageBudgetScopeSite, Period: conftypes.CompletionsUsageBudgetPeriodMonth, MaxCostUSD: 500},
+				},
+				ModelPrices: map[string]conftypes.CompletionsModelPrice{
+					"claude-2": {InputPer1KTokensUSD: 0.008, OutputPer1KTokensUSD: 0.024},
+				},
+			},
+		},
 		{
 			name:       "App but no dotcom username",
 			deployType: deploy.App,
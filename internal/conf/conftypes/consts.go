@@ -17,6 +17,86 @@ type CompletionsConfig struct {
 	Endpoint                         string
 	PerUserDailyLimit                int
 	PerUserCodeCompletionsDailyLimit int
+
+	// Routing configures failover to and load balancing across additional
+	// providers. It is nil if only the primary provider is configured.
+	Routing *CompletionsRoutingConfig
+
+	// OpenAICompatibleModels is the catalogue of models served by the
+	// openai-compatible provider.
+	OpenAICompatibleModels []OpenAICompatibleModel
+
+	// UsageBudgets are the token and cost budgets enforced for completions
+	// requests.
+	UsageBudgets []CompletionsUsageBudget
+
+	// ModelPrices maps lowercase model names to their price, used to compute
+	// the cost of requests.
+	ModelPrices map[string]CompletionsModelPrice
+
+	// UsageReports enables counting the usage of completions requests for
+	// usage reports, even if no usage budgets are configured.
+	UsageReports bool
+}
+
+type CompletionsUsageBudgetScope string
+
+const (
+	CompletionsUsageBudgetScopeUser CompletionsUsageBudgetScope = "user"
+	CompletionsUsageBudgetScopeOrg  CompletionsUsageBudgetScope = "org"
+	CompletionsUsageBudgetScopeSite CompletionsUsageBudgetScope = "site"
+)
+
+type CompletionsUsageBudgetPeriod string
+
+const (
+	CompletionsUsageBudgetPeriodDay   CompletionsUsageBudgetPeriod = "day"
+	CompletionsUsageBudgetPeriodMonth CompletionsUsageBudgetPeriod = "month"
+)
+
+type CompletionsUsageBudget struct {
+	Scope      CompletionsUsageBudgetScope
+	Period     CompletionsUsageBudgetPeriod
+	MaxTokens  int
+	MaxCostUSD float64
+}
+
+type CompletionsModelPrice struct {
+	InputPer1KTokensUSD  float64
+	OutputPer1KTokensUSD float64
+}
+
+type OpenAICompatibleModel struct {
+	Name          string
+	ChatTemplate  string
+	StopSequences []string
+	ContextWindow int
+	Tokenizer     string
+}
+
+type CompletionsRoutingStrategy string
+
+const (
+	CompletionsRoutingStrategyOrdered  CompletionsRoutingStrategy = "ordered"
+	CompletionsRoutingStrategyWeighted CompletionsRoutingStrategy = "weighted"
+)
+
+type CompletionsRoutingConfig struct {
+	Strategy         CompletionsRoutingStrategy
+	Weight           int
+	FailureThreshold int
+	Cooldown         time.Duration
+	Fallbacks        []CompletionsBackendConfig
+}
+
+type CompletionsBackendConfig struct {
+	Provider        CompletionsProviderName
+	Endpoint        string
+	AccessToken     string
+	ChatModel       string
+	FastChatModel   string
+	CompletionModel string
+	Weight          int
 }
 
 type CompletionsProviderName string
@@ -28,6 +108,9 @@ const (
 	CompletionsProviderNameSourcegraph CompletionsProviderName = "sourcegraph"
 	CompletionsProviderNameFireworks   CompletionsProviderName = "fireworks"
 	CompletionsProviderNameAWSBedrock  CompletionsProviderName = "aws-bedrock"
+	// CompletionsProviderNameOpenAICompatible is any self-hosted server that
+	// implements the OpenAI completions APIs.
+	CompletionsProviderNameOpenAICompatible CompletionsProviderName = "openai-compatible"
 )
 
 type EmbeddingsConfig struct {
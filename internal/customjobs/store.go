@@ -0,0 +1,280 @@
+package customjobs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// ErrNotFound is returned when a custom job does not exist or is not visible
+// to the current user.
+var ErrNotFound = errors.New("custom job not found")
+
+var jobColumns = []*sqlf.Query{
+	sqlf.Sprintf("custom_jobs.id"),
+	sqlf.Sprintf("custom_jobs.template"),
+	sqlf.Sprintf("custom_jobs.spec"),
+	sqlf.Sprintf("custom_jobs.repository_id"),
+	sqlf.Sprintf("(SELECT name FROM repo WHERE repo.id = custom_jobs.repository_id)"),
+	sqlf.Sprintf("custom_jobs.commit"),
+	sqlf.Sprintf("custom_jobs.user_id"),
+	sqlf.Sprintf("custom_jobs.state"),
+	sqlf.Sprintf("custom_jobs.failure_message"),
+	sqlf.Sprintf("custom_jobs.queued_at"),
+	sqlf.Sprintf("custom_jobs.started_at"),
+	sqlf.Sprintf("custom_jobs.finished_at"),
+	sqlf.Sprintf("custom_jobs.process_after"),
+	sqlf.Sprintf("custom_jobs.num_resets"),
+	sqlf.Sprintf("custom_jobs.num_failures"),
+	sqlf.Sprintf("custom_jobs.last_heartbeat_at"),
+	sqlf.Sprintf("custom_jobs.execution_logs"),
+	sqlf.Sprintf("custom_jobs.worker_hostname"),
+	sqlf.Sprintf("custom_jobs.cancel"),
+	sqlf.Sprintf("custom_jobs.created_at"),
+	sqlf.Sprintf("custom_jobs.updated_at"),
+}
+
+var workerStoreOptions = dbworkerstore.Options[*Job]{
+	Name:              "custom_jobs_store",
+	TableName:         "custom_jobs",
+	ColumnExpressions: jobColumns,
+	Scan:              dbworkerstore.BuildWorkerScan(scanJob),
+	OrderByExpression: sqlf.Sprintf("custom_jobs.queued_at, custom_jobs.id"),
+	StalledMaxAge:     time.Minute,
+	MaxNumResets:      3,
+	// Custom jobs run user-defined commands that are not guaranteed to be
+	// idempotent, so failed jobs are not retried automatically.
+	MaxNumRetries: 0,
+}
+
+// NewWorkerStore returns a dbworkerstore.Store that wraps the "custom_jobs"
+// table, used by the executor queue and the resetter.
+func NewWorkerStore(observationCtx *observation.Context, handle basestore.TransactableHandle) dbworkerstore.Store[*Job] {
+	return dbworkerstore.New(observationCtx, handle, workerStoreOptions)
+}
+
+// Store reads and writes custom jobs.
+type Store struct {
+	*basestore.Store
+	db database.DB
+}
+
+// NewStore returns a new Store backed by the given database.
+func NewStore(db database.DB) *Store {
+	return &Store{
+		Store: basestore.NewWithHandle(db.Handle()),
+		db:    db,
+	}
+}
+
+// CreateOpts are the options for creating a custom job.
+type CreateOpts struct {
+	Template     schema.CustomJobTemplate
+	RepositoryID api.RepoID
+	Commit       api.CommitID
+	UserID       int32
+}
+
+// Create enqueues a new custom job and returns it.
+func (s *Store) Create(ctx context.Context, opts CreateOpts) (*Job, error) {
+	spec, err := json.Marshal(opts.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(
+		createJobQueryFmtstr,
+		opts.Template.Name,
+		spec,
+		opts.RepositoryID,
+		opts.Commit,
+		dbutil.NullInt32Column(opts.UserID),
+	)))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+const createJobQueryFmtstr = `
+INSERT INTO custom_jobs (template, spec, repository_id, commit, user_id)
+VALUES (%s, %s, %s, %s, %s)
+RETURNING id
+`
+
+// GetByID returns the custom job with the given ID. ErrNotFound is returned if
+// the job does not exist or its repository is not visible to the current user.
+func (s *Store) GetByID(ctx context.Context, id int) (*Job, error) {
+	jobs, err := s.list(ctx, []*sqlf.Query{sqlf.Sprintf("custom_jobs.id = %s", id)}, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, ErrNotFound
+	}
+	return jobs[0], nil
+}
+
+// ListOpts are the options for listing custom jobs.
+type ListOpts struct {
+	// Limit is the maximum number of jobs returned. No limit is applied if 0.
+	Limit int
+	// Cursor, if set, only returns jobs with an ID lower than the cursor.
+	Cursor int
+
+	UserID       int32
+	RepositoryID api.RepoID
+	State        JobState
+}
+
+// List returns the custom jobs matching the given options, newest first. Only
+// jobs in repositories visible to the current user are returned.
+func (s *Store) List(ctx context.Context, opts ListOpts) ([]*Job, error) {
+	var conds []*sqlf.Query
+	if opts.Cursor > 0 {
+		conds = append(conds, sqlf.Sprintf("custom_jobs.id < %s", opts.Cursor))
+	}
+	if opts.UserID != 0 {
+		conds = append(conds, sqlf.Sprintf("custom_jobs.user_id = %s", opts.UserID))
+	}
+	if opts.RepositoryID != 0 {
+		conds = append(conds, sqlf.Sprintf("custom_jobs.repository_id = %s", opts.RepositoryID))
+	}
+	if opts.State != "" {
+		conds = append(conds, sqlf.Sprintf("custom_jobs.state = %s", opts.State))
+	}
+	return s.list(ctx, conds, opts.Limit)
+}
+
+func (s *Store) list(ctx context.Context, conds []*sqlf.Query, limit int) ([]*Job, error) {
+	// 🚨 SECURITY: Only return jobs in repositories the current user can see.
+	authzConds, err := database.AuthzQueryConds(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	conds = append(conds, authzConds)
+
+	limitClause := sqlf.Sprintf("")
+	if limit > 0 {
+		limitClause = sqlf.Sprintf("LIMIT %s", limit)
+	}
+
+	return scanJobs(s.Query(ctx, sqlf.Sprintf(
+		listJobsQueryFmtstr,
+		sqlf.Join(jobColumns, ", "),
+		sqlf.Join(conds, "\n AND "),
+		limitClause,
+	)))
+}
+
+const listJobsQueryFmtstr = `
+SELECT %s
+FROM custom_jobs
+JOIN repo ON repo.id = custom_jobs.repository_id
+WHERE
+	repo.deleted_at IS NULL
+	AND %s
+ORDER BY custom_jobs.id DESC
+%s
+`
+
+// Cancel cancels the custom job with the given ID. Queued jobs are canceled
+// right away, jobs that are being processed are marked for cancelation so
+// that the executor running them stops. Jobs that have already finished are
+// left untouched.
+func (s *Store) Cancel(ctx context.Context, id int) (*Job, error) {
+	// 🚨 SECURITY: Make sure the job is visible to the current user.
+	if _, err := s.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.Exec(ctx, sqlf.Sprintf(
+		cancelJobQueryFmtstr,
+		JobStateProcessing,
+		JobStateProcessing,
+		JobStateCanceled,
+		JobStateProcessing,
+		now,
+		now,
+		id,
+		JobStateQueued,
+		JobStateErrored,
+		JobStateProcessing,
+	)); err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+const cancelJobQueryFmtstr = `
+UPDATE custom_jobs
+SET
+	cancel = state = %s,
+	state = CASE WHEN state = %s THEN state ELSE %s END,
+	finished_at = CASE WHEN state = %s THEN finished_at ELSE %s END,
+	updated_at = %s
+WHERE
+	id = %s
+	AND state IN (%s, %s, %s)
+	AND NOT cancel
+`
+
+func scanJob(sc dbutil.Scanner) (*Job, error) {
+	var (
+		job           Job
+		spec          []byte
+		userID        int32
+		executionLogs []executor.ExecutionLogEntry
+	)
+	if err := sc.Scan(
+		&job.ID,
+		&job.Template,
+		&spec,
+		&job.RepositoryID,
+		&dbutil.NullString{S: (*string)(&job.RepositoryName)},
+		&job.Commit,
+		&dbutil.NullInt32{N: &userID},
+		&job.State,
+		&job.FailureMessage,
+		&job.QueuedAt,
+		&dbutil.NullTime{Time: &job.StartedAt},
+		&dbutil.NullTime{Time: &job.FinishedAt},
+		&dbutil.NullTime{Time: &job.ProcessAfter},
+		&job.NumResets,
+		&job.NumFailures,
+		&dbutil.NullTime{Time: &job.LastHeartbeatAt},
+		pq.Array(&executionLogs),
+		&dbutil.NullString{S: &job.WorkerHostname},
+		&job.Cancel,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(spec, &job.Spec); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling custom job spec")
+	}
+	job.UserID = userID
+	job.ExecutionLogs = executionLogs
+
+	return &job, nil
+}
+
+var scanJobs = basestore.NewSliceScanner(scanJob)
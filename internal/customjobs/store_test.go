@@ -0,0 +1,90 @@
+package customjobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestStore(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+
+	user, err := db.Users().Create(ctx, database.NewUser{Username: "alice"})
+	require.NoError(t, err)
+
+	repo := &types.Repo{Name: "github.com/sourcegraph/sourcegraph"}
+	require.NoError(t, db.Repos().Create(ctx, repo))
+
+	store := NewStore(db)
+	template := schema.CustomJobTemplate{
+		Name:  "sbom",
+		Image: "alpine:3",
+		Steps: []*schema.CustomJobStep{{Run: "syft . -o json"}},
+	}
+
+	job, err := store.Create(ctx, CreateOpts{
+		Template:     template,
+		RepositoryID: repo.ID,
+		Commit:       api.CommitID("deadbeef"),
+		UserID:       user.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "sbom", job.Template)
+	assert.Equal(t, template, job.Spec)
+	assert.Equal(t, repo.Name, job.RepositoryName)
+	assert.Equal(t, user.ID, job.UserID)
+	assert.Equal(t, JobStateQueued, job.State)
+
+	other, err := store.Create(ctx, CreateOpts{
+		Template:     template,
+		RepositoryID: repo.ID,
+		Commit:       api.CommitID("cafebabe"),
+	})
+	require.NoError(t, err)
+
+	t.Run("List", func(t *testing.T) {
+		jobs, err := store.List(ctx, ListOpts{})
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, other.ID, jobs[0].ID)
+		assert.Equal(t, job.ID, jobs[1].ID)
+
+		jobs, err = store.List(ctx, ListOpts{UserID: user.ID})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, job.ID, jobs[0].ID)
+
+		jobs, err = store.List(ctx, ListOpts{Cursor: other.ID, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, job.ID, jobs[0].ID)
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		canceled, err := store.Cancel(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStateCanceled, canceled.State)
+		assert.False(t, canceled.Cancel)
+
+		jobs, err := store.List(ctx, ListOpts{State: JobStateQueued})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, other.ID, jobs[0].ID)
+	})
+
+	t.Run("GetByID not found", func(t *testing.T) {
+		_, err := store.GetByID(ctx, 1000)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,27 @@
+package customjobs
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// QueueName is the name of the executor queue custom jobs are processed in.
+const QueueName = "customjobs"
+
+// Templates returns the custom job templates defined in the site
+// configuration.
+func Templates() []*schema.CustomJobTemplate {
+	return conf.Get().ExecutorsCustomJobTemplates
+}
+
+// TemplateByName returns the custom job template with the given name from the
+// site configuration.
+func TemplateByName(name string) (*schema.CustomJobTemplate, error) {
+	for _, t := range Templates() {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, errors.Newf("custom job template %q is not defined", name)
+}
@@ -0,0 +1,75 @@
+package customjobs
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// JobState is the state of a custom job, as tracked by the dbworker.
+type JobState string
+
+const (
+	JobStateQueued     JobState = "queued"
+	JobStateProcessing JobState = "processing"
+	JobStateErrored    JobState = "errored"
+	JobStateFailed     JobState = "failed"
+	JobStateCompleted  JobState = "completed"
+	JobStateCanceled   JobState = "canceled"
+)
+
+// Valid returns true if the state is one of the known job states.
+func (s JobState) Valid() bool {
+	switch s {
+	case JobStateQueued, JobStateProcessing, JobStateErrored, JobStateFailed, JobStateCompleted, JobStateCanceled:
+		return true
+	}
+	return false
+}
+
+// Job is a run of a custom job template against a repository at a given
+// commit. Maps to the `custom_jobs` database table.
+type Job struct {
+	ID int
+
+	// Template is the name of the template the job was created from.
+	Template string
+	// Spec is a snapshot of the template at the time the job was created, so
+	// that changes to the site configuration don't affect queued jobs.
+	Spec schema.CustomJobTemplate
+
+	RepositoryID   api.RepoID
+	RepositoryName api.RepoName
+	Commit         api.CommitID
+
+	// UserID is the user that created the job. It is 0 if the user has been
+	// deleted since.
+	UserID int32
+
+	State           JobState
+	FailureMessage  *string
+	QueuedAt        time.Time
+	StartedAt       time.Time
+	FinishedAt      time.Time
+	ProcessAfter    time.Time
+	NumResets       int
+	NumFailures     int
+	LastHeartbeatAt time.Time
+	ExecutionLogs   []executor.ExecutionLogEntry
+	WorkerHostname  string
+	Cancel          bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (j *Job) RecordID() int {
+	return j.ID
+}
+
+func (j *Job) RecordUID() string {
+	return strconv.Itoa(j.ID)
+}
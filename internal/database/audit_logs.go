@@ -0,0 +1,276 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// AuditLog is an audit log record stored in the database.
+type AuditLog struct {
+	ID                int64
+	AuditID           string
+	CreatedAt         time.Time
+	Entity            string
+	Action            string
+	ActorUID          int32
+	AnonymousActorUID string
+	IP                string
+	UserAgent         string
+	ForwardedFor      string
+	Fields            json.RawMessage
+}
+
+// AuditLogsListOptions contains options for listing and counting audit log
+// records.
+type AuditLogsListOptions struct {
+	// ActorUID, if set, only matches records of the given user.
+	ActorUID *int32
+	// Entity, if set, only matches records of the given entity.
+	Entity string
+	// Action, if set, only matches records of the given action.
+	Action string
+	// Since, if set, only matches records created at or after it.
+	Since *time.Time
+	// Until, if set, only matches records created before it.
+	Until *time.Time
+	// BeforeID, if set, only matches records with a smaller ID. Records are
+	// listed by descending ID, so this is used for pagination.
+	BeforeID int64
+
+	*LimitOffset
+}
+
+func (o AuditLogsListOptions) sqlConditions() []*sqlf.Query {
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if o.ActorUID != nil {
+		conds = append(conds, sqlf.Sprintf("actor_uid = %s", *o.ActorUID))
+	}
+	if o.Entity != "" {
+		conds = append(conds, sqlf.Sprintf("entity = %s", o.Entity))
+	}
+	if o.Action != "" {
+		conds = append(conds, sqlf.Sprintf("action = %s", o.Action))
+	}
+	if o.Since != nil {
+		conds = append(conds, sqlf.Sprintf("created_at >= %s", *o.Since))
+	}
+	if o.Until != nil {
+		conds = append(conds, sqlf.Sprintf("created_at < %s", *o.Until))
+	}
+	if o.BeforeID > 0 {
+		conds = append(conds, sqlf.Sprintf("id < %s", o.BeforeID))
+	}
+	return conds
+}
+
+// AuditLogStore provides persistence for audit log records. Records are
+// stored in monthly partitions of the audit_logs table, so that old records
+// can be dropped cheaply.
+type AuditLogStore interface {
+	basestore.ShareableStore
+
+	// Insert adds the given records to the store.
+	Insert(ctx context.Context, logs []*AuditLog) error
+	// List returns the records matching the given options, newest first.
+	List(ctx context.Context, opts AuditLogsListOptions) ([]*AuditLog, error)
+	// Count returns the number of records matching the given options.
+	Count(ctx context.Context, opts AuditLogsListOptions) (int, error)
+	// EnsurePartitions creates the partitions for the month of now and the
+	// following month, if they don't exist yet.
+	EnsurePartitions(ctx context.Context, now time.Time) error
+	// DeleteOlderThan drops the partitions that only contain records created
+	// before t and deletes older records from the default partition. It
+	// returns the number of dropped partitions.
+	DeleteOlderThan(ctx context.Context, t time.Time) (int, error)
+}
+
+type auditLogStore struct {
+	*basestore.Store
+}
+
+// AuditLogsWith instantiates and returns a new AuditLogStore using the other
+// store handle.
+func AuditLogsWith(other basestore.ShareableStore) AuditLogStore {
+	return &auditLogStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *auditLogStore) Insert(ctx context.Context, logs []*AuditLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	vals := make([]*sqlf.Query, 0, len(logs))
+	for _, l := range logs {
+		fields := string(l.Fields)
+		if fields == "" {
+			fields = "{}"
+		}
+		createdAt := l.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = time.Now()
+		}
+		vals = append(vals, sqlf.Sprintf("(%s, %s, %s, %s, %s, %s, %s, %s, %s, %s)",
+			l.AuditID,
+			createdAt.UTC(),
+			l.Entity,
+			l.Action,
+			l.ActorUID,
+			l.AnonymousActorUID,
+			l.IP,
+			l.UserAgent,
+			l.ForwardedFor,
+			fields,
+		))
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(insertAuditLogsQueryFmtstr, sqlf.Join(vals, ",")))
+}
+
+const insertAuditLogsQueryFmtstr = `
+INSERT INTO audit_logs (audit_id, created_at, entity, action, actor_uid, anonymous_actor_uid, ip, user_agent, forwarded_for, fields)
+VALUES %s
+`
+
+func (s *auditLogStore) List(ctx context.Context, opts AuditLogsListOptions) ([]*AuditLog, error) {
+	q := sqlf.Sprintf(listAuditLogsQueryFmtstr, sqlf.Join(opts.sqlConditions(), "AND"), opts.LimitOffset.SQL())
+	return scanAuditLogs(s.Query(ctx, q))
+}
+
+const listAuditLogsQueryFmtstr = `
+SELECT id, audit_id, created_at, entity, action, actor_uid, anonymous_actor_uid, ip, user_agent, forwarded_for, fields
+FROM audit_logs
+WHERE %s
+ORDER BY id DESC
+%s
+`
+
+var scanAuditLogs = basestore.NewSliceScanner(func(s dbutil.Scanner) (*AuditLog, error) {
+	var l AuditLog
+	err := s.Scan(
+		&l.ID,
+		&l.AuditID,
+		&l.CreatedAt,
+		&l.Entity,
+		&l.Action,
+		&l.ActorUID,
+		&l.AnonymousActorUID,
+		&l.IP,
+		&l.UserAgent,
+		&l.ForwardedFor,
+		&l.Fields,
+	)
+	return &l, err
+})
+
+func (s *auditLogStore) Count(ctx context.Context, opts AuditLogsListOptions) (int, error) {
+	q := sqlf.Sprintf("SELECT COUNT(*) FROM audit_logs WHERE %s", sqlf.Join(opts.sqlConditions(), "AND"))
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, q))
+	return count, err
+}
+
+// auditLogsPartitionName returns the name of the partition holding the records
+// created in the month of t.
+func auditLogsPartitionName(t time.Time) string {
+	return fmt.Sprintf("audit_logs_y%04dm%02d", t.Year(), t.Month())
+}
+
+var auditLogsPartitionPattern = regexp.MustCompile(`^audit_logs_y(\d{4})m(\d{2})$`)
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *auditLogStore) EnsurePartitions(ctx context.Context, now time.Time) error {
+	for _, month := range []time.Time{monthStart(now), monthStart(now).AddDate(0, 1, 0)} {
+		if err := s.ensurePartition(ctx, month); err != nil {
+			return errors.Wrapf(err, "creating partition for %s", month.Format("2006-01"))
+		}
+	}
+	return nil
+}
+
+// ensurePartition creates and attaches the partition for the month starting
+// at from. Records of that month that landed in the default partition are
+// moved to the new partition, as it can't be attached otherwise.
+func (s *auditLogStore) ensurePartition(ctx context.Context, from time.Time) (err error) {
+	name := auditLogsPartitionName(from)
+	to := from.AddDate(0, 1, 0)
+
+	exists, _, err := basestore.ScanFirstBool(s.Query(ctx, sqlf.Sprintf("SELECT to_regclass(%s) IS NOT NULL", name)))
+	if err != nil || exists {
+		return err
+	}
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	// The name and bounds are generated from digits only, so they're safe to
+	// interpolate. DDL statements don't support bind parameters.
+	bounds := fmt.Sprintf("FROM ('%s') TO ('%s')", from.Format(time.RFC3339), to.Format(time.RFC3339))
+
+	if err := tx.Exec(ctx, sqlf.Sprintf("CREATE TABLE "+name+" (LIKE audit_logs INCLUDING DEFAULTS)")); err != nil {
+		return err
+	}
+	if err := tx.Exec(ctx, sqlf.Sprintf(`
+WITH moved AS (
+	DELETE FROM audit_logs_default
+	WHERE created_at >= %s AND created_at < %s
+	RETURNING *
+)
+INSERT INTO `+name+` SELECT * FROM moved
+`, from, to)); err != nil {
+		return err
+	}
+	return tx.Exec(ctx, sqlf.Sprintf("ALTER TABLE audit_logs ATTACH PARTITION "+name+" FOR VALUES "+bounds))
+}
+
+func (s *auditLogStore) DeleteOlderThan(ctx context.Context, t time.Time) (dropped int, err error) {
+	names, err := basestore.ScanStrings(s.Query(ctx, sqlf.Sprintf(listAuditLogsPartitionsQuery)))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, name := range names {
+		m := auditLogsPartitionPattern.FindStringSubmatch(name)
+		if m == nil {
+			continue
+		}
+		from, err := time.Parse("2006-01", m[1]+"-"+m[2])
+		if err != nil {
+			continue
+		}
+		// Only drop partitions whose records are all older than t.
+		if from.AddDate(0, 1, 0).After(t) {
+			continue
+		}
+		if err := s.Exec(ctx, sqlf.Sprintf("DROP TABLE IF EXISTS "+name)); err != nil {
+			return dropped, errors.Wrapf(err, "dropping partition %s", name)
+		}
+		dropped++
+	}
+
+	if err := s.Exec(ctx, sqlf.Sprintf("DELETE FROM audit_logs_default WHERE created_at < %s", t)); err != nil {
+		return dropped, err
+	}
+	return dropped, nil
+}
+
+const listAuditLogsPartitionsQuery = `
+SELECT c.relname
+FROM pg_inherits i
+JOIN pg_class c ON c.oid = i.inhrelid
+WHERE i.inhparent = 'audit_logs'::regclass
+`
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestAuditLogStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+	store := db.AuditLogs()
+
+	now := time.Date(2023, 10, 5, 12, 0, 0, 0, time.UTC)
+	lastMonth := now.AddDate(0, -1, 0)
+	lastYear := now.AddDate(-1, 0, 0)
+
+	// Records of this month land in the default partition first, and are
+	// moved when the partition is created.
+	require.NoError(t, store.Insert(ctx, []*AuditLog{
+		{AuditID: "a", CreatedAt: now, Entity: "security events", Action: "AccessGranted", ActorUID: 1, IP: "127.0.0.1", Fields: json.RawMessage(`{"foo":"bar"}`)},
+	}))
+	require.NoError(t, store.EnsurePartitions(ctx, lastYear))
+	require.NoError(t, store.EnsurePartitions(ctx, lastMonth))
+	// Creating partitions is idempotent.
+	require.NoError(t, store.EnsurePartitions(ctx, lastMonth))
+	require.NoError(t, store.Insert(ctx, []*AuditLog{
+		{AuditID: "b", CreatedAt: lastMonth, Entity: "security events", Action: "AccessDenied", ActorUID: 2},
+		{AuditID: "c", CreatedAt: lastYear, Entity: "gitserver", Action: "exec", ActorUID: 1},
+	}))
+
+	t.Run("List", func(t *testing.T) {
+		logs, err := store.List(ctx, AuditLogsListOptions{})
+		require.NoError(t, err)
+		require.Len(t, logs, 3)
+		// Newest first.
+		assert.Equal(t, "c", logs[0].AuditID)
+		assert.Equal(t, "a", logs[2].AuditID)
+		assert.Equal(t, "127.0.0.1", logs[2].IP)
+		assert.JSONEq(t, `{"foo":"bar"}`, string(logs[2].Fields))
+		assert.JSONEq(t, `{}`, string(logs[0].Fields))
+
+		logs, err = store.List(ctx, AuditLogsListOptions{ActorUID: pointers.Ptr(int32(1)), Since: pointers.Ptr(lastMonth)})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, "a", logs[0].AuditID)
+
+		logs, err = store.List(ctx, AuditLogsListOptions{Entity: "security events", Until: pointers.Ptr(now)})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, "b", logs[0].AuditID)
+
+		all, err := store.List(ctx, AuditLogsListOptions{})
+		require.NoError(t, err)
+		logs, err = store.List(ctx, AuditLogsListOptions{BeforeID: all[0].ID, LimitOffset: &LimitOffset{Limit: 1}})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, all[1].ID, logs[0].ID)
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		count, err := store.Count(ctx, AuditLogsListOptions{Action: "AccessDenied"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("DeleteOlderThan", func(t *testing.T) {
+		dropped, err := store.DeleteOlderThan(ctx, lastMonth.AddDate(0, 0, -1))
+		require.NoError(t, err)
+		// The partitions of last year's month and the month after it.
+		assert.Equal(t, 2, dropped)
+
+		count, err := store.Count(ctx, AuditLogsListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+}
@@ -91,3 +91,19 @@ func validateSchema(observationCtx *observation.Context, db *sql.DB, schema *sch
 func shouldMigrate(validateOnly bool) bool {
 	return !validateOnly || os.Getenv("SG_DEV_MIGRATE_ON_APPLICATION_STARTUP") != ""
 }
+
+// MigrateStandaloneDB applies all migrations of the given schema to the database.
+// It is meant for services that keep their own tables in a database that is not
+// managed by the migrator, such as a dedicated events database.
+func MigrateStandaloneDB(ctx context.Context, observationCtx *observation.Context, db *sql.DB, schema *schemas.Schema) error {
+	migrationRunner := runnerFromDB(observationCtx.Logger, newStoreFactory(observationCtx), db, schema)
+
+	return migrationRunner.Run(ctx, runner.Options{
+		Operations: []runner.MigrationOperation{
+			{
+				SchemaName: schema.Name,
+				Type:       runner.MigrationOperationTypeUpgrade,
+			},
+		},
+	})
+}
@@ -24,6 +24,7 @@ type DB interface {
 
 	AccessRequests() AccessRequestStore
 	AccessTokens() AccessTokenStore
+	AuditLogs() AuditLogStore
 	Authz() AuthzStore
 	BitbucketProjectPermissions() BitbucketProjectPermissionsStore
 	CodeMonitors() CodeMonitorStore
@@ -142,6 +143,10 @@ func (d *db) AccessRequests() AccessRequestStore {
 	return AccessRequestsWith(d.Store, d.logger.Scoped("AccessRequestStore", ""))
 }
 
+func (d *db) AuditLogs() AuditLogStore {
+	return AuditLogsWith(d.Store)
+}
+
 func (d *db) BitbucketProjectPermissions() BitbucketProjectPermissionsStore {
 	return BitbucketProjectPermissionsStoreWith(d.Store)
 }
@@ -3779,6 +3779,762 @@ func (c AssignedTeamsStoreListAssignedTeamsForRepoFuncCall) Results() []interfac
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// MockAuditLogStore is a mock implementation of the AuditLogStore interface
+// (from the package github.com/sourcegraph/sourcegraph/internal/database)
+// used for unit testing.
+type MockAuditLogStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *AuditLogStoreCountFunc
+	// DeleteOlderThanFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteOlderThan.
+	DeleteOlderThanFunc *AuditLogStoreDeleteOlderThanFunc
+	// EnsurePartitionsFunc is an instance of a mock function object
+	// controlling the behavior of the method EnsurePartitions.
+	EnsurePartitionsFunc *AuditLogStoreEnsurePartitionsFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *AuditLogStoreHandleFunc
+	// InsertFunc is an instance of a mock function object controlling the
+	// behavior of the method Insert.
+	InsertFunc *AuditLogStoreInsertFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *AuditLogStoreListFunc
+}
+
+// NewMockAuditLogStore creates a new mock of the AuditLogStore interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockAuditLogStore() *MockAuditLogStore {
+	return &MockAuditLogStore{
+		CountFunc: &AuditLogStoreCountFunc{
+			defaultHook: func(context.Context, database.AuditLogsListOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		DeleteOlderThanFunc: &AuditLogStoreDeleteOlderThanFunc{
+			defaultHook: func(context.Context, time.Time) (r0 int, r1 error) {
+				return
+			},
+		},
+		EnsurePartitionsFunc: &AuditLogStoreEnsurePartitionsFunc{
+			defaultHook: func(context.Context, time.Time) (r0 error) {
+				return
+			},
+		},
+		HandleFunc: &AuditLogStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		InsertFunc: &AuditLogStoreInsertFunc{
+			defaultHook: func(context.Context, []*database.AuditLog) (r0 error) {
+				return
+			},
+		},
+		ListFunc: &AuditLogStoreListFunc{
+			defaultHook: func(context.Context, database.AuditLogsListOptions) (r0 []*database.AuditLog, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockAuditLogStore creates a new mock of the AuditLogStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockAuditLogStore() *MockAuditLogStore {
+	return &MockAuditLogStore{
+		CountFunc: &AuditLogStoreCountFunc{
+			defaultHook: func(context.Context, database.AuditLogsListOptions) (int, error) {
+				panic("unexpected invocation of MockAuditLogStore.Count")
+			},
+		},
+		DeleteOlderThanFunc: &AuditLogStoreDeleteOlderThanFunc{
+			defaultHook: func(context.Context, time.Time) (int, error) {
+				panic("unexpected invocation of MockAuditLogStore.DeleteOlderThan")
+			},
+		},
+		EnsurePartitionsFunc: &AuditLogStoreEnsurePartitionsFunc{
+			defaultHook: func(context.Context, time.Time) error {
+				panic("unexpected invocation of MockAuditLogStore.EnsurePartitions")
+			},
+		},
+		HandleFunc: &AuditLogStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockAuditLogStore.Handle")
+			},
+		},
+		InsertFunc: &AuditLogStoreInsertFunc{
+			defaultHook: func(context.Context, []*database.AuditLog) error {
+				panic("unexpected invocation of MockAuditLogStore.Insert")
+			},
+		},
+		ListFunc: &AuditLogStoreListFunc{
+			defaultHook: func(context.Context, database.AuditLogsListOptions) ([]*database.AuditLog, error) {
+				panic("unexpected invocation of MockAuditLogStore.List")
+			},
+		},
+	}
+}
+
+// NewMockAuditLogStoreFrom creates a new mock of the MockAuditLogStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockAuditLogStoreFrom(i database.AuditLogStore) *MockAuditLogStore {
+	return &MockAuditLogStore{
+		CountFunc: &AuditLogStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		DeleteOlderThanFunc: &AuditLogStoreDeleteOlderThanFunc{
+			defaultHook: i.DeleteOlderThan,
+		},
+		EnsurePartitionsFunc: &AuditLogStoreEnsurePartitionsFunc{
+			defaultHook: i.EnsurePartitions,
+		},
+		HandleFunc: &AuditLogStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		InsertFunc: &AuditLogStoreInsertFunc{
+			defaultHook: i.Insert,
+		},
+		ListFunc: &AuditLogStoreListFunc{
+			defaultHook: i.List,
+		},
+	}
+}
+
+// AuditLogStoreCountFunc describes the behavior when the Count method of
+// the parent MockAuditLogStore instance is invoked.
+type AuditLogStoreCountFunc struct {
+	defaultHook func(context.Context, database.AuditLogsListOptions) (int, error)
+	hooks       []func(context.Context, database.AuditLogsListOptions) (int, error)
+	history     []AuditLogStoreCountFuncCall
+	mutex       sync.Mutex
+}
+
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) Count(v0 context.Context, v1 database.AuditLogsListOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(AuditLogStoreCountFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreCountFunc) SetDefaultHook(hook func(context.Context, database.AuditLogsListOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Count method of the parent MockAuditLogStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreCountFunc) PushHook(hook func(context.Context, database.AuditLogsListOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.AuditLogsListOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, database.AuditLogsListOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *AuditLogStoreCountFunc) nextHook() func(context.Context, database.AuditLogsListOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreCountFunc) appendCall(r0 AuditLogStoreCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreCountFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreCountFunc) History() []AuditLogStoreCountFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreCountFuncCall is an object that describes an invocation of
+// method Count on an instance of MockAuditLogStore.
+type AuditLogStoreCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.AuditLogsListOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AuditLogStoreDeleteOlderThanFunc describes the behavior when the
+// DeleteOlderThan method of the parent MockAuditLogStore instance is
+// invoked.
+type AuditLogStoreDeleteOlderThanFunc struct {
+	defaultHook func(context.Context, time.Time) (int, error)
+	hooks       []func(context.Context, time.Time) (int, error)
+	history     []AuditLogStoreDeleteOlderThanFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteOlderThan delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAuditLogStore) DeleteOlderThan(v0 context.Context, v1 time.Time) (int, error) {
+	r0, r1 := m.DeleteOlderThanFunc.nextHook()(v0, v1)
+	m.DeleteOlderThanFunc.appendCall(AuditLogStoreDeleteOlderThanFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DeleteOlderThan
+// method of the parent MockAuditLogStore instance is invoked and the hook
+// queue is empty.
+func (f *AuditLogStoreDeleteOlderThanFunc) SetDefaultHook(hook func(context.Context, time.Time) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteOlderThan method of the parent MockAuditLogStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AuditLogStoreDeleteOlderThanFunc) PushHook(hook func(context.Context, time.Time) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreDeleteOlderThanFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreDeleteOlderThanFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *AuditLogStoreDeleteOlderThanFunc) nextHook() func(context.Context, time.Time) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreDeleteOlderThanFunc) appendCall(r0 AuditLogStoreDeleteOlderThanFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreDeleteOlderThanFuncCall
+// objects describing the invocations of this function.
+func (f *AuditLogStoreDeleteOlderThanFunc) History() []AuditLogStoreDeleteOlderThanFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreDeleteOlderThanFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreDeleteOlderThanFuncCall is an object that describes an
+// invocation of method DeleteOlderThan on an instance of MockAuditLogStore.
+type AuditLogStoreDeleteOlderThanFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreDeleteOlderThanFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreDeleteOlderThanFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AuditLogStoreEnsurePartitionsFunc describes the behavior when the
+// EnsurePartitions method of the parent MockAuditLogStore instance is
+// invoked.
+type AuditLogStoreEnsurePartitionsFunc struct {
+	defaultHook func(context.Context, time.Time) error
+	hooks       []func(context.Context, time.Time) error
+	history     []AuditLogStoreEnsurePartitionsFuncCall
+	mutex       sync.Mutex
+}
+
+// EnsurePartitions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAuditLogStore) EnsurePartitions(v0 context.Context, v1 time.Time) error {
+	r0 := m.EnsurePartitionsFunc.nextHook()(v0, v1)
+	m.EnsurePartitionsFunc.appendCall(AuditLogStoreEnsurePartitionsFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the EnsurePartitions
+// method of the parent MockAuditLogStore instance is invoked and the hook
+// queue is empty.
+func (f *AuditLogStoreEnsurePartitionsFunc) SetDefaultHook(hook func(context.Context, time.Time) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EnsurePartitions method of the parent MockAuditLogStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AuditLogStoreEnsurePartitionsFunc) PushHook(hook func(context.Context, time.Time) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreEnsurePartitionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreEnsurePartitionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, time.Time) error {
+		return r0
+	})
+}
+
+func (f *AuditLogStoreEnsurePartitionsFunc) nextHook() func(context.Context, time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreEnsurePartitionsFunc) appendCall(r0 AuditLogStoreEnsurePartitionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreEnsurePartitionsFuncCall
+// objects describing the invocations of this function.
+func (f *AuditLogStoreEnsurePartitionsFunc) History() []AuditLogStoreEnsurePartitionsFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreEnsurePartitionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreEnsurePartitionsFuncCall is an object that describes an
+// invocation of method EnsurePartitions on an instance of
+// MockAuditLogStore.
+type AuditLogStoreEnsurePartitionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreEnsurePartitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreEnsurePartitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AuditLogStoreHandleFunc describes the behavior when the Handle method of
+// the parent MockAuditLogStore instance is invoked.
+type AuditLogStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []AuditLogStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(AuditLogStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockAuditLogStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *AuditLogStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreHandleFunc) appendCall(r0 AuditLogStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreHandleFunc) History() []AuditLogStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockAuditLogStore.
+type AuditLogStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AuditLogStoreInsertFunc describes the behavior when the Insert method of
+// the parent MockAuditLogStore instance is invoked.
+type AuditLogStoreInsertFunc struct {
+	defaultHook func(context.Context, []*database.AuditLog) error
+	hooks       []func(context.Context, []*database.AuditLog) error
+	history     []AuditLogStoreInsertFuncCall
+	mutex       sync.Mutex
+}
+
+// Insert delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) Insert(v0 context.Context, v1 []*database.AuditLog) error {
+	r0 := m.InsertFunc.nextHook()(v0, v1)
+	m.InsertFunc.appendCall(AuditLogStoreInsertFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Insert method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreInsertFunc) SetDefaultHook(hook func(context.Context, []*database.AuditLog) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Insert method of the parent MockAuditLogStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreInsertFunc) PushHook(hook func(context.Context, []*database.AuditLog) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreInsertFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, []*database.AuditLog) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreInsertFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, []*database.AuditLog) error {
+		return r0
+	})
+}
+
+func (f *AuditLogStoreInsertFunc) nextHook() func(context.Context, []*database.AuditLog) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreInsertFunc) appendCall(r0 AuditLogStoreInsertFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreInsertFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreInsertFunc) History() []AuditLogStoreInsertFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreInsertFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreInsertFuncCall is an object that describes an invocation of
+// method Insert on an instance of MockAuditLogStore.
+type AuditLogStoreInsertFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []*database.AuditLog
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreInsertFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreInsertFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AuditLogStoreListFunc describes the behavior when the List method of the
+// parent MockAuditLogStore instance is invoked.
+type AuditLogStoreListFunc struct {
+	defaultHook func(context.Context, database.AuditLogsListOptions) ([]*database.AuditLog, error)
+	hooks       []func(context.Context, database.AuditLogsListOptions) ([]*database.AuditLog, error)
+	history     []AuditLogStoreListFuncCall
+	mutex       sync.Mutex
+}
+
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) List(v0 context.Context, v1 database.AuditLogsListOptions) ([]*database.AuditLog, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(AuditLogStoreListFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreListFunc) SetDefaultHook(hook func(context.Context, database.AuditLogsListOptions) ([]*database.AuditLog, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// List method of the parent MockAuditLogStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreListFunc) PushHook(hook func(context.Context, database.AuditLogsListOptions) ([]*database.AuditLog, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreListFunc) SetDefaultReturn(r0 []*database.AuditLog, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.AuditLogsListOptions) ([]*database.AuditLog, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreListFunc) PushReturn(r0 []*database.AuditLog, r1 error) {
+	f.PushHook(func(context.Context, database.AuditLogsListOptions) ([]*database.AuditLog, error) {
+		return r0, r1
+	})
+}
+
+func (f *AuditLogStoreListFunc) nextHook() func(context.Context, database.AuditLogsListOptions) ([]*database.AuditLog, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreListFunc) appendCall(r0 AuditLogStoreListFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreListFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreListFunc) History() []AuditLogStoreListFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreListFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreListFuncCall is an object that describes an invocation of
+// method List on an instance of MockAuditLogStore.
+type AuditLogStoreListFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.AuditLogsListOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.AuditLog
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockAuthzStore is a mock implementation of the AuthzStore interface (from
 // the package github.com/sourcegraph/sourcegraph/internal/database) used
 // for unit testing.
@@ -14906,6 +15662,9 @@ type MockDB struct {
 	// AssignedTeamsFunc is an instance of a mock function object
 	// controlling the behavior of the method AssignedTeams.
 	AssignedTeamsFunc *DBAssignedTeamsFunc
+	// AuditLogsFunc is an instance of a mock function object controlling
+	// the behavior of the method AuditLogs.
+	AuditLogsFunc *DBAuditLogsFunc
 	// AuthzFunc is an instance of a mock function object controlling the
 	// behavior of the method Authz.
 	AuthzFunc *DBAuthzFunc
@@ -15121,6 +15880,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		AuditLogsFunc: &DBAuditLogsFunc{
+			defaultHook: func() (r0 database.AuditLogStore) {
+				return
+			},
+		},
 		AuthzFunc: &DBAuthzFunc{
 			defaultHook: func() (r0 database.AuthzStore) {
 				return
@@ -15458,6 +16222,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.AssignedTeams")
 			},
 		},
+		AuditLogsFunc: &DBAuditLogsFunc{
+			defaultHook: func() database.AuditLogStore {
+				panic("unexpected invocation of MockDB.AuditLogs")
+			},
+		},
 		AuthzFunc: &DBAuthzFunc{
 			defaultHook: func() database.AuthzStore {
 				panic("unexpected invocation of MockDB.Authz")
@@ -15787,6 +16556,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		AssignedTeamsFunc: &DBAssignedTeamsFunc{
 			defaultHook: i.AssignedTeams,
 		},
+		AuditLogsFunc: &DBAuditLogsFunc{
+			defaultHook: i.AuditLogs,
+		},
 		AuthzFunc: &DBAuthzFunc{
 			defaultHook: i.Authz,
 		},
@@ -16370,6 +17142,104 @@ func (c DBAssignedTeamsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBAuditLogsFunc describes the behavior when the AuditLogs method of the
+// parent MockDB instance is invoked.
+type DBAuditLogsFunc struct {
+	defaultHook func() database.AuditLogStore
+	hooks       []func() database.AuditLogStore
+	history     []DBAuditLogsFuncCall
+	mutex       sync.Mutex
+}
+
+// AuditLogs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDB) AuditLogs() database.AuditLogStore {
+	r0 := m.AuditLogsFunc.nextHook()()
+	m.AuditLogsFunc.appendCall(DBAuditLogsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the AuditLogs method of
+// the parent MockDB instance is invoked and the hook queue is empty.
+func (f *DBAuditLogsFunc) SetDefaultHook(hook func() database.AuditLogStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AuditLogs method of the parent MockDB instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *DBAuditLogsFunc) PushHook(hook func() database.AuditLogStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBAuditLogsFunc) SetDefaultReturn(r0 database.AuditLogStore) {
+	f.SetDefaultHook(func() database.AuditLogStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBAuditLogsFunc) PushReturn(r0 database.AuditLogStore) {
+	f.PushHook(func() database.AuditLogStore {
+		return r0
+	})
+}
+
+func (f *DBAuditLogsFunc) nextHook() func() database.AuditLogStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBAuditLogsFunc) appendCall(r0 DBAuditLogsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBAuditLogsFuncCall objects describing the
+// invocations of this function.
+func (f *DBAuditLogsFunc) History() []DBAuditLogsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBAuditLogsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBAuditLogsFuncCall is an object that describes an invocation of method
+// AuditLogs on an instance of MockDB.
+type DBAuditLogsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.AuditLogStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBAuditLogsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBAuditLogsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBAuthzFunc describes the behavior when the Authz method of the parent
 // MockDB instance is invoked.
 type DBAuthzFunc struct {
@@ -71592,6 +72462,9 @@ func (c SignalConfigurationStoreWithTransactFuncCall) Results() []interface{} {
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
 // testing.
 type MockSubRepoPermsStore struct {
+	// DeleteACLFunc is an instance of a mock function object controlling
+	// the behavior of the method DeleteACL.
+	DeleteACLFunc *SubRepoPermsStoreDeleteACLFunc
 	// DeleteByUserFunc is an instance of a mock function object controlling
 	// the behavior of the method DeleteByUser.
 	DeleteByUserFunc *SubRepoPermsStoreDeleteByUserFunc
@@ -71601,6 +72474,9 @@ type MockSubRepoPermsStore struct {
 	// GetFunc is an instance of a mock function object controlling the
 	// behavior of the method Get.
 	GetFunc *SubRepoPermsStoreGetFunc
+	// GetACLFunc is an instance of a mock function object controlling the
+	// behavior of the method GetACL.
+	GetACLFunc *SubRepoPermsStoreGetACLFunc
 	// GetByUserFunc is an instance of a mock function object controlling
 	// the behavior of the method GetByUser.
 	GetByUserFunc *SubRepoPermsStoreGetByUserFunc
@@ -71622,6 +72498,9 @@ type MockSubRepoPermsStore struct {
 	// UpsertFunc is an instance of a mock function object controlling the
 	// behavior of the method Upsert.
 	UpsertFunc *SubRepoPermsStoreUpsertFunc
+	// UpsertACLFunc is an instance of a mock function object controlling
+	// the behavior of the method UpsertACL.
+	UpsertACLFunc *SubRepoPermsStoreUpsertACLFunc
 	// UpsertWithSpecFunc is an instance of a mock function object
 	// controlling the behavior of the method UpsertWithSpec.
 	UpsertWithSpecFunc *SubRepoPermsStoreUpsertWithSpecFunc
@@ -71635,6 +72514,11 @@ type MockSubRepoPermsStore struct {
 // overwritten.
 func NewMockSubRepoPermsStore() *MockSubRepoPermsStore {
 	return &MockSubRepoPermsStore{
+		DeleteACLFunc: &SubRepoPermsStoreDeleteACLFunc{
+			defaultHook: func(context.Context, api.RepoID, database.SubRepoPermsACLSource) (r0 error) {
+				return
+			},
+		},
 		DeleteByUserFunc: &SubRepoPermsStoreDeleteByUserFunc{
 			defaultHook: func(context.Context, int32) (r0 error) {
 				return
@@ -71650,6 +72534,11 @@ func NewMockSubRepoPermsStore() *MockSubRepoPermsStore {
 				return
 			},
 		},
+		GetACLFunc: &SubRepoPermsStoreGetACLFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *database.SubRepoPermsACL, r1 error) {
+				return
+			},
+		},
 		GetByUserFunc: &SubRepoPermsStoreGetByUserFunc{
 			defaultHook: func(context.Context, int32) (r0 map[api.RepoName]authz.SubRepoPermissions, r1 error) {
 				return
@@ -71685,6 +72574,11 @@ func NewMockSubRepoPermsStore() *MockSubRepoPermsStore {
 				return
 			},
 		},
+		UpsertACLFunc: &SubRepoPermsStoreUpsertACLFunc{
+			defaultHook: func(context.Context, *database.SubRepoPermsACL) (r0 error) {
+				return
+			},
+		},
 		UpsertWithSpecFunc: &SubRepoPermsStoreUpsertWithSpecFunc{
 			defaultHook: func(context.Context, int32, api.ExternalRepoSpec, authz.SubRepoPermissions) (r0 error) {
 				return
@@ -71703,6 +72597,11 @@ func NewMockSubRepoPermsStore() *MockSubRepoPermsStore {
 // overwritten.
 func NewStrictMockSubRepoPermsStore() *MockSubRepoPermsStore {
 	return &MockSubRepoPermsStore{
+		DeleteACLFunc: &SubRepoPermsStoreDeleteACLFunc{
+			defaultHook: func(context.Context, api.RepoID, database.SubRepoPermsACLSource) error {
+				panic("unexpected invocation of MockSubRepoPermsStore.DeleteACL")
+			},
+		},
 		DeleteByUserFunc: &SubRepoPermsStoreDeleteByUserFunc{
 			defaultHook: func(context.Context, int32) error {
 				panic("unexpected invocation of MockSubRepoPermsStore.DeleteByUser")
@@ -71718,6 +72617,11 @@ func NewStrictMockSubRepoPermsStore() *MockSubRepoPermsStore {
 				panic("unexpected invocation of MockSubRepoPermsStore.Get")
 			},
 		},
+		GetACLFunc: &SubRepoPermsStoreGetACLFunc{
+			defaultHook: func(context.Context, api.RepoID) (*database.SubRepoPermsACL, error) {
+				panic("unexpected invocation of MockSubRepoPermsStore.GetACL")
+			},
+		},
 		GetByUserFunc: &SubRepoPermsStoreGetByUserFunc{
 			defaultHook: func(context.Context, int32) (map[api.RepoName]authz.SubRepoPermissions, error) {
 				panic("unexpected invocation of MockSubRepoPermsStore.GetByUser")
@@ -71753,6 +72657,11 @@ func NewStrictMockSubRepoPermsStore() *MockSubRepoPermsStore {
 				panic("unexpected invocation of MockSubRepoPermsStore.Upsert")
 			},
 		},
+		UpsertACLFunc: &SubRepoPermsStoreUpsertACLFunc{
+			defaultHook: func(context.Context, *database.SubRepoPermsACL) error {
+				panic("unexpected invocation of MockSubRepoPermsStore.UpsertACL")
+			},
+		},
 		UpsertWithSpecFunc: &SubRepoPermsStoreUpsertWithSpecFunc{
 			defaultHook: func(context.Context, int32, api.ExternalRepoSpec, authz.SubRepoPermissions) error {
 				panic("unexpected invocation of MockSubRepoPermsStore.UpsertWithSpec")
@@ -71771,6 +72680,9 @@ func NewStrictMockSubRepoPermsStore() *MockSubRepoPermsStore {
 // implementation, unless overwritten.
 func NewMockSubRepoPermsStoreFrom(i database.SubRepoPermsStore) *MockSubRepoPermsStore {
 	return &MockSubRepoPermsStore{
+		DeleteACLFunc: &SubRepoPermsStoreDeleteACLFunc{
+			defaultHook: i.DeleteACL,
+		},
 		DeleteByUserFunc: &SubRepoPermsStoreDeleteByUserFunc{
 			defaultHook: i.DeleteByUser,
 		},
@@ -71780,6 +72692,9 @@ func NewMockSubRepoPermsStoreFrom(i database.SubRepoPermsStore) *MockSubRepoPerm
 		GetFunc: &SubRepoPermsStoreGetFunc{
 			defaultHook: i.Get,
 		},
+		GetACLFunc: &SubRepoPermsStoreGetACLFunc{
+			defaultHook: i.GetACL,
+		},
 		GetByUserFunc: &SubRepoPermsStoreGetByUserFunc{
 			defaultHook: i.GetByUser,
 		},
@@ -71801,6 +72716,9 @@ func NewMockSubRepoPermsStoreFrom(i database.SubRepoPermsStore) *MockSubRepoPerm
 		UpsertFunc: &SubRepoPermsStoreUpsertFunc{
 			defaultHook: i.Upsert,
 		},
+		UpsertACLFunc: &SubRepoPermsStoreUpsertACLFunc{
+			defaultHook: i.UpsertACL,
+		},
 		UpsertWithSpecFunc: &SubRepoPermsStoreUpsertWithSpecFunc{
 			defaultHook: i.UpsertWithSpec,
 		},
@@ -71810,6 +72728,114 @@ func NewMockSubRepoPermsStoreFrom(i database.SubRepoPermsStore) *MockSubRepoPerm
 	}
 }
 
+// SubRepoPermsStoreDeleteACLFunc describes the behavior when the DeleteACL
+// method of the parent MockSubRepoPermsStore instance is invoked.
+type SubRepoPermsStoreDeleteACLFunc struct {
+	defaultHook func(context.Context, api.RepoID, database.SubRepoPermsACLSource) error
+	hooks       []func(context.Context, api.RepoID, database.SubRepoPermsACLSource) error
+	history     []SubRepoPermsStoreDeleteACLFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteACL delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSubRepoPermsStore) DeleteACL(v0 context.Context, v1 api.RepoID, v2 database.SubRepoPermsACLSource) error {
+	r0 := m.DeleteACLFunc.nextHook()(v0, v1, v2)
+	m.DeleteACLFunc.appendCall(SubRepoPermsStoreDeleteACLFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteACL method of
+// the parent MockSubRepoPermsStore instance is invoked and the hook queue
+// is empty.
+func (f *SubRepoPermsStoreDeleteACLFunc) SetDefaultHook(hook func(context.Context, api.RepoID, database.SubRepoPermsACLSource) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteACL method of the parent MockSubRepoPermsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SubRepoPermsStoreDeleteACLFunc) PushHook(hook func(context.Context, api.RepoID, database.SubRepoPermsACLSource) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SubRepoPermsStoreDeleteACLFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, database.SubRepoPermsACLSource) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SubRepoPermsStoreDeleteACLFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, database.SubRepoPermsACLSource) error {
+		return r0
+	})
+}
+
+func (f *SubRepoPermsStoreDeleteACLFunc) nextHook() func(context.Context, api.RepoID, database.SubRepoPermsACLSource) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SubRepoPermsStoreDeleteACLFunc) appendCall(r0 SubRepoPermsStoreDeleteACLFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SubRepoPermsStoreDeleteACLFuncCall objects
+// describing the invocations of this function.
+func (f *SubRepoPermsStoreDeleteACLFunc) History() []SubRepoPermsStoreDeleteACLFuncCall {
+	f.mutex.Lock()
+	history := make([]SubRepoPermsStoreDeleteACLFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SubRepoPermsStoreDeleteACLFuncCall is an object that describes an
+// invocation of method DeleteACL on an instance of MockSubRepoPermsStore.
+type SubRepoPermsStoreDeleteACLFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 database.SubRepoPermsACLSource
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SubRepoPermsStoreDeleteACLFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SubRepoPermsStoreDeleteACLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // SubRepoPermsStoreDeleteByUserFunc describes the behavior when the
 // DeleteByUser method of the parent MockSubRepoPermsStore instance is
 // invoked.
@@ -72130,6 +73156,114 @@ func (c SubRepoPermsStoreGetFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// SubRepoPermsStoreGetACLFunc describes the behavior when the GetACL method
+// of the parent MockSubRepoPermsStore instance is invoked.
+type SubRepoPermsStoreGetACLFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*database.SubRepoPermsACL, error)
+	hooks       []func(context.Context, api.RepoID) (*database.SubRepoPermsACL, error)
+	history     []SubRepoPermsStoreGetACLFuncCall
+	mutex       sync.Mutex
+}
+
+// GetACL delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSubRepoPermsStore) GetACL(v0 context.Context, v1 api.RepoID) (*database.SubRepoPermsACL, error) {
+	r0, r1 := m.GetACLFunc.nextHook()(v0, v1)
+	m.GetACLFunc.appendCall(SubRepoPermsStoreGetACLFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetACL method of the
+// parent MockSubRepoPermsStore instance is invoked and the hook queue is
+// empty.
+func (f *SubRepoPermsStoreGetACLFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*database.SubRepoPermsACL, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetACL method of the parent MockSubRepoPermsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SubRepoPermsStoreGetACLFunc) PushHook(hook func(context.Context, api.RepoID) (*database.SubRepoPermsACL, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SubRepoPermsStoreGetACLFunc) SetDefaultReturn(r0 *database.SubRepoPermsACL, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*database.SubRepoPermsACL, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SubRepoPermsStoreGetACLFunc) PushReturn(r0 *database.SubRepoPermsACL, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*database.SubRepoPermsACL, error) {
+		return r0, r1
+	})
+}
+
+func (f *SubRepoPermsStoreGetACLFunc) nextHook() func(context.Context, api.RepoID) (*database.SubRepoPermsACL, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SubRepoPermsStoreGetACLFunc) appendCall(r0 SubRepoPermsStoreGetACLFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SubRepoPermsStoreGetACLFuncCall objects
+// describing the invocations of this function.
+func (f *SubRepoPermsStoreGetACLFunc) History() []SubRepoPermsStoreGetACLFuncCall {
+	f.mutex.Lock()
+	history := make([]SubRepoPermsStoreGetACLFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SubRepoPermsStoreGetACLFuncCall is an object that describes an invocation
+// of method GetACL on an instance of MockSubRepoPermsStore.
+type SubRepoPermsStoreGetACLFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.SubRepoPermsACL
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SubRepoPermsStoreGetACLFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SubRepoPermsStoreGetACLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // SubRepoPermsStoreGetByUserFunc describes the behavior when the GetByUser
 // method of the parent MockSubRepoPermsStore instance is invoked.
 type SubRepoPermsStoreGetByUserFunc struct {
@@ -72892,6 +74026,111 @@ func (c SubRepoPermsStoreUpsertFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// SubRepoPermsStoreUpsertACLFunc describes the behavior when the UpsertACL
+// method of the parent MockSubRepoPermsStore instance is invoked.
+type SubRepoPermsStoreUpsertACLFunc struct {
+	defaultHook func(context.Context, *database.SubRepoPermsACL) error
+	hooks       []func(context.Context, *database.SubRepoPermsACL) error
+	history     []SubRepoPermsStoreUpsertACLFuncCall
+	mutex       sync.Mutex
+}
+
+// UpsertACL delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSubRepoPermsStore) UpsertACL(v0 context.Context, v1 *database.SubRepoPermsACL) error {
+	r0 := m.UpsertACLFunc.nextHook()(v0, v1)
+	m.UpsertACLFunc.appendCall(SubRepoPermsStoreUpsertACLFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the UpsertACL method of
+// the parent MockSubRepoPermsStore instance is invoked and the hook queue
+// is empty.
+func (f *SubRepoPermsStoreUpsertACLFunc) SetDefaultHook(hook func(context.Context, *database.SubRepoPermsACL) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpsertACL method of the parent MockSubRepoPermsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SubRepoPermsStoreUpsertACLFunc) PushHook(hook func(context.Context, *database.SubRepoPermsACL) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SubRepoPermsStoreUpsertACLFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *database.SubRepoPermsACL) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SubRepoPermsStoreUpsertACLFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *database.SubRepoPermsACL) error {
+		return r0
+	})
+}
+
+func (f *SubRepoPermsStoreUpsertACLFunc) nextHook() func(context.Context, *database.SubRepoPermsACL) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SubRepoPermsStoreUpsertACLFunc) appendCall(r0 SubRepoPermsStoreUpsertACLFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SubRepoPermsStoreUpsertACLFuncCall objects
+// describing the invocations of this function.
+func (f *SubRepoPermsStoreUpsertACLFunc) History() []SubRepoPermsStoreUpsertACLFuncCall {
+	f.mutex.Lock()
+	history := make([]SubRepoPermsStoreUpsertACLFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SubRepoPermsStoreUpsertACLFuncCall is an object that describes an
+// invocation of method UpsertACL on an instance of MockSubRepoPermsStore.
+type SubRepoPermsStoreUpsertACLFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *database.SubRepoPermsACL
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SubRepoPermsStoreUpsertACLFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SubRepoPermsStoreUpsertACLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // SubRepoPermsStoreUpsertWithSpecFunc describes the behavior when the
 // UpsertWithSpec method of the parent MockSubRepoPermsStore instance is
 // invoked.
@@ -63,8 +63,9 @@ func (e ExecutorSecret) Value(ctx context.Context, s ExecutorSecretAccessLogCrea
 type ExecutorSecretScope string
 
 const (
-	ExecutorSecretScopeBatches   ExecutorSecretScope = "batches"
-	ExecutorSecretScopeCodeIntel ExecutorSecretScope = "codeintel"
+	ExecutorSecretScopeBatches    ExecutorSecretScope = "batches"
+	ExecutorSecretScopeCodeIntel  ExecutorSecretScope = "codeintel"
+	ExecutorSecretScopeCustomJobs ExecutorSecretScope = "customjobs"
 )
 
 // ExecutorSecretNotFoundErr is returned when a secret cannot be found.
@@ -49,6 +49,26 @@ func Drift(commandName string, factory RunnerFactory, outFactory OutputFactory,
 		Usage:    "Ignore the running migrator not being the latest version. It is recommended to use the latest migrator version.",
 		Required: false,
 	}
+	fixFlag := &cli.BoolFlag{
+		Name:     "fix",
+		Usage:    "Repair the detected drift with a generated SQL script, applied in a single transaction.",
+		Required: false,
+	}
+	dryRunFlag := &cli.BoolFlag{
+		Name:     "dry-run",
+		Usage:    "Write the repair script instead of applying it. Requires -fix.",
+		Required: false,
+	}
+	outFlag := &cli.StringFlag{
+		Name:     "out",
+		Usage:    "With -fix -dry-run, the file to write the repair script to. If not supplied, stdout is used.",
+		Required: false,
+	}
+	forceFlag := &cli.BoolFlag{
+		Name:     "force",
+		Usage:    "Force write the file given by -out if it already exists.",
+		Required: false,
+	}
 	// Only in available via `sg migration`` in development mode
 	autofixFlag := &cli.BoolFlag{
 		Name:     "auto-fix",
@@ -58,6 +78,10 @@ func Drift(commandName string, factory RunnerFactory, outFactory OutputFactory,
 	}
 
 	action := makeAction(outFactory, func(ctx context.Context, cmd *cli.Context, out *output.Output) error {
+		if dryRunFlag.Get(cmd) && !fixFlag.Get(cmd) {
+			return flagHelp(out, "-dry-run is only supported together with -fix")
+		}
+
 		airgapped := isAirgapped(ctx)
 		if airgapped != nil {
 			out.WriteLine(output.Line(output.EmojiWarningSign, output.StyleYellow, airgapped.Error()))
@@ -141,6 +165,31 @@ func Drift(commandName string, factory RunnerFactory, outFactory OutputFactory,
 		schema := allSchemas["public"]
 		summaries := drift.CompareSchemaDescriptions(schemaName, version, multiversion.Canonicalize(schema), multiversion.Canonicalize(expectedSchema))
 
+		if fixFlag.Get(cmd) {
+			if dryRunFlag.Get(cmd) {
+				w, _, err := getOutput(out, outFlag.Get(cmd), forceFlag.Get(cmd), true)
+				if err != nil {
+					return err
+				}
+				defer w.Close()
+
+				unfixable, err := writeRepairScript(out, w, summaries)
+				if err != nil || len(unfixable) == 0 {
+					return err
+				}
+
+				out.WriteLine(output.Linef(output.EmojiWarningSign, output.StyleWarning, "The following differences must be repaired by hand"))
+				return drift.DisplaySchemaSummaries(out, unfixable)
+			}
+
+			summaries, err = applyRepairScript(ctx, out, store, summaries, func(schema schemas.SchemaDescription) []drift.Summary {
+				return drift.CompareSchemaDescriptions(schemaName, version, multiversion.Canonicalize(schema), multiversion.Canonicalize(expectedSchema))
+			})
+			if err != nil {
+				return err
+			}
+		}
+
 		if autofixFlag.Get(cmd) {
 			summaries, err = attemptAutofix(ctx, out, store, summaries, func(schema schemas.SchemaDescription) []drift.Summary {
 				return drift.CompareSchemaDescriptions(schemaName, version, multiversion.Canonicalize(schema), multiversion.Canonicalize(expectedSchema))
@@ -159,6 +208,10 @@ func Drift(commandName string, factory RunnerFactory, outFactory OutputFactory,
 		fileFlag,
 		skipVersionCheckFlag,
 		ignoreMigratorUpdateCheckFlag,
+		fixFlag,
+		dryRunFlag,
+		outFlag,
+		forceFlag,
 	}
 	if development {
 		flags = append(flags, autofixFlag)
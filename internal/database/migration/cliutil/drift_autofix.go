@@ -3,6 +3,7 @@ package cliutil
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/internal/database/migration/drift"
@@ -78,3 +79,49 @@ func runAutofix(
 
 	return successes > 0 || len(errs) > 0
 }
+
+// writeRepairScript writes the repair script of the given summaries to w, and returns the
+// summaries that cannot be repaired by the script.
+func writeRepairScript(out *output.Output, w io.Writer, summaries []drift.Summary) ([]drift.Summary, error) {
+	statements, unfixable := drift.RepairScript(summaries)
+	if len(statements) == 0 {
+		out.WriteLine(output.Linef(output.EmojiInfo, output.StyleReset, "No drift can be repaired automatically"))
+		return unfixable, nil
+	}
+
+	if _, err := io.WriteString(w, drift.FormatRepairScript(statements)); err != nil {
+		return nil, err
+	}
+
+	out.WriteLine(output.Linef(output.EmojiInfo, output.StyleReset, "Wrote a repair script of %d statements for %d of %d differences. Re-run without -dry-run to apply it", len(statements), len(summaries)-len(unfixable), len(summaries)))
+	return unfixable, nil
+}
+
+// applyRepairScript applies the repair script of the given summaries in a single transaction.
+// This function returns a fresh drift description of the target schema if the script was
+// applied, and the given summaries otherwise.
+func applyRepairScript(
+	ctx context.Context,
+	out *output.Output,
+	store Store,
+	summaries []drift.Summary,
+	compareDescriptionAgainstTarget func(descriptions.SchemaDescription) []drift.Summary,
+) ([]drift.Summary, error) {
+	statements, _ := drift.RepairScript(summaries)
+	if len(statements) == 0 {
+		out.WriteLine(output.Linef(output.EmojiInfo, output.StyleReset, "No drift can be repaired automatically"))
+		return summaries, nil
+	}
+
+	if err := store.RunDDLStatements(ctx, statements); err != nil {
+		return nil, errors.Wrap(err, "failed to apply repair script, no changes were made. Re-run with -dry-run to review the script")
+	}
+	out.WriteLine(output.Linef(output.EmojiSuccess, output.StyleSuccess, "Applied a repair script of %d statements", len(statements)))
+
+	out.WriteLine(output.Linef(output.EmojiInfo, output.StyleReset, "Re-checking drift"))
+	schemas, err := store.Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return compareDescriptionAgainstTarget(schemas["public"]), nil
+}
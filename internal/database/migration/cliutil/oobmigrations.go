@@ -0,0 +1,187 @@
+package cliutil
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/store"
+	"github.com/sourcegraph/sourcegraph/internal/oobmigration"
+	"github.com/sourcegraph/sourcegraph/lib/output"
+)
+
+func OutOfBandMigrations(commandName string, runnerFactory RunnerFactory, outFactory OutputFactory) *cli.Command {
+	idsFlag := func(required bool) *cli.IntSliceFlag {
+		return &cli.IntSliceFlag{
+			Name:     "id",
+			Usage:    "The target migration(s). Multiple values may be supplied.",
+			Required: required,
+		}
+	}
+	intervalFlag := &cli.DurationFlag{
+		Name:     "interval",
+		Usage:    "The minimum time between invocations of the migration (e.g. 30s). Supply 0 to remove the throttle.",
+		Required: true,
+	}
+
+	// withStore invokes the given function with an out-of-band migration store over the frontend database.
+	withStore := func(f func(ctx context.Context, cmd *cli.Context, out *output.Output, store *oobmigration.Store) error) func(cmd *cli.Context) error {
+		return makeAction(outFactory, func(ctx context.Context, cmd *cli.Context, out *output.Output) error {
+			r, err := runnerFactory(schemas.SchemaNames)
+			if err != nil {
+				return err
+			}
+			db, err := store.ExtractDatabase(ctx, r)
+			if err != nil {
+				return err
+			}
+
+			return f(ctx, cmd, out, oobmigration.NewStoreWithDB(db))
+		})
+	}
+
+	statusIDsFlag := idsFlag(false)
+	statusAction := withStore(func(ctx context.Context, cmd *cli.Context, out *output.Output, s *oobmigration.Store) error {
+		migrations, err := listOutOfBandMigrations(ctx, s, statusIDsFlag.Get(cmd))
+		if err != nil {
+			return err
+		}
+
+		for _, m := range migrations {
+			remaining, hasETA, err := s.EstimateRemaining(ctx, m)
+			if err != nil {
+				return err
+			}
+
+			out.WriteLine(output.Linef("", output.StyleReset, "Migration #%d (%s): %s", m.ID, m.Component, describeOutOfBandMigrationState(m, remaining, hasETA)))
+		}
+
+		return nil
+	})
+
+	pauseIDsFlag := idsFlag(true)
+	pauseAction := withStore(func(ctx context.Context, cmd *cli.Context, out *output.Output, s *oobmigration.Store) error {
+		return updateOutOfBandMigrations(ctx, s, out, pauseIDsFlag.Get(cmd), "Paused", func(id int) error {
+			return s.UpdatePaused(ctx, id, true)
+		})
+	})
+
+	resumeIDsFlag := idsFlag(true)
+	resumeAction := withStore(func(ctx context.Context, cmd *cli.Context, out *output.Output, s *oobmigration.Store) error {
+		return updateOutOfBandMigrations(ctx, s, out, resumeIDsFlag.Get(cmd), "Resumed", func(id int) error {
+			return s.UpdatePaused(ctx, id, false)
+		})
+	})
+
+	throttleIDsFlag := idsFlag(true)
+	throttleAction := withStore(func(ctx context.Context, cmd *cli.Context, out *output.Output, s *oobmigration.Store) error {
+		interval := intervalFlag.Get(cmd)
+		if interval < 0 {
+			return flagHelp(out, "supply a non-negative -interval")
+		}
+
+		return updateOutOfBandMigrations(ctx, s, out, throttleIDsFlag.Get(cmd), "Throttled", func(id int) error {
+			return s.UpdateThrottleInterval(ctx, id, interval)
+		})
+	})
+
+	return &cli.Command{
+		Name:        "out-of-band-migrations",
+		Usage:       "Inspect and control the progress of out of band migrations.",
+		Description: "Paused and throttled migrations are respected by running Sourcegraph instances as well as by the run-out-of-band-migrations and upgrade commands.",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "status",
+				Usage:  "Display the progress, controls and estimated time remaining of out of band migrations.",
+				Action: statusAction,
+				Flags:  []cli.Flag{statusIDsFlag},
+			},
+			{
+				Name:   "pause",
+				Usage:  "Pause out of band migrations until they are resumed.",
+				Action: pauseAction,
+				Flags:  []cli.Flag{pauseIDsFlag},
+			},
+			{
+				Name:   "resume",
+				Usage:  "Resume paused out of band migrations.",
+				Action: resumeAction,
+				Flags:  []cli.Flag{resumeIDsFlag},
+			},
+			{
+				Name:   "throttle",
+				Usage:  "Set the minimum time between invocations of out of band migrations.",
+				Action: throttleAction,
+				Flags:  []cli.Flag{throttleIDsFlag, intervalFlag},
+			},
+		},
+	}
+}
+
+// listOutOfBandMigrations returns the migrations with the given identifiers, or all migrations
+// if no identifiers are given, ordered by identifier.
+func listOutOfBandMigrations(ctx context.Context, s *oobmigration.Store, ids []int) ([]oobmigration.Migration, error) {
+	var migrations []oobmigration.Migration
+	var err error
+	if len(ids) == 0 {
+		migrations, err = s.List(ctx)
+	} else {
+		migrations, err = s.GetByIDs(ctx, ids)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].ID < migrations[j].ID })
+	return migrations, nil
+}
+
+// updateOutOfBandMigrations invokes the given update function for each of the given migrations,
+// after checking that all of them exist.
+func updateOutOfBandMigrations(ctx context.Context, s *oobmigration.Store, out *output.Output, ids []int, verb string, update func(id int) error) error {
+	migrations, err := listOutOfBandMigrations(ctx, s, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if err := update(m.ID); err != nil {
+			return err
+		}
+
+		out.WriteLine(output.Linef(output.EmojiSuccess, output.StyleSuccess, "%s migration #%d (%s)", verb, m.ID, m.Component))
+	}
+
+	return nil
+}
+
+// describeOutOfBandMigrationState returns a one-line description of the progress, controls and
+// estimated time remaining of the given migration.
+func describeOutOfBandMigrationState(m oobmigration.Migration, remaining time.Duration, hasETA bool) string {
+	direction := "up"
+	if m.ApplyReverse {
+		direction = "down"
+	}
+
+	parts := []string{fmt.Sprintf("%.2f%% complete (%s)", m.Progress*100, direction)}
+	if m.Complete() {
+		return parts[0]
+	}
+
+	if m.Paused {
+		parts = append(parts, "paused")
+	}
+	if m.ThrottleInterval > 0 {
+		parts = append(parts, fmt.Sprintf("throttled to one run every %s", m.ThrottleInterval))
+	}
+	if hasETA {
+		parts = append(parts, fmt.Sprintf("about %s remaining", remaining.Round(time.Minute)))
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,241 @@
+package cliutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/urfave/cli/v2"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/risk"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/runner"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/output"
+)
+
+func Plan(commandName string, runnerFactory runner.RunnerFactoryWithSchemas, outFactory OutputFactory) *cli.Command {
+	fromFlag := &cli.StringFlag{
+		Name:     "from",
+		Usage:    "The source (current) instance version. Must be of the form `{Major}.{Minor}` or `v{Major}.{Minor}`.",
+		Required: false,
+	}
+	toFlag := &cli.StringFlag{
+		Name:     "to",
+		Usage:    "The target instance version. Must be of the form `{Major}.{Minor}` or `v{Major}.{Minor}`.",
+		Required: false,
+	}
+	formatFlag := &cli.StringFlag{
+		Name:     "format",
+		Usage:    "The output format of the report, 'text' or 'json'.",
+		Value:    "text",
+		Required: false,
+	}
+	outFlag := &cli.StringFlag{
+		Name:     "out",
+		Usage:    "The file to write the JSON report to. If not supplied, stdout is used.",
+		Required: false,
+	}
+	forceFlag := &cli.BoolFlag{
+		Name:     "force",
+		Usage:    "Force write the file if it already exists.",
+		Required: false,
+	}
+	verboseFlag := &cli.BoolFlag{
+		Name:     "verbose",
+		Usage:    "List low risk statements in the text report as well.",
+		Required: false,
+	}
+
+	action := makeAction(outFactory, func(ctx context.Context, cmd *cli.Context, out *output.Output) error {
+		format := formatFlag.Get(cmd)
+		if format != "text" && format != "json" {
+			return flagHelp(out, "unrecognized format %q (must be text or json)", format)
+		}
+
+		r, err := runnerFactory(schemas.SchemaNames, schemas.Schemas)
+		if err != nil {
+			return errors.Wrap(err, "new runner")
+		}
+		db, err := store.ExtractDatabase(ctx, r)
+		if err != nil {
+			return errors.Wrap(err, "new db handle")
+		}
+
+		plan, err := planUpgrade(ctx, db, fromFlag.Get(cmd), toFlag.Get(cmd))
+		if err != nil {
+			return err
+		}
+
+		// Read the applied migrations and the live table statistics of each schema, so that
+		// we only report on pending migrations and can estimate the impact on large tables.
+		appliedIDsBySchemaName := make(map[string][]int, len(schemas.SchemaNames))
+		statsBySchemaName := make(map[string]risk.SchemaStats, len(schemas.SchemaNames))
+		for _, schemaName := range schemas.SchemaNames {
+			schemaStore, err := r.Store(ctx, schemaName)
+			if err != nil {
+				return err
+			}
+			appliedIDs, _, _, err := schemaStore.Versions(ctx)
+			if err != nil {
+				return err
+			}
+			appliedIDsBySchemaName[schemaName] = appliedIDs
+
+			schemaDB, err := store.ExtractDB(ctx, r, schemaName)
+			if err != nil {
+				return err
+			}
+			if statsBySchemaName[schemaName], err = risk.LoadSchemaStats(ctx, schemaDB); err != nil {
+				return errors.Wrapf(err, "load table statistics of %q", schemaName)
+			}
+		}
+
+		planned, err := plan.PlannedMigrations(appliedIDsBySchemaName)
+		if err != nil {
+			return err
+		}
+		migrations := make([]risk.Migration, 0, len(planned))
+		for _, m := range planned {
+			migrations = append(migrations, risk.Migration{
+				Schema:           m.SchemaName,
+				ID:               m.Definition.ID,
+				Name:             m.Definition.Name,
+				Privileged:       m.Definition.Privileged,
+				NonTransactional: m.Definition.IsCreateIndexConcurrently,
+				Query:            m.Definition.UpQuery.Query(sqlf.PostgresBindVar),
+			})
+		}
+		report := risk.NewReport(migrations, statsBySchemaName)
+
+		if format == "json" {
+			w, _, err := getOutput(out, outFlag.Get(cmd), forceFlag.Get(cmd), true)
+			if err != nil {
+				return err
+			}
+			defer w.Close()
+
+			serialized, err := json.MarshalIndent(struct {
+				From string `json:"from"`
+				To   string `json:"to"`
+				risk.Report
+			}{
+				From:   plan.From().String(),
+				To:     plan.To().String(),
+				Report: report,
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(append(serialized, '\n'))
+			return err
+		}
+
+		writeRiskReport(out, plan.From().String(), plan.To().String(), report, verboseFlag.Get(cmd))
+		return nil
+	})
+
+	return &cli.Command{
+		Name:  "plan",
+		Usage: "Report the lock impact of the schema migrations of an upgrade without applying them",
+		Description: "Analyzes the SQL of the schema migrations that an upgrade would apply, and combines it with the " +
+			"size of the affected tables to report which migrations block reads or writes, and for roughly how long.",
+		Action: action,
+		Flags: []cli.Flag{
+			fromFlag,
+			toFlag,
+			formatFlag,
+			outFlag,
+			forceFlag,
+			verboseFlag,
+		},
+	}
+}
+
+var riskStyles = map[risk.Level]output.Style{
+	risk.LevelLow:    output.StyleSuccess,
+	risk.LevelMedium: output.StyleYellow,
+	risk.LevelHigh:   output.StyleFailure,
+}
+
+var riskEmojis = map[risk.Level]string{
+	risk.LevelLow:    output.EmojiSuccess,
+	risk.LevelMedium: output.EmojiWarningSign,
+	risk.LevelHigh:   output.EmojiFailure,
+}
+
+// writeRiskReport writes the given report as text. Low risk migrations are only listed
+// in verbose mode.
+func writeRiskReport(out *output.Output, from, to string, report risk.Report, verbose bool) {
+	counts := map[risk.Level]int{}
+	for _, m := range report.Migrations {
+		counts[m.Risk]++
+	}
+
+	out.WriteLine(output.Linef(output.EmojiInfo, output.StyleReset,
+		"Upgrade from v%s to v%s applies %d schema migrations: %d high, %d medium and %d low risk",
+		from, to, len(report.Migrations), counts[risk.LevelHigh], counts[risk.LevelMedium], counts[risk.LevelLow],
+	))
+
+	for _, m := range report.Migrations {
+		if m.Risk == risk.LevelLow && !verbose {
+			continue
+		}
+
+		privileged := ""
+		if m.Privileged {
+			privileged = " (privileged)"
+		}
+		out.WriteLine(output.Linef(riskEmojis[m.Risk], riskStyles[m.Risk],
+			"[%s] %s %d %s%s, estimated %s",
+			strings.ToUpper(string(m.Risk)), m.Schema, m.ID, m.Name, privileged, formatSeconds(m.EstimatedSeconds),
+		))
+
+		for _, s := range m.Statements {
+			if s.Risk == risk.LevelLow && !verbose {
+				continue
+			}
+
+			lock := s.Lock
+			if lock == risk.LockNone {
+				lock = "no"
+			}
+			size := "unknown size"
+			if s.Stats != nil {
+				size = fmt.Sprintf("%d rows, %s", s.Stats.Rows, formatBytes(s.Stats.TableBytes))
+			}
+			out.WriteLine(output.Linef("", riskStyles[s.Risk],
+				"    %s lock on %s (%s), %s: %s - %s",
+				lock, s.Table, size, s.Work, s.Reason, s.RiskReason,
+			))
+		}
+	}
+
+	out.WriteLine(output.Linef(riskEmojis[report.Risk], riskStyles[report.Risk],
+		"Overall risk: %s, estimated schema migration time %s. Estimates assume an idle database; locks also wait for running queries on the same tables.",
+		report.Risk, formatSeconds(report.EstimatedSeconds),
+	))
+}
+
+func formatSeconds(seconds float64) string {
+	if seconds < 1 {
+		return "<1s"
+	}
+	return fmt.Sprintf("%.0fs", seconds)
+}
+
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
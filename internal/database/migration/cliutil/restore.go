@@ -0,0 +1,120 @@
+package cliutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"golang.org/x/exp/slices"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/multiversion"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/runner"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/snapshot"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/output"
+)
+
+func Restore(commandName string, runnerFactory runner.RunnerFactoryWithSchemas, outFactory OutputFactory) *cli.Command {
+	idFlag := &cli.StringFlag{
+		Name:     "id",
+		Usage:    "The ID of the snapshot to restore. If not supplied, the latest snapshot recorded in the frontend database is restored.",
+		Required: false,
+	}
+	snapshotFlag := &cli.StringFlag{
+		Name:     "snapshot",
+		Usage:    "The directory the snapshot was written to, or `uploadstore`. If not supplied, the location recorded with the snapshot is used.",
+		Required: false,
+	}
+	schemaNamesFlag := &cli.StringSliceFlag{
+		Name:     "schema",
+		Usage:    "The target `schema(s)` to restore. Comma-separated values are accepted. Supply \"all\" to restore all schemas of the snapshot.",
+		Value:    cli.NewStringSlice("all"),
+		Required: false,
+	}
+
+	action := makeAction(outFactory, func(ctx context.Context, cmd *cli.Context, out *output.Output) error {
+		schemaNames := sanitizeSchemaNames(schemaNamesFlag.Get(cmd), out)
+		if len(schemaNames) == 0 {
+			return flagHelp(out, "supply a schema via -schema")
+		}
+
+		r, err := runnerFactory(schemas.SchemaNames, schemas.Schemas)
+		if err != nil {
+			return errors.Wrap(err, "new runner")
+		}
+
+		id, location := idFlag.Get(cmd), snapshotFlag.Get(cmd)
+		if id == "" || location == "" {
+			db, err := store.ExtractDB(ctx, r, "frontend")
+			if err != nil {
+				return err
+			}
+			latestID, latestLocation, ok, err := snapshot.Latest(ctx, db)
+			if err != nil {
+				return errors.Wrap(err, "reading recorded snapshots")
+			}
+			if !ok {
+				return flagHelp(out, "no snapshot is recorded in the frontend database, supply -id and -snapshot")
+			}
+			if id == "" {
+				id = latestID
+			}
+			if location == "" {
+				if id != latestID {
+					return flagHelp(out, "supply -snapshot with the location of snapshot %q", id)
+				}
+				location = latestLocation
+			}
+		}
+
+		storage, err := snapshot.NewStorage(ctx, migratorObservationCtx, location)
+		if err != nil {
+			return err
+		}
+		manifest, err := snapshot.LoadManifest(ctx, storage, id)
+		if err != nil {
+			return err
+		}
+
+		restoreSchemaNames := make([]string, 0, len(manifest.Schemas))
+		for _, s := range manifest.Schemas {
+			if slices.Contains(schemaNames, s.Name) {
+				restoreSchemaNames = append(restoreSchemaNames, s.Name)
+			}
+		}
+		if len(restoreSchemaNames) == 0 {
+			return flagHelp(out, "snapshot %q holds none of the given schemas", id)
+		}
+		targets, err := multiversion.SnapshotTargets(ctx, r, restoreSchemaNames)
+		if err != nil {
+			return err
+		}
+
+		out.WriteLine(output.Linef(output.EmojiFingerPointRight, output.StyleReset,
+			"Restoring %v from snapshot %s, taken before upgrading from v%s to v%s",
+			restoreSchemaNames, manifest.ID, manifest.FromVersion, manifest.ToVersion,
+		))
+		if err := snapshot.Restore(ctx, storage, manifest, targets); err != nil {
+			return err
+		}
+
+		out.WriteLine(output.Linef(output.EmojiSuccess, output.StyleSuccess,
+			"Restored snapshot %s. Run Sourcegraph v%s against the restored databases", manifest.ID, manifest.FromVersion,
+		))
+		return nil
+	})
+
+	return &cli.Command{
+		Name:        "restore",
+		Usage:       "Restore databases to a snapshot taken before an upgrade",
+		Description: fmt.Sprintf("Snapshots are taken by the upgrade command with -snapshot. Requires pg_restore.\n\n%s", ConstructLongHelp()),
+		Action:      action,
+		Flags: []cli.Flag{
+			idFlag,
+			snapshotFlag,
+			schemaNamesFlag,
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/migration/multiversion"
 	"github.com/sourcegraph/sourcegraph/internal/database/migration/runner"
 	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
@@ -75,6 +76,11 @@ func Upgrade(
 		Usage:    "If set, progress bar animations are not displayed.",
 		Required: false,
 	}
+	snapshotFlag := &cli.StringFlag{
+		Name:     "snapshot",
+		Usage:    "Take a snapshot of the databases before applying migrations, to the given directory, or to the upload store configured by MIGRATION_SNAPSHOT_UPLOAD_* if set to `uploadstore`. Requires pg_dump. The snapshot can be restored with the restore command.",
+		Required: false,
+	}
 
 	action := makeAction(outFactory, func(ctx context.Context, cmd *cli.Context, out *output.Output) error {
 		airgapped := isAirgapped(ctx)
@@ -106,61 +112,20 @@ func Upgrade(
 		if err != nil {
 			return errors.Wrap(err, "new db handle")
 		}
-		currentVersion, autoUpgrade, err := upgradestore.New(db).GetAutoUpgrade(ctx)
-		if err != nil {
-			return errors.Wrap(err, "checking auto upgrade")
-		}
-
-		// determine versioning logic for upgrade based on auto_upgrade readiness and existence of to and from flags
-		var fromStr, toStr string
-		if fromFlag.Get(cmd) != "" || toFlag.Get(cmd) != "" {
-			fromStr = fromFlag.Get(cmd)
-			toStr = toFlag.Get(cmd)
-		} else if autoUpgrade {
-			fromStr = currentVersion
-			toStr = version.Version()
-		}
-		// check for null case
-		if fromStr == "" || toStr == "" {
-			return errors.New("the -from and -to flags are required when auto upgrade is not enabled")
-		}
-
-		from, ok := oobmigration.NewVersionFromString(fromStr)
-		if !ok {
-			return errors.Newf("bad format for -from = %s", fromStr)
-		}
-		to, ok := oobmigration.NewVersionFromString(toStr)
-		if !ok {
-			return errors.Newf("bad format for -to = %s", toStr)
-		}
-		if oobmigration.CompareVersions(from, to) != oobmigration.VersionOrderBefore {
-			return errors.Newf("invalid range (from=%s >= to=%s)", from, to)
-		}
-
-		// Construct inclusive upgrade range (with knowledge of major version changes)
-		versionRange, err := oobmigration.UpgradeRange(from, to)
+		plan, err := planUpgrade(ctx, db, fromFlag.Get(cmd), toFlag.Get(cmd))
 		if err != nil {
 			return err
 		}
 
-		// Determine the set of versions that need to have out of band migrations completed
-		// prior to a subsequent instance upgrade. We'll "pause" the migration at these points
-		// and run the out of band migration routines to completion.
-		interrupts, err := oobmigration.ScheduleMigrationInterrupts(from, to)
-		if err != nil {
-			return err
-		}
-
-		// Find the relevant schema and data migrations to perform (and in what order)
-		// for the given version range.
-		plan, err := multiversion.PlanMigration(from, to, versionRange, interrupts)
+		privilegedMode, err := getPivilegedModeFromFlags(cmd, out, unprivilegedOnlyFlag, noopPrivilegedFlag)
 		if err != nil {
 			return err
 		}
 
-		privilegedMode, err := getPivilegedModeFromFlags(cmd, out, unprivilegedOnlyFlag, noopPrivilegedFlag)
-		if err != nil {
-			return err
+		if location := snapshotFlag.Get(cmd); location != "" && !dryRunFlag.Get(cmd) {
+			if _, err := multiversion.TakeSnapshot(ctx, migratorObservationCtx, runner, location, plan, out); err != nil {
+				return err
+			}
 		}
 
 		// Perform the upgrade on the configured databases.
@@ -198,6 +163,61 @@ func Upgrade(
 			ignoreMigratorUpdateCheckFlag,
 			dryRunFlag,
 			disableAnimation,
+			snapshotFlag,
 		},
 	}
 }
+
+// planUpgrade determines the source and target versions of an upgrade from the values of
+// the -from and -to flags, or from the auto upgrade state of the instance if neither is
+// set, and plans the schema and out-of-band migrations to run.
+func planUpgrade(ctx context.Context, db database.DB, fromFlagValue, toFlagValue string) (multiversion.MigrationPlan, error) {
+	currentVersion, autoUpgrade, err := upgradestore.New(db).GetAutoUpgrade(ctx)
+	if err != nil {
+		return multiversion.MigrationPlan{}, errors.Wrap(err, "checking auto upgrade")
+	}
+
+	// determine versioning logic for upgrade based on auto_upgrade readiness and existence of to and from flags
+	var fromStr, toStr string
+	if fromFlagValue != "" || toFlagValue != "" {
+		fromStr = fromFlagValue
+		toStr = toFlagValue
+	} else if autoUpgrade {
+		fromStr = currentVersion
+		toStr = version.Version()
+	}
+	// check for null case
+	if fromStr == "" || toStr == "" {
+		return multiversion.MigrationPlan{}, errors.New("the -from and -to flags are required when auto upgrade is not enabled")
+	}
+
+	from, ok := oobmigration.NewVersionFromString(fromStr)
+	if !ok {
+		return multiversion.MigrationPlan{}, errors.Newf("bad format for -from = %s", fromStr)
+	}
+	to, ok := oobmigration.NewVersionFromString(toStr)
+	if !ok {
+		return multiversion.MigrationPlan{}, errors.Newf("bad format for -to = %s", toStr)
+	}
+	if oobmigration.CompareVersions(from, to) != oobmigration.VersionOrderBefore {
+		return multiversion.MigrationPlan{}, errors.Newf("invalid range (from=%s >= to=%s)", from, to)
+	}
+
+	// Construct inclusive upgrade range (with knowledge of major version changes)
+	versionRange, err := oobmigration.UpgradeRange(from, to)
+	if err != nil {
+		return multiversion.MigrationPlan{}, err
+	}
+
+	// Determine the set of versions that need to have out of band migrations completed
+	// prior to a subsequent instance upgrade. We'll "pause" the migration at these points
+	// and run the out of band migration routines to completion.
+	interrupts, err := oobmigration.ScheduleMigrationInterrupts(from, to)
+	if err != nil {
+		return multiversion.MigrationPlan{}, err
+	}
+
+	// Find the relevant schema and data migrations to perform (and in what order)
+	// for the given version range.
+	return multiversion.PlanMigration(from, to, versionRange, interrupts)
+}
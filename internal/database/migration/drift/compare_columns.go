@@ -23,6 +23,7 @@ func compareColumnsCallbackFor(schemaName, version string, table schemas.TableDe
 				fmt.Sprintf("Missing column %q.%q", table.GetName(), expectedColumn.GetName()),
 				"define the column",
 			).withStatements(
+				phaseColumns,
 				expectedColumn.CreateStatement(table),
 			).withURLHint(
 				makeSearchURL(schemaName, version,
@@ -38,10 +39,13 @@ func compareColumnsCallbackFor(schemaName, version string, table schemas.TableDe
 				fmt.Sprintf("Unexpected properties of column %s.%q", table.GetName(), expectedColumn.GetName()),
 				"alter the column",
 			).withStatements(
+				phaseColumns,
 				alterStatements...,
 			)
 		}
 
+		// Redefining the column would lose its data, so the repair script refuses
+		// to run until the column has been repaired by hand.
 		return newDriftSummary(
 			fmt.Sprintf("%q.%q", table.GetName(), expectedColumn.GetName()),
 			fmt.Sprintf("Unexpected properties of column %q.%q", table.GetName(), expectedColumn.GetName()),
@@ -49,6 +53,9 @@ func compareColumnsCallbackFor(schemaName, version string, table schemas.TableDe
 		).withDiff(
 			expectedColumn,
 			*column,
+		).withStatements(
+			phaseColumns,
+			raiseStatement(fmt.Sprintf("column %s.%s must be redefined by hand", table.GetName(), expectedColumn.GetName())),
 		).withURLHint(
 			makeSearchURL(schemaName, version,
 				fmt.Sprintf("CREATE TABLE %s", table.GetName()),
@@ -67,6 +74,7 @@ func compareColumnsAdditionalCallbackFor(table schemas.TableDescription) func(_
 				fmt.Sprintf("Unexpected column %q.%q", table.GetName(), column.GetName()),
 				"drop the column",
 			).withStatements(
+				phaseDropColumns,
 				column.DropStatement(table),
 			))
 		}
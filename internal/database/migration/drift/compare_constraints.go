@@ -23,6 +23,7 @@ func compareConstraintsCallbackFor(table schemas.TableDescription) func(_ *schem
 				fmt.Sprintf("Missing constraint %q.%q", table.GetName(), expectedConstraint.GetName()),
 				"define the constraint",
 			).withStatements(
+				phaseConstraints,
 				expectedConstraint.CreateStatement(table),
 			)
 		}
@@ -35,7 +36,10 @@ func compareConstraintsCallbackFor(table schemas.TableDescription) func(_ *schem
 			expectedConstraint,
 			*constraint,
 		).withStatements(
+			phaseDropConstraints,
 			expectedConstraint.DropStatement(table),
+		).withStatements(
+			phaseConstraints,
 			expectedConstraint.CreateStatement(table),
 		)
 	}
@@ -50,6 +54,7 @@ func compareConstraintsAdditionalCallbackFor(table schemas.TableDescription) fun
 				fmt.Sprintf("Unexpected constraint %q.%q", table.GetName(), constraint.GetName()),
 				"drop the constraint",
 			).withStatements(
+				phaseDropConstraints,
 				constraint.DropStatement(table),
 			))
 		}
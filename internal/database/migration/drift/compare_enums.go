@@ -7,16 +7,21 @@ import (
 )
 
 func compareEnums(schemaName, version string, actual, expected schemas.SchemaDescription) []Summary {
-	return compareNamedLists(actual.Enums, expected.Enums, compareEnumsCallback)
+	return compareNamedLists(actual.Enums, expected.Enums, func(enum *schemas.EnumDescription, expectedEnum schemas.EnumDescription) Summary {
+		return compareEnumsCallback(actual.Tables, enum, expectedEnum)
+	})
 }
 
-func compareEnumsCallback(enum *schemas.EnumDescription, expectedEnum schemas.EnumDescription) Summary {
+// compareEnumsCallback compares an enum to the expected one. The given tables are
+// the ones whose columns may use the enum.
+func compareEnumsCallback(tables []schemas.TableDescription, enum *schemas.EnumDescription, expectedEnum schemas.EnumDescription) Summary {
 	if enum == nil {
 		return newDriftSummary(
 			expectedEnum.GetName(),
 			fmt.Sprintf("Missing enum %q", expectedEnum.GetName()),
 			"define the type",
 		).withStatements(
+			phaseEnums,
 			expectedEnum.CreateStatement(),
 		)
 	}
@@ -27,6 +32,7 @@ func compareEnumsCallback(enum *schemas.EnumDescription, expectedEnum schemas.En
 			fmt.Sprintf("Unexpected properties of enum %q", expectedEnum.GetName()),
 			"alter the type",
 		).withStatements(
+			phaseEnums,
 			alterStatements...,
 		)
 	}
@@ -39,7 +45,7 @@ func compareEnumsCallback(enum *schemas.EnumDescription, expectedEnum schemas.En
 		expectedEnum.Labels,
 		enum.Labels,
 	).withStatements(
-		expectedEnum.DropStatement(),
-		expectedEnum.CreateStatement(),
+		phaseEnums,
+		expectedEnum.RedefineStatements(tables)...,
 	)
 }
@@ -17,6 +17,7 @@ func compareExtensionsCallback(extension *schemas.ExtensionDescription, expected
 			fmt.Sprintf("Missing extension %q", expectedExtension.GetName()),
 			"define the extension",
 		).withStatements(
+			phaseExtensions,
 			expectedExtension.CreateStatement(),
 		)
 	}
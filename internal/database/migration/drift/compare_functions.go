@@ -17,6 +17,7 @@ func compareFunctionsCallback(function *schemas.FunctionDescription, expectedFun
 			fmt.Sprintf("Missing function %q", expectedFunction.GetName()),
 			"define the function",
 		).withStatements(
+			phaseFunctions,
 			expectedFunction.CreateOrReplaceStatement(),
 		)
 	}
@@ -29,6 +30,7 @@ func compareFunctionsCallback(function *schemas.FunctionDescription, expectedFun
 		expectedFunction.Definition,
 		function.Definition,
 	).withStatements(
+		phaseFunctions,
 		expectedFunction.CreateOrReplaceStatement(),
 	)
 }
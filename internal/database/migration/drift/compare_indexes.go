@@ -23,6 +23,7 @@ func compareIndexesCallbackFor(table schemas.TableDescription) func(_ *schemas.I
 				fmt.Sprintf("Missing index %q.%q", table.GetName(), expectedIndex.GetName()),
 				"define the index",
 			).withStatements(
+				phaseIndexes,
 				expectedIndex.CreateStatement(table),
 			)
 		}
@@ -35,7 +36,10 @@ func compareIndexesCallbackFor(table schemas.TableDescription) func(_ *schemas.I
 			expectedIndex,
 			*index,
 		).withStatements(
+			phaseDropIndexes,
 			expectedIndex.DropStatement(table),
+		).withStatements(
+			phaseIndexes,
 			expectedIndex.CreateStatement(table),
 		)
 	}
@@ -50,6 +54,7 @@ func compareIndexesCallbackAdditionalFor(table schemas.TableDescription) func(_
 				fmt.Sprintf("Unexpected index %q.%q", table.GetName(), index.GetName()),
 				"drop the index",
 			).withStatements(
+				phaseDropIndexes,
 				index.DropStatement(table),
 			))
 		}
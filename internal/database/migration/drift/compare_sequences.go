@@ -18,6 +18,7 @@ func compareSequencesCallbackFor(schemaName, version string) func(_ *schemas.Seq
 				fmt.Sprintf("Missing sequence %q", expectedSequence.GetName()),
 				"define the sequence",
 			).withStatements(
+				phaseSequences,
 				expectedSequence.CreateStatement(),
 			)
 		}
@@ -28,6 +29,7 @@ func compareSequencesCallbackFor(schemaName, version string) func(_ *schemas.Seq
 				fmt.Sprintf("Unexpected properties of sequence %q", expectedSequence.GetName()),
 				"alter the sequence",
 			).withStatements(
+				phaseSequences,
 				alterStatements...,
 			)
 		}
@@ -39,6 +41,9 @@ func compareSequencesCallbackFor(schemaName, version string) func(_ *schemas.Seq
 		).withDiff(
 			expectedSequence,
 			*sequence,
+		).withStatements(
+			phaseSequences,
+			expectedSequence.AlterStatement(),
 		).withURLHint(
 			makeSearchURL(schemaName, version,
 				fmt.Sprintf("CREATE SEQUENCE %s", expectedSequence.GetName()),
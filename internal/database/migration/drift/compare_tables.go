@@ -13,11 +13,25 @@ func compareTables(schemaName, version string, actual, expected schemas.SchemaDe
 func compareTablesCallbackFor(schemaName, version string) func(_ *schemas.TableDescription, _ schemas.TableDescription) []Summary {
 	return func(table *schemas.TableDescription, expectedTable schemas.TableDescription) []Summary {
 		if table == nil {
-			return singleton(newDriftSummary(
+			summary := newDriftSummary(
 				expectedTable.GetName(),
 				fmt.Sprintf("Missing table %q", expectedTable.GetName()),
 				"define the table",
-			).withURLHint(
+			).withStatements(
+				phaseTables,
+				expectedTable.CreateStatement(),
+			)
+			for _, index := range expectedTable.Indexes {
+				summary.withStatements(phaseIndexes, index.CreateStatement(expectedTable))
+			}
+			for _, constraint := range expectedTable.Constraints {
+				summary.withStatements(phaseConstraints, constraint.CreateStatement(expectedTable))
+			}
+			for _, trigger := range expectedTable.Triggers {
+				summary.withStatements(phaseTriggers, trigger.CreateStatement())
+			}
+
+			return singleton(summary.withURLHint(
 				makeSearchURL(schemaName, version,
 					fmt.Sprintf("CREATE TABLE %s", expectedTable.GetName()),
 					fmt.Sprintf("ALTER TABLE ONLY %s", expectedTable.GetName()),
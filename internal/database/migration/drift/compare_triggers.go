@@ -23,6 +23,7 @@ func compareNamedListsCallbackFor(table schemas.TableDescription) func(_ *schema
 				fmt.Sprintf("Missing trigger %q.%q", table.GetName(), expectedTrigger.GetName()),
 				"define the trigger",
 			).withStatements(
+				phaseTriggers,
 				expectedTrigger.CreateStatement(),
 			)
 		}
@@ -35,7 +36,10 @@ func compareNamedListsCallbackFor(table schemas.TableDescription) func(_ *schema
 			expectedTrigger,
 			*trigger,
 		).withStatements(
+			phaseDropTriggers,
 			expectedTrigger.DropStatement(table),
+		).withStatements(
+			phaseTriggers,
 			expectedTrigger.CreateStatement(),
 		)
 	}
@@ -50,6 +54,7 @@ func compareNamedListsAdditionalCallbackFor(table schemas.TableDescription) func
 				fmt.Sprintf("Unexpected trigger %q.%q", table.GetName(), trigger.GetName()),
 				"drop the trigger",
 			).withStatements(
+				phaseDropTriggers,
 				trigger.DropStatement(table),
 			))
 		}
@@ -17,6 +17,7 @@ func compareViewsCallback(view *schemas.ViewDescription, expectedView schemas.Vi
 			fmt.Sprintf("Missing view %q", expectedView.GetName()),
 			"define the view",
 		).withStatements(
+			phaseViews,
 			expectedView.CreateStatement(),
 		)
 	}
@@ -29,7 +30,10 @@ func compareViewsCallback(view *schemas.ViewDescription, expectedView schemas.Vi
 		expectedView.Definition,
 		view.Definition,
 	).withStatements(
+		phaseDropViews,
 		expectedView.DropStatement(),
+	).withStatements(
+		phaseViews,
 		expectedView.CreateStatement(),
 	)
 }
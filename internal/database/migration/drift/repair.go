@@ -0,0 +1,85 @@
+package drift
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// phase orders the statements of a repair script. Objects are dropped before the
+// objects they depend on, and created after them.
+type phase int
+
+const (
+	phaseDropViews phase = iota
+	phaseDropTriggers
+	phaseDropConstraints
+	phaseDropIndexes
+	phaseDropColumns
+	phaseExtensions
+	phaseEnums
+	phaseFunctions
+	phaseSequences
+	phaseTables
+	phaseColumns
+	phaseIndexes
+	phaseConstraints
+	phaseTriggers
+	phaseViews
+)
+
+// RepairScript returns the statements of the given summaries, ordered so that they
+// can be applied in a single transaction. Statements of the same phase keep the
+// order of the summaries. Summaries without statements are returned as unfixable,
+// and must be resolved by hand.
+func RepairScript(summaries []Summary) (statements []string, unfixable []Summary) {
+	var steps []step
+	for _, summary := range summaries {
+		if s, ok := summary.(*driftSummary); ok && s.hasStatements {
+			steps = append(steps, s.steps...)
+			continue
+		}
+		if summaryStatements, ok := summary.Statements(); ok {
+			// Unknown summaries run last, in their own order
+			for _, statement := range summaryStatements {
+				steps = append(steps, step{phase: phaseViews + 1, statement: statement})
+			}
+			continue
+		}
+
+		unfixable = append(unfixable, summary)
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].phase < steps[j].phase })
+
+	statements = make([]string, 0, len(steps))
+	for _, step := range steps {
+		statements = append(statements, step.statement)
+	}
+	return statements, unfixable
+}
+
+// raiseStatement returns a statement that aborts the repair script with the given
+// message. It stands in for differences that can't be repaired by a statement, so
+// that a script never looks complete while it isn't.
+func raiseStatement(message string) string {
+	message = strings.NewReplacer("'", "''", "%", "%%").Replace(message)
+	return fmt.Sprintf("DO $$ BEGIN RAISE EXCEPTION '%s'; END $$;", message)
+}
+
+// FormatRepairScript formats the given statements as a SQL script that applies them
+// in a single transaction.
+func FormatRepairScript(statements []string) string {
+	var sb strings.Builder
+	sb.WriteString("BEGIN;\n\n")
+	for _, statement := range statements {
+		statement = strings.TrimSpace(statement)
+		sb.WriteString(statement)
+		if !strings.HasSuffix(statement, ";") {
+			sb.WriteString(";")
+		}
+		sb.WriteString("\n\n")
+	}
+	sb.WriteString("COMMIT;\n")
+	return sb.String()
+}
@@ -0,0 +1,101 @@
+package drift
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+)
+
+func TestRepairScript(t *testing.T) {
+	expected := schemas.SchemaDescription{
+		Tables: []schemas.TableDescription{
+			{
+				Name: "users",
+				Columns: []schemas.ColumnDescription{
+					{Name: "name", Index: 2, TypeName: "text", IsNullable: true},
+					{Name: "id", Index: 1, TypeName: "integer"},
+				},
+				Indexes: []schemas.IndexDescription{
+					{Name: "users_name", IndexDefinition: "CREATE INDEX users_name ON users USING btree (name)"},
+				},
+			},
+		},
+		Views: []schemas.ViewDescription{
+			{Name: "user_names", Definition: "SELECT name FROM users;"},
+		},
+	}
+	actual := schemas.SchemaDescription{
+		Views: []schemas.ViewDescription{
+			{Name: "user_names", Definition: "SELECT 'old' AS name;"},
+		},
+	}
+
+	statements, unfixable := RepairScript(CompareSchemaDescriptions("frontend", "v5.2.0", actual, expected))
+	if len(unfixable) != 0 {
+		t.Fatalf("unexpected unfixable summaries: %v", unfixable)
+	}
+
+	want := []string{
+		"DROP VIEW IF EXISTS user_names;",
+		"CREATE TABLE users (\n    id integer NOT NULL,\n    name text\n);",
+		"CREATE INDEX users_name ON users USING btree (name);",
+		"CREATE VIEW user_names AS SELECT name FROM users;",
+	}
+	if diff := cmp.Diff(want, statements); diff != "" {
+		t.Errorf("unexpected statements (-want +got):\n%s", diff)
+	}
+}
+
+func TestRepairScriptEnum(t *testing.T) {
+	jobs := func(labels ...string) schemas.SchemaDescription {
+		return schemas.SchemaDescription{
+			Enums: []schemas.EnumDescription{{Name: "job_state", Labels: labels}},
+			Tables: []schemas.TableDescription{
+				{
+					Name: "jobs",
+					Columns: []schemas.ColumnDescription{
+						{Name: "state", TypeName: "job_state", Default: "'queued'::job_state"},
+						{Name: "history", TypeName: "job_state[]", IsNullable: true},
+					},
+				},
+			},
+		}
+	}
+
+	// A label can't be removed from an enum type, so the type is redefined, and the
+	// columns using it are converted to the redefined type.
+	statements, unfixable := RepairScript(CompareSchemaDescriptions("frontend", "v5.2.0", jobs("queued", "paused", "done"), jobs("queued", "done")))
+	if len(unfixable) != 0 {
+		t.Fatalf("unexpected unfixable summaries: %v", unfixable)
+	}
+
+	want := []string{
+		"CREATE TYPE job_state_redefined AS ENUM ('queued', 'done');",
+		"ALTER TABLE jobs ALTER COLUMN state DROP DEFAULT;",
+		"ALTER TABLE jobs ALTER COLUMN state SET DATA TYPE job_state_redefined USING state::text::job_state_redefined;",
+		"ALTER TABLE jobs ALTER COLUMN history SET DATA TYPE job_state_redefined[] USING history::text::job_state_redefined[];",
+		"DROP TYPE IF EXISTS job_state;",
+		"ALTER TYPE job_state_redefined RENAME TO job_state;",
+		"ALTER TABLE jobs ALTER COLUMN state SET DEFAULT 'queued'::job_state;",
+	}
+	if diff := cmp.Diff(want, statements); diff != "" {
+		t.Errorf("unexpected statements (-want +got):\n%s", diff)
+	}
+}
+
+func TestRaiseStatement(t *testing.T) {
+	want := `DO $$ BEGIN RAISE EXCEPTION 'column ''t''.c is 100%% wrong'; END $$;`
+	if have := raiseStatement("column 't'.c is 100% wrong"); have != want {
+		t.Errorf("unexpected statement. want=%q have=%q", want, have)
+	}
+}
+
+func TestFormatRepairScript(t *testing.T) {
+	script := FormatRepairScript([]string{"DROP VIEW v;", "  CREATE VIEW v AS SELECT 1  "})
+	want := "BEGIN;\n\nDROP VIEW v;\n\nCREATE VIEW v AS SELECT 1;\n\nCOMMIT;\n"
+	if script != want {
+		t.Errorf("unexpected script (-want +got):\n%s", cmp.Diff(want, script))
+	}
+}
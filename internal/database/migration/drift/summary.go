@@ -16,11 +16,18 @@ type driftSummary struct {
 	hasDiff       bool
 	a, b          any
 	hasStatements bool
-	statements    []string
+	steps         []step
 	hasURLHint    bool
 	url           string
 }
 
+// step is a statement of a summary, along with the phase of the repair script it
+// runs in.
+type step struct {
+	phase     phase
+	statement string
+}
+
 func singleton(summary Summary) []Summary {
 	return []Summary{summary}
 }
@@ -39,9 +46,12 @@ func (s *driftSummary) withDiff(a, b any) *driftSummary {
 	return s
 }
 
-func (s *driftSummary) withStatements(statements ...string) *driftSummary {
+// withStatements adds statements that run in the given phase of the repair script.
+func (s *driftSummary) withStatements(phase phase, statements ...string) *driftSummary {
 	s.hasStatements = true
-	s.statements = statements
+	for _, statement := range statements {
+		s.steps = append(s.steps, step{phase: phase, statement: statement})
+	}
 	return s
 }
 
@@ -51,9 +61,16 @@ func (s *driftSummary) withURLHint(url string) *driftSummary {
 	return s
 }
 
-func (s *driftSummary) Name() string                 { return s.name }
-func (s *driftSummary) Problem() string              { return s.problem }
-func (s *driftSummary) Solution() string             { return s.solution }
-func (s *driftSummary) Diff() (a, b any, _ bool)     { return s.a, s.b, s.hasDiff }
-func (s *driftSummary) Statements() ([]string, bool) { return s.statements, s.hasStatements }
-func (s *driftSummary) URLHint() (string, bool)      { return s.url, s.hasURLHint }
+func (s *driftSummary) Name() string             { return s.name }
+func (s *driftSummary) Problem() string          { return s.problem }
+func (s *driftSummary) Solution() string         { return s.solution }
+func (s *driftSummary) Diff() (a, b any, _ bool) { return s.a, s.b, s.hasDiff }
+func (s *driftSummary) URLHint() (string, bool)  { return s.url, s.hasURLHint }
+
+func (s *driftSummary) Statements() ([]string, bool) {
+	statements := make([]string, 0, len(s.steps))
+	for _, step := range s.steps {
+		statements = append(statements, step.statement)
+	}
+	return statements, s.hasStatements
+}
@@ -5,4 +5,5 @@ import "github.com/sourcegraph/sourcegraph/internal/env"
 var (
 	EnvShouldAutoUpgrade    = env.MustGetBool("SRC_AUTOUPGRADE", false, "If you forgot to set intent to autoupgrade before shutting down the instance, or you're upgrading from pre-5.1, set this env var.")
 	EnvAutoUpgradeSkipDrift = env.MustGetBool("SRC_AUTOUPGRADE_IGNORE_DRIFT", false, "Skip drift checking when performing an autoupgrade.")
+	EnvAutoUpgradeSnapshot  = env.Get("SRC_AUTOUPGRADE_SNAPSHOT", "", "If set, take a snapshot of the databases before performing an autoupgrade, to the given directory, or to the upload store configured by MIGRATION_SNAPSHOT_UPLOAD_* if set to 'uploadstore'. Requires pg_dump.")
 )
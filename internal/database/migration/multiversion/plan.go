@@ -1,6 +1,8 @@
 package multiversion
 
 import (
+	"sort"
+
 	"github.com/sourcegraph/sourcegraph/internal/database/migration/definition"
 	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
 	"github.com/sourcegraph/sourcegraph/internal/database/migration/shared"
@@ -22,6 +24,12 @@ type MigrationPlan struct {
 	steps []MigrationStep
 }
 
+// From returns the source instance version of the plan.
+func (p MigrationPlan) From() oobmigration.Version { return p.from }
+
+// To returns the target instance version of the plan.
+func (p MigrationPlan) To() oobmigration.Version { return p.to }
+
 // SerializeUpgradePlan converts a MigrationPlan into a relevant UpgradePlan for display in
 // the "hobbled" UI displayed during a multi-version upgrade.
 func SerializeUpgradePlan(plan MigrationPlan) upgradestore.UpgradePlan {
@@ -149,3 +157,45 @@ func filterStitchedMigrationsForTags(tags []string) (map[string]shared.StitchedM
 
 	return filteredStitchedMigrationBySchemaName, nil
 }
+
+// PlannedMigration is a schema migration applied by a migration plan.
+type PlannedMigration struct {
+	SchemaName string
+	Definition definition.Definition
+}
+
+// PlannedMigrations returns the schema migrations the plan applies, in the order they are
+// applied. Migrations listed in appliedIDsBySchemaName are already applied and are excluded.
+func (p MigrationPlan) PlannedMigrations(appliedIDsBySchemaName map[string][]int) ([]PlannedMigration, error) {
+	appliedIDs := make(map[string][]int, len(appliedIDsBySchemaName))
+	for schemaName, ids := range appliedIDsBySchemaName {
+		appliedIDs[schemaName] = append([]int(nil), ids...)
+	}
+
+	var migrations []PlannedMigration
+	for _, step := range p.steps {
+		schemaNames := make([]string, 0, len(step.schemaMigrationLeafIDsBySchemaName))
+		for schemaName := range step.schemaMigrationLeafIDsBySchemaName {
+			schemaNames = append(schemaNames, schemaName)
+		}
+		sort.Strings(schemaNames)
+
+		for _, schemaName := range schemaNames {
+			definitions, ok := p.stitchedDefinitionsBySchemaName[schemaName]
+			if !ok {
+				return nil, errors.Newf("unknown schema %q", schemaName)
+			}
+			pending, err := definitions.Up(appliedIDs[schemaName], step.schemaMigrationLeafIDsBySchemaName[schemaName])
+			if err != nil {
+				return nil, err
+			}
+
+			for _, definition := range pending {
+				migrations = append(migrations, PlannedMigration{SchemaName: schemaName, Definition: definition})
+				appliedIDs[schemaName] = append(appliedIDs[schemaName], definition.ID)
+			}
+		}
+	}
+
+	return migrations, nil
+}
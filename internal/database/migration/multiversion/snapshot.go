@@ -0,0 +1,74 @@
+package multiversion
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/runner"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/snapshot"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/store"
+	"github.com/sourcegraph/sourcegraph/internal/database/postgresdsn"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/output"
+)
+
+// TakeSnapshot snapshots all schemas to the given location before the given plan is
+// applied. The location is a local directory, or snapshot.UploadStoreLocation.
+func TakeSnapshot(
+	ctx context.Context,
+	observationCtx *observation.Context,
+	r *runner.Runner,
+	location string,
+	plan MigrationPlan,
+	out *output.Output,
+) (snapshot.Manifest, error) {
+	storage, err := snapshot.NewStorage(ctx, observationCtx, location)
+	if err != nil {
+		return snapshot.Manifest{}, err
+	}
+	targets, err := SnapshotTargets(ctx, r, schemas.SchemaNames)
+	if err != nil {
+		return snapshot.Manifest{}, err
+	}
+
+	out.WriteLine(output.Linef(output.EmojiFingerPointRight, output.StyleReset, "Taking a snapshot of the databases to %s", location))
+
+	m, err := snapshot.Take(ctx, storage, location, plan.from.String(), plan.to.String(), targets)
+	if err != nil {
+		return snapshot.Manifest{}, errors.Wrap(err, "taking snapshot")
+	}
+
+	out.WriteLine(output.Linef(output.EmojiSuccess, output.StyleSuccess, "Took snapshot %s. Run `migrator restore --id=%s` to roll back the upgrade", m.ID, m.ID))
+	return m, nil
+}
+
+// SnapshotTargets returns the snapshot targets of the given schemas, connected
+// through the given runner.
+func SnapshotTargets(ctx context.Context, r *runner.Runner, schemaNames []string) ([]snapshot.Target, error) {
+	dsns, err := postgresdsn.DSNsBySchema(schemaNames)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]snapshot.Target, 0, len(schemaNames))
+	for _, schemaName := range schemaNames {
+		schemaStore, err := r.Store(ctx, schemaName)
+		if err != nil {
+			return nil, err
+		}
+		db, err := store.ExtractDB(ctx, r, schemaName)
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, snapshot.Target{
+			SchemaName: schemaName,
+			DSN:        dsns[schemaName],
+			DB:         db,
+			Store:      schemaStore,
+		})
+	}
+
+	return targets, nil
+}
@@ -0,0 +1,399 @@
+package risk
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Lock modes of the table-level locks taken by statements, from weakest to
+// strongest. See https://www.postgresql.org/docs/current/explicit-locking.html.
+const (
+	LockNone                 = ""
+	LockRowExclusive         = "ROW EXCLUSIVE"
+	LockShareUpdateExclusive = "SHARE UPDATE EXCLUSIVE"
+	LockShare                = "SHARE"
+	LockShareRowExclusive    = "SHARE ROW EXCLUSIVE"
+	LockExclusive            = "EXCLUSIVE"
+	LockAccessExclusive      = "ACCESS EXCLUSIVE"
+)
+
+var lockStrength = map[string]int{
+	LockNone:                 0,
+	LockRowExclusive:         1,
+	LockShareUpdateExclusive: 2,
+	LockShare:                3,
+	LockShareRowExclusive:    4,
+	LockExclusive:            5,
+	LockAccessExclusive:      6,
+}
+
+// BlocksWrites returns true if the lock mode conflicts with INSERT, UPDATE
+// and DELETE statements.
+func BlocksWrites(lock string) bool {
+	return lockStrength[lock] >= lockStrength[LockShare]
+}
+
+// BlocksReads returns true if the lock mode conflicts with SELECT statements.
+func BlocksReads(lock string) bool {
+	return lock == LockAccessExclusive
+}
+
+// Work describes how much work a statement does on the table it locks.
+type Work string
+
+const (
+	// WorkCatalog statements only change the catalog and finish quickly once
+	// they acquired their lock.
+	WorkCatalog Work = "catalog"
+	// WorkScan statements read the whole table, for example to validate a
+	// constraint.
+	WorkScan Work = "scan"
+	// WorkIndexBuild statements build an index on the table.
+	WorkIndexBuild Work = "index build"
+	// WorkRewrite statements rewrite the whole table and its indexes.
+	WorkRewrite Work = "rewrite"
+	// WorkDataChange statements insert, update or delete a potentially large
+	// number of rows.
+	WorkDataChange Work = "data change"
+)
+
+// Statement is a statement of a migration that locks a table.
+type Statement struct {
+	// SQL is the statement, with comments removed and whitespace collapsed.
+	SQL string `json:"sql"`
+	// Table is the name of the locked table, or the name of the index for
+	// statements on indexes whose table can't be determined statically.
+	Table string `json:"table"`
+	// Lock is the table-level lock mode taken by the statement.
+	Lock string `json:"lock"`
+	// Work is the kind of work done by the statement while holding the lock.
+	Work Work `json:"work"`
+	// CreatesTable is true for statements that create the table.
+	CreatesTable bool `json:"createsTable,omitempty"`
+	// Reason describes why the statement takes the lock.
+	Reason string `json:"reason"`
+}
+
+// Analyze returns the statements of the given migration query that lock a
+// table. Statements that don't lock existing tables, such as creating
+// functions, are skipped.
+func Analyze(query string) []Statement {
+	var statements []Statement
+	for _, stmt := range splitStatements(query) {
+		statements = append(statements, analyzeStatement(stmt)...)
+	}
+	return statements
+}
+
+const ident = `((?:"[^"]+"|[\w$]+)(?:\.(?:"[^"]+"|[\w$]+))?)`
+
+var (
+	createIndexPattern     = regexp.MustCompile(`(?i)^CREATE\s+(?:UNIQUE\s+)?INDEX\s+(CONCURRENTLY\s+)?(?:IF\s+NOT\s+EXISTS\s+)?(?:` + ident + `\s+)?ON\s+(?:ONLY\s+)?` + ident)
+	dropIndexPattern       = regexp.MustCompile(`(?i)^DROP\s+INDEX\s+(CONCURRENTLY\s+)?(?:IF\s+EXISTS\s+)?` + ident)
+	reindexPattern         = regexp.MustCompile(`(?i)^REINDEX\s+(?:\([^)]*\)\s+)?(INDEX|TABLE)\s+(CONCURRENTLY\s+)?` + ident)
+	alterTablePattern      = regexp.MustCompile(`(?i)^ALTER\s+TABLE\s+(?:IF\s+EXISTS\s+)?(?:ONLY\s+)?` + ident + `\s+(.*)$`)
+	createTablePattern     = regexp.MustCompile(`(?i)^CREATE\s+(?:(?:GLOBAL\s+|LOCAL\s+)?(?:TEMPORARY|TEMP)\s+|UNLOGGED\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?` + ident)
+	dropTablePattern       = regexp.MustCompile(`(?i)^DROP\s+TABLE\s+(?:IF\s+EXISTS\s+)?(.*?)(?:\s+(?:CASCADE|RESTRICT))?$`)
+	truncatePattern        = regexp.MustCompile(`(?i)^TRUNCATE\s+(?:TABLE\s+)?(.*?)(?:\s+(?:RESTART|CONTINUE)\s+IDENTITY)?(?:\s+(?:CASCADE|RESTRICT))?$`)
+	vacuumFullPattern      = regexp.MustCompile(`(?i)^VACUUM\s+(?:FULL\s+|\([^)]*\bFULL\b[^)]*\)\s+)(?:VERBOSE\s+|ANALYZE\s+)*` + ident)
+	clusterPattern         = regexp.MustCompile(`(?i)^CLUSTER\s+(?:VERBOSE\s+)?` + ident)
+	lockTablePattern       = regexp.MustCompile(`(?i)^LOCK\s+(?:TABLE\s+)?(?:ONLY\s+)?` + ident + `(?:\s+IN\s+([A-Z ]+?)\s+MODE)?(?:\s+NOWAIT)?$`)
+	updatePattern          = regexp.MustCompile(`(?i)^UPDATE\s+(?:ONLY\s+)?` + ident)
+	deletePattern          = regexp.MustCompile(`(?i)^DELETE\s+FROM\s+(?:ONLY\s+)?` + ident)
+	insertSelectPattern    = regexp.MustCompile(`(?i)^INSERT\s+INTO\s+` + ident + `.*\bSELECT\b`)
+	refreshMatviewPattern  = regexp.MustCompile(`(?i)^REFRESH\s+MATERIALIZED\s+VIEW\s+(CONCURRENTLY\s+)?` + ident)
+	volatileDefaultPattern = regexp.MustCompile(`(?i)\bDEFAULT\b.*\b(?:random|clock_timestamp|timeofday|gen_random_uuid|uuid_generate_v[14]|nextval)\s*\(`)
+	serialTypePattern      = regexp.MustCompile(`(?i)\b(?:SMALL|BIG)?SERIAL\b|\bGENERATED\s+ALWAYS\s+AS\s*\(.*\)\s*STORED\b|\bGENERATED\s+(?:ALWAYS|BY\s+DEFAULT)\s+AS\s+IDENTITY\b`)
+)
+
+func analyzeStatement(stmt string) []Statement {
+	newStatement := func(table, lock string, work Work, reason string) Statement {
+		return Statement{SQL: stmt, Table: normalizeIdent(table), Lock: lock, Work: work, Reason: reason}
+	}
+
+	if m := createIndexPattern.FindStringSubmatch(stmt); m != nil {
+		if m[1] != "" {
+			return []Statement{newStatement(m[3], LockShareUpdateExclusive, WorkIndexBuild, "concurrent index build")}
+		}
+		return []Statement{newStatement(m[3], LockShare, WorkIndexBuild, "non-concurrent index build blocks writes")}
+	}
+	if m := dropIndexPattern.FindStringSubmatch(stmt); m != nil {
+		if m[1] != "" {
+			return []Statement{newStatement(m[2], LockShareUpdateExclusive, WorkCatalog, "concurrent index drop")}
+		}
+		return []Statement{newStatement(m[2], LockAccessExclusive, WorkCatalog, "non-concurrent index drop locks the table of the index")}
+	}
+	if m := reindexPattern.FindStringSubmatch(stmt); m != nil {
+		if m[2] != "" {
+			return []Statement{newStatement(m[3], LockShareUpdateExclusive, WorkIndexBuild, "concurrent reindex")}
+		}
+		return []Statement{newStatement(m[3], LockShare, WorkIndexBuild, "non-concurrent reindex blocks writes")}
+	}
+	if m := alterTablePattern.FindStringSubmatch(stmt); m != nil {
+		var statements []Statement
+		for _, action := range splitTopLevel(m[2], ',') {
+			lock, work, reason := analyzeAlterTableAction(action)
+			statements = append(statements, newStatement(m[1], lock, work, reason))
+		}
+		return statements
+	}
+	if m := createTablePattern.FindStringSubmatch(stmt); m != nil {
+		s := newStatement(m[1], LockNone, WorkCatalog, "new table")
+		s.CreatesTable = true
+		return []Statement{s}
+	}
+	if m := dropTablePattern.FindStringSubmatch(stmt); m != nil {
+		var statements []Statement
+		for _, table := range splitTopLevel(m[1], ',') {
+			statements = append(statements, newStatement(table, LockAccessExclusive, WorkCatalog, "table drop"))
+		}
+		return statements
+	}
+	if m := truncatePattern.FindStringSubmatch(stmt); m != nil {
+		var statements []Statement
+		for _, table := range splitTopLevel(m[1], ',') {
+			table = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(table), "ONLY "))
+			statements = append(statements, newStatement(table, LockAccessExclusive, WorkCatalog, "truncate"))
+		}
+		return statements
+	}
+	if m := vacuumFullPattern.FindStringSubmatch(stmt); m != nil {
+		return []Statement{newStatement(m[1], LockAccessExclusive, WorkRewrite, "VACUUM FULL rewrites the table")}
+	}
+	if m := clusterPattern.FindStringSubmatch(stmt); m != nil {
+		return []Statement{newStatement(m[1], LockAccessExclusive, WorkRewrite, "CLUSTER rewrites the table")}
+	}
+	if m := lockTablePattern.FindStringSubmatch(stmt); m != nil {
+		lock := strings.ToUpper(strings.Join(strings.Fields(m[2]), " "))
+		if _, ok := lockStrength[lock]; !ok || lock == LockNone {
+			lock = LockAccessExclusive
+		}
+		return []Statement{newStatement(m[1], lock, WorkCatalog, "explicit table lock")}
+	}
+	if m := updatePattern.FindStringSubmatch(stmt); m != nil {
+		return []Statement{newStatement(m[1], LockRowExclusive, WorkDataChange, "update may touch all rows of the table")}
+	}
+	if m := deletePattern.FindStringSubmatch(stmt); m != nil {
+		return []Statement{newStatement(m[1], LockRowExclusive, WorkDataChange, "delete may touch all rows of the table")}
+	}
+	if m := insertSelectPattern.FindStringSubmatch(stmt); m != nil {
+		return []Statement{newStatement(m[1], LockRowExclusive, WorkDataChange, "backfill from a query")}
+	}
+	if m := refreshMatviewPattern.FindStringSubmatch(stmt); m != nil {
+		if m[1] != "" {
+			return []Statement{newStatement(m[2], LockExclusive, WorkRewrite, "concurrent materialized view refresh blocks writes")}
+		}
+		return []Statement{newStatement(m[2], LockAccessExclusive, WorkRewrite, "materialized view refresh")}
+	}
+
+	return nil
+}
+
+var (
+	addConstraintPattern   = regexp.MustCompile(`(?i)^ADD\s+(?:CONSTRAINT\s+\S+\s+)?(PRIMARY\s+KEY|UNIQUE|FOREIGN\s+KEY|CHECK|EXCLUDE)\b`)
+	addColumnPattern       = regexp.MustCompile(`(?i)^ADD\s+(?:COLUMN\s+)?`)
+	alterColumnTypePattern = regexp.MustCompile(`(?i)^ALTER\s+(?:COLUMN\s+)?\S+\s+(?:SET\s+DATA\s+)?TYPE\b`)
+	setNotNullPattern      = regexp.MustCompile(`(?i)^ALTER\s+(?:COLUMN\s+)?\S+\s+SET\s+NOT\s+NULL\b`)
+	alterColumnPattern     = regexp.MustCompile(`(?i)^ALTER\s+(?:COLUMN\s+)?\S+\s+(?:SET|DROP)\s+(?:DEFAULT|NOT\s+NULL|STATISTICS|STORAGE)\b`)
+	rewritePattern         = regexp.MustCompile(`(?i)^SET\s+(?:LOGGED|UNLOGGED|TABLESPACE|ACCESS\s+METHOD)\b`)
+	setStorageParamPattern = regexp.MustCompile(`(?i)^(?:SET|RESET)\s*\(`)
+	triggerPattern         = regexp.MustCompile(`(?i)^(?:ENABLE|DISABLE)\s+(?:ALWAYS\s+|REPLICA\s+)?TRIGGER\b`)
+	validatePattern        = regexp.MustCompile(`(?i)^VALIDATE\s+CONSTRAINT\b`)
+	attachPartitionPattern = regexp.MustCompile(`(?i)^ATTACH\s+PARTITION\b`)
+	notValidPattern        = regexp.MustCompile(`(?i)\bNOT\s+VALID\b`)
+	usingIndexPattern      = regexp.MustCompile(`(?i)\bUSING\s+INDEX\s+\S+\s*$`)
+)
+
+// analyzeAlterTableAction returns the lock mode and work of a single action of
+// an ALTER TABLE statement.
+func analyzeAlterTableAction(action string) (lock string, work Work, reason string) {
+	if m := addConstraintPattern.FindStringSubmatch(action); m != nil {
+		kind := strings.ToUpper(strings.Join(strings.Fields(m[1]), " "))
+		switch kind {
+		case "PRIMARY KEY", "UNIQUE", "EXCLUDE":
+			if usingIndexPattern.MatchString(action) {
+				return LockAccessExclusive, WorkCatalog, "constraint using an existing index"
+			}
+			return LockAccessExclusive, WorkIndexBuild, "constraint builds an index while blocking reads and writes"
+		case "FOREIGN KEY":
+			if notValidPattern.MatchString(action) {
+				return LockShareRowExclusive, WorkCatalog, "foreign key added without validation"
+			}
+			return LockShareRowExclusive, WorkScan, "foreign key is validated while blocking writes to both tables"
+		default:
+			if notValidPattern.MatchString(action) {
+				return LockAccessExclusive, WorkCatalog, "check constraint added without validation"
+			}
+			return LockAccessExclusive, WorkScan, "check constraint is validated while blocking reads and writes"
+		}
+	}
+	if addColumnPattern.MatchString(action) {
+		if strings.Contains(strings.ToUpper(action), " REFERENCES ") {
+			return LockAccessExclusive, WorkCatalog, "column with a foreign key, also blocks writes to the referenced table"
+		}
+		if volatileDefaultPattern.MatchString(action) || serialTypePattern.MatchString(action) {
+			return LockAccessExclusive, WorkRewrite, "column with a volatile default rewrites the table"
+		}
+		return LockAccessExclusive, WorkCatalog, "column added"
+	}
+	if alterColumnTypePattern.MatchString(action) {
+		return LockAccessExclusive, WorkRewrite, "column type change rewrites the table unless the types are binary compatible"
+	}
+	if setNotNullPattern.MatchString(action) {
+		return LockAccessExclusive, WorkScan, "SET NOT NULL scans the table while blocking reads and writes"
+	}
+	if alterColumnPattern.MatchString(action) {
+		return LockAccessExclusive, WorkCatalog, "column altered"
+	}
+	if rewritePattern.MatchString(action) {
+		return LockAccessExclusive, WorkRewrite, "table is rewritten"
+	}
+	if setStorageParamPattern.MatchString(action) {
+		return LockShareUpdateExclusive, WorkCatalog, "storage parameters changed"
+	}
+	if triggerPattern.MatchString(action) {
+		return LockShareRowExclusive, WorkCatalog, "trigger enabled or disabled"
+	}
+	if validatePattern.MatchString(action) {
+		return LockShareUpdateExclusive, WorkScan, "constraint validation does not block reads and writes"
+	}
+	if attachPartitionPattern.MatchString(action) {
+		return LockShareUpdateExclusive, WorkScan, "attached partition is scanned for its constraint"
+	}
+
+	return LockAccessExclusive, WorkCatalog, "table altered"
+}
+
+// normalizeIdent removes quotes and the public schema from the given
+// identifier, so that it can be compared to table names.
+func normalizeIdent(name string) string {
+	name = strings.TrimSpace(name)
+	if !strings.Contains(name, `"`) {
+		// Unquoted identifiers are case-insensitive.
+		name = strings.ToLower(name)
+	}
+	name = strings.ReplaceAll(name, `"`, "")
+	return strings.TrimPrefix(name, "public.")
+}
+
+// splitStatements splits the given query into statements, with comments
+// removed and whitespace collapsed. Quoted strings, quoted identifiers and
+// dollar-quoted bodies are kept intact.
+func splitStatements(query string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+	)
+	flush := func() {
+		if stmt := strings.Join(strings.Fields(current.String()), " "); stmt != "" {
+			statements = append(statements, stmt)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			if end := strings.IndexByte(query[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(query)
+			}
+			current.WriteByte(' ')
+
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			if end := strings.Index(query[i+2:], "*/"); end >= 0 {
+				i += end + 3
+			} else {
+				i = len(query)
+			}
+			current.WriteByte(' ')
+
+		case c == '\'' || c == '"':
+			end := i + 1
+			for end < len(query) {
+				if query[end] == c {
+					// Doubled quotes escape the quote character.
+					if end+1 < len(query) && query[end+1] == c {
+						end += 2
+						continue
+					}
+					break
+				}
+				end++
+			}
+			if end >= len(query) {
+				end = len(query) - 1
+			}
+			current.WriteString(query[i : end+1])
+			i = end
+
+		case c == '$' && (i == 0 || !isIdentChar(query[i-1])):
+			if tag := dollarQuoteTag(query[i:]); tag != "" {
+				end := strings.Index(query[i+len(tag):], tag)
+				if end < 0 {
+					end = len(query) - i - len(tag)
+				} else {
+					end += len(tag)
+				}
+				current.WriteString(query[i : i+len(tag)+end])
+				i += len(tag) + end - 1
+			} else {
+				current.WriteByte(c)
+			}
+
+		case c == ';':
+			flush()
+
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+
+	return statements
+}
+
+var dollarQuoteTagPattern = regexp.MustCompile(`^\$(?:[A-Za-z_][A-Za-z0-9_]*)?\$`)
+
+func dollarQuoteTag(s string) string {
+	return dollarQuoteTagPattern.FindString(s)
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// splitTopLevel splits s on sep, ignoring separators within parentheses and
+// quotes.
+func splitTopLevel(s string, sep byte) []string {
+	var (
+		parts []string
+		depth int
+		quote byte
+		start int
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	if part := strings.TrimSpace(s[start:]); part != "" {
+		parts = append(parts, part)
+	}
+	return parts
+}
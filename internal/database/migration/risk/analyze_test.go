@@ -0,0 +1,146 @@
+package risk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAnalyze(t *testing.T) {
+	type result struct {
+		Table        string
+		Lock         string
+		Work         Work
+		CreatesTable bool
+	}
+
+	for _, tc := range []struct {
+		name  string
+		query string
+		want  []result
+	}{
+		{
+			name:  "index build",
+			query: `CREATE INDEX IF NOT EXISTS lsif_uploads_repo_id ON lsif_uploads(repository_id);`,
+			want:  []result{{Table: "lsif_uploads", Lock: LockShare, Work: WorkIndexBuild}},
+		},
+		{
+			name:  "concurrent index build",
+			query: `CREATE UNIQUE INDEX CONCURRENTLY lsif_uploads_repo_id ON public.lsif_uploads USING btree (repository_id);`,
+			want:  []result{{Table: "lsif_uploads", Lock: LockShareUpdateExclusive, Work: WorkIndexBuild}},
+		},
+		{
+			name:  "unnamed index",
+			query: `CREATE INDEX ON "Repo" (name)`,
+			want:  []result{{Table: "Repo", Lock: LockShare, Work: WorkIndexBuild}},
+		},
+		{
+			name:  "index drop",
+			query: `DROP INDEX IF EXISTS lsif_uploads_repo_id;`,
+			want:  []result{{Table: "lsif_uploads_repo_id", Lock: LockAccessExclusive, Work: WorkCatalog}},
+		},
+		{
+			name: "alter table actions",
+			query: `ALTER TABLE repo
+				ADD COLUMN IF NOT EXISTS stars integer DEFAULT 0 NOT NULL,
+				ADD COLUMN uuid uuid DEFAULT gen_random_uuid(),
+				ALTER COLUMN name TYPE citext,
+				ALTER COLUMN uri SET NOT NULL,
+				ADD CONSTRAINT repo_stars_check CHECK (stars >= 0) NOT VALID,
+				VALIDATE CONSTRAINT repo_stars_check;`,
+			want: []result{
+				{Table: "repo", Lock: LockAccessExclusive, Work: WorkCatalog},
+				{Table: "repo", Lock: LockAccessExclusive, Work: WorkRewrite},
+				{Table: "repo", Lock: LockAccessExclusive, Work: WorkRewrite},
+				{Table: "repo", Lock: LockAccessExclusive, Work: WorkScan},
+				{Table: "repo", Lock: LockAccessExclusive, Work: WorkCatalog},
+				{Table: "repo", Lock: LockShareUpdateExclusive, Work: WorkScan},
+			},
+		},
+		{
+			name:  "foreign key",
+			query: `ALTER TABLE ONLY lsif_uploads ADD CONSTRAINT lsif_uploads_repo_fk FOREIGN KEY (repository_id) REFERENCES repo(id) ON DELETE CASCADE;`,
+			want:  []result{{Table: "lsif_uploads", Lock: LockShareRowExclusive, Work: WorkScan}},
+		},
+		{
+			name:  "primary key",
+			query: `ALTER TABLE lsif_uploads ADD PRIMARY KEY (id);`,
+			want:  []result{{Table: "lsif_uploads", Lock: LockAccessExclusive, Work: WorkIndexBuild}},
+		},
+		{
+			name: "new table and backfill",
+			query: `
+				CREATE TABLE IF NOT EXISTS repo_stars (repo_id integer NOT NULL, stars integer NOT NULL);
+				INSERT INTO repo_stars (repo_id, stars) SELECT id, stars FROM repo;
+				UPDATE repo SET stars = 0 WHERE stars IS NULL;
+				DELETE FROM "repo_stars" WHERE stars = 0;`,
+			want: []result{
+				{Table: "repo_stars", Lock: LockNone, Work: WorkCatalog, CreatesTable: true},
+				{Table: "repo_stars", Lock: LockRowExclusive, Work: WorkDataChange},
+				{Table: "repo", Lock: LockRowExclusive, Work: WorkDataChange},
+				{Table: "repo_stars", Lock: LockRowExclusive, Work: WorkDataChange},
+			},
+		},
+		{
+			name:  "drop and truncate",
+			query: `DROP TABLE IF EXISTS a, b CASCADE; TRUNCATE TABLE c RESTART IDENTITY;`,
+			want: []result{
+				{Table: "a", Lock: LockAccessExclusive, Work: WorkCatalog},
+				{Table: "b", Lock: LockAccessExclusive, Work: WorkCatalog},
+				{Table: "c", Lock: LockAccessExclusive, Work: WorkCatalog},
+			},
+		},
+		{
+			name:  "explicit lock",
+			query: `LOCK TABLE repo IN SHARE ROW EXCLUSIVE MODE; LOCK repo;`,
+			want: []result{
+				{Table: "repo", Lock: LockShareRowExclusive, Work: WorkCatalog},
+				{Table: "repo", Lock: LockAccessExclusive, Work: WorkCatalog},
+			},
+		},
+		{
+			name:  "materialized view",
+			query: `REFRESH MATERIALIZED VIEW CONCURRENTLY codeintel_langs;`,
+			want:  []result{{Table: "codeintel_langs", Lock: LockExclusive, Work: WorkRewrite}},
+		},
+		{
+			name: "functions, comments and strings are skipped",
+			query: `
+				-- ALTER TABLE repo ADD COLUMN ignored text;
+				/* DROP TABLE repo; */
+				CREATE OR REPLACE FUNCTION func() RETURNS trigger AS $$
+				BEGIN
+					UPDATE repo SET name = 'a;b';
+					RETURN NEW;
+				END;
+				$$ LANGUAGE plpgsql;
+				COMMENT ON TABLE repo IS 'DROP TABLE repo; -- not a comment';`,
+			want: nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []result
+			for _, s := range Analyze(tc.query) {
+				got = append(got, result{Table: s.Table, Lock: s.Lock, Work: s.Work, CreatesTable: s.CreatesTable})
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected statements (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	got := splitStatements(`
+		SELECT 'a;''b' AS "x;y"; -- comment;
+		DO $body$ BEGIN PERFORM 1; END $body$;
+		SELECT 1`)
+	want := []string{
+		`SELECT 'a;''b' AS "x;y"`,
+		`DO $body$ BEGIN PERFORM 1; END $body$`,
+		`SELECT 1`,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected statements (-want +got):\n%s", diff)
+	}
+}
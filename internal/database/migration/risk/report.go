@@ -0,0 +1,239 @@
+package risk
+
+import (
+	"math"
+	"time"
+)
+
+// Level is the risk level of a migration or statement.
+type Level string
+
+const (
+	LevelLow    Level = "low"
+	LevelMedium Level = "medium"
+	LevelHigh   Level = "high"
+)
+
+var levelOrder = map[Level]int{LevelLow: 0, LevelMedium: 1, LevelHigh: 2}
+
+func maxLevel(a, b Level) Level {
+	if levelOrder[b] > levelOrder[a] {
+		return b
+	}
+	return a
+}
+
+// Rough throughputs used to estimate how long statements take, in bytes per
+// second. They are meant to tell seconds from hours, not to be accurate.
+const (
+	scanBytesPerSecond       = 200 << 20
+	indexBuildBytesPerSecond = 30 << 20
+	rewriteBytesPerSecond    = 40 << 20
+	dataChangeBytesPerSecond = 20 << 20
+)
+
+const (
+	// mediumLockDuration is the duration of a blocking lock that is considered
+	// noticeable.
+	mediumLockDuration = time.Second
+	// highLockDuration is the duration of a blocking lock that is considered
+	// an outage.
+	highLockDuration = 10 * time.Second
+	// longDataChangeDuration is the duration of a non-blocking data change
+	// that is considered noticeable.
+	longDataChangeDuration = time.Minute
+)
+
+// Migration is a migration to analyze.
+type Migration struct {
+	Schema     string
+	ID         int
+	Name       string
+	Privileged bool
+	// NonTransactional is true for migrations that do not run in a
+	// transaction, such as concurrent index builds. Locks are released after
+	// each statement instead of at the end of the migration.
+	NonTransactional bool
+	Query            string
+}
+
+// Report is the risk report of a sequence of migrations.
+type Report struct {
+	Migrations       []MigrationReport `json:"migrations"`
+	EstimatedSeconds float64           `json:"estimatedSeconds"`
+	Risk             Level             `json:"risk"`
+}
+
+// MigrationReport is the risk report of a single migration.
+type MigrationReport struct {
+	Schema           string            `json:"schema"`
+	ID               int               `json:"id"`
+	Name             string            `json:"name"`
+	Privileged       bool              `json:"privileged"`
+	Statements       []StatementReport `json:"statements"`
+	EstimatedSeconds float64           `json:"estimatedSeconds"`
+	Risk             Level             `json:"risk"`
+}
+
+// StatementReport is the risk report of a statement that locks a table.
+type StatementReport struct {
+	Statement
+	BlocksReads  bool `json:"blocksReads"`
+	BlocksWrites bool `json:"blocksWrites"`
+	// Stats are the live statistics of the table, if it exists.
+	Stats *TableStats `json:"stats,omitempty"`
+	// EstimatedSeconds is the estimated duration of the statement.
+	EstimatedSeconds float64 `json:"estimatedSeconds"`
+	// LockSeconds is the estimated duration the lock is held for. Locks are
+	// held until the migration commits, so this includes the duration of all
+	// following statements of the migration.
+	LockSeconds float64 `json:"lockSeconds"`
+	Risk        Level   `json:"risk"`
+	// RiskReason explains the risk level.
+	RiskReason string `json:"riskReason"`
+}
+
+// NewReport analyzes the given migrations, which are expected in the order
+// they are applied, and joins them with the live statistics of the tables of
+// each schema.
+func NewReport(migrations []Migration, statsBySchema map[string]SchemaStats) Report {
+	report := Report{Risk: LevelLow}
+	createdTablesBySchema := map[string]map[string]struct{}{}
+
+	for _, migration := range migrations {
+		stats := statsBySchema[migration.Schema]
+		createdTables, ok := createdTablesBySchema[migration.Schema]
+		if !ok {
+			createdTables = map[string]struct{}{}
+			createdTablesBySchema[migration.Schema] = createdTables
+		}
+
+		migrationReport := MigrationReport{
+			Schema:     migration.Schema,
+			ID:         migration.ID,
+			Name:       migration.Name,
+			Privileged: migration.Privileged,
+			Statements: []StatementReport{},
+			Risk:       LevelLow,
+		}
+
+		var durations []time.Duration
+		for _, statement := range Analyze(migration.Query) {
+			if table, ok := stats.IndexTables[statement.Table]; ok {
+				statement.Table = table
+			}
+			if statement.CreatesTable {
+				createdTables[statement.Table] = struct{}{}
+			}
+
+			statementReport := StatementReport{
+				Statement:    statement,
+				BlocksReads:  BlocksReads(statement.Lock),
+				BlocksWrites: BlocksWrites(statement.Lock),
+			}
+			if s, ok := stats.Tables[statement.Table]; ok {
+				statementReport.Stats = &s
+			}
+
+			duration := estimateDuration(statement.Work, statementReport.Stats)
+			statementReport.EstimatedSeconds = seconds(duration)
+			durations = append(durations, duration)
+			migrationReport.Statements = append(migrationReport.Statements, statementReport)
+		}
+
+		var total time.Duration
+		for i := range migrationReport.Statements {
+			s := &migrationReport.Statements[i]
+
+			lockDuration := durations[i]
+			if !migration.NonTransactional {
+				for _, d := range durations[i+1:] {
+					lockDuration += d
+				}
+			}
+			s.LockSeconds = seconds(lockDuration)
+
+			_, created := createdTables[s.Table]
+			s.Risk, s.RiskReason = assessStatement(*s, lockDuration, durations[i], created)
+
+			total += durations[i]
+			migrationReport.Risk = maxLevel(migrationReport.Risk, s.Risk)
+		}
+		migrationReport.EstimatedSeconds = seconds(total)
+
+		report.Migrations = append(report.Migrations, migrationReport)
+		report.EstimatedSeconds += migrationReport.EstimatedSeconds
+		report.Risk = maxLevel(report.Risk, migrationReport.Risk)
+	}
+
+	return report
+}
+
+// estimateDuration returns the estimated duration of work done on a table with
+// the given statistics.
+func estimateDuration(work Work, stats *TableStats) time.Duration {
+	if stats == nil {
+		return 0
+	}
+
+	var bytes, bytesPerSecond int64
+	switch work {
+	case WorkScan:
+		bytes, bytesPerSecond = stats.TableBytes, scanBytesPerSecond
+	case WorkIndexBuild:
+		bytes, bytesPerSecond = stats.TableBytes, indexBuildBytesPerSecond
+	case WorkRewrite:
+		bytes, bytesPerSecond = stats.TableBytes+stats.IndexBytes, rewriteBytesPerSecond
+	case WorkDataChange:
+		bytes, bytesPerSecond = stats.TableBytes, dataChangeBytesPerSecond
+	default:
+		return 0
+	}
+
+	return time.Duration(float64(bytes) / float64(bytesPerSecond) * float64(time.Second))
+}
+
+// assessStatement returns the risk level of a statement that holds its lock
+// for lockDuration and runs for duration.
+func assessStatement(s StatementReport, lockDuration, duration time.Duration, tableCreatedByPlan bool) (Level, string) {
+	if s.Lock == LockNone {
+		return LevelLow, "does not lock existing tables"
+	}
+	if tableCreatedByPlan && s.Stats == nil {
+		return LevelLow, "table is created by this upgrade"
+	}
+
+	if !s.BlocksWrites {
+		if s.Work == WorkDataChange && duration >= longDataChangeDuration {
+			return LevelMedium, "long-running data change holds row locks and adds load"
+		}
+		return LevelLow, "lock does not block reads or writes"
+	}
+
+	if s.Stats == nil && s.Work != WorkCatalog {
+		return LevelMedium, "table size is unknown"
+	}
+
+	blocked := "writes"
+	if s.BlocksReads {
+		blocked = "reads and writes"
+	}
+	switch {
+	case lockDuration >= highLockDuration:
+		return LevelHigh, "blocks " + blocked + " for about " + formatDuration(lockDuration)
+	case lockDuration >= mediumLockDuration:
+		return LevelMedium, "blocks " + blocked + " for about " + formatDuration(lockDuration)
+	case s.BlocksReads:
+		return LevelMedium, "brief, but waits for and blocks all queries on the table"
+	default:
+		return LevelLow, "brief lock"
+	}
+}
+
+func seconds(d time.Duration) float64 {
+	return math.Round(d.Seconds()*10) / 10
+}
+
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
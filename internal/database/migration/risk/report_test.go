@@ -0,0 +1,98 @@
+package risk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReport(t *testing.T) {
+	const gb = 1 << 30
+
+	stats := map[string]SchemaStats{
+		"frontend": {
+			Tables: map[string]TableStats{
+				"repo":         {Rows: 1000, TableBytes: 1 << 20, IndexBytes: 1 << 20},
+				"lsif_uploads": {Rows: 10_000_000, TableBytes: 10 * gb, IndexBytes: 2 * gb},
+			},
+			IndexTables: map[string]string{
+				"lsif_uploads_repo_id": "lsif_uploads",
+			},
+		},
+	}
+
+	report := NewReport([]Migration{
+		{
+			Schema: "frontend",
+			ID:     1,
+			Name:   "new table",
+			Query:  `CREATE TABLE repo_stars (id integer); CREATE INDEX repo_stars_id ON repo_stars(id);`,
+		},
+		{
+			Schema: "frontend",
+			ID:     2,
+			Name:   "small table",
+			Query:  `ALTER TABLE repo ADD COLUMN stars integer;`,
+		},
+		{
+			Schema: "frontend",
+			ID:     3,
+			Name:   "index build on large table",
+			Query:  `CREATE INDEX lsif_uploads_state ON lsif_uploads(state);`,
+		},
+		{
+			Schema:           "frontend",
+			ID:               4,
+			Name:             "concurrent index build on large table",
+			NonTransactional: true,
+			Query:            `CREATE INDEX CONCURRENTLY lsif_uploads_state ON lsif_uploads(state);`,
+		},
+		{
+			Schema: "frontend",
+			ID:     5,
+			Name:   "lock held during backfill",
+			Query:  `ALTER TABLE repo ADD COLUMN uploads integer; UPDATE lsif_uploads SET state = 'queued';`,
+		},
+		{
+			Schema: "frontend",
+			ID:     6,
+			Name:   "unknown table",
+			Query:  `ALTER TABLE missing ALTER COLUMN id TYPE bigint; DROP INDEX lsif_uploads_repo_id;`,
+		},
+	}, stats)
+
+	require.Len(t, report.Migrations, 6)
+
+	risks := map[string]Level{}
+	for _, m := range report.Migrations {
+		risks[m.Name] = m.Risk
+	}
+	assert.Equal(t, map[string]Level{
+		"new table":                             LevelLow,
+		"small table":                           LevelMedium,
+		"index build on large table":            LevelHigh,
+		"concurrent index build on large table": LevelLow,
+		"lock held during backfill":             LevelHigh,
+		"unknown table":                         LevelMedium,
+	}, risks)
+	assert.Equal(t, LevelHigh, report.Risk)
+
+	// The index build reads the whole table at the index build throughput.
+	indexBuild := report.Migrations[2].Statements[0]
+	assert.True(t, indexBuild.BlocksWrites)
+	assert.False(t, indexBuild.BlocksReads)
+	assert.Equal(t, int64(10_000_000), indexBuild.Stats.Rows)
+	assert.Equal(t, 341.3, indexBuild.EstimatedSeconds)
+
+	// The lock on repo is held until the update of lsif_uploads completes.
+	backfill := report.Migrations[4]
+	assert.Equal(t, "repo", backfill.Statements[0].Table)
+	assert.Equal(t, 0.0, backfill.Statements[0].EstimatedSeconds)
+	assert.Equal(t, 512.0, backfill.Statements[0].LockSeconds)
+	assert.Equal(t, LevelHigh, backfill.Statements[0].Risk)
+	assert.Equal(t, LevelMedium, backfill.Statements[1].Risk)
+
+	// Indexes are resolved to their tables.
+	assert.Equal(t, "lsif_uploads", report.Migrations[5].Statements[1].Table)
+}
@@ -0,0 +1,89 @@
+package risk
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// TableStats are the live statistics of a table.
+type TableStats struct {
+	// Rows is the estimated number of rows, as of the last VACUUM or ANALYZE.
+	Rows int64 `json:"rows"`
+	// TableBytes is the size of the table, including TOAST data.
+	TableBytes int64 `json:"tableBytes"`
+	// IndexBytes is the size of all indexes of the table.
+	IndexBytes int64 `json:"indexBytes"`
+}
+
+// SchemaStats are the live statistics of the tables of a database.
+type SchemaStats struct {
+	// Tables are the statistics of the tables by name.
+	Tables map[string]TableStats
+	// IndexTables are the names of the tables by index name.
+	IndexTables map[string]string
+}
+
+const tableStatsQuery = `
+SELECT
+	c.relname,
+	GREATEST(c.reltuples, 0)::bigint,
+	pg_table_size(c.oid),
+	pg_indexes_size(c.oid)
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE
+	c.relkind IN ('r', 'p', 'm') AND
+	n.nspname = ANY(current_schemas(false))
+`
+
+const indexTablesQuery = `
+SELECT i.indexname, i.tablename
+FROM pg_indexes i
+WHERE i.schemaname = ANY(current_schemas(false))
+`
+
+// LoadSchemaStats reads the statistics of the tables in the search path of
+// the given database from the Postgres catalog.
+func LoadSchemaStats(ctx context.Context, db *sql.DB) (_ SchemaStats, err error) {
+	stats := SchemaStats{
+		Tables:      map[string]TableStats{},
+		IndexTables: map[string]string{},
+	}
+
+	rows, err := db.QueryContext(ctx, tableStatsQuery)
+	if err != nil {
+		return SchemaStats{}, errors.Wrap(err, "query table stats")
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		var name string
+		var s TableStats
+		if err := rows.Scan(&name, &s.Rows, &s.TableBytes, &s.IndexBytes); err != nil {
+			return SchemaStats{}, err
+		}
+		stats.Tables[name] = s
+	}
+	if err := rows.Err(); err != nil {
+		return SchemaStats{}, err
+	}
+
+	indexRows, err := db.QueryContext(ctx, indexTablesQuery)
+	if err != nil {
+		return SchemaStats{}, errors.Wrap(err, "query index tables")
+	}
+	defer func() { err = basestore.CloseRows(indexRows, err) }()
+
+	for indexRows.Next() {
+		var index, table string
+		if err := indexRows.Scan(&index, &table); err != nil {
+			return SchemaStats{}, err
+		}
+		stats.IndexTables[index] = table
+	}
+
+	return stats, nil
+}
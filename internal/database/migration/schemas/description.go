@@ -2,6 +2,7 @@ package schemas
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
@@ -53,6 +54,44 @@ func (d EnumDescription) DropStatement() string {
 	return fmt.Sprintf("DROP TYPE IF EXISTS %s;", d.Name)
 }
 
+// RedefineStatements returns the statements that replace the existing enum type of the
+// same name with the enum, for when AlterToTarget can't. The existing type can't be dropped
+// while the given tables use it, so the enum is created under a temporary name, the columns
+// are converted to it by their labels, and it takes the place of the existing type. The
+// conversion fails if a column holds a label that isn't part of the enum.
+func (d EnumDescription) RedefineStatements(tables []TableDescription) []string {
+	redefined := EnumDescription{Name: d.Name + "_redefined", Labels: d.Labels}
+	statements := []string{redefined.CreateStatement()}
+
+	var defaults []string
+	for _, table := range tables {
+		for _, column := range table.Columns {
+			typeName := redefined.Name
+			switch column.TypeName {
+			case d.Name:
+			case d.Name + "[]":
+				typeName += "[]"
+			default:
+				continue
+			}
+
+			// Defaults are cast to the existing type, so they are set again once the
+			// enum has taken its place.
+			if column.Default != "" {
+				statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT;", table.Name, column.Name))
+				defaults = append(defaults, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s;", table.Name, column.Name, column.Default))
+			}
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET DATA TYPE %s USING %s::text::%s;", table.Name, column.Name, typeName, column.Name, typeName))
+		}
+	}
+
+	statements = append(statements,
+		d.DropStatement(),
+		fmt.Sprintf("ALTER TYPE %s RENAME TO %s;", redefined.Name, d.Name),
+	)
+	return append(statements, defaults...)
+}
+
 // AlterToTarget returns a set of `ALTER ENUM ADD VALUE` statements to make the given enum equivalent to
 // the expected enum, then additive statements cannot bring the enum to the expected state and we return
 // a false-valued flag. In this case the existing type must be dropped and re-created as there's currently
@@ -152,6 +191,16 @@ type SequenceDescription struct {
 }
 
 func (d SequenceDescription) CreateStatement() string {
+	return fmt.Sprintf("CREATE SEQUENCE %s %s;", d.Name, d.properties())
+}
+
+// AlterStatement returns the statement that sets every property of the existing sequence
+// of the same name to the ones of the sequence.
+func (d SequenceDescription) AlterStatement() string {
+	return fmt.Sprintf("ALTER SEQUENCE %s %s;", d.Name, d.properties())
+}
+
+func (d SequenceDescription) properties() string {
 	minValue := "NO MINVALUE"
 	if d.MinimumValue != 0 {
 		minValue = fmt.Sprintf("MINVALUE %d", d.MinimumValue)
@@ -162,17 +211,23 @@ func (d SequenceDescription) CreateStatement() string {
 	}
 
 	return fmt.Sprintf(
-		"CREATE SEQUENCE %s AS %s INCREMENT BY %d %s %s START WITH %d %s CYCLE;",
-		d.Name,
+		"AS %s INCREMENT BY %d %s %s START WITH %d %s",
 		d.TypeName,
 		d.Increment,
 		minValue,
 		maxValue,
 		d.StartValue,
-		d.CycleOption,
+		d.cycleClause(),
 	)
 }
 
+func (d SequenceDescription) cycleClause() string {
+	if d.CycleOption == "YES" {
+		return "CYCLE"
+	}
+	return "NO CYCLE"
+}
+
 func (d SequenceDescription) AlterToTarget(target SequenceDescription) ([]string, bool) {
 	statements := []string{}
 
@@ -184,6 +239,31 @@ func (d SequenceDescription) AlterToTarget(target SequenceDescription) ([]string
 		d.MaximumValue = target.MaximumValue
 	}
 
+	var clauses []string
+	if d.Increment != target.Increment {
+		clauses = append(clauses, fmt.Sprintf("INCREMENT BY %d", target.Increment))
+		d.Increment = target.Increment
+	}
+	if d.MinimumValue != target.MinimumValue {
+		clauses = append(clauses, fmt.Sprintf("MINVALUE %d", target.MinimumValue))
+		d.MinimumValue = target.MinimumValue
+	}
+	if d.MaximumValue != target.MaximumValue {
+		clauses = append(clauses, fmt.Sprintf("MAXVALUE %d", target.MaximumValue))
+		d.MaximumValue = target.MaximumValue
+	}
+	if d.StartValue != target.StartValue {
+		clauses = append(clauses, fmt.Sprintf("START WITH %d", target.StartValue))
+		d.StartValue = target.StartValue
+	}
+	if d.CycleOption != target.CycleOption {
+		clauses = append(clauses, target.cycleClause())
+		d.CycleOption = target.CycleOption
+	}
+	if len(clauses) > 0 {
+		statements = append(statements, fmt.Sprintf("ALTER SEQUENCE %s %s;", d.Name, strings.Join(clauses, " ")))
+	}
+
 	// Abort if there are other fields we haven't addressed
 	hasAdditionalDiff := cmp.Diff(d, target) != ""
 	return statements, !hasAdditionalDiff
@@ -198,6 +278,21 @@ type TableDescription struct {
 	Triggers    []TriggerDescription
 }
 
+// CreateStatement returns the statement that creates the table with its columns. Indexes,
+// constraints and triggers are created separately.
+func (d TableDescription) CreateStatement() string {
+	columns := make([]ColumnDescription, len(d.Columns))
+	copy(columns, d.Columns)
+	sort.SliceStable(columns, func(i, j int) bool { return columns[i].Index < columns[j].Index })
+
+	definitions := make([]string, 0, len(columns))
+	for _, column := range columns {
+		definitions = append(definitions, "    "+column.definition())
+	}
+
+	return fmt.Sprintf("CREATE TABLE %s (\n%s\n);", d.Name, strings.Join(definitions, ",\n"))
+}
+
 type ColumnDescription struct {
 	Name                   string
 	Index                  int
@@ -213,6 +308,11 @@ type ColumnDescription struct {
 }
 
 func (d ColumnDescription) CreateStatement(table TableDescription) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", table.Name, d.definition())
+}
+
+// definition returns the column definition as used in CREATE TABLE and ADD COLUMN.
+func (d ColumnDescription) definition() string {
 	nullableExpr := ""
 	if !d.IsNullable {
 		nullableExpr = " NOT NULL"
@@ -221,8 +321,14 @@ func (d ColumnDescription) CreateStatement(table TableDescription) string {
 	if d.Default != "" {
 		defaultExpr = fmt.Sprintf(" DEFAULT %s", d.Default)
 	}
+	generatedExpr := ""
+	if d.IsGenerated == "ALWAYS" {
+		generatedExpr = fmt.Sprintf(" GENERATED ALWAYS AS (%s) STORED", d.GenerationExpression)
+	} else if d.IsIdentity {
+		generatedExpr = fmt.Sprintf(" GENERATED %s AS IDENTITY", d.IdentityGeneration)
+	}
 
-	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s%s%s;", table.Name, d.Name, d.TypeName, nullableExpr, defaultExpr)
+	return fmt.Sprintf("%s %s%s%s%s", d.Name, d.TypeName, nullableExpr, defaultExpr, generatedExpr)
 }
 
 func (d ColumnDescription) DropStatement(table TableDescription) string {
@@ -232,11 +338,43 @@ func (d ColumnDescription) DropStatement(table TableDescription) string {
 func (d ColumnDescription) AlterToTarget(table TableDescription, target ColumnDescription) ([]string, bool) {
 	statements := []string{}
 
-	if d.TypeName != target.TypeName {
-		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET DATA TYPE %s;", table.Name, target.Name, target.TypeName))
+	if d.IsGenerated != target.IsGenerated || d.GenerationExpression != target.GenerationExpression {
+		if target.IsGenerated != "ALWAYS" {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP EXPRESSION;", table.Name, target.Name))
+		} else {
+			// The expression of a generated column can't be altered. Its values are derived
+			// from other columns, so it can be re-created without losing data.
+			return []string{
+				d.DropStatement(table),
+				target.CreateStatement(table),
+			}, true
+		}
+
+		// Remove from diff below
+		d.IsGenerated = target.IsGenerated
+		d.GenerationExpression = target.GenerationExpression
+	}
+	if d.TypeName != target.TypeName || d.CharacterMaximumLength != target.CharacterMaximumLength {
+		// Values are converted explicitly, as not every type can be assigned to another.
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET DATA TYPE %s USING %s::%s;", table.Name, target.Name, target.TypeName, target.Name, target.TypeName))
 
 		// Remove from diff below
 		d.TypeName = target.TypeName
+		d.CharacterMaximumLength = target.CharacterMaximumLength
+	}
+	if d.IsIdentity != target.IsIdentity || d.IdentityGeneration != target.IdentityGeneration {
+		switch {
+		case !target.IsIdentity:
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP IDENTITY IF EXISTS;", table.Name, target.Name))
+		case d.IsIdentity:
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET GENERATED %s;", table.Name, target.Name, target.IdentityGeneration))
+		default:
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s ADD GENERATED %s AS IDENTITY;", table.Name, target.Name, target.IdentityGeneration))
+		}
+
+		// Remove from diff below
+		d.IsIdentity = target.IsIdentity
+		d.IdentityGeneration = target.IdentityGeneration
 	}
 	if d.IsNullable != target.IsNullable {
 		var verb string
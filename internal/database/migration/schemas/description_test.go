@@ -1,6 +1,9 @@
 package schemas
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestNormalizeFunction(t *testing.T) {
 	for _, testCase := range []struct {
@@ -73,3 +76,51 @@ func TestNormalizeFunction(t *testing.T) {
 		}
 	}
 }
+
+func TestSequenceAlterToTarget(t *testing.T) {
+	sequence := SequenceDescription{Name: "s", TypeName: "bigint", StartValue: 1, MinimumValue: 1, MaximumValue: 100, Increment: 1, CycleOption: "NO"}
+	target := SequenceDescription{Name: "s", TypeName: "bigint", StartValue: 5, MinimumValue: 1, MaximumValue: 100, Increment: 2, CycleOption: "YES"}
+
+	statements, ok := sequence.AlterToTarget(target)
+	if !ok {
+		t.Fatalf("expected sequence to be altered to target")
+	}
+	if want := "ALTER SEQUENCE s INCREMENT BY 2 START WITH 5 CYCLE;"; len(statements) != 1 || statements[0] != want {
+		t.Errorf("unexpected statements. want=%q have=%q", want, statements)
+	}
+}
+
+func TestSequenceAlterStatement(t *testing.T) {
+	sequence := SequenceDescription{Name: "s", TypeName: "integer", StartValue: 1, MinimumValue: 1, Increment: 1, CycleOption: "NO"}
+	if want, have := "ALTER SEQUENCE s AS integer INCREMENT BY 1 MINVALUE 1 NO MAXVALUE START WITH 1 NO CYCLE;", sequence.AlterStatement(); have != want {
+		t.Errorf("unexpected statement. want=%q have=%q", want, have)
+	}
+}
+
+func TestColumnAlterToTargetType(t *testing.T) {
+	table := TableDescription{Name: "t"}
+	column := ColumnDescription{Name: "n", TypeName: "text"}
+	target := ColumnDescription{Name: "n", TypeName: "integer"}
+
+	statements, ok := column.AlterToTarget(table, target)
+	if !ok {
+		t.Fatalf("expected column to be altered to target")
+	}
+	if want := "ALTER TABLE t ALTER COLUMN n SET DATA TYPE integer USING n::integer;"; len(statements) != 1 || statements[0] != want {
+		t.Errorf("unexpected statements. want=%q have=%q", want, statements)
+	}
+}
+
+func TestColumnAlterToTargetIdentity(t *testing.T) {
+	table := TableDescription{Name: "t"}
+	column := ColumnDescription{Name: "id", TypeName: "integer"}
+	target := ColumnDescription{Name: "id", TypeName: "integer", IsIdentity: true, IdentityGeneration: "ALWAYS"}
+
+	statements, ok := column.AlterToTarget(table, target)
+	if !ok {
+		t.Fatalf("expected column to be altered to target")
+	}
+	if len(statements) != 1 || !strings.Contains(statements[0], "ADD GENERATED ALWAYS AS IDENTITY") {
+		t.Errorf("unexpected statements: %q", statements)
+	}
+}
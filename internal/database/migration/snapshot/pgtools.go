@@ -0,0 +1,221 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// runCommand runs the given Postgres client tool, which must be on the PATH. The
+// given environment variables are added to the environment of the process.
+var runCommand = func(ctx context.Context, env []string, stdin io.Reader, stdout io.Writer, name string, args ...string) error {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return errors.Wrapf(err, "%s is required to take and restore snapshots, and must be on the PATH", name)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path, args...)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "%s: %s", name, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// pgDump writes a custom format dump of the database with the given DSN to w.
+// Ownership and privileges are not dumped, so that the dump can be restored by
+// the same user that took it.
+func pgDump(ctx context.Context, dsn string, w io.Writer) error {
+	dsn, env, err := dsnWithoutPassword(dsn)
+	if err != nil {
+		return err
+	}
+	return runCommand(ctx, env, nil, w, "pg_dump",
+		"--format=custom",
+		"--no-owner",
+		"--no-privileges",
+		"--dbname="+dsn,
+	)
+}
+
+// pgRestoreList returns the table of contents of the dump at the given path.
+func pgRestoreList(ctx context.Context, dumpPath string) (string, error) {
+	var out bytes.Buffer
+	if err := runCommand(ctx, nil, nil, &out, "pg_restore", "--list", dumpPath); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// pgRestore restores the entries of the given list from the dump at the given path
+// into the database with the given DSN. Objects in the dump are dropped before they
+// are recreated, and everything is restored in a single transaction.
+func pgRestore(ctx context.Context, dsn, dumpPath, listPath string) error {
+	dsn, env, err := dsnWithoutPassword(dsn)
+	if err != nil {
+		return err
+	}
+	return runCommand(ctx, env, nil, io.Discard, "pg_restore",
+		"--clean",
+		"--if-exists",
+		"--no-owner",
+		"--no-privileges",
+		"--single-transaction",
+		"--use-list="+listPath,
+		"--dbname="+dsn,
+		dumpPath,
+	)
+}
+
+// dsnWithoutPassword removes the password from the given DSN, which may be a URL or
+// a list of keyword/value pairs, and returns it as a PGPASSWORD environment variable
+// instead. Command line arguments are visible to all users of the host, so they must
+// not contain the password.
+func dsnWithoutPassword(dsn string) (string, []string, error) {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "invalid DSN")
+		}
+		if u.User == nil {
+			return dsn, nil, nil
+		}
+		password, ok := u.User.Password()
+		if !ok {
+			return dsn, nil, nil
+		}
+		u.User = url.User(u.User.Username())
+		return u.String(), []string{"PGPASSWORD=" + password}, nil
+	}
+
+	pairs, err := parseKeywordValueDSN(dsn)
+	if err != nil {
+		return "", nil, err
+	}
+	var (
+		kept     []string
+		password string
+		found    bool
+	)
+	for _, pair := range pairs {
+		if pair.key == "password" {
+			password, found = pair.value, true
+			continue
+		}
+		kept = append(kept, pair.raw)
+	}
+	if !found {
+		return dsn, nil, nil
+	}
+	return strings.Join(kept, " "), []string{"PGPASSWORD=" + password}, nil
+}
+
+type dsnPair struct {
+	key, value string
+	// raw is the pair as it appeared in the DSN.
+	raw string
+}
+
+// parseKeywordValueDSN parses a DSN of the form `host=localhost password='s3 cr\'et'`.
+// Values may be single-quoted, with backslash escapes for quotes and backslashes.
+func parseKeywordValueDSN(dsn string) ([]dsnPair, error) {
+	var pairs []dsnPair
+	s := strings.TrimSpace(dsn)
+	for s != "" {
+		eq := strings.IndexByte(s, '=')
+		if eq < 0 {
+			return nil, errors.New("invalid DSN: missing = after keyword")
+		}
+		key := strings.TrimSpace(s[:eq])
+		rest := strings.TrimLeft(s[eq+1:], " \t\n")
+
+		var (
+			value strings.Builder
+			i     int
+		)
+		if strings.HasPrefix(rest, "'") {
+			for i = 1; ; i++ {
+				if i >= len(rest) {
+					return nil, errors.New("invalid DSN: unterminated quoted value")
+				}
+				if rest[i] == '\\' && i+1 < len(rest) {
+					i++
+					value.WriteByte(rest[i])
+					continue
+				}
+				if rest[i] == '\'' {
+					i++
+					break
+				}
+				value.WriteByte(rest[i])
+			}
+		} else {
+			for i < len(rest) && !strings.ContainsRune(" \t\n", rune(rest[i])) {
+				if rest[i] == '\\' && i+1 < len(rest) {
+					i++
+				}
+				value.WriteByte(rest[i])
+				i++
+			}
+		}
+
+		pairs = append(pairs, dsnPair{
+			key:   key,
+			value: value.String(),
+			raw:   key + "=" + rest[:i],
+		})
+		s = strings.TrimLeft(rest[i:], " \t\n")
+	}
+	return pairs, nil
+}
+
+// excludedRestoreEntryPrefixes are the descriptions of dump entries that are not
+// restored. Schemas and extensions are shared with objects outside of the snapshot
+// and require elevated privileges to drop, and are never removed by migrations.
+var excludedRestoreEntryPrefixes = []string{
+	"SCHEMA ",
+	"EXTENSION ",
+	"COMMENT - SCHEMA ",
+	"COMMENT - EXTENSION ",
+}
+
+// filterRestoreList removes the entries that must not be restored from the output
+// of pg_restore --list. Entries have the form `{id}; {catalog oid} {oid} {desc} ...`.
+func filterRestoreList(list string) string {
+	var filtered strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(list))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+outer:
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, ";") {
+			if fields := strings.SplitN(line, " ", 4); len(fields) == 4 {
+				for _, prefix := range excludedRestoreEntryPrefixes {
+					if strings.HasPrefix(fields[3], prefix) {
+						continue outer
+					}
+				}
+			}
+		}
+
+		filtered.WriteString(line)
+		filtered.WriteByte('\n')
+	}
+
+	return filtered.String()
+}
@@ -0,0 +1,82 @@
+package snapshot
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Snapshots are recorded in the migration_snapshots table of each schema, next to
+// the migration_logs table. The record is written before the schema is dumped, so
+// that it survives restoring the snapshot.
+const ensureRecordTableQuery = `
+CREATE TABLE IF NOT EXISTS migration_snapshots (
+	id SERIAL PRIMARY KEY,
+	snapshot_id text NOT NULL,
+	schema text NOT NULL,
+	location text NOT NULL,
+	from_version text NOT NULL,
+	to_version text NOT NULL,
+	applied_versions integer[] NOT NULL,
+	created_at timestamptz NOT NULL DEFAULT NOW()
+)
+`
+
+const insertRecordQuery = `
+INSERT INTO migration_snapshots (snapshot_id, schema, location, from_version, to_version, applied_versions)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+const deleteRecordQuery = `
+DELETE FROM migration_snapshots WHERE snapshot_id = $1 AND schema = $2
+`
+
+const latestRecordQuery = `
+SELECT snapshot_id, location
+FROM migration_snapshots
+ORDER BY created_at DESC, id DESC
+LIMIT 1
+`
+
+func record(ctx context.Context, db *sql.DB, m Manifest, schemaName string, appliedVersions []int) error {
+	if _, err := db.ExecContext(ctx, ensureRecordTableQuery); err != nil {
+		return errors.Wrap(err, "create migration_snapshots table")
+	}
+
+	versions := make([]int64, 0, len(appliedVersions))
+	for _, v := range appliedVersions {
+		versions = append(versions, int64(v))
+	}
+	if _, err := db.ExecContext(ctx, insertRecordQuery, m.ID, schemaName, m.Location, m.FromVersion, m.ToVersion, pq.Array(versions)); err != nil {
+		return errors.Wrap(err, "record snapshot")
+	}
+	return nil
+}
+
+func deleteRecord(ctx context.Context, db *sql.DB, id, schemaName string) error {
+	_, err := db.ExecContext(ctx, deleteRecordQuery, id, schemaName)
+	return err
+}
+
+// Latest returns the ID and location of the most recent snapshot recorded in the
+// given database. The returned flag is false if no snapshot was recorded.
+func Latest(ctx context.Context, db *sql.DB) (id, location string, _ bool, err error) {
+	var exists bool
+	if err := db.QueryRowContext(ctx, `SELECT to_regclass('migration_snapshots') IS NOT NULL`).Scan(&exists); err != nil {
+		return "", "", false, err
+	}
+	if !exists {
+		return "", "", false, nil
+	}
+
+	if err := db.QueryRowContext(ctx, latestRecordQuery).Scan(&id, &location); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", false, nil
+		}
+		return "", "", false, err
+	}
+	return id, location, true, nil
+}
@@ -0,0 +1,300 @@
+// Package snapshot takes logical snapshots of the schemas of an instance before an
+// upgrade, and restores an instance to such a snapshot. Snapshots are taken and
+// restored with the pg_dump and pg_restore client tools.
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Manifest describes a snapshot. It is stored next to the dumps of the schemas.
+type Manifest struct {
+	ID          string           `json:"id"`
+	Location    string           `json:"location"`
+	FromVersion string           `json:"fromVersion"`
+	ToVersion   string           `json:"toVersion"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	Schemas     []SchemaSnapshot `json:"schemas"`
+}
+
+// SchemaSnapshot describes the snapshot of a single schema.
+type SchemaSnapshot struct {
+	Name string `json:"name"`
+	// Key is the key of the dump of the schema in the snapshot storage.
+	Key string `json:"key"`
+	// AppliedVersions are the migrations applied to the schema when the snapshot
+	// was taken.
+	AppliedVersions []int `json:"appliedVersions"`
+	// Objects are the objects of the schema when the snapshot was taken. Objects
+	// created afterwards are dropped on restore.
+	Objects Objects `json:"objects"`
+}
+
+// Objects are the names of the objects of a schema.
+type Objects struct {
+	Tables    []string `json:"tables"`
+	Views     []string `json:"views"`
+	Sequences []string `json:"sequences"`
+	Functions []string `json:"functions"`
+	Enums     []string `json:"enums"`
+}
+
+// Store is the subset of the migration store used to take and restore snapshots.
+type Store interface {
+	Describe(ctx context.Context) (map[string]schemas.SchemaDescription, error)
+	Versions(ctx context.Context) (appliedVersions, pendingVersions, failedVersions []int, _ error)
+	RunDDLStatements(ctx context.Context, statements []string) error
+}
+
+// Target is a schema to snapshot or restore.
+type Target struct {
+	SchemaName string
+	DSN        string
+	DB         *sql.DB
+	Store      Store
+}
+
+// NewID returns a new snapshot ID, which sorts by the time it was taken.
+func NewID(now time.Time) string {
+	return now.UTC().Format("20060102T150405Z")
+}
+
+// Take dumps the given schemas to the storage at the given location and records the
+// snapshot in each schema. Schemas with pending or failed migrations can't be
+// snapshotted, as restoring them would not bring back a consistent state.
+func Take(ctx context.Context, storage Storage, location, fromVersion, toVersion string, targets []Target) (Manifest, error) {
+	now := time.Now()
+	m := Manifest{
+		ID:          NewID(now),
+		Location:    location,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		CreatedAt:   now.UTC(),
+	}
+
+	for _, target := range targets {
+		s, err := takeSchema(ctx, storage, m, target)
+		if err != nil {
+			return Manifest{}, errors.Wrapf(err, "snapshot schema %q", target.SchemaName)
+		}
+		m.Schemas = append(m.Schemas, s)
+	}
+
+	serialized, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return Manifest{}, err
+	}
+	if _, err := storage.Upload(ctx, manifestKey(m.ID), bytes.NewReader(serialized)); err != nil {
+		return Manifest{}, errors.Wrap(err, "upload snapshot manifest")
+	}
+
+	return m, nil
+}
+
+func takeSchema(ctx context.Context, storage Storage, m Manifest, target Target) (_ SchemaSnapshot, err error) {
+	applied, pending, failed, err := target.Store.Versions(ctx)
+	if err != nil {
+		return SchemaSnapshot{}, err
+	}
+	if len(pending)+len(failed) > 0 {
+		return SchemaSnapshot{}, errors.Newf("schema has pending or failed migrations (pending=%v, failed=%v)", pending, failed)
+	}
+
+	descriptions, err := target.Store.Describe(ctx)
+	if err != nil {
+		return SchemaSnapshot{}, err
+	}
+
+	if err := record(ctx, target.DB, m, target.SchemaName, applied); err != nil {
+		return SchemaSnapshot{}, err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Append(err, deleteRecord(context.Background(), target.DB, m.ID, target.SchemaName))
+		}
+	}()
+
+	key := path.Join(m.ID, target.SchemaName+".dump")
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(pgDump(ctx, target.DSN, pw))
+	}()
+	_, err = storage.Upload(ctx, key, pr)
+	// Unblock the dump if the upload returned early.
+	pr.CloseWithError(err)
+	if err != nil {
+		return SchemaSnapshot{}, errors.Wrap(err, "dump schema")
+	}
+
+	return SchemaSnapshot{
+		Name:            target.SchemaName,
+		Key:             key,
+		AppliedVersions: applied,
+		Objects:         objectsOf(descriptions["public"]),
+	}, nil
+}
+
+// LoadManifest reads the manifest of the snapshot with the given ID.
+func LoadManifest(ctx context.Context, storage Storage, id string) (Manifest, error) {
+	rc, err := storage.Get(ctx, manifestKey(id))
+	if err != nil {
+		return Manifest{}, errors.Wrapf(err, "read manifest of snapshot %q", id)
+	}
+	defer rc.Close()
+
+	var m Manifest
+	if err := json.NewDecoder(rc).Decode(&m); err != nil {
+		return Manifest{}, errors.Wrapf(err, "decode manifest of snapshot %q", id)
+	}
+	return m, nil
+}
+
+// Restore brings the given schemas back to the state of the snapshot. Objects that
+// were created after the snapshot are dropped, then the objects of the snapshot are
+// dropped and recreated with their data in a single transaction. Schemas that are
+// not part of the snapshot are not touched.
+func Restore(ctx context.Context, storage Storage, m Manifest, targets []Target) error {
+	targetsBySchemaName := make(map[string]Target, len(targets))
+	for _, target := range targets {
+		targetsBySchemaName[target.SchemaName] = target
+	}
+
+	for _, s := range m.Schemas {
+		target, ok := targetsBySchemaName[s.Name]
+		if !ok {
+			continue
+		}
+		if err := restoreSchema(ctx, storage, s, target); err != nil {
+			return errors.Wrapf(err, "restore schema %q", s.Name)
+		}
+	}
+
+	return nil
+}
+
+func restoreSchema(ctx context.Context, storage Storage, s SchemaSnapshot, target Target) error {
+	dir, err := os.MkdirTemp("", "migration-snapshot-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	// pg_restore reads the dump twice, so it must be a seekable file.
+	dumpPath := filepath.Join(dir, "schema.dump")
+	if err := download(ctx, storage, s.Key, dumpPath); err != nil {
+		return errors.Wrap(err, "download dump")
+	}
+
+	list, err := pgRestoreList(ctx, dumpPath)
+	if err != nil {
+		return err
+	}
+	listPath := filepath.Join(dir, "schema.list")
+	if err := os.WriteFile(listPath, []byte(filterRestoreList(list)), 0o600); err != nil {
+		return err
+	}
+
+	descriptions, err := target.Store.Describe(ctx)
+	if err != nil {
+		return err
+	}
+	if statements := dropStatements(s.Objects, descriptions["public"]); len(statements) > 0 {
+		if err := target.Store.RunDDLStatements(ctx, statements); err != nil {
+			return errors.Wrap(err, "drop objects created after the snapshot")
+		}
+	}
+
+	return pgRestore(ctx, target.DSN, dumpPath, listPath)
+}
+
+func download(ctx context.Context, storage Storage, key, dst string) (err error) {
+	rc, err := storage.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Append(err, f.Close()) }()
+
+	_, err = io.Copy(f, rc)
+	return err
+}
+
+func manifestKey(id string) string {
+	return path.Join(id, "manifest.json")
+}
+
+func objectsOf(description schemas.SchemaDescription) Objects {
+	objects := Objects{
+		Tables:    make([]string, 0, len(description.Tables)),
+		Views:     make([]string, 0, len(description.Views)),
+		Sequences: make([]string, 0, len(description.Sequences)),
+		Functions: make([]string, 0, len(description.Functions)),
+		Enums:     make([]string, 0, len(description.Enums)),
+	}
+	for _, t := range description.Tables {
+		objects.Tables = append(objects.Tables, t.Name)
+	}
+	for _, v := range description.Views {
+		objects.Views = append(objects.Views, v.Name)
+	}
+	for _, s := range description.Sequences {
+		objects.Sequences = append(objects.Sequences, s.Name)
+	}
+	for _, f := range description.Functions {
+		objects.Functions = append(objects.Functions, f.Name)
+	}
+	for _, e := range description.Enums {
+		objects.Enums = append(objects.Enums, e.Name)
+	}
+	return objects
+}
+
+// dropStatements returns the statements that drop the objects of the current
+// description that are not part of the given snapshot objects. Objects are dropped
+// in dependency order, and with CASCADE so that dependent indexes, triggers and
+// constraints go with them.
+func dropStatements(snapshot Objects, current schemas.SchemaDescription) []string {
+	extra := func(kind string, snapshotNames, currentNames []string) []string {
+		known := make(map[string]struct{}, len(snapshotNames))
+		for _, name := range snapshotNames {
+			known[name] = struct{}{}
+		}
+
+		var statements []string
+		for _, name := range currentNames {
+			if _, ok := known[name]; !ok {
+				statements = append(statements, "DROP "+kind+" IF EXISTS "+pq.QuoteIdentifier(name)+" CASCADE;")
+			}
+		}
+		sort.Strings(statements)
+		return statements
+	}
+
+	objects := objectsOf(current)
+	var statements []string
+	statements = append(statements, extra("VIEW", snapshot.Views, objects.Views)...)
+	statements = append(statements, extra("TABLE", snapshot.Tables, objects.Tables)...)
+	statements = append(statements, extra("SEQUENCE", snapshot.Sequences, objects.Sequences)...)
+	statements = append(statements, extra("FUNCTION", snapshot.Functions, objects.Functions)...)
+	statements = append(statements, extra("TYPE", snapshot.Enums, objects.Enums)...)
+	return statements
+}
@@ -0,0 +1,49 @@
+package snapshot
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestDirectoryStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage := NewDirectoryStorage(dir)
+
+	n, err := storage.Upload(ctx, "20231012T100000Z/frontend.dump", strings.NewReader("dump"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), n)
+
+	rc, err := storage.Get(ctx, "20231012T100000Z/frontend.dump")
+	require.NoError(t, err)
+	content, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	assert.Equal(t, "dump", string(content))
+
+	// A failed upload leaves neither the file nor a temporary file behind
+	_, err = storage.Upload(ctx, "20231012T100000Z/codeintel.dump", io.MultiReader(strings.NewReader("partial"), errReader{}))
+	require.Error(t, err)
+	entries, err := os.ReadDir(filepath.Join(dir, "20231012T100000Z"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "frontend.dump", entries[0].Name())
+
+	for _, key := range []string{"", "../escape", "/abs/path"} {
+		_, err := storage.Upload(ctx, key, strings.NewReader("dump"))
+		assert.Error(t, err, "key %q", key)
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("dump failed") }
@@ -33,6 +33,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitmanifest"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/pagure"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
@@ -551,6 +552,8 @@ func scanRepo(logger log.Logger, rows *sql.Rows, r *types.Repo) (err error) {
 		r.Metadata = &struct{}{}
 	case extsvc.VariantLocalGit.AsType():
 		r.Metadata = new(extsvc.LocalGitMetadata)
+	case extsvc.VariantGitManifest.AsType():
+		r.Metadata = new(gitmanifest.Repository)
 	default:
 		logger.Warn("unknown service type", log.String("type", typ))
 		return nil
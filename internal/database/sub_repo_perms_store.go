@@ -2,14 +2,19 @@ package database
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/keegancsmith/sqlf"
 	"github.com/lib/pq"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/authz/subrepoperms"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -46,6 +51,52 @@ type SubRepoPermsStore interface {
 	RepoIDSupported(ctx context.Context, repoID api.RepoID) (bool, error)
 	RepoSupported(ctx context.Context, repo api.RepoName) (bool, error)
 	DeleteByUser(ctx context.Context, userID int32) error
+
+	// UpsertACL stores the path ACL file of a repository. An ACL file committed
+	// to the repository does not replace one uploaded by a site admin.
+	UpsertACL(ctx context.Context, acl *SubRepoPermsACL) error
+	// GetACL returns the path ACL file of a repository.
+	GetACL(ctx context.Context, repoID api.RepoID) (*SubRepoPermsACL, error)
+	// DeleteACL deletes the path ACL file of a repository if it has the given
+	// source.
+	DeleteACL(ctx context.Context, repoID api.RepoID, source SubRepoPermsACLSource) error
+}
+
+// SubRepoPermsACLSource is the origin of a path ACL file.
+type SubRepoPermsACLSource string
+
+const (
+	// SubRepoPermsACLSourceUpload is the source of ACL files uploaded by site
+	// admins.
+	SubRepoPermsACLSourceUpload SubRepoPermsACLSource = "upload"
+	// SubRepoPermsACLSourceRepository is the source of ACL files committed to
+	// the repository.
+	SubRepoPermsACLSourceRepository SubRepoPermsACLSource = "repository"
+)
+
+// SubRepoPermsACL is a path ACL file, in CODEOWNERS syntax, from which the
+// sub-repo permissions of a repository are derived. See subrepoperms.ParseACL.
+type SubRepoPermsACL struct {
+	RepoID   api.RepoID
+	Contents string
+	Source   SubRepoPermsACLSource
+	// CommitID is the commit the ACL file was read from, if Source is
+	// SubRepoPermsACLSourceRepository.
+	CommitID  api.CommitID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type SubRepoPermsACLNotFoundError struct {
+	repoID api.RepoID
+}
+
+func (e SubRepoPermsACLNotFoundError) Error() string {
+	return fmt.Sprintf("sub-repo permissions ACL file not found for repo %d", e.repoID)
+}
+
+func (SubRepoPermsACLNotFoundError) NotFound() bool {
+	return true
 }
 
 // subRepoPermsStore is the unified interface for managing sub repository
@@ -147,7 +198,9 @@ WHERE repo_id = %s
 	return perms, nil
 }
 
-// GetByUser fetches all sub repo perms for a user keyed by repo.
+// GetByUser fetches all sub repo perms for a user keyed by repo. Repos with a
+// path ACL file get the permissions derived from it, unless the code host
+// already provides sub repo perms for the user.
 func (s *subRepoPermsStore) GetByUser(ctx context.Context, userID int32) (map[api.RepoName]authz.SubRepoPermissions, error) {
 	enforceForSiteAdmins := conf.Get().AuthzEnforceForSiteAdmins
 
@@ -183,9 +236,180 @@ func (s *subRepoPermsStore) GetByUser(ctx context.Context, userID int32) (map[ap
 		return nil, errors.Wrap(err, "closing rows")
 	}
 
+	if err := s.addACLPermissions(ctx, userID, enforceForSiteAdmins, result); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
+// addACLPermissions adds the permissions the path ACL files grant to the user
+// for repos that don't have any in result yet.
+func (s *subRepoPermsStore) addACLPermissions(ctx context.Context, userID int32, enforceForSiteAdmins bool, result map[api.RepoName]authz.SubRepoPermissions) error {
+	versions, err := scanACLVersions(s.Query(ctx, sqlf.Sprintf(getACLVersionsQuery)))
+	if err != nil {
+		return errors.Wrap(err, "getting sub repo permissions ACL files")
+	}
+	parsedACLs.prune(versions)
+
+	for repoID, version := range versions {
+		if _, ok := result[version.repoName]; ok {
+			delete(versions, repoID)
+		}
+	}
+	if len(versions) == 0 {
+		return nil
+	}
+
+	id, found, err := basestore.NewFirstScanner(func(sc dbutil.Scanner) (id subrepoperms.ACLIdentity, err error) {
+		err = sc.Scan(&id.Username, pq.Array(&id.Emails), pq.Array(&id.Teams))
+		return id, err
+	})(s.Query(ctx, sqlf.Sprintf(getACLIdentityQuery, userID, enforceForSiteAdmins)))
+	if err != nil {
+		return errors.Wrap(err, "getting user identity for sub repo permissions ACL files")
+	}
+	if !found {
+		return nil
+	}
+
+	acls, stale := parsedACLs.get(versions)
+	if len(stale) > 0 {
+		err := basestore.NewCallbackScanner(func(sc dbutil.Scanner) (bool, error) {
+			var repoID api.RepoID
+			var contents string
+			var updatedAt time.Time
+			if err := sc.Scan(&repoID, &contents, &updatedAt); err != nil {
+				return false, err
+			}
+			acl := parseACLVersion(contents, updatedAt)
+			parsedACLs.put(repoID, acl)
+			acls[repoID] = acl
+			return true, nil
+		})(s.Query(ctx, sqlf.Sprintf(getACLContentsQuery, pq.Array(stale))))
+		if err != nil {
+			return errors.Wrap(err, "getting sub repo permissions ACL files")
+		}
+	}
+
+	for repoID, version := range versions {
+		acl, ok := acls[repoID]
+		if !ok {
+			// The ACL file was deleted since we listed it.
+			continue
+		}
+		if acl.acl == nil {
+			// ACL files are validated when they are stored, so this only
+			// happens if the parser became stricter. Deny access to all
+			// paths rather than granting access to all of them.
+			result[version.repoName] = authz.SubRepoPermissions{}
+			continue
+		}
+		result[version.repoName] = acl.acl.PermissionsFor(id)
+	}
+	return nil
+}
+
+const getACLVersionsQuery = `
+SELECT a.repo_id, r.name, a.updated_at
+FROM sub_repo_perms_acls a
+JOIN repo r ON r.id = a.repo_id
+WHERE r.deleted_at IS NULL
+AND r.private = TRUE
+`
+
+const getACLContentsQuery = `
+SELECT repo_id, contents, updated_at
+FROM sub_repo_perms_acls
+WHERE repo_id = ANY(%s)
+`
+
+// aclVersion identifies the current version of the ACL file of a repository.
+type aclVersion struct {
+	repoName  api.RepoName
+	updatedAt time.Time
+}
+
+var scanACLVersions = basestore.NewMapScanner(func(sc dbutil.Scanner) (repoID api.RepoID, version aclVersion, err error) {
+	err = sc.Scan(&repoID, &version.repoName, &version.updatedAt)
+	return repoID, version, err
+})
+
+// parsedACL is a parsed ACL file, along with the time it was last updated. acl
+// is nil if the ACL file failed to parse.
+type parsedACL struct {
+	acl       *subrepoperms.ACL
+	updatedAt time.Time
+}
+
+func parseACLVersion(contents string, updatedAt time.Time) parsedACL {
+	acl, err := subrepoperms.ParseACL([]byte(contents))
+	if err != nil {
+		acl = nil
+	}
+	return parsedACL{acl: acl, updatedAt: updatedAt}
+}
+
+// parsedACLs caches parsed ACL files by repository, so that GetByUser only
+// loads and parses the ACL files that changed since they were last used.
+var parsedACLs = &parsedACLCache{acls: map[api.RepoID]parsedACL{}}
+
+type parsedACLCache struct {
+	mu   sync.Mutex
+	acls map[api.RepoID]parsedACL
+}
+
+// get returns the cached ACL files of the given versions, along with the
+// repositories whose ACL file is missing from the cache or outdated.
+func (c *parsedACLCache) get(versions map[api.RepoID]aclVersion) (map[api.RepoID]parsedACL, []api.RepoID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	acls := make(map[api.RepoID]parsedACL, len(versions))
+	var stale []api.RepoID
+	for repoID, version := range versions {
+		if acl, ok := c.acls[repoID]; ok && acl.updatedAt.Equal(version.updatedAt) {
+			acls[repoID] = acl
+		} else {
+			stale = append(stale, repoID)
+		}
+	}
+	return acls, stale
+}
+
+func (c *parsedACLCache) put(repoID api.RepoID, acl parsedACL) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.acls[repoID]; !ok || acl.updatedAt.After(cached.updatedAt) {
+		c.acls[repoID] = acl
+	}
+}
+
+// prune removes the ACL files of repositories that no longer have one.
+func (c *parsedACLCache) prune(versions map[api.RepoID]aclVersion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for repoID := range c.acls {
+		if _, ok := versions[repoID]; !ok {
+			delete(c.acls, repoID)
+		}
+	}
+}
+
+const getACLIdentityQuery = `
+SELECT
+	u.username,
+	ARRAY(SELECT e.email::text FROM user_emails e WHERE e.user_id = u.id AND e.verified_at IS NOT NULL),
+	ARRAY(SELECT t.name FROM team_members tm JOIN teams t ON t.id = tm.team_id WHERE tm.user_id = u.id)
+FROM users u
+WHERE u.id = %s
+AND u.deleted_at IS NULL
+-- Site admins bypass sub repo perms unless AuthzEnforceForSiteAdmins is TRUE,
+-- see GetByUser.
+AND NOT (u.site_admin AND NOT %t)
+`
+
 func (s *subRepoPermsStore) GetByUserAndService(ctx context.Context, userID int32, serviceType string, serviceID string) (map[api.ExternalRepoSpec]authz.SubRepoPermissions, error) {
 	q := sqlf.Sprintf(`
 SELECT r.external_id, paths
@@ -223,7 +447,8 @@ WHERE user_id = %s
 }
 
 // RepoIDSupported returns true if repo with the given ID has sub-repo permissions
-// (i.e. it is private and its type is one of the SubRepoSupportedCodeHostTypes)
+// (i.e. it is private and its type is one of the SubRepoSupportedCodeHostTypes or
+// it has a path ACL file)
 func (s *subRepoPermsStore) RepoIDSupported(ctx context.Context, repoID api.RepoID) (bool, error) {
 	q := sqlf.Sprintf(`
 SELECT EXISTS(
@@ -231,7 +456,10 @@ SELECT
 FROM repo
 WHERE id = %s
 AND private = TRUE
-AND external_service_type IN (%s)
+AND (
+	external_service_type IN (%s)
+	OR EXISTS (SELECT FROM sub_repo_perms_acls a WHERE a.repo_id = repo.id)
+)
 )
 `, repoID, sqlf.Join(supportedTypesQuery, ","))
 
@@ -243,7 +471,8 @@ AND external_service_type IN (%s)
 }
 
 // RepoSupported returns true if repo has sub-repo permissions
-// (i.e. it is private and its type is one of the SubRepoSupportedCodeHostTypes)
+// (i.e. it is private and its type is one of the SubRepoSupportedCodeHostTypes or
+// it has a path ACL file)
 func (s *subRepoPermsStore) RepoSupported(ctx context.Context, repo api.RepoName) (bool, error) {
 	q := sqlf.Sprintf(`
 SELECT EXISTS(
@@ -251,7 +480,10 @@ SELECT
 FROM repo
 WHERE name = %s
 AND private = TRUE
-AND external_service_type IN (%s)
+AND (
+	external_service_type IN (%s)
+	OR EXISTS (SELECT FROM sub_repo_perms_acls a WHERE a.repo_id = repo.id)
+)
 )
 `, repo, sqlf.Join(supportedTypesQuery, ","))
 
@@ -269,3 +501,53 @@ DELETE FROM sub_repo_permissions WHERE user_id = %d
 `, userID)
 	return s.Exec(ctx, q)
 }
+
+// UpsertACL stores the path ACL file of a repository.
+func (s *subRepoPermsStore) UpsertACL(ctx context.Context, acl *SubRepoPermsACL) error {
+	q := sqlf.Sprintf(`
+INSERT INTO sub_repo_perms_acls (repo_id, contents, source, commit_id, created_at, updated_at)
+VALUES (%s, %s, %s, %s, now(), now())
+ON CONFLICT (repo_id)
+DO UPDATE
+SET
+  contents = EXCLUDED.contents,
+  source = EXCLUDED.source,
+  commit_id = EXCLUDED.commit_id,
+  updated_at = now()
+-- ACL files uploaded by site admins take precedence over committed ones.
+WHERE sub_repo_perms_acls.source = %s OR EXCLUDED.source = %s
+`, acl.RepoID, acl.Contents, acl.Source, dbutil.NullStringColumn(string(acl.CommitID)), SubRepoPermsACLSourceRepository, SubRepoPermsACLSourceUpload)
+	return errors.Wrap(s.Exec(ctx, q), "upserting sub repo permissions ACL file")
+}
+
+// GetACL returns the path ACL file of a repository, or a
+// SubRepoPermsACLNotFoundError.
+func (s *subRepoPermsStore) GetACL(ctx context.Context, repoID api.RepoID) (*SubRepoPermsACL, error) {
+	q := sqlf.Sprintf(`
+SELECT repo_id, contents, source, commit_id, created_at, updated_at
+FROM sub_repo_perms_acls
+WHERE repo_id = %s
+`, repoID)
+
+	acl, found, err := basestore.NewFirstScanner(func(sc dbutil.Scanner) (*SubRepoPermsACL, error) {
+		var acl SubRepoPermsACL
+		err := sc.Scan(&acl.RepoID, &acl.Contents, &acl.Source, dbutil.NullString{S: (*string)(&acl.CommitID)}, &acl.CreatedAt, &acl.UpdatedAt)
+		return &acl, err
+	})(s.Query(ctx, q))
+	if err != nil {
+		return nil, errors.Wrap(err, "getting sub repo permissions ACL file")
+	}
+	if !found {
+		return nil, SubRepoPermsACLNotFoundError{repoID: repoID}
+	}
+	return acl, nil
+}
+
+// DeleteACL deletes the path ACL file of a repository if it has the given
+// source.
+func (s *subRepoPermsStore) DeleteACL(ctx context.Context, repoID api.RepoID, source SubRepoPermsACLSource) error {
+	q := sqlf.Sprintf(`
+DELETE FROM sub_repo_perms_acls WHERE repo_id = %s AND source = %s
+`, repoID, source)
+	return errors.Wrap(s.Exec(ctx, q), "deleting sub repo permissions ACL file")
+}
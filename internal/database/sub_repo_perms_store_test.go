@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -353,6 +354,94 @@ func TestSubRepoPermsSupportedForRepoId(t *testing.T) {
 	testSubRepoNotSupportedForRepo(ctx, t, s, 5, "github.com/foo/qux", "Repo is not perforce, therefore sub-repo perms are not supported")
 }
 
+func TestSubRepoPermsACL(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{AuthzEnforceForSiteAdmins: true}})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+
+	ctx := context.Background()
+	s := db.SubRepoPerms()
+	prepareSubRepoTestData(ctx, t, db)
+
+	testSubRepoNotSupportedForRepo(ctx, t, s, 5, "github.com/foo/qux", "Repo has no ACL file, therefore sub-repo perms are not supported")
+
+	if err := s.UpsertACL(ctx, &SubRepoPermsACL{
+		RepoID:   5,
+		Contents: "/secrets/ @alice\n/docs/ @bob\n",
+		Source:   SubRepoPermsACLSourceUpload,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	testSubRepoSupportedForRepo(ctx, t, s, 5, "github.com/foo/qux", "Repo has an ACL file, therefore sub-repo perms are supported")
+
+	// A committed ACL file doesn't replace an uploaded one.
+	if err := s.UpsertACL(ctx, &SubRepoPermsACL{
+		RepoID:   5,
+		Contents: "* @bob\n",
+		Source:   SubRepoPermsACLSourceRepository,
+		CommitID: "deadbeef",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	acl, err := s.GetACL(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, SubRepoPermsACLSourceUpload, acl.Source)
+	assert.Equal(t, api.CommitID(""), acl.CommitID)
+
+	have, err := s.GetByUser(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[api.RepoName]authz.SubRepoPermissions{
+		"github.com/foo/qux": {
+			Paths: []string{"/**", "/secrets/**", "-/docs/**"},
+		},
+	}
+	assert.Equal(t, want, have)
+
+	// Updating the ACL file replaces the cached permissions.
+	if err := s.UpsertACL(ctx, &SubRepoPermsACL{
+		RepoID:   5,
+		Contents: "/secrets/ @alice\n/docs/ @alice\n",
+		Source:   SubRepoPermsACLSourceUpload,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	have, err = s.GetByUser(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = map[api.RepoName]authz.SubRepoPermissions{
+		"github.com/foo/qux": {
+			Paths: []string{"/**", "/secrets/**", "/docs/**"},
+		},
+	}
+	assert.Equal(t, want, have)
+
+	// Deleting the committed ACL file leaves the uploaded one in place.
+	if err := s.DeleteACL(ctx, 5, SubRepoPermsACLSourceRepository); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetACL(ctx, 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DeleteACL(ctx, 5, SubRepoPermsACLSourceUpload); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetACL(ctx, 5); !errcode.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
 func testSubRepoNotSupportedForRepo(ctx context.Context, t *testing.T, s SubRepoPermsStore, repoID api.RepoID, repoName api.RepoName, errMsg string) {
 	t.Helper()
 	exists, err := s.RepoIDSupported(ctx, repoID)
@@ -0,0 +1,658 @@
+// Code generated by go-mockgen 1.3.7; DO NOT EDIT.
+//
+// This file was generated by running `sg generate` (or `go-mockgen`) at the root of
+// this repository. To add additional mocks to this or another package, add a new entry
+// to the mockgen.yaml file in the root of this repository.
+
+package artifacts
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MockStore is a mock implementation of the Store interface (from the
+// package github.com/sourcegraph/sourcegraph/internal/executor/artifacts)
+// used for unit testing.
+type MockStore struct {
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *StoreDeleteFunc
+	// GetByIDFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByID.
+	GetByIDFunc *StoreGetByIDFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *StoreListFunc
+	// ListExpiredFunc is an instance of a mock function object controlling
+	// the behavior of the method ListExpired.
+	ListExpiredFunc *StoreListExpiredFunc
+	// UpsertFunc is an instance of a mock function object controlling the
+	// behavior of the method Upsert.
+	UpsertFunc *StoreUpsertFunc
+}
+
+// NewMockStore creates a new mock of the Store interface. All methods
+// return zero values for all results, unless overwritten.
+func NewMockStore() *MockStore {
+	return &MockStore{
+		DeleteFunc: &StoreDeleteFunc{
+			defaultHook: func(context.Context, []int) (r0 error) {
+				return
+			},
+		},
+		GetByIDFunc: &StoreGetByIDFunc{
+			defaultHook: func(context.Context, int) (r0 *Artifact, r1 error) {
+				return
+			},
+		},
+		ListFunc: &StoreListFunc{
+			defaultHook: func(context.Context, string, int) (r0 []*Artifact, r1 error) {
+				return
+			},
+		},
+		ListExpiredFunc: &StoreListExpiredFunc{
+			defaultHook: func(context.Context, time.Duration, int) (r0 []*Artifact, r1 error) {
+				return
+			},
+		},
+		UpsertFunc: &StoreUpsertFunc{
+			defaultHook: func(context.Context, Artifact) (r0 *Artifact, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockStore creates a new mock of the Store interface. All methods
+// panic on invocation, unless overwritten.
+func NewStrictMockStore() *MockStore {
+	return &MockStore{
+		DeleteFunc: &StoreDeleteFunc{
+			defaultHook: func(context.Context, []int) error {
+				panic("unexpected invocation of MockStore.Delete")
+			},
+		},
+		GetByIDFunc: &StoreGetByIDFunc{
+			defaultHook: func(context.Context, int) (*Artifact, error) {
+				panic("unexpected invocation of MockStore.GetByID")
+			},
+		},
+		ListFunc: &StoreListFunc{
+			defaultHook: func(context.Context, string, int) ([]*Artifact, error) {
+				panic("unexpected invocation of MockStore.List")
+			},
+		},
+		ListExpiredFunc: &StoreListExpiredFunc{
+			defaultHook: func(context.Context, time.Duration, int) ([]*Artifact, error) {
+				panic("unexpected invocation of MockStore.ListExpired")
+			},
+		},
+		UpsertFunc: &StoreUpsertFunc{
+			defaultHook: func(context.Context, Artifact) (*Artifact, error) {
+				panic("unexpected invocation of MockStore.Upsert")
+			},
+		},
+	}
+}
+
+// NewMockStoreFrom creates a new mock of the MockStore interface. All
+// methods delegate to the given implementation, unless overwritten.
+func NewMockStoreFrom(i Store) *MockStore {
+	return &MockStore{
+		DeleteFunc: &StoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		GetByIDFunc: &StoreGetByIDFunc{
+			defaultHook: i.GetByID,
+		},
+		ListFunc: &StoreListFunc{
+			defaultHook: i.List,
+		},
+		ListExpiredFunc: &StoreListExpiredFunc{
+			defaultHook: i.ListExpired,
+		},
+		UpsertFunc: &StoreUpsertFunc{
+			defaultHook: i.Upsert,
+		},
+	}
+}
+
+// StoreDeleteFunc describes the behavior when the Delete method of the
+// parent MockStore instance is invoked.
+type StoreDeleteFunc struct {
+	defaultHook func(context.Context, []int) error
+	hooks       []func(context.Context, []int) error
+	history     []StoreDeleteFuncCall
+	mutex       sync.Mutex
+}
+
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockStore) Delete(v0 context.Context, v1 []int) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1)
+	m.DeleteFunc.appendCall(StoreDeleteFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreDeleteFunc) SetDefaultHook(hook func(context.Context, []int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Delete method of the parent MockStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *StoreDeleteFunc) PushHook(hook func(context.Context, []int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, []int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, []int) error {
+		return r0
+	})
+}
+
+func (f *StoreDeleteFunc) nextHook() func(context.Context, []int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreDeleteFunc) appendCall(r0 StoreDeleteFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreDeleteFuncCall objects describing the
+// invocations of this function.
+func (f *StoreDeleteFunc) History() []StoreDeleteFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreDeleteFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreDeleteFuncCall is an object that describes an invocation of method
+// Delete on an instance of MockStore.
+type StoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreDeleteFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// StoreGetByIDFunc describes the behavior when the GetByID method of the
+// parent MockStore instance is invoked.
+type StoreGetByIDFunc struct {
+	defaultHook func(context.Context, int) (*Artifact, error)
+	hooks       []func(context.Context, int) (*Artifact, error)
+	history     []StoreGetByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByID delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockStore) GetByID(v0 context.Context, v1 int) (*Artifact, error) {
+	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
+	m.GetByIDFunc.appendCall(StoreGetByIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByID method of
+// the parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int) (*Artifact, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByID method of the parent MockStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *StoreGetByIDFunc) PushHook(hook func(context.Context, int) (*Artifact, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetByIDFunc) SetDefaultReturn(r0 *Artifact, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (*Artifact, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetByIDFunc) PushReturn(r0 *Artifact, r1 error) {
+	f.PushHook(func(context.Context, int) (*Artifact, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetByIDFunc) nextHook() func(context.Context, int) (*Artifact, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetByIDFunc) appendCall(r0 StoreGetByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetByIDFuncCall objects describing the
+// invocations of this function.
+func (f *StoreGetByIDFunc) History() []StoreGetByIDFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetByIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetByIDFuncCall is an object that describes an invocation of method
+// GetByID on an instance of MockStore.
+type StoreGetByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *Artifact
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreListFunc describes the behavior when the List method of the parent
+// MockStore instance is invoked.
+type StoreListFunc struct {
+	defaultHook func(context.Context, string, int) ([]*Artifact, error)
+	hooks       []func(context.Context, string, int) ([]*Artifact, error)
+	history     []StoreListFuncCall
+	mutex       sync.Mutex
+}
+
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockStore) List(v0 context.Context, v1 string, v2 int) ([]*Artifact, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1, v2)
+	m.ListFunc.appendCall(StoreListFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreListFunc) SetDefaultHook(hook func(context.Context, string, int) ([]*Artifact, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// List method of the parent MockStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *StoreListFunc) PushHook(hook func(context.Context, string, int) ([]*Artifact, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreListFunc) SetDefaultReturn(r0 []*Artifact, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, int) ([]*Artifact, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreListFunc) PushReturn(r0 []*Artifact, r1 error) {
+	f.PushHook(func(context.Context, string, int) ([]*Artifact, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreListFunc) nextHook() func(context.Context, string, int) ([]*Artifact, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreListFunc) appendCall(r0 StoreListFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreListFuncCall objects describing the
+// invocations of this function.
+func (f *StoreListFunc) History() []StoreListFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreListFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreListFuncCall is an object that describes an invocation of method
+// List on an instance of MockStore.
+type StoreListFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*Artifact
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreListExpiredFunc describes the behavior when the ListExpired method
+// of the parent MockStore instance is invoked.
+type StoreListExpiredFunc struct {
+	defaultHook func(context.Context, time.Duration, int) ([]*Artifact, error)
+	hooks       []func(context.Context, time.Duration, int) ([]*Artifact, error)
+	history     []StoreListExpiredFuncCall
+	mutex       sync.Mutex
+}
+
+// ListExpired delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockStore) ListExpired(v0 context.Context, v1 time.Duration, v2 int) ([]*Artifact, error) {
+	r0, r1 := m.ListExpiredFunc.nextHook()(v0, v1, v2)
+	m.ListExpiredFunc.appendCall(StoreListExpiredFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListExpired method
+// of the parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreListExpiredFunc) SetDefaultHook(hook func(context.Context, time.Duration, int) ([]*Artifact, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListExpired method of the parent MockStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *StoreListExpiredFunc) PushHook(hook func(context.Context, time.Duration, int) ([]*Artifact, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreListExpiredFunc) SetDefaultReturn(r0 []*Artifact, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, int) ([]*Artifact, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreListExpiredFunc) PushReturn(r0 []*Artifact, r1 error) {
+	f.PushHook(func(context.Context, time.Duration, int) ([]*Artifact, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreListExpiredFunc) nextHook() func(context.Context, time.Duration, int) ([]*Artifact, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreListExpiredFunc) appendCall(r0 StoreListExpiredFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreListExpiredFuncCall objects describing
+// the invocations of this function.
+func (f *StoreListExpiredFunc) History() []StoreListExpiredFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreListExpiredFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreListExpiredFuncCall is an object that describes an invocation of
+// method ListExpired on an instance of MockStore.
+type StoreListExpiredFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*Artifact
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreListExpiredFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreListExpiredFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreUpsertFunc describes the behavior when the Upsert method of the
+// parent MockStore instance is invoked.
+type StoreUpsertFunc struct {
+	defaultHook func(context.Context, Artifact) (*Artifact, error)
+	hooks       []func(context.Context, Artifact) (*Artifact, error)
+	history     []StoreUpsertFuncCall
+	mutex       sync.Mutex
+}
+
+// Upsert delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockStore) Upsert(v0 context.Context, v1 Artifact) (*Artifact, error) {
+	r0, r1 := m.UpsertFunc.nextHook()(v0, v1)
+	m.UpsertFunc.appendCall(StoreUpsertFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Upsert method of the
+// parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreUpsertFunc) SetDefaultHook(hook func(context.Context, Artifact) (*Artifact, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Upsert method of the parent MockStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *StoreUpsertFunc) PushHook(hook func(context.Context, Artifact) (*Artifact, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreUpsertFunc) SetDefaultReturn(r0 *Artifact, r1 error) {
+	f.SetDefaultHook(func(context.Context, Artifact) (*Artifact, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreUpsertFunc) PushReturn(r0 *Artifact, r1 error) {
+	f.PushHook(func(context.Context, Artifact) (*Artifact, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreUpsertFunc) nextHook() func(context.Context, Artifact) (*Artifact, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreUpsertFunc) appendCall(r0 StoreUpsertFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreUpsertFuncCall objects describing the
+// invocations of this function.
+func (f *StoreUpsertFunc) History() []StoreUpsertFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreUpsertFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreUpsertFuncCall is an object that describes an invocation of method
+// Upsert on an instance of MockStore.
+type StoreUpsertFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 Artifact
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *Artifact
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreUpsertFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreUpsertFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -0,0 +1,193 @@
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrNotFound is returned when an artifact does not exist.
+var ErrNotFound = errors.New("executor job artifact not found")
+
+// Artifact is a file that was uploaded by an executor job. The contents are
+// kept in the upload store under ObjectKey. Maps to the
+// `executor_job_artifacts` database table.
+type Artifact struct {
+	ID    int
+	Queue string
+	JobID int
+	// Name is the path of the file, relative to the workspace of the job.
+	Name      string
+	ObjectKey string
+	Size      int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ObjectKey returns the key the contents of the artifact with the given name
+// are stored under in the upload store.
+func ObjectKey(queue string, jobID int, name string) string {
+	return fmt.Sprintf("%s/%d/%s", queue, jobID, name)
+}
+
+// Store reads and writes the metadata of executor job artifacts.
+type Store interface {
+	// Upsert records an uploaded artifact. An existing artifact of the job with
+	// the same name is replaced.
+	Upsert(ctx context.Context, artifact Artifact) (*Artifact, error)
+	// GetByID returns the artifact with the given ID.
+	GetByID(ctx context.Context, id int) (*Artifact, error)
+	// List returns the artifacts of the job with the given ID in the given queue,
+	// ordered by name.
+	List(ctx context.Context, queue string, jobID int) ([]*Artifact, error)
+	// ListExpired returns up to limit artifacts that were last uploaded longer
+	// than maxAge ago, oldest first.
+	ListExpired(ctx context.Context, maxAge time.Duration, limit int) ([]*Artifact, error)
+	// Delete deletes the artifacts with the given IDs. It does not delete their
+	// contents from the upload store.
+	Delete(ctx context.Context, ids []int) error
+}
+
+type store struct {
+	*basestore.Store
+}
+
+// NewStore returns a new Store backed by the given database handle.
+func NewStore(handle basestore.TransactableHandle) Store {
+	return &store{Store: basestore.NewWithHandle(handle)}
+}
+
+var artifactColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("queue"),
+	sqlf.Sprintf("job_id"),
+	sqlf.Sprintf("name"),
+	sqlf.Sprintf("object_key"),
+	sqlf.Sprintf("size"),
+	sqlf.Sprintf("created_at"),
+	sqlf.Sprintf("updated_at"),
+}
+
+func (s *store) Upsert(ctx context.Context, artifact Artifact) (*Artifact, error) {
+	a, ok, err := scanFirstArtifact(s.Query(ctx, sqlf.Sprintf(
+		upsertArtifactQueryFmtstr,
+		artifact.Queue,
+		artifact.JobID,
+		artifact.Name,
+		artifact.ObjectKey,
+		artifact.Size,
+		sqlf.Join(artifactColumns, ", "),
+	)))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("upserting executor job artifact returned no row")
+	}
+	return a, nil
+}
+
+const upsertArtifactQueryFmtstr = `
+INSERT INTO executor_job_artifacts (queue, job_id, name, object_key, size)
+VALUES (%s, %s, %s, %s, %s)
+ON CONFLICT (queue, job_id, name) DO UPDATE SET
+	object_key = EXCLUDED.object_key,
+	size = EXCLUDED.size,
+	updated_at = NOW()
+RETURNING %s
+`
+
+func (s *store) GetByID(ctx context.Context, id int) (*Artifact, error) {
+	a, ok, err := scanFirstArtifact(s.Query(ctx, sqlf.Sprintf(
+		getArtifactQueryFmtstr,
+		sqlf.Join(artifactColumns, ", "),
+		id,
+	)))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return a, nil
+}
+
+const getArtifactQueryFmtstr = `
+SELECT %s
+FROM executor_job_artifacts
+WHERE id = %s
+`
+
+func (s *store) List(ctx context.Context, queue string, jobID int) ([]*Artifact, error) {
+	return scanArtifacts(s.Query(ctx, sqlf.Sprintf(
+		listArtifactsQueryFmtstr,
+		sqlf.Join(artifactColumns, ", "),
+		queue,
+		jobID,
+	)))
+}
+
+const listArtifactsQueryFmtstr = `
+SELECT %s
+FROM executor_job_artifacts
+WHERE queue = %s AND job_id = %s
+ORDER BY name
+`
+
+func (s *store) ListExpired(ctx context.Context, maxAge time.Duration, limit int) ([]*Artifact, error) {
+	return scanArtifacts(s.Query(ctx, sqlf.Sprintf(
+		listExpiredArtifactsQueryFmtstr,
+		sqlf.Join(artifactColumns, ", "),
+		maxAge/time.Second,
+		limit,
+	)))
+}
+
+const listExpiredArtifactsQueryFmtstr = `
+SELECT %s
+FROM executor_job_artifacts
+WHERE NOW() - updated_at >= %s * interval '1 second'
+ORDER BY updated_at, id
+LIMIT %s
+`
+
+func (s *store) Delete(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.Exec(ctx, sqlf.Sprintf(deleteArtifactsQueryFmtstr, pq.Array(ids)))
+}
+
+const deleteArtifactsQueryFmtstr = `
+DELETE FROM executor_job_artifacts
+WHERE id = ANY(%s)
+`
+
+func scanArtifact(sc dbutil.Scanner) (*Artifact, error) {
+	var a Artifact
+	if err := sc.Scan(
+		&a.ID,
+		&a.Queue,
+		&a.JobID,
+		&a.Name,
+		&a.ObjectKey,
+		&a.Size,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+var (
+	scanArtifacts     = basestore.NewSliceScanner(scanArtifact)
+	scanFirstArtifact = basestore.NewFirstScanner(scanArtifact)
+)
@@ -0,0 +1,69 @@
+package artifacts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestStore(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+	store := NewStore(db.Handle())
+
+	report, err := store.Upsert(ctx, Artifact{Queue: "customjobs", JobID: 1, Name: "report.xml", ObjectKey: ObjectKey("customjobs", 1, "report.xml"), Size: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "customjobs/1/report.xml", report.ObjectKey)
+	assert.Equal(t, int64(10), report.Size)
+
+	_, err = store.Upsert(ctx, Artifact{Queue: "customjobs", JobID: 1, Name: "build.log", ObjectKey: ObjectKey("customjobs", 1, "build.log"), Size: 5})
+	require.NoError(t, err)
+	// Same job ID, but in another queue.
+	_, err = store.Upsert(ctx, Artifact{Queue: "batches", JobID: 1, Name: "report.xml", ObjectKey: ObjectKey("batches", 1, "report.xml"), Size: 1})
+	require.NoError(t, err)
+
+	// Uploading an artifact again replaces it.
+	updated, err := store.Upsert(ctx, Artifact{Queue: "customjobs", JobID: 1, Name: "report.xml", ObjectKey: ObjectKey("customjobs", 1, "report.xml"), Size: 20})
+	require.NoError(t, err)
+	assert.Equal(t, report.ID, updated.ID)
+	assert.Equal(t, int64(20), updated.Size)
+
+	got, err := store.GetByID(ctx, report.ID)
+	require.NoError(t, err)
+	assert.Equal(t, updated, got)
+
+	_, err = store.GetByID(ctx, 1000)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	artifacts, err := store.List(ctx, "customjobs", 1)
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+	assert.Equal(t, "build.log", artifacts[0].Name)
+	assert.Equal(t, "report.xml", artifacts[1].Name)
+
+	artifacts, err = store.List(ctx, "customjobs", 2)
+	require.NoError(t, err)
+	assert.Empty(t, artifacts)
+
+	expired, err := store.ListExpired(ctx, time.Hour, 10)
+	require.NoError(t, err)
+	assert.Empty(t, expired)
+
+	expired, err = store.ListExpired(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, expired, 2)
+
+	require.NoError(t, store.Delete(ctx, []int{expired[0].ID, expired[1].ID}))
+	remaining, err := store.ListExpired(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.NotContains(t, []int{expired[0].ID, expired[1].ID}, remaining[0].ID)
+}
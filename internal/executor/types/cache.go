@@ -21,4 +21,8 @@ var DequeuePropertiesPerQueue = &schema.DequeueCacheConfig{
 		Limit:  250,
 		Weight: 1,
 	},
+	Customjobs: &schema.Customjobs{
+		Limit:  50,
+		Weight: 1,
+	},
 }
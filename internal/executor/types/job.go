@@ -71,6 +71,12 @@ type Job struct {
 	// takes precedence over a potentially configured EXECUTOR_DOCKER_AUTH_CONFIG environment
 	// variable.
 	DockerAuthConfig DockerAuthConfig `json:"dockerAuthConfig,omitempty"`
+
+	// CacheVolumes optionally describes named volumes that are persisted on the
+	// executor host between jobs and mounted into every docker step, e.g. to
+	// share package manager caches. Only set for custom jobs. Executors without
+	// a configured cache volume directory ignore this field. Requires Version 2.
+	CacheVolumes []CacheVolume `json:"cacheVolumes,omitempty"`
 }
 
 func (j Job) MarshalJSON() ([]byte, error) {
@@ -90,6 +96,7 @@ func (j Job) MarshalJSON() ([]byte, error) {
 			CliSteps:            j.CliSteps,
 			RedactedValues:      j.RedactedValues,
 			DockerAuthConfig:    j.DockerAuthConfig,
+			CacheVolumes:        j.CacheVolumes,
 		}
 		v2.VirtualMachineFiles = make(map[string]v2VirtualMachineFile, len(j.VirtualMachineFiles))
 		for k, v := range j.VirtualMachineFiles {
@@ -151,6 +158,7 @@ func (j *Job) UnmarshalJSON(data []byte) error {
 		j.CliSteps = v2.CliSteps
 		j.RedactedValues = v2.RedactedValues
 		j.DockerAuthConfig = v2.DockerAuthConfig
+		j.CacheVolumes = v2.CacheVolumes
 		return nil
 	}
 	var v1 v1Job
@@ -201,6 +209,7 @@ type v2Job struct {
 	CliSteps            []CliStep                       `json:"cliSteps"`
 	RedactedValues      map[string]string               `json:"redactedValues"`
 	DockerAuthConfig    DockerAuthConfig                `json:"dockerAuthConfig,omitempty"`
+	CacheVolumes        []CacheVolume                   `json:"cacheVolumes,omitempty"`
 }
 
 type v1Job struct {
@@ -280,6 +289,39 @@ type DockerStep struct {
 
 	// Env specifies a set of NAME=value pairs to supply to the docker command.
 	Env []string `json:"env"`
+
+	// Timeout optionally specifies the maximum duration of a single attempt
+	// to run the step.
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// Retries specifies how often the step is retried if it fails. The first
+	// retry happens after RetryBackoff, which doubles with every attempt.
+	Retries      int           `json:"retries,omitempty"`
+	RetryBackoff time.Duration `json:"retryBackoff,omitempty"`
+
+	// Artifacts optionally lists paths of files, relative to Dir, that are
+	// uploaded as artifacts of the job once all steps ran. Paths may contain
+	// glob patterns. Artifacts are uploaded even if a step failed.
+	Artifacts []string `json:"artifacts,omitempty"`
+}
+
+// CacheVolume is a named volume that is kept on the executor host between jobs.
+// Jobs of the same repository that request a volume with the same name and the
+// same key file contents share the volume.
+type CacheVolume struct {
+	// Name identifies the cache, e.g. the language or package manager it holds
+	// dependencies for.
+	Name string `json:"name"`
+
+	// MountPath is the absolute path inside the step containers the volume is
+	// mounted read-write at.
+	MountPath string `json:"mountPath"`
+
+	// KeyFiles are paths of files, relative to the repository root, whose
+	// contents are hashed into the key of the volume, e.g. lockfiles. When one
+	// of them changes, a fresh volume is used. Files that don't exist are
+	// ignored.
+	KeyFiles []string `json:"keyFiles,omitempty"`
 }
 
 // CliStep is a step that runs a src-cli command.
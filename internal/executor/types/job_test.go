@@ -94,6 +94,77 @@ func TestJob_MarshalJSON(t *testing.T) {
 		"redactedValues": {
 			"password": "foo"
 		}
+	}`,
+		},
+		{
+			name: "Cache volumes",
+			input: Job{
+				Version:        2,
+				ID:             1,
+				RepositoryName: "my-repo",
+				Commit:         "xyz",
+				CacheVolumes: []CacheVolume{
+					{Name: "npm", MountPath: "/root/.npm", KeyFiles: []string{"package-lock.json"}},
+				},
+			},
+			expected: `{
+		"version": 2,
+		"id": 1,
+		"token": "",
+		"repositoryName": "my-repo",
+		"repositoryDirectory": "",
+		"commit": "xyz",
+		"fetchTags": false,
+		"shallowClone": false,
+		"sparseCheckout": null,
+		"files": {},
+		"dockerAuthConfig": {},
+		"dockerSteps": null,
+		"cliSteps": null,
+		"redactedValues": null,
+		"cacheVolumes": [{
+			"name": "npm",
+			"mountPath": "/root/.npm",
+			"keyFiles": ["package-lock.json"]
+		}]
+	}`,
+		},
+		{
+			name: "Artifacts",
+			input: Job{
+				Version:        2,
+				ID:             1,
+				RepositoryName: "my-repo",
+				Commit:         "xyz",
+				DockerSteps: []DockerStep{
+					{
+						Image:     "my-image",
+						Commands:  []string{"run"},
+						Artifacts: []string{"reports/*.xml"},
+					},
+				},
+			},
+			expected: `{
+		"version": 2,
+		"id": 1,
+		"token": "",
+		"repositoryName": "my-repo",
+		"repositoryDirectory": "",
+		"commit": "xyz",
+		"fetchTags": false,
+		"shallowClone": false,
+		"sparseCheckout": null,
+		"files": {},
+		"dockerAuthConfig": {},
+		"dockerSteps": [{
+			"image": "my-image",
+			"commands": ["run"],
+			"dir": "",
+			"env": null,
+			"artifacts": ["reports/*.xml"]
+		}],
+		"cliSteps": null,
+		"redactedValues": null
 	}`,
 		},
 		{
@@ -264,6 +335,30 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Cache volumes",
+			input: `{
+	"version": 2,
+	"id": 1,
+	"repositoryName": "my-repo",
+	"commit": "xyz",
+	"cacheVolumes": [{
+		"name": "npm",
+		"mountPath": "/root/.npm",
+		"keyFiles": ["package-lock.json"]
+	}]
+}`,
+			expected: Job{
+				Version:             2,
+				ID:                  1,
+				RepositoryName:      "my-repo",
+				Commit:              "xyz",
+				VirtualMachineFiles: map[string]VirtualMachineFile{},
+				CacheVolumes: []CacheVolume{
+					{Name: "npm", MountPath: "/root/.npm", KeyFiles: []string{"package-lock.json"}},
+				},
+			},
+		},
 		{
 			name: "4.2",
 			input: `{
@@ -1,3 +1,3 @@
 package types
 
-var ValidQueueNames = []string{"batches", "codeintel"}
+var ValidQueueNames = []string{"batches", "codeintel", "customjobs"}
@@ -91,6 +91,14 @@ type CreatePullRequestInput struct {
 	ForkSource        *ForkRef                      `json:"forkSource"`
 	IsDraft           bool                          `json:"isDraft"`
 	CompletionOptions *PullRequestCompletionOptions `json:"completionOptions"`
+	Labels            []PullRequestLabel            `json:"labels,omitempty"`
+}
+
+// PullRequestLabel is a tag that is attached to a pull request.
+type PullRequestLabel struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Active bool   `json:"active,omitempty"`
 }
 
 type ForkRef struct {
@@ -122,26 +130,27 @@ type PullRequestCommonArgs struct {
 }
 
 type PullRequest struct {
-	Repository            Repository        `json:"repository"`
-	ID                    int               `json:"pullRequestId"`
-	CodeReviewID          int               `json:"codeReviewId"`
-	Status                PullRequestStatus `json:"status"`
-	CreationDate          time.Time         `json:"creationDate"`
-	Title                 string            `json:"title"`
-	Description           string            `json:"description"`
-	CreatedBy             CreatorInfo       `json:"createdBy"`
-	SourceRefName         string            `json:"sourceRefName"`
-	TargetRefName         string            `json:"targetRefName"`
-	MergeStatus           string            `json:"mergeStatus"`
-	MergeID               string            `json:"mergeId"`
-	LastMergeSourceCommit PullRequestCommit `json:"lastMergeSourceCommit"`
-	LastMergeTargetCommit PullRequestCommit `json:"lastMergeTargetCommit"`
-	SupportsIterations    bool              `json:"supportsIterations"`
-	ArtifactID            string            `json:"artifactId"`
-	Reviewers             []Reviewer        `json:"reviewers"`
-	ForkSource            *ForkRef          `json:"forkSource"`
-	URL                   string            `json:"url"`
-	IsDraft               bool              `json:"isDraft"`
+	Repository            Repository         `json:"repository"`
+	ID                    int                `json:"pullRequestId"`
+	CodeReviewID          int                `json:"codeReviewId"`
+	Status                PullRequestStatus  `json:"status"`
+	CreationDate          time.Time          `json:"creationDate"`
+	Title                 string             `json:"title"`
+	Description           string             `json:"description"`
+	CreatedBy             CreatorInfo        `json:"createdBy"`
+	SourceRefName         string             `json:"sourceRefName"`
+	TargetRefName         string             `json:"targetRefName"`
+	MergeStatus           string             `json:"mergeStatus"`
+	MergeID               string             `json:"mergeId"`
+	LastMergeSourceCommit PullRequestCommit  `json:"lastMergeSourceCommit"`
+	LastMergeTargetCommit PullRequestCommit  `json:"lastMergeTargetCommit"`
+	SupportsIterations    bool               `json:"supportsIterations"`
+	ArtifactID            string             `json:"artifactId"`
+	Reviewers             []Reviewer         `json:"reviewers"`
+	Labels                []PullRequestLabel `json:"labels,omitempty"`
+	ForkSource            *ForkRef           `json:"forkSource"`
+	URL                   string             `json:"url"`
+	IsDraft               bool               `json:"isDraft"`
 }
 
 type PullRequestCommit struct {
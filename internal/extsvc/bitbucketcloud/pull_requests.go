@@ -17,6 +17,9 @@ type PullRequestInput struct {
 	Description  string
 	SourceBranch string
 	Reviewers    []Account
+	// ReviewerAccountIDs are additional reviewers, referenced by their
+	// account ID rather than their UUID.
+	ReviewerAccountIDs []string
 
 	// The following fields are optional.
 	//
@@ -199,12 +202,20 @@ func (input *PullRequestInput) MarshalJSON() ([]byte, error) {
 		Repository *repository `json:"repository,omitempty"`
 	}
 
+	// Reviewers can be referenced either by their UUID or by their account
+	// ID.
+	type reviewer struct {
+		UUID      string `json:"uuid,omitempty"`
+		AccountID string `json:"account_id,omitempty"`
+	}
+
 	type request struct {
-		Title             string  `json:"title"`
-		Description       string  `json:"description,omitempty"`
-		Source            source  `json:"source"`
-		Destination       *source `json:"destination,omitempty"`
-		CloseSourceBranch bool    `json:"close_source_branch,omitempty"`
+		Title             string     `json:"title"`
+		Description       string     `json:"description,omitempty"`
+		Source            source     `json:"source"`
+		Destination       *source    `json:"destination,omitempty"`
+		CloseSourceBranch bool       `json:"close_source_branch,omitempty"`
+		Reviewers         []reviewer `json:"reviewers,omitempty"`
 	}
 
 	req := request{
@@ -225,6 +236,12 @@ func (input *PullRequestInput) MarshalJSON() ([]byte, error) {
 			Branch: branch{Name: *input.DestinationBranch},
 		}
 	}
+	for _, r := range input.Reviewers {
+		req.Reviewers = append(req.Reviewers, reviewer{UUID: r.UUID})
+	}
+	for _, id := range input.ReviewerAccountIDs {
+		req.Reviewers = append(req.Reviewers, reviewer{AccountID: id})
+	}
 
 	return json.Marshal(&req)
 }
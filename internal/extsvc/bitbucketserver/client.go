@@ -19,6 +19,7 @@ import (
 	"github.com/gomodule/oauth1/oauth"
 	"github.com/inconshreveable/log15"
 	"github.com/segmentio/fasthash/fnv1"
+	"golang.org/x/exp/slices"
 
 	"github.com/sourcegraph/log"
 
@@ -551,8 +552,17 @@ func (c *Client) CreatePullRequest(ctx context.Context, pr *PullRequest) error {
 		// return errors.Wrap(err, "fetching default reviewers")
 	}
 
-	reviewers := make([]reviewer, 0, len(defaultReviewers))
-	for _, r := range defaultReviewers {
+	// The reviewers set on the pull request are requested in addition to the
+	// default reviewers.
+	names := defaultReviewers
+	for _, r := range pr.Reviewers {
+		if r.User != nil && !slices.Contains(names, r.User.Name) {
+			names = append(names, r.User.Name)
+		}
+	}
+
+	reviewers := make([]reviewer, 0, len(names))
+	for _, r := range names {
 		reviewers = append(reviewers, reviewer{User: struct {
 			Name string `json:"name"`
 		}{Name: r}})
@@ -304,3 +304,41 @@ func (c *client) SetCommitMessage(ctx context.Context, changeID string, input Se
 	}
 	return nil
 }
+
+// AddReviewer adds a user or group as a reviewer to a Gerrit change.
+func (c *client) AddReviewer(ctx context.Context, changeID string, input AddReviewerPayload) error {
+	return c.postChange(ctx, changeID, "reviewers", input)
+}
+
+// SetHashtags adds and removes hashtags on a Gerrit change.
+func (c *client) SetHashtags(ctx context.Context, changeID string, input SetHashtagsPayload) error {
+	return c.postChange(ctx, changeID, "hashtags", input)
+}
+
+func (c *client) postChange(ctx context.Context, changeID, endpoint string, input any) error {
+	pathStr, err := url.JoinPath("a/changes", url.PathEscape(changeID), endpoint)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	reqURL := url.URL{Path: pathStr}
+	req, err := http.NewRequest("POST", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -52,6 +52,8 @@ type Client interface {
 	SetReadyForReview(ctx context.Context, changeID string) error
 	MoveChange(ctx context.Context, changeID string, input MoveChangePayload) (*Change, error)
 	SetCommitMessage(ctx context.Context, changeID string, input SetCommitMessagePayload) error
+	AddReviewer(ctx context.Context, changeID string, input AddReviewerPayload) error
+	SetHashtags(ctx context.Context, changeID string, input SetHashtagsPayload) error
 }
 
 // NewClient returns an authenticated Gerrit API client with
@@ -680,6 +680,8 @@ type UpdatePullRequestInput struct {
 	Title string `json:"title"`
 	// The body of the pull request (optional).
 	Body string `json:"body"`
+	// The Node ID of the milestone to set on the pull request (optional).
+	MilestoneID string `json:"milestoneId,omitempty"`
 }
 
 // UpdatePullRequest creates a PullRequest on Github.
@@ -0,0 +1,225 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PullRequestAttributes are the names of the optional attributes of a pull
+// request. Reviewers can either be user logins or teams in the form
+// "org/team-slug".
+type PullRequestAttributes struct {
+	Reviewers []string
+	Assignees []string
+	Labels    []string
+	Milestone string
+}
+
+// PullRequestAttributeIDs are the node IDs of the PullRequestAttributes, as
+// required by the GraphQL mutations that set them.
+type PullRequestAttributeIDs struct {
+	ReviewerUserIDs []string
+	ReviewerTeamIDs []string
+	AssigneeIDs     []string
+	LabelIDs        []string
+	MilestoneID     string
+}
+
+// ResolvePullRequestAttributes looks up the node IDs of the given attributes
+// in a single query. An error is returned if any of them cannot be found.
+func (c *V4Client) ResolvePullRequestAttributes(ctx context.Context, owner, name string, attrs PullRequestAttributes) (*PullRequestAttributeIDs, error) {
+	ids := &PullRequestAttributeIDs{}
+	if len(attrs.Reviewers) == 0 && len(attrs.Assignees) == 0 && len(attrs.Labels) == 0 && attrs.Milestone == "" {
+		return ids, nil
+	}
+
+	var q strings.Builder
+	q.WriteString("query {\n")
+	fmt.Fprintf(&q, "repository(owner: %q, name: %q) {\n", owner, name)
+	for i, label := range attrs.Labels {
+		fmt.Fprintf(&q, "label%d: label(name: %q) { id }\n", i, label)
+	}
+	if attrs.Milestone != "" {
+		fmt.Fprintf(&q, "milestones(first: 100, states: OPEN, query: %q) { nodes { id title } }\n", attrs.Milestone)
+	}
+	q.WriteString("}\n")
+	for i, reviewer := range attrs.Reviewers {
+		if org, team, ok := strings.Cut(reviewer, "/"); ok {
+			fmt.Fprintf(&q, "reviewer%d: organization(login: %q) { team(slug: %q) { id } }\n", i, org, team)
+		} else {
+			fmt.Fprintf(&q, "reviewer%d: user(login: %q) { id }\n", i, reviewer)
+		}
+	}
+	for i, assignee := range attrs.Assignees {
+		fmt.Fprintf(&q, "assignee%d: user(login: %q) { id }\n", i, assignee)
+	}
+	q.WriteString("}")
+
+	var result map[string]json.RawMessage
+	if err := c.requestGraphQL(ctx, q.String(), nil, &result); err != nil {
+		return nil, err
+	}
+
+	type node struct {
+		ID   string `json:"id"`
+		Team *node  `json:"team"`
+	}
+	decode := func(raw json.RawMessage) (*node, error) {
+		var n *node
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return nil, err
+		}
+		return n, nil
+	}
+
+	var repo map[string]json.RawMessage
+	if err := json.Unmarshal(result["repository"], &repo); err != nil {
+		return nil, errors.Wrap(err, "decoding repository")
+	}
+	if repo == nil {
+		return nil, errors.Errorf("repository %s/%s not found", owner, name)
+	}
+
+	for i, label := range attrs.Labels {
+		n, err := decode(repo[fmt.Sprintf("label%d", i)])
+		if err != nil {
+			return nil, err
+		}
+		if n == nil {
+			return nil, errors.Errorf("label %q not found in %s/%s", label, owner, name)
+		}
+		ids.LabelIDs = append(ids.LabelIDs, n.ID)
+	}
+
+	if attrs.Milestone != "" {
+		var milestones struct {
+			Nodes []struct {
+				ID    string `json:"id"`
+				Title string `json:"title"`
+			} `json:"nodes"`
+		}
+		if err := json.Unmarshal(repo["milestones"], &milestones); err != nil {
+			return nil, errors.Wrap(err, "decoding milestones")
+		}
+		for _, m := range milestones.Nodes {
+			if m.Title == attrs.Milestone {
+				ids.MilestoneID = m.ID
+				break
+			}
+		}
+		if ids.MilestoneID == "" {
+			return nil, errors.Errorf("open milestone %q not found in %s/%s", attrs.Milestone, owner, name)
+		}
+	}
+
+	for i, reviewer := range attrs.Reviewers {
+		n, err := decode(result[fmt.Sprintf("reviewer%d", i)])
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(reviewer, "/") {
+			if n == nil || n.Team == nil {
+				return nil, errors.Errorf("team %q not found", reviewer)
+			}
+			ids.ReviewerTeamIDs = append(ids.ReviewerTeamIDs, n.Team.ID)
+			continue
+		}
+		if n == nil {
+			return nil, errors.Errorf("user %q not found", reviewer)
+		}
+		ids.ReviewerUserIDs = append(ids.ReviewerUserIDs, n.ID)
+	}
+
+	for i, assignee := range attrs.Assignees {
+		n, err := decode(result[fmt.Sprintf("assignee%d", i)])
+		if err != nil {
+			return nil, err
+		}
+		if n == nil {
+			return nil, errors.Errorf("user %q not found", assignee)
+		}
+		ids.AssigneeIDs = append(ids.AssigneeIDs, n.ID)
+	}
+
+	return ids, nil
+}
+
+const requestReviewsMutation = `
+mutation RequestReviews($input: RequestReviewsInput!) {
+  requestReviews(input: $input) {
+    pullRequest { id }
+  }
+}
+`
+
+// RequestReviews requests reviews on the PullRequest from the given users and
+// teams, identified by their node IDs. Existing review requests are kept.
+func (c *V4Client) RequestReviews(ctx context.Context, pr *PullRequest, userIDs, teamIDs []string) error {
+	var result struct {
+		RequestReviews struct {
+			PullRequest struct {
+				ID string
+			} `json:"pullRequest"`
+		} `json:"requestReviews"`
+	}
+
+	input := map[string]any{"input": struct {
+		PullRequestID string   `json:"pullRequestId"`
+		UserIDs       []string `json:"userIds,omitempty"`
+		TeamIDs       []string `json:"teamIds,omitempty"`
+		Union         bool     `json:"union"`
+	}{PullRequestID: pr.ID, UserIDs: userIDs, TeamIDs: teamIDs, Union: true}}
+	return c.requestGraphQL(ctx, requestReviewsMutation, input, &result)
+}
+
+const addLabelsMutation = `
+mutation AddLabels($input: AddLabelsToLabelableInput!) {
+  addLabelsToLabelable(input: $input) {
+    clientMutationId
+  }
+}
+`
+
+// AddLabels adds the labels, identified by their node IDs, to the pull request
+// with the given node ID. Existing labels are kept.
+func (c *V4Client) AddLabels(ctx context.Context, pullRequestID string, labelIDs []string) error {
+	var result struct {
+		AddLabelsToLabelable struct {
+			ClientMutationID string `json:"clientMutationId"`
+		} `json:"addLabelsToLabelable"`
+	}
+
+	input := map[string]any{"input": struct {
+		LabelableID string   `json:"labelableId"`
+		LabelIDs    []string `json:"labelIds"`
+	}{LabelableID: pullRequestID, LabelIDs: labelIDs}}
+	return c.requestGraphQL(ctx, addLabelsMutation, input, &result)
+}
+
+const addAssigneesMutation = `
+mutation AddAssignees($input: AddAssigneesToAssignableInput!) {
+  addAssigneesToAssignable(input: $input) {
+    clientMutationId
+  }
+}
+`
+
+// AddAssignees assigns the users, identified by their node IDs, to the pull
+// request with the given node ID. Existing assignees are kept.
+func (c *V4Client) AddAssignees(ctx context.Context, pullRequestID string, assigneeIDs []string) error {
+	var result struct {
+		AddAssigneesToAssignable struct {
+			ClientMutationID string `json:"clientMutationId"`
+		} `json:"addAssigneesToAssignable"`
+	}
+
+	input := map[string]any{"input": struct {
+		AssignableID string   `json:"assignableId"`
+		AssigneeIDs  []string `json:"assigneeIds"`
+	}{AssignableID: pullRequestID, AssigneeIDs: assigneeIDs}}
+	return c.requestGraphQL(ctx, addAssigneesMutation, input, &result)
+}
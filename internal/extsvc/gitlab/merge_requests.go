@@ -52,6 +52,9 @@ type MergeRequest struct {
 	// `Email` and `Identities`. If we need more, we need to issue an additional API
 	// request. Otherwise, we should use a different type here.
 	Author User `json:"author"`
+	// Assignees and Reviewers are also partial User objects.
+	Assignees []User `json:"assignees,omitempty"`
+	Reviewers []User `json:"reviewers,omitempty"`
 
 	DiffRefs DiffRefs `json:"diff_refs"`
 
@@ -114,6 +117,11 @@ type CreateMergeRequestOpts struct {
 	Title              string `json:"title"`
 	Description        string `json:"description,omitempty"`
 	RemoveSourceBranch bool   `json:"remove_source_branch,omitempty"`
+	// Labels is a comma-separated list of label names.
+	Labels      string  `json:"labels,omitempty"`
+	AssigneeIDs []int32 `json:"assignee_ids,omitempty"`
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
+	MilestoneID int     `json:"milestone_id,omitempty"`
 	// TODO: other fields at
 	// https://docs.gitlab.com/ee/api/merge_requests.html#create-mr as needed.
 }
@@ -223,6 +231,14 @@ type UpdateMergeRequestOpts struct {
 	Description        string                       `json:"description,omitempty"`
 	StateEvent         UpdateMergeRequestStateEvent `json:"state_event,omitempty"`
 	RemoveSourceBranch bool                         `json:"remove_source_branch,omitempty"`
+	// AddLabels is a comma-separated list of label names to add to the
+	// labels of the merge request.
+	AddLabels string `json:"add_labels,omitempty"`
+	// AssigneeIDs and ReviewerIDs replace the assignees and reviewers of the
+	// merge request.
+	AssigneeIDs []int32 `json:"assignee_ids,omitempty"`
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
+	MilestoneID int     `json:"milestone_id,omitempty"`
 }
 
 type UpdateMergeRequestStateEvent string
@@ -0,0 +1,45 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type Milestone struct {
+	ID    int    `json:"id"`
+	IID   int    `json:"iid"`
+	Title string `json:"title"`
+	State string `json:"state"`
+}
+
+// GetProjectMilestoneByTitle returns the active milestone with the given title
+// in the project or one of its parent groups. An error is returned if no such
+// milestone exists.
+func (c *Client) GetProjectMilestoneByTitle(ctx context.Context, project *Project, title string) (*Milestone, error) {
+	if MockGetProjectMilestoneByTitle != nil {
+		return MockGetProjectMilestoneByTitle(c, ctx, project, title)
+	}
+
+	q := url.Values{}
+	q.Set("title", title)
+	q.Set("state", "active")
+	q.Set("include_parent_milestones", "true")
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("projects/%d/milestones?%s", project.ID, q.Encode()), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to get milestones")
+	}
+
+	var milestones []*Milestone
+	if _, _, err := c.do(ctx, req, &milestones); err != nil {
+		return nil, errors.Wrap(err, "sending request to get milestones")
+	}
+	if len(milestones) == 0 {
+		return nil, errors.Errorf("milestone %q not found", title)
+	}
+	return milestones[0], nil
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/peterhellberg/link"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type User struct {
@@ -84,3 +87,25 @@ func (c *Client) GetUser(ctx context.Context, id string) (*AuthUser, error) {
 	}
 	return &usr, nil
 }
+
+// GetUserByUsername returns the user with the given username. An error is
+// returned if no such user exists.
+func (c *Client) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	if MockGetUserByUsername != nil {
+		return MockGetUserByUsername(c, ctx, username)
+	}
+
+	req, err := http.NewRequest("GET", "users?username="+url.QueryEscape(username), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*User
+	if _, _, err := c.do(ctx, req, &users); err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, errors.Errorf("user %q not found", username)
+	}
+	return users[0], nil
+}
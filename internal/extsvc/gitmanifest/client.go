@@ -0,0 +1,166 @@
+// Package gitmanifest implements a client for Git hosting services that
+// publish the repositories they host in a JSON manifest.
+package gitmanifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// maxManifestSize is the maximum size of a manifest we're willing to read.
+const maxManifestSize = 64 * 1024 * 1024
+
+// Client fetches the manifest of a Git hosting service.
+type Client struct {
+	// URL is the URL of the manifest.
+	URL *url.URL
+
+	token      string
+	httpClient httpcli.Doer
+}
+
+// NewClient returns a client for the manifest configured in the given
+// connection. If a nil httpClient is provided, httpcli.ExternalDoer will be
+// used.
+func NewClient(config *schema.GitManifestConnection, httpClient httpcli.Doer) (*Client, error) {
+	u, err := url.Parse(config.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing manifest URL")
+	}
+
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	return &Client{
+		URL:        u,
+		token:      config.Token,
+		httpClient: httpClient,
+	}, nil
+}
+
+// Manifest is the document listing the repositories of a Git hosting service.
+type Manifest struct {
+	Repositories []*Repository `json:"repositories"`
+}
+
+// Repository is a repository listed in a manifest. It is also stored as the
+// metadata of the repos synced from a manifest.
+type Repository struct {
+	// Name is the name of the repository on the Git hosting service, such as
+	// "team/myrepo". It must be unique within the manifest.
+	Name string `json:"name"`
+	// CloneURL is the URL the repository is cloned from.
+	CloneURL    string   `json:"cloneURL"`
+	Description string   `json:"description,omitempty"`
+	Visibility  string   `json:"visibility,omitempty"`
+	Archived    bool     `json:"archived,omitempty"`
+	Topics      []string `json:"topics,omitempty"`
+}
+
+// Visibility values of a Repository. An empty visibility is treated as
+// private.
+const (
+	VisibilityPublic   = "public"
+	VisibilityInternal = "internal"
+	VisibilityPrivate  = "private"
+)
+
+// Private returns true if the repository is not public.
+func (r *Repository) Private() bool {
+	return r.Visibility != VisibilityPublic
+}
+
+// Fetch fetches and validates the manifest.
+func (c *Client) Fetch(ctx context.Context) (*Manifest, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return nil, errors.WithStack(&httpError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		})
+	}
+	if len(bs) > maxManifestSize {
+		return nil, errors.Newf("manifest exceeds the maximum size of %d bytes", maxManifestSize)
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(bs, &m); err != nil {
+		return nil, errors.Wrap(err, "decoding manifest")
+	}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (m *Manifest) validate() error {
+	seen := make(map[string]struct{}, len(m.Repositories))
+	for i, r := range m.Repositories {
+		if r == nil {
+			return errors.Newf("repositories[%d]: must not be null", i)
+		}
+		if r.Name == "" {
+			return errors.Newf("repositories[%d]: name is required", i)
+		}
+		if r.CloneURL == "" {
+			return errors.Newf("repositories[%d] (%s): cloneURL is required", i, r.Name)
+		}
+		switch r.Visibility {
+		case "", VisibilityPublic, VisibilityInternal, VisibilityPrivate:
+		default:
+			return errors.Newf("repositories[%d] (%s): invalid visibility %q", i, r.Name, r.Visibility)
+		}
+		if _, ok := seen[r.Name]; ok {
+			return errors.Newf("repositories[%d]: duplicate name %q", i, r.Name)
+		}
+		seen[r.Name] = struct{}{}
+	}
+	return nil
+}
+
+type httpError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("manifest HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
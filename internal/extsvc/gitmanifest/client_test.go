@@ -0,0 +1,83 @@
+package gitmanifest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestClient_Fetch(t *testing.T) {
+	var body string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer s.Close()
+
+	cli, err := NewClient(&schema.GitManifestConnection{Url: s.URL, Token: "token"}, http.DefaultClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		body = `{"repositories": [
+			{"name": "a", "cloneURL": "https://git.example.com/a.git", "visibility": "public", "topics": ["go"]},
+			{"name": "b", "cloneURL": "https://git.example.com/b.git", "archived": true}
+		]}`
+
+		m, err := cli.Fetch(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &Manifest{Repositories: []*Repository{
+			{Name: "a", CloneURL: "https://git.example.com/a.git", Visibility: VisibilityPublic, Topics: []string{"go"}},
+			{Name: "b", CloneURL: "https://git.example.com/b.git", Archived: true},
+		}}
+		if diff := cmp.Diff(want, m); diff != "" {
+			t.Fatalf("unexpected manifest (-want +have):\n%s", diff)
+		}
+		if m.Repositories[0].Private() || !m.Repositories[1].Private() {
+			t.Fatal("repositories without public visibility must be private")
+		}
+	})
+
+	for _, tc := range []struct {
+		name string
+		body string
+	}{
+		{name: "malformed", body: `{"repositories": [`},
+		{name: "missing name", body: `{"repositories": [{"cloneURL": "https://git.example.com/a.git"}]}`},
+		{name: "missing clone URL", body: `{"repositories": [{"name": "a"}]}`},
+		{name: "invalid visibility", body: `{"repositories": [{"name": "a", "cloneURL": "https://git.example.com/a.git", "visibility": "secret"}]}`},
+		{name: "duplicate name", body: `{"repositories": [{"name": "a", "cloneURL": "x"}, {"name": "a", "cloneURL": "y"}]}`},
+		{name: "null repository", body: `{"repositories": [null]}`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			body = tc.body
+			if _, err := cli.Fetch(context.Background()); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		cli, err := NewClient(&schema.GitManifestConnection{Url: s.URL}, http.DefaultClient)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = cli.Fetch(context.Background())
+		if !errcode.IsUnauthorized(err) {
+			t.Fatalf("expected unauthorized error, got %v", err)
+		}
+	})
+}
@@ -212,6 +212,10 @@ const (
 
 	// VariantLocalGit is the (api.ExternalRepoSpec).ServiceType for local git repositories
 	VariantLocalGit
+
+	// VariantGitManifest is the (api.ExternalRepoSpec).ServiceType value for repositories
+	// listed in a JSON manifest. The ServiceID value is the base URL of the manifest.
+	VariantGitManifest
 )
 
 type variantValues struct {
@@ -228,6 +232,7 @@ var variantValuesMap = map[Variant]variantValues{
 	VariantBitbucketCloud:  {AsKind: "BITBUCKETCLOUD", AsType: "bitbucketCloud", ConfigPrototype: func() any { return &schema.BitbucketCloudConnection{} }, WebhookURLPath: "bitbucket-cloud-webhooks", SupportsRepoExclusion: true},
 	VariantBitbucketServer: {AsKind: "BITBUCKETSERVER", AsType: "bitbucketServer", ConfigPrototype: func() any { return &schema.BitbucketServerConnection{} }, WebhookURLPath: "bitbucket-server-webhooks", SupportsRepoExclusion: true},
 	VariantGerrit:          {AsKind: "GERRIT", AsType: "gerrit", ConfigPrototype: func() any { return &schema.GerritConnection{} }},
+	VariantGitManifest:     {AsKind: "GITMANIFEST", AsType: "gitManifest", ConfigPrototype: func() any { return &schema.GitManifestConnection{} }, SupportsRepoExclusion: true},
 	VariantGitHub:          {AsKind: "GITHUB", AsType: "github", ConfigPrototype: func() any { return &schema.GitHubConnection{} }, WebhookURLPath: "github-webhooks", SupportsRepoExclusion: true},
 	VariantGitLab:          {AsKind: "GITLAB", AsType: "gitlab", ConfigPrototype: func() any { return &schema.GitLabConnection{} }, WebhookURLPath: "gitlab-webhooks", SupportsRepoExclusion: true},
 	VariantGitolite:        {AsKind: "GITOLITE", AsType: "gitolite", ConfigPrototype: func() any { return &schema.GitoliteConnection{} }, SupportsRepoExclusion: true},
@@ -562,6 +567,8 @@ func extractToken(parsed any, kind string) (string, error) {
 		return c.Token, nil
 	case *schema.PagureConnection:
 		return c.Token, nil
+	case *schema.GitManifestConnection:
+		return c.Token, nil
 	default:
 		return "", errors.Errorf("unable to extract token for service kind %q", kind)
 	}
@@ -858,6 +865,8 @@ func uniqueCodeHostIdentifier(kind string, cfg any) (string, error) {
 		return VariantRubyPackages.AsKind(), nil
 	case *schema.PagureConnection:
 		rawURL = c.Url
+	case *schema.GitManifestConnection:
+		rawURL = c.Url
 	case *schema.LocalGitExternalService:
 		return VariantLocalGit.AsKind(), nil
 	default:
@@ -0,0 +1,128 @@
+package fileutil
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrRotatingWriterClosed is returned when writing to a closed RotatingWriter.
+var ErrRotatingWriterClosed = errors.New("rotating file writer is closed")
+
+// RotatingWriter appends to the file at a path, rotating it once it exceeds a
+// maximum size. It is safe for concurrent use.
+type RotatingWriter struct {
+	path         string
+	maxSizeBytes int64
+	maxBackups   int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewRotatingWriter opens the file at path for appending. Once a write would
+// make the file exceed maxSizeBytes, it is rotated to path.1, path.1 to path.2
+// and so on, keeping at most maxBackups rotated files. With no backups, the
+// file is truncated instead.
+func NewRotatingWriter(path string, maxSizeBytes int64, maxBackups int) (*RotatingWriter, error) {
+	w := &RotatingWriter{
+		path:         path,
+		maxSizeBytes: maxSizeBytes,
+		maxBackups:   maxBackups,
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write appends p to the file. A single write is never split across files, so
+// writes larger than the maximum size end up in a file of their own.
+func (w *RotatingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return 0, ErrRotatingWriterClosed
+	}
+
+	if w.size > 0 && w.size+int64(len(p)) > w.maxSizeBytes {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	if err != nil {
+		return n, errors.Wrapf(err, "write %s", w.path)
+	}
+	return n, nil
+}
+
+// Stat returns the file info of the current file.
+func (w *RotatingWriter) Stat() (os.FileInfo, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil, ErrRotatingWriterClosed
+	}
+	return w.file.Stat()
+}
+
+// Close closes the file. Subsequent writes return ErrRotatingWriterClosed.
+func (w *RotatingWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+func (w *RotatingWriter) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Wrapf(err, "open %s", w.path)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "stat %s", w.path)
+	}
+	w.file = f
+	w.size = info.Size()
+	return nil
+}
+
+// rotate must be called with w.mu held.
+func (w *RotatingWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return errors.Wrapf(err, "close %s", w.path)
+	}
+	w.file = nil
+
+	if w.maxBackups > 0 {
+		// Shift the existing backups, the oldest one is overwritten.
+		for i := w.maxBackups - 1; i > 0; i-- {
+			from := fmt.Sprintf("%s.%d", w.path, i)
+			if err := os.Rename(from, fmt.Sprintf("%s.%d", w.path, i+1)); err != nil && !os.IsNotExist(err) {
+				return errors.Wrapf(err, "rotate %s", w.path)
+			}
+		}
+		if err := os.Rename(w.path, w.path+".1"); err != nil {
+			return errors.Wrapf(err, "rotate %s", w.path)
+		}
+	} else if err := os.Remove(w.path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "rotate %s", w.path)
+	}
+
+	return w.open()
+}
@@ -0,0 +1,78 @@
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+
+	w, err := NewRotatingWriter(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// "a" and "b" fit into one file, every following line causes a rotation.
+	for _, line := range []string{"aaaa\n", "bbbb\n", "cccc\n", "dddddddddddd\n", "eeee\n"} {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for path, want := range map[string]string{
+		path:        "eeee\n",
+		path + ".1": "dddddddddddd\n",
+		path + ".2": "cccc\n",
+	} {
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("unexpected contents of %s: want %q, got %q", path, want, got)
+		}
+	}
+	// Only 2 backups are kept.
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("expected %s.3 to not exist, got %v", path, err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("ffff\n")); err != ErrRotatingWriterClosed {
+		t.Errorf("expected ErrRotatingWriterClosed, got %v", err)
+	}
+	if _, err := w.Stat(); err != ErrRotatingWriterClosed {
+		t.Errorf("expected ErrRotatingWriterClosed, got %v", err)
+	}
+}
+
+func TestRotatingWriterNoBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	if err := os.WriteFile(path, []byte("existing\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewRotatingWriter(path, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	// The existing contents count towards the size.
+	if _, err := w.Write([]byte("new\n")); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "new\n" {
+		t.Errorf("unexpected contents: want %q, got %q", "new\n", got)
+	}
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Errorf("expected %s.1 to not exist, got %v", path, err)
+	}
+}
@@ -73,8 +73,25 @@ func externalAccountTokenRefresher(store database.UserExternalAccountsStore, ext
 	}
 }
 
+type dryRunKey struct{}
+
+// WithDryRun returns a context in which the OAuth tokens of external accounts
+// are not refreshed. A refresh may invalidate the stored refresh token, so it
+// can't be done without storing the new token.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// ErrDryRunRefresh is returned instead of refreshing a token in a context
+// created with WithDryRun.
+var ErrDryRunRefresh = errors.New("the OAuth token of the external account needs to be refreshed, which is not done in a dry run")
+
 func GetAccountRefreshAndStoreOAuthTokenFunc(store database.UserExternalAccountsStore, externalAccountID int32, oauthContext *oauthutil.OAuthContext) func(context.Context, httpcli.Doer, *auth.OAuthBearerToken) (string, string, time.Time, error) {
 	return func(ctx context.Context, cli httpcli.Doer, a *auth.OAuthBearerToken) (string, string, time.Time, error) {
+		if dryRun, _ := ctx.Value(dryRunKey{}).(bool); dryRun {
+			return "", "", time.Time{}, ErrDryRunRefresh
+		}
+
 		tokenRefresher := externalAccountTokenRefresher(store, externalAccountID, a)
 		token, err := tokenRefresher(ctx, cli, *oauthContext)
 		if err != nil {
@@ -78,3 +78,20 @@ func TestExternalAccountTokenRefresher(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedNewToken, newToken.Token)
 }
+
+func TestGetAccountRefreshAndStoreOAuthTokenFuncDryRun(t *testing.T) {
+	externalAccounts := dbmocks.NewMockUserExternalAccountsStore()
+	doer := &mockDoer{
+		do: func(r *http.Request) (*http.Response, error) {
+			t.Fatal("unexpected request to refresh the token")
+			return nil, nil
+		},
+	}
+
+	refresh := GetAccountRefreshAndStoreOAuthTokenFunc(externalAccounts, 1, &oauthutil.OAuthContext{})
+	_, _, _, err := refresh(WithDryRun(context.Background()), doer, &auth.OAuthBearerToken{Token: "expired", RefreshToken: "refresh_token"})
+	require.ErrorIs(t, err, ErrDryRunRefresh)
+
+	assert.Empty(t, externalAccounts.TransactFunc.History())
+	assert.Empty(t, externalAccounts.LookupUserAndSaveFunc.History())
+}
@@ -0,0 +1,58 @@
+package oobmigration
+
+import (
+	"context"
+	"time"
+)
+
+// ProgressSample is the progress of a migration at a point in time.
+type ProgressSample struct {
+	Progress float64
+	Created  time.Time
+}
+
+// etaWindow is the time over which the recent progress rate of a migration is measured.
+const etaWindow = time.Hour
+
+// EstimateRemaining estimates the time until the given migration completes in its current
+// direction, from the rate of progress recorded over the last hour. A false-valued flag is
+// returned if the migration is complete or paused, or if it made no progress in that time.
+func (s *Store) EstimateRemaining(ctx context.Context, m Migration) (time.Duration, bool, error) {
+	if m.Complete() || m.Paused {
+		return 0, false, nil
+	}
+
+	samples, err := s.ProgressSamples(ctx, m.ID, time.Now().Add(-etaWindow))
+	if err != nil {
+		return 0, false, err
+	}
+
+	remaining, ok := estimateRemaining(m, samples)
+	return remaining, ok, nil
+}
+
+// estimateRemaining extrapolates the rate of progress between the oldest of the given samples
+// and the current progress of the given migration.
+func estimateRemaining(m Migration, samples []ProgressSample) (time.Duration, bool) {
+	if len(samples) == 0 {
+		return 0, false
+	}
+
+	latest := samples[len(samples)-1]
+	if m.LastUpdated != nil && m.LastUpdated.After(latest.Created) {
+		latest = ProgressSample{Progress: m.Progress, Created: *m.LastUpdated}
+	}
+
+	oldest := samples[0]
+	elapsed := latest.Created.Sub(oldest.Created)
+	delta := latest.Progress - oldest.Progress
+	remaining := 1 - latest.Progress
+	if m.ApplyReverse {
+		delta, remaining = -delta, latest.Progress
+	}
+	if elapsed <= 0 || delta <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(float64(elapsed) * remaining / delta), true
+}
@@ -0,0 +1,69 @@
+package oobmigration
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEstimateRemaining(t *testing.T) {
+	now := time.Unix(1697200000, 0)
+	lastUpdated := now
+
+	for _, testCase := range []struct {
+		name      string
+		migration Migration
+		samples   []ProgressSample
+		expected  time.Duration
+		ok        bool
+	}{
+		{
+			name:      "forward",
+			migration: Migration{Progress: 0.5, LastUpdated: &lastUpdated},
+			samples:   []ProgressSample{{Progress: 0.25, Created: now.Add(-time.Minute * 30)}},
+			expected:  time.Minute * 60,
+			ok:        true,
+		},
+		{
+			name:      "reverse",
+			migration: Migration{Progress: 0.5, LastUpdated: &lastUpdated, ApplyReverse: true},
+			samples:   []ProgressSample{{Progress: 0.75, Created: now.Add(-time.Minute * 10)}},
+			expected:  time.Minute * 20,
+			ok:        true,
+		},
+		{
+			name:      "samples only",
+			migration: Migration{Progress: 0.5},
+			samples: []ProgressSample{
+				{Progress: 0.1, Created: now.Add(-time.Minute * 4)},
+				{Progress: 0.2, Created: now.Add(-time.Minute * 3)},
+				{Progress: 0.5, Created: now},
+			},
+			expected: time.Minute * 5,
+			ok:       true,
+		},
+		{
+			name:      "no progress",
+			migration: Migration{Progress: 0.5, LastUpdated: &lastUpdated},
+			samples:   []ProgressSample{{Progress: 0.5, Created: now.Add(-time.Minute * 30)}},
+		},
+		{
+			name:      "wrong direction",
+			migration: Migration{Progress: 0.5, LastUpdated: &lastUpdated, ApplyReverse: true},
+			samples:   []ProgressSample{{Progress: 0.25, Created: now.Add(-time.Minute * 30)}},
+		},
+		{
+			name:      "no samples",
+			migration: Migration{Progress: 0.5, LastUpdated: &lastUpdated},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			remaining, ok := estimateRemaining(testCase.migration, testCase.samples)
+			if ok != testCase.ok {
+				t.Fatalf("unexpected ok. want=%v have=%v", testCase.ok, ok)
+			}
+			if remaining != testCase.expected {
+				t.Errorf("unexpected remaining time. want=%s have=%s", testCase.expected, remaining)
+			}
+		})
+	}
+}
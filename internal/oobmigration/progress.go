@@ -19,6 +19,10 @@ func MakeProgressUpdater(out *output.Output, ids []int, animateProgress bool) (
 ) {
 	if !animateProgress || shouldDisableProgressAnimation() {
 		update = func(i int, m Migration) {
+			if m.Paused {
+				out.WriteLine(output.Linef("", output.StyleWarning, "Migration #%d is %.2f%% complete (paused)", m.ID, m.Progress*100))
+				return
+			}
 			out.WriteLine(output.Linef("", output.StyleReset, "Migration #%d is %.2f%% complete", m.ID, m.Progress*100))
 		}
 		return update, func() {}
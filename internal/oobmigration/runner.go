@@ -70,6 +70,9 @@ type MigratorOptions struct {
 
 	// ticker mocks periodic behavior for tests.
 	ticker glock.Ticker
+
+	// clock mocks the time used to throttle migrations for tests.
+	clock glock.Clock
 }
 
 func (r *Runner) SynchronizeMetadata(ctx context.Context) error {
@@ -89,9 +92,13 @@ func (r *Runner) Register(id int, migrator Migrator, options MigratorOptions) er
 	if options.ticker == nil {
 		options.ticker = glock.NewRealTicker(options.Interval)
 	}
+	if options.clock == nil {
+		options.clock = glock.NewRealClock()
+	}
 
 	r.migrators[id] = migratorAndOption{migrator, migratorOptions{
 		ticker: options.ticker,
+		clock:  options.clock,
 	}}
 	return nil
 }
@@ -339,12 +346,20 @@ func (r *Runner) Stop() {
 
 type migratorOptions struct {
 	ticker glock.Ticker
+	clock  glock.Clock
 }
 
 // runMigrator runs the given migrator function periodically (on each read from ticker)
 // while the migration is not complete. We will periodically (on each read from migrations)
-// update our current view of the migration progress and (more importantly) its direction.
+// update our current view of the migration progress, its direction, and whether it has been
+// paused or throttled by an admin.
 func runMigrator(ctx context.Context, store storeIface, migrator Migrator, migrations <-chan Migration, options migratorOptions, logger log.Logger, operations *operations) {
+	clock := options.clock
+	if clock == nil {
+		clock = glock.NewRealClock()
+	}
+	var lastRun time.Time
+
 	// Get initial migration. This channel will close when the context
 	// is canceled, so we don't need to do any more complex select here.
 	migration, ok := <-migrations
@@ -376,8 +391,16 @@ func runMigrator(ctx context.Context, store storeIface, migrator Migrator, migra
 			}
 
 		case <-options.ticker.Chan():
+			if migration.Paused {
+				continue
+			}
+			if migration.ThrottleInterval > 0 && clock.Since(lastRun) < migration.ThrottleInterval {
+				continue
+			}
+
 			if !migration.Complete() {
 				// Run the migration only if there's something left to do
+				lastRun = clock.Now()
 				if err := runMigrationFunction(ctx, store, &migration, migrator, logger, operations); err != nil {
 					if !errors.Is(err, ctx.Err()) {
 						logger.Error("Failed migration action", log.Error(err), log.Int("migrationID", migration.ID))
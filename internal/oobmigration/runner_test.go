@@ -295,6 +295,63 @@ func TestRunMigratorMigrationDesyncedFromData(t *testing.T) {
 	}
 }
 
+func TestRunnerPaused(t *testing.T) {
+	store := NewMockStoreIface()
+	ticker := glock.NewMockTicker(time.Second)
+	refreshTicker := glock.NewMockTicker(time.Second * 30)
+
+	store.ListFunc.SetDefaultReturn([]Migration{
+		{ID: 1, Progress: 0.5, Paused: true},
+	}, nil)
+
+	runner := newRunner(&observation.TestContext, store, refreshTicker)
+
+	migrator := NewMockMigrator()
+	migrator.ProgressFunc.SetDefaultReturn(0.5, nil)
+
+	if err := runner.Register(1, migrator, MigratorOptions{ticker: ticker}); err != nil {
+		t.Fatalf("unexpected error registering migrator: %s", err)
+	}
+
+	go runner.startInternal(allowAll)
+	tickN(ticker, 3)
+	runner.Stop()
+
+	if callCount := len(migrator.UpFunc.History()); callCount != 0 {
+		t.Errorf("unexpected number of calls to Up. want=%d have=%d", 0, callCount)
+	}
+}
+
+func TestRunnerThrottled(t *testing.T) {
+	store := NewMockStoreIface()
+	ticker := glock.NewMockTicker(time.Second)
+	clock := glock.NewMockClock()
+	refreshTicker := glock.NewMockTicker(time.Second * 30)
+
+	store.ListFunc.SetDefaultReturn([]Migration{
+		{ID: 1, Progress: 0.5, ThrottleInterval: time.Second * 10},
+	}, nil)
+
+	runner := newRunner(&observation.TestContext, store, refreshTicker)
+
+	migrator := NewMockMigrator()
+	migrator.ProgressFunc.SetDefaultReturn(0.5, nil)
+
+	if err := runner.Register(1, migrator, MigratorOptions{ticker: ticker, clock: clock}); err != nil {
+		t.Fatalf("unexpected error registering migrator: %s", err)
+	}
+
+	go runner.startInternal(allowAll)
+	tickN(ticker, 5)
+	clock.Advance(time.Second * 10)
+	tickN(ticker, 5)
+	runner.Stop()
+
+	if callCount := len(migrator.UpFunc.History()); callCount != 2 {
+		t.Errorf("unexpected number of calls to Up. want=%d have=%d", 2, callCount)
+	}
+}
+
 // runMigratorWrapped creates a migrations channel, then passes it to both the runMigrator
 // function and the given interact function, which execute concurrently. This channel can
 // control the behavior of the migration controller from within the interact function.
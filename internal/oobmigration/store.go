@@ -42,6 +42,11 @@ type Migration struct {
 	Errors         []MigrationError
 	// Metadata can be used to store custom JSON data
 	Metadata json.RawMessage
+	// Paused is set by an admin to stop the migration from running until it is resumed.
+	Paused bool
+	// ThrottleInterval, if non-zero, is the minimum time between invocations of the
+	// migration, as set by an admin.
+	ThrottleInterval time.Duration
 }
 
 // Complete returns true if the migration has 0 un-migrated record in whichever
@@ -76,6 +81,7 @@ func scanMigrations(rows *sql.Rows, queryErr error) (_ []Migration, err error) {
 		var message string
 		var created *time.Time
 		var deprecatedMajor, deprecatedMinor *int
+		var throttleIntervalSeconds *int
 		value := Migration{Errors: []MigrationError{}}
 
 		if err := rows.Scan(
@@ -94,6 +100,8 @@ func scanMigrations(rows *sql.Rows, queryErr error) (_ []Migration, err error) {
 			&value.IsEnterprise,
 			&value.ApplyReverse,
 			&value.Metadata,
+			&value.Paused,
+			&throttleIntervalSeconds,
 			&dbutil.NullString{S: &message},
 			&created,
 		); err != nil {
@@ -107,6 +115,10 @@ func scanMigrations(rows *sql.Rows, queryErr error) (_ []Migration, err error) {
 			})
 		}
 
+		if throttleIntervalSeconds != nil {
+			value.ThrottleInterval = time.Duration(*throttleIntervalSeconds) * time.Second
+		}
+
 		if deprecatedMajor != nil && deprecatedMinor != nil {
 			value.Deprecated = &Version{
 				Major: *deprecatedMajor,
@@ -346,7 +358,9 @@ ON CONFLICT (id) DO UPDATE SET
 // GetByID retrieves a migration by its identifier. If the migration does not exist, a false
 // valued flag is returned.
 func (s *Store) GetByID(ctx context.Context, id int) (_ Migration, _ bool, err error) {
-	migrations, err := scanMigrations(s.Store.Query(ctx, sqlf.Sprintf(getByIDQuery, id)))
+	migrations, err := s.queryMigrations(ctx, func(controlColumns *sqlf.Query) *sqlf.Query {
+		return sqlf.Sprintf(getByIDQuery, controlColumns, id)
+	})
 	if err != nil {
 		return Migration{}, false, err
 	}
@@ -375,6 +389,7 @@ SELECT
 	m.is_enterprise,
 	m.apply_reverse,
 	m.metadata,
+	%s,
 	e.message,
 	e.created
 FROM out_of_band_migrations m
@@ -384,7 +399,9 @@ ORDER BY e.created desc
 `
 
 func (s *Store) GetByIDs(ctx context.Context, ids []int) (_ []Migration, err error) {
-	migrations, err := scanMigrations(s.Store.Query(ctx, sqlf.Sprintf(getByIDsQuery, pq.Array(ids))))
+	migrations, err := s.queryMigrations(ctx, func(controlColumns *sqlf.Query) *sqlf.Query {
+		return sqlf.Sprintf(getByIDsQuery, controlColumns, pq.Array(ids))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -420,6 +437,7 @@ SELECT
 	m.is_enterprise,
 	m.apply_reverse,
 	m.metadata,
+	%s,
 	e.message,
 	e.created
 FROM out_of_band_migrations m
@@ -442,13 +460,15 @@ func (s *Store) List(ctx context.Context) (_ []Migration, err error) {
 		sqlf.Sprintf("m.id = ANY(%s)", pq.Array(yamlMigrationIDs)),
 	}
 
-	migrations, err := scanMigrations(s.Store.Query(ctx, sqlf.Sprintf(listQuery, sqlf.Join(conds, "AND"))))
+	migrations, err := s.queryMigrations(ctx, func(controlColumns *sqlf.Query) *sqlf.Query {
+		return sqlf.Sprintf(listQuery, controlColumns, sqlf.Join(conds, "AND"))
+	})
 	if err != nil {
 		if !shouldFallback(err) {
 			return nil, err
 		}
 
-		return scanMigrations(s.Store.Query(ctx, sqlf.Sprintf(listFallbackQuery, sqlf.Join(conds, "AND"))))
+		return scanMigrations(s.Store.Query(ctx, sqlf.Sprintf(listFallbackQuery, sqlf.Sprintf(controlColumnsFallback), sqlf.Join(conds, "AND"))))
 	}
 
 	return migrations, nil
@@ -471,6 +491,7 @@ SELECT
 	m.is_enterprise,
 	m.apply_reverse,
 	m.metadata,
+	%s,
 	e.message,
 	e.created
 FROM out_of_band_migrations m
@@ -506,6 +527,7 @@ SELECT
 	true AS is_enterprise,
 	m.apply_reverse,
 	m.metadata,
+	%s,
 	e.message,
 	e.created
 FROM split_migrations m
@@ -514,6 +536,23 @@ WHERE %s
 ORDER BY m.id desc, e.created DESC
 `
 
+// queryMigrations scans the migrations returned by the query built by the given function. The
+// query must select the given control columns in place of the paused and throttle interval
+// columns. The controls were introduced in 5.3; if the query fails because they do not yet
+// exist (when the migrator runs against an older schema), the query is re-run with defaults.
+func (s *Store) queryMigrations(ctx context.Context, makeQuery func(controlColumns *sqlf.Query) *sqlf.Query) ([]Migration, error) {
+	migrations, err := scanMigrations(s.Store.Query(ctx, makeQuery(sqlf.Sprintf(controlColumns))))
+	if err != nil && isUndefinedColumn(err, "paused", "throttle_interval_seconds") {
+		return scanMigrations(s.Store.Query(ctx, makeQuery(sqlf.Sprintf(controlColumnsFallback))))
+	}
+
+	return migrations, err
+}
+
+const controlColumns = `m.paused, m.throttle_interval_seconds`
+
+const controlColumnsFallback = `false AS paused, NULL::integer AS throttle_interval_seconds`
+
 // UpdateDirection updates the direction for the given migration.
 func (s *Store) UpdateDirection(ctx context.Context, id int, applyReverse bool) error {
 	return s.Store.Exec(ctx, sqlf.Sprintf(updateDirectionQuery, applyReverse, id))
@@ -523,13 +562,43 @@ const updateDirectionQuery = `
 UPDATE out_of_band_migrations SET apply_reverse = %s WHERE id = %s
 `
 
+// UpdatePaused pauses or resumes the given migration.
+func (s *Store) UpdatePaused(ctx context.Context, id int, paused bool) error {
+	return s.Store.Exec(ctx, sqlf.Sprintf(updatePausedQuery, paused, id))
+}
+
+const updatePausedQuery = `
+UPDATE out_of_band_migrations SET paused = %s WHERE id = %s
+`
+
+// UpdateThrottleInterval sets the minimum time between invocations of the given migration.
+// A zero interval removes the throttle.
+func (s *Store) UpdateThrottleInterval(ctx context.Context, id int, interval time.Duration) error {
+	var seconds *int
+	if interval > 0 {
+		// Round up so that sub-second intervals still throttle
+		v := int((interval + time.Second - 1) / time.Second)
+		seconds = &v
+	}
+
+	return s.Store.Exec(ctx, sqlf.Sprintf(updateThrottleIntervalQuery, seconds, id))
+}
+
+const updateThrottleIntervalQuery = `
+UPDATE out_of_band_migrations SET throttle_interval_seconds = %s WHERE id = %s
+`
+
 // UpdateProgress updates the progress for the given migration.
 func (s *Store) UpdateProgress(ctx context.Context, id int, progress float64) error {
 	return s.updateProgress(ctx, id, progress, time.Now())
 }
 
 func (s *Store) updateProgress(ctx context.Context, id int, progress float64, now time.Time) error {
-	return s.Store.Exec(ctx, sqlf.Sprintf(updateProgressQuery, progress, now, id, progress))
+	if err := s.Store.Exec(ctx, sqlf.Sprintf(updateProgressQuery, progress, now, id, progress)); err != nil {
+		return err
+	}
+
+	return s.addProgressSample(ctx, id, progress, now)
 }
 
 const updateProgressQuery = `
@@ -596,6 +665,88 @@ DELETE FROM out_of_band_migrations_errors WHERE id IN (
 )
 `
 
+// MaxProgressSamples is the maximum number of progress samples we'll track for a single
+// migration before pruning older entries.
+const MaxProgressSamples = 100
+
+// progressSampleInterval is the minimum time between two progress samples of a migration.
+const progressSampleInterval = time.Minute
+
+// addProgressSample records the given progress of the given migration, unless a sample was
+// recorded within the last progressSampleInterval. While there are more than MaxProgressSamples
+// samples for this migration, the oldest samples are pruned.
+//
+// The samples table was introduced in 5.3. When the migrator runs migrations against an
+// older schema, no sample is recorded.
+func (s *Store) addProgressSample(ctx context.Context, id int, progress float64, now time.Time) (err error) {
+	defer func() {
+		if isUndefinedTable(err) {
+			err = nil
+		}
+	}()
+
+	// Use a savepoint when called within a transaction, so that a missing table does not
+	// abort the transaction
+	tx, err := s.Store.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Exec(ctx, sqlf.Sprintf(addProgressSampleQuery, id, progress, now, id, now.Add(-progressSampleInterval))); err != nil {
+		return err
+	}
+
+	if err := tx.Exec(ctx, sqlf.Sprintf(addProgressSamplePruneQuery, id, MaxProgressSamples)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+const addProgressSampleQuery = `
+INSERT INTO out_of_band_migrations_progress (migration_id, progress, created)
+SELECT %s, %s, %s
+WHERE NOT EXISTS (
+	SELECT 1 FROM out_of_band_migrations_progress WHERE migration_id = %s AND created > %s
+)
+`
+
+const addProgressSamplePruneQuery = `
+DELETE FROM out_of_band_migrations_progress WHERE id IN (
+	SELECT id FROM out_of_band_migrations_progress WHERE migration_id = %s ORDER BY created DESC OFFSET %s
+)
+`
+
+// ProgressSamples returns the progress samples of the given migration recorded since the given
+// time, ordered from oldest to newest.
+func (s *Store) ProgressSamples(ctx context.Context, id int, since time.Time) (_ []ProgressSample, err error) {
+	rows, err := s.Store.Query(ctx, sqlf.Sprintf(progressSamplesQuery, id, since))
+	if err != nil {
+		if isUndefinedTable(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var samples []ProgressSample
+	for rows.Next() {
+		var sample ProgressSample
+		if err := rows.Scan(&sample.Progress, &sample.Created); err != nil {
+			return nil, err
+		}
+
+		samples = append(samples, sample)
+	}
+
+	return samples, nil
+}
+
+const progressSamplesQuery = `
+SELECT progress, created FROM out_of_band_migrations_progress WHERE migration_id = %s AND created >= %s ORDER BY created
+`
+
 var columnsSupporingFallback = []string{
 	"is_enterprise",
 	"introduced_version_major",
@@ -605,9 +756,15 @@ var columnsSupporingFallback = []string{
 }
 
 func shouldFallback(err error) bool {
+	return isUndefinedColumn(err, columnsSupporingFallback...)
+}
+
+// isUndefinedColumn returns true if the given error is raised by a reference to one of the
+// given columns that does not exist.
+func isUndefinedColumn(err error, columns ...string) bool {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgErr.Code == "42703" {
-		for _, column := range columnsSupporingFallback {
+		for _, column := range columns {
 			if strings.Contains(pgErr.Message, column) {
 				return true
 			}
@@ -617,6 +774,13 @@ func shouldFallback(err error) bool {
 	return false
 }
 
+// isUndefinedTable returns true if the given error is raised by a reference to a table that
+// does not exist.
+func isUndefinedTable(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "42P01"
+}
+
 func versionString(major, minor int) string {
 	return fmt.Sprintf("%d.%d.0", major, minor)
 }
@@ -233,6 +233,101 @@ func TestUpdateDirection(t *testing.T) {
 	}
 }
 
+func TestUpdatePaused(t *testing.T) {
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := testStore(t, db)
+
+	if err := store.UpdatePaused(context.Background(), 3, true); err != nil {
+		t.Fatalf("unexpected error pausing migration: %s", err)
+	}
+
+	migration, exists, err := store.GetByID(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("unexpected error getting migrations: %s", err)
+	}
+	if !exists {
+		t.Fatalf("expected record to exist")
+	}
+
+	expectedMigration := testMigrations[2] // ID = 3
+	expectedMigration.Paused = true
+
+	if diff := cmp.Diff(expectedMigration, migration); diff != "" {
+		t.Errorf("unexpected migration (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpdateThrottleInterval(t *testing.T) {
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := testStore(t, db)
+
+	if err := store.UpdateThrottleInterval(context.Background(), 3, time.Second*30); err != nil {
+		t.Fatalf("unexpected error throttling migration: %s", err)
+	}
+
+	migrations, err := store.GetByIDs(context.Background(), []int{3})
+	if err != nil {
+		t.Fatalf("unexpected error getting migrations: %s", err)
+	}
+
+	expectedMigration := testMigrations[2] // ID = 3
+	expectedMigration.ThrottleInterval = time.Second * 30
+
+	if diff := cmp.Diff([]Migration{expectedMigration}, migrations); diff != "" {
+		t.Errorf("unexpected migrations (-want +got):\n%s", diff)
+	}
+
+	if err := store.UpdateThrottleInterval(context.Background(), 3, 0); err != nil {
+		t.Fatalf("unexpected error removing throttle: %s", err)
+	}
+
+	migrations, err = store.GetByIDs(context.Background(), []int{3})
+	if err != nil {
+		t.Fatalf("unexpected error getting migrations: %s", err)
+	}
+	if diff := cmp.Diff([]Migration{testMigrations[2]}, migrations); diff != "" {
+		t.Errorf("unexpected migrations (-want +got):\n%s", diff)
+	}
+}
+
+func TestProgressSamples(t *testing.T) {
+	t.Parallel()
+	now := testTime.Add(time.Hour * 7)
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := testStore(t, db)
+
+	for i, update := range []struct {
+		progress float64
+		offset   time.Duration
+	}{
+		{0.50, 0},
+		{0.55, time.Second * 30}, // within the sample interval of the previous sample
+		{0.60, time.Minute * 2},
+	} {
+		if err := store.updateProgress(context.Background(), 3, update.progress, now.Add(update.offset)); err != nil {
+			t.Fatalf("unexpected error updating migration (update %d): %s", i, err)
+		}
+	}
+
+	samples, err := store.ProgressSamples(context.Background(), 3, now)
+	if err != nil {
+		t.Fatalf("unexpected error getting progress samples: %s", err)
+	}
+
+	expectedSamples := []ProgressSample{
+		{Progress: 0.50, Created: now},
+		{Progress: 0.60, Created: now.Add(time.Minute * 2)},
+	}
+	if diff := cmp.Diff(expectedSamples, samples); diff != "" {
+		t.Errorf("unexpected samples (-want +got):\n%s", diff)
+	}
+}
+
 func TestUpdateProgress(t *testing.T) {
 	t.Parallel()
 	now := testTime.Add(time.Hour * 7)
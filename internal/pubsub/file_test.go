@@ -0,0 +1,28 @@
+package pubsub
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileTopicClient(t *testing.T) {
+	path := t.TempDir() + "/messages.jsonl"
+
+	// Rotation is covered by fileutil.RotatingWriter.
+	topic, err := NewFileTopicClient(path, 1024, 2)
+	require.NoError(t, err)
+	require.NoError(t, topic.Publish(context.Background(), []byte(`{"id":"a"}`), []byte(`{"id":"b"}`)))
+	require.NoError(t, topic.Ping(context.Background()))
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":\"a\"}\n{\"id\":\"b\"}\n", string(b))
+
+	topic.Stop()
+	assert.Error(t, topic.Ping(context.Background()))
+	assert.Error(t, topic.Publish(context.Background(), []byte(`{"id":"e"}`)))
+}
@@ -0,0 +1,160 @@
+package pubsub
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// httpRequestTimeout is the timeout for sending a single batch of messages.
+const httpRequestTimeout = 30 * time.Second
+
+// NewHTTPTopicClient creates a Pub/Sub client that sends messages in batches
+// to the given endpoint. Each batch is sent as a POST request with the
+// messages as lines of an application/x-ndjson body.
+//
+// Messages are batched across Publish calls: a batch is sent once it holds
+// maxBatchSize messages, or batchDelay after its first message was published.
+// Publish waits for the batch holding its messages to be sent, and returns an
+// error if sending the batch failed.
+func NewHTTPTopicClient(cli httpcli.Doer, endpoint string, maxBatchSize int, batchDelay time.Duration) TopicClient {
+	if maxBatchSize < 1 {
+		maxBatchSize = 1
+	}
+	return &httpTopicClient{
+		cli:          cli,
+		endpoint:     endpoint,
+		maxBatchSize: maxBatchSize,
+		batchDelay:   batchDelay,
+	}
+}
+
+type httpTopicClient struct {
+	cli          httpcli.Doer
+	endpoint     string
+	maxBatchSize int
+	batchDelay   time.Duration
+
+	mu      sync.Mutex
+	pending *httpBatch
+	stopped bool
+}
+
+type httpBatch struct {
+	messages [][]byte
+	timer    *time.Timer
+
+	// done is closed once the batch was sent, err is the result of sending it.
+	done chan struct{}
+	err  error
+}
+
+// Ping does nothing, as there is no generic way to check the health of the
+// endpoint without sending messages.
+func (c *httpTopicClient) Ping(context.Context) error { return nil }
+
+func (c *httpTopicClient) Publish(ctx context.Context, messages ...[]byte) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	if c.stopped {
+		c.mu.Unlock()
+		return errors.New("topic client is stopped")
+	}
+	b := c.pending
+	if b == nil {
+		b = &httpBatch{done: make(chan struct{})}
+		b.timer = time.AfterFunc(c.batchDelay, func() { c.flush(b) })
+		c.pending = b
+	}
+	b.messages = append(b.messages, messages...)
+	full := len(b.messages) >= c.maxBatchSize
+	if full {
+		c.pending = nil
+	}
+	c.mu.Unlock()
+
+	if full {
+		b.timer.Stop()
+		go c.send(b)
+	}
+
+	select {
+	case <-b.done:
+		return b.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stop sends the pending batch, if any, and waits for it to be sent.
+func (c *httpTopicClient) Stop() {
+	c.mu.Lock()
+	c.stopped = true
+	b := c.pending
+	c.pending = nil
+	c.mu.Unlock()
+
+	if b != nil {
+		b.timer.Stop()
+		c.send(b)
+	}
+}
+
+// flush sends the batch if it is still pending.
+func (c *httpTopicClient) flush(b *httpBatch) {
+	c.mu.Lock()
+	if c.pending != b {
+		// Already sent because it was full, or the client was stopped.
+		c.mu.Unlock()
+		return
+	}
+	c.pending = nil
+	c.mu.Unlock()
+
+	c.send(b)
+}
+
+func (c *httpTopicClient) send(b *httpBatch) {
+	b.err = c.post(b.messages)
+	close(b.done)
+}
+
+func (c *httpTopicClient) post(messages [][]byte) error {
+	// The batch may hold the messages of many Publish calls, so we don't use
+	// the context of any of them.
+	ctx, cancel := context.WithTimeout(context.Background(), httpRequestTimeout)
+	defer cancel()
+
+	var body bytes.Buffer
+	for _, msg := range messages {
+		body.Write(msg)
+		body.WriteByte('\n')
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, &body)
+	if err != nil {
+		return errors.Wrap(err, "create request")
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "send messages")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("send messages: unexpected status code %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package pubsub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/conc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPTopicClient(t *testing.T) {
+	var mu sync.Mutex
+	var batches []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if strings.Contains(string(body), "fail") {
+			http.Error(w, "oh no", http.StatusInternalServerError)
+			return
+		}
+		mu.Lock()
+		batches = append(batches, string(body))
+		mu.Unlock()
+	}))
+	t.Cleanup(srv.Close)
+
+	t.Run("full batches are sent immediately", func(t *testing.T) {
+		batches = nil
+		// The delay is longer than the test timeout, so only full batches are
+		// sent.
+		topic := NewHTTPTopicClient(http.DefaultClient, srv.URL, 2, time.Hour)
+
+		var wg conc.WaitGroup
+		for _, msg := range []string{"a", "b", "c", "d"} {
+			msg := msg
+			wg.Go(func() { assert.NoError(t, topic.Publish(context.Background(), []byte(msg))) })
+		}
+		wg.Wait()
+
+		require.Len(t, batches, 2)
+		for _, batch := range batches {
+			assert.Len(t, strings.Split(strings.TrimSuffix(batch, "\n"), "\n"), 2)
+		}
+	})
+
+	t.Run("pending batches are sent after the delay", func(t *testing.T) {
+		batches = nil
+		topic := NewHTTPTopicClient(http.DefaultClient, srv.URL, 100, 10*time.Millisecond)
+
+		require.NoError(t, topic.Publish(context.Background(), []byte("a"), []byte("b")))
+		assert.Equal(t, []string{"a\nb\n"}, batches)
+	})
+
+	t.Run("pending batches are sent on stop", func(t *testing.T) {
+		batches = nil
+		topic := NewHTTPTopicClient(http.DefaultClient, srv.URL, 100, time.Hour)
+
+		errs := make(chan error)
+		go func() { errs <- topic.Publish(context.Background(), []byte("a")) }()
+		require.Eventually(t, func() bool {
+			topic.(*httpTopicClient).mu.Lock()
+			defer topic.(*httpTopicClient).mu.Unlock()
+			return topic.(*httpTopicClient).pending != nil
+		}, time.Second, time.Millisecond)
+
+		topic.Stop()
+		require.NoError(t, <-errs)
+		assert.Equal(t, []string{"a\n"}, batches)
+		assert.Error(t, topic.Publish(context.Background(), []byte("b")))
+	})
+
+	t.Run("failed batches", func(t *testing.T) {
+		topic := NewHTTPTopicClient(http.DefaultClient, srv.URL, 1, time.Hour)
+
+		err := topic.Publish(context.Background(), []byte("fail"))
+		assert.ErrorContains(t, err, "unexpected status code 500")
+	})
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS pubsub_messages
+(
+    id         BIGSERIAL PRIMARY KEY,
+    topic      text                                   NOT NULL,
+    data       bytea                                  NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS pubsub_messages_topic_id ON pubsub_messages (topic, id);
@@ -0,0 +1,79 @@
+package pubsub
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+//go:embed migrations
+var postgresMigrations embed.FS
+
+// PostgresSchema describes the migrations of the pubsub_messages table used by
+// NewPostgresTopicClient. Callers must apply it to the database, e.g. with
+// connections.MigrateStandaloneDB, before publishing messages.
+var PostgresSchema = func() *schemas.Schema {
+	fsys, err := fs.Sub(postgresMigrations, "migrations")
+	if err != nil {
+		panic(fmt.Sprintf("malformed Pub/Sub migrations: %s", err))
+	}
+	schema, err := schemas.ResolveSchema(fsys, "pubsub")
+	if err != nil {
+		panic(err.Error())
+	}
+	return schema
+}()
+
+// NewPostgresTopicClient creates a Pub/Sub client that inserts messages into
+// the pubsub_messages table of the given database, which must have
+// PostgresSchema applied. Consumers are expected to read the messages of the
+// topic in order of their ID and delete them once processed.
+//
+// The database is closed when calling Stop.
+func NewPostgresTopicClient(db *sql.DB, topicID string) TopicClient {
+	return &postgresTopicClient{
+		db:    db,
+		topic: topicID,
+	}
+}
+
+type postgresTopicClient struct {
+	db    *sql.DB
+	topic string
+}
+
+func (c *postgresTopicClient) Ping(ctx context.Context) error {
+	return c.db.PingContext(ctx)
+}
+
+const insertPostgresMessagesQuery = `
+INSERT INTO pubsub_messages (topic, data)
+VALUES %s
+`
+
+func (c *postgresTopicClient) Publish(ctx context.Context, messages ...[]byte) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	values := make([]*sqlf.Query, 0, len(messages))
+	for _, msg := range messages {
+		values = append(values, sqlf.Sprintf("(%s, %s)", c.topic, msg))
+	}
+	q := sqlf.Sprintf(insertPostgresMessagesQuery, sqlf.Join(values, ", "))
+	if _, err := c.db.ExecContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...); err != nil {
+		return errors.Wrap(err, "insert messages")
+	}
+	return nil
+}
+
+func (c *postgresTopicClient) Stop() {
+	_ = c.db.Close()
+}
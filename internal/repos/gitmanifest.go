@@ -0,0 +1,155 @@
+package repos
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitmanifest"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A GitManifestSource yields repositories listed in the JSON manifest of a
+// single GitManifest connection configured in Sourcegraph via the external
+// services configuration.
+type GitManifestSource struct {
+	svc       *types.ExternalService
+	config    *schema.GitManifestConnection
+	cli       *gitmanifest.Client
+	exclude   excludeFunc
+	serviceID string
+}
+
+// NewGitManifestSource returns a new GitManifestSource from the given external
+// service.
+func NewGitManifestSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*GitManifestSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.GitManifestConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	httpCli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := gitmanifest.NewClient(&c, httpCli)
+	if err != nil {
+		return nil, err
+	}
+
+	var eb excludeBuilder
+	for _, r := range c.Exclude {
+		eb.Exact(r.Name)
+		eb.Pattern(r.Pattern)
+		if r.Archived {
+			eb.Generic(func(repo any) bool {
+				if mr, ok := repo.(gitmanifest.Repository); ok {
+					return mr.Archived
+				}
+				return false
+			})
+		}
+	}
+	exclude, err := eb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GitManifestSource{
+		svc:       svc,
+		config:    &c,
+		cli:       cli,
+		exclude:   exclude,
+		serviceID: extsvc.NormalizeBaseURL(&url.URL{Scheme: cli.URL.Scheme, Host: cli.URL.Host}).String(),
+	}, nil
+}
+
+// CheckConnection fetches the manifest and returns an error if it can't be
+// fetched or is invalid.
+func (s *GitManifestSource) CheckConnection(ctx context.Context) error {
+	if _, err := s.cli.Fetch(ctx); err != nil {
+		return errors.Wrap(err, "fetching manifest")
+	}
+	return nil
+}
+
+// ListRepos returns all repositories listed in the manifest of this
+// GitManifestSource's config, except the excluded ones.
+func (s *GitManifestSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	m, err := s.cli.Fetch(ctx)
+	if err != nil {
+		results <- SourceResult{Source: s, Err: err}
+		return
+	}
+
+	for _, r := range m.Repositories {
+		if s.excludes(r) {
+			continue
+		}
+		results <- SourceResult{Source: s, Repo: s.makeRepo(r)}
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *GitManifestSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s *GitManifestSource) excludes(r *gitmanifest.Repository) bool {
+	return s.exclude(r.Name) || s.exclude(*r)
+}
+
+func (s *GitManifestSource) makeRepo(r *gitmanifest.Repository) *types.Repo {
+	urn := s.svc.URN()
+	name := s.repoName(r.Name)
+
+	return &types.Repo{
+		Name:        name,
+		URI:         string(name),
+		Description: r.Description,
+		Private:     r.Private(),
+		Archived:    r.Archived,
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          r.Name,
+			ServiceType: extsvc.VariantGitManifest.AsType(),
+			ServiceID:   s.serviceID,
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: r.CloneURL,
+			},
+		},
+		Metadata: r,
+	}
+}
+
+// repoName returns the Sourcegraph repository name of the manifest repository
+// with the given name, according to the configured repositoryPathPattern.
+func (s *GitManifestSource) repoName(name string) api.RepoName {
+	pattern := s.config.RepositoryPathPattern
+	if pattern == "" {
+		pattern = "{host}/{name}"
+	}
+
+	return api.RepoName(strings.NewReplacer(
+		"{host}", s.cli.URL.Host,
+		"{name}", strings.Trim(path.Clean(name), "/"),
+	).Replace(pattern))
+}
@@ -0,0 +1,157 @@
+package repos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitmanifest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestGitManifestSource_ListRepos(t *testing.T) {
+	manifest, err := os.ReadFile("testdata/gitmanifest-repos.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const token = "secret"
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/manifest.json" {
+			http.Error(w, r.URL.String()+" not found", http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(manifest)
+	}))
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host := u.Host
+
+	type repo struct {
+		Name     api.RepoName
+		ID       string
+		CloneURL string
+		Private  bool
+		Archived bool
+	}
+
+	cases := []struct {
+		name    string
+		conn    *schema.GitManifestConnection
+		want    []repo
+		wantErr bool
+	}{{
+		name: "simple",
+		conn: &schema.GitManifestConnection{Url: s.URL + "/manifest.json", Token: token},
+		want: []repo{
+			{Name: api.RepoName(host + "/platform/api"), ID: "platform/api", CloneURL: "https://git.example.com/platform/api.git"},
+			{Name: api.RepoName(host + "/platform/billing"), ID: "platform/billing", CloneURL: "https://git.example.com/platform/billing.git", Private: true},
+			{Name: api.RepoName(host + "/tools/linters"), ID: "tools/linters", CloneURL: "ssh://git@git.example.com/tools/linters.git", Private: true},
+			{Name: api.RepoName(host + "/experiments/legacy"), ID: "experiments/legacy", CloneURL: "https://git.example.com/experiments/legacy.git", Archived: true},
+		},
+	}, {
+		name: "repositoryPathPattern",
+		conn: &schema.GitManifestConnection{Url: s.URL + "/manifest.json", Token: token, RepositoryPathPattern: "git/{name}"},
+		want: []repo{
+			{Name: "git/platform/api", ID: "platform/api", CloneURL: "https://git.example.com/platform/api.git"},
+			{Name: "git/platform/billing", ID: "platform/billing", CloneURL: "https://git.example.com/platform/billing.git", Private: true},
+			{Name: "git/tools/linters", ID: "tools/linters", CloneURL: "ssh://git@git.example.com/tools/linters.git", Private: true},
+			{Name: "git/experiments/legacy", ID: "experiments/legacy", CloneURL: "https://git.example.com/experiments/legacy.git", Archived: true},
+		},
+	}, {
+		name: "exclude",
+		conn: &schema.GitManifestConnection{
+			Url:                   s.URL + "/manifest.json",
+			Token:                 token,
+			RepositoryPathPattern: "{name}",
+			Exclude: []*schema.ExcludedGitManifestRepo{
+				{Name: "PLATFORM/billing"},
+				{Pattern: "^tools/"},
+				{Archived: true},
+			},
+		},
+		want: []repo{
+			{Name: "platform/api", ID: "platform/api", CloneURL: "https://git.example.com/platform/api.git"},
+		},
+	}, {
+		name:    "unauthorized",
+		conn:    &schema.GitManifestConnection{Url: s.URL + "/manifest.json"},
+		wantErr: true,
+	}, {
+		name:    "not found",
+		conn:    &schema.GitManifestConnection{Url: s.URL + "/missing.json", Token: token},
+		wantErr: true,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &types.ExternalService{
+				ID:     1,
+				Kind:   extsvc.VariantGitManifest.AsKind(),
+				Config: extsvc.NewUnencryptedConfig(MarshalJSON(t, tc.conn)),
+			}
+
+			src, err := NewGitManifestSource(context.Background(), svc, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			repos, err := ListAll(context.Background(), src)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if err := src.CheckConnection(context.Background()); err == nil {
+					t.Fatal("expected CheckConnection to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := src.CheckConnection(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			have := make([]repo, 0, len(repos))
+			for _, r := range repos {
+				if r.ExternalRepo.ServiceType != extsvc.VariantGitManifest.AsType() {
+					t.Errorf("unexpected service type %q", r.ExternalRepo.ServiceType)
+				}
+				if want := "http://" + host + "/"; r.ExternalRepo.ServiceID != want {
+					t.Errorf("want service ID %q, have %q", want, r.ExternalRepo.ServiceID)
+				}
+				if _, ok := r.Metadata.(*gitmanifest.Repository); !ok {
+					t.Errorf("unexpected metadata type %T", r.Metadata)
+				}
+				have = append(have, repo{
+					Name:     r.Name,
+					ID:       r.ExternalRepo.ID,
+					CloneURL: r.Sources[svc.URN()].CloneURL,
+					Private:  r.Private,
+					Archived: r.Archived,
+				})
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf("unexpected repos (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
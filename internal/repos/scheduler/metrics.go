@@ -18,6 +18,10 @@ var (
 		Name: "src_repoupdater_sched_auto_fetch",
 		Help: "Incremented each time the scheduler updates a managed repository due to hitting a deadline.",
 	})
+	schedQuietHoursPostponed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_repoupdater_sched_quiet_hours_postponed",
+		Help: "Incremented each time the scheduler postpones an update because of the quiet hours of an update scheduling policy.",
+	})
 	schedManualFetch = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "src_repoupdater_sched_manual_fetch",
 		Help: "Incremented each time the scheduler updates a repository due to user traffic.",
@@ -0,0 +1,347 @@
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grafana/regexp"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// policyRefreshInterval is how often the scheduler reloads the update
+// scheduling policies from the code host configurations.
+const policyRefreshInterval = time.Minute
+
+// schedulingPolicy is an update scheduling policy declared in the
+// updateSchedulingPolicies field of a code host configuration. It overrides
+// the global interval bounds and the queue priority of the repos it matches,
+// and can prevent scheduled updates during quiet hours.
+type schedulingPolicy struct {
+	ExternalServiceID int64
+	Pattern           string
+	MinInterval       time.Duration
+	MaxInterval       time.Duration
+	Priority          string
+	QuietHours        []string
+	Timezone          string
+
+	pattern  *regexp.Regexp
+	windows  []quietWindow
+	location *time.Location
+}
+
+// quietWindow is a daily time window, expressed as offsets from midnight.
+type quietWindow struct {
+	start, end time.Duration
+}
+
+// rawSchedulingPolicy is the shape of a policy in the code host
+// configuration. All code host kinds share it, so we read it without going
+// through the kind specific connection types.
+type rawSchedulingPolicy struct {
+	Pattern     string   `json:"pattern"`
+	MinInterval int      `json:"minInterval"`
+	MaxInterval int      `json:"maxInterval"`
+	Priority    string   `json:"priority"`
+	QuietHours  []string `json:"quietHours"`
+	Timezone    string   `json:"timezone"`
+}
+
+// parseSchedulingPolicies returns the update scheduling policies declared in
+// the given code host configuration.
+func parseSchedulingPolicies(externalServiceID int64, config string) ([]*schedulingPolicy, error) {
+	var c struct {
+		UpdateSchedulingPolicies []rawSchedulingPolicy `json:"updateSchedulingPolicies"`
+	}
+	if err := jsonc.Unmarshal(config, &c); err != nil {
+		return nil, err
+	}
+
+	policies := make([]*schedulingPolicy, 0, len(c.UpdateSchedulingPolicies))
+	for i, raw := range c.UpdateSchedulingPolicies {
+		p, err := newSchedulingPolicy(externalServiceID, raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "updateSchedulingPolicies[%d]", i)
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+func newSchedulingPolicy(externalServiceID int64, raw rawSchedulingPolicy) (*schedulingPolicy, error) {
+	p := &schedulingPolicy{
+		ExternalServiceID: externalServiceID,
+		Pattern:           raw.Pattern,
+		MinInterval:       time.Duration(raw.MinInterval) * time.Minute,
+		MaxInterval:       time.Duration(raw.MaxInterval) * time.Minute,
+		Priority:          raw.Priority,
+		QuietHours:        raw.QuietHours,
+		Timezone:          raw.Timezone,
+		location:          time.UTC,
+	}
+
+	if p.Priority == "" {
+		p.Priority = "normal"
+	}
+	switch p.Priority {
+	case "low", "normal", "high":
+	default:
+		return nil, errors.Errorf("invalid priority %q", p.Priority)
+	}
+
+	if p.MinInterval != 0 && p.MaxInterval != 0 && p.MinInterval > p.MaxInterval {
+		return nil, errors.New("minInterval must not be greater than maxInterval")
+	}
+
+	if p.Pattern != "" {
+		re, err := regexp.Compile(p.Pattern)
+		if err != nil {
+			return nil, errors.Wrap(err, "compiling pattern")
+		}
+		p.pattern = re
+	}
+
+	if p.Timezone != "" {
+		loc, err := time.LoadLocation(p.Timezone)
+		if err != nil {
+			return nil, errors.Wrap(err, "loading timezone")
+		}
+		p.location = loc
+	}
+
+	for _, qh := range p.QuietHours {
+		w, err := parseQuietWindow(qh)
+		if err != nil {
+			return nil, err
+		}
+		p.windows = append(p.windows, w)
+	}
+
+	return p, nil
+}
+
+func parseQuietWindow(s string) (quietWindow, error) {
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return quietWindow{}, errors.Errorf("invalid quiet hours %q: expected HH:MM-HH:MM", s)
+	}
+
+	var w quietWindow
+	for _, v := range []struct {
+		s string
+		d *time.Duration
+	}{{start, &w.start}, {end, &w.end}} {
+		t, err := time.Parse("15:04", strings.TrimSpace(v.s))
+		if err != nil {
+			return quietWindow{}, errors.Errorf("invalid quiet hours %q: expected HH:MM-HH:MM", s)
+		}
+		*v.d = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	return w, nil
+}
+
+// matches returns true if the policy applies to the repo with the given name.
+func (p *schedulingPolicy) matches(name string) bool {
+	return p.pattern == nil || p.pattern.MatchString(name)
+}
+
+// bounds returns the interval bounds of the policy, falling back to the
+// global bounds for those the policy doesn't set.
+func (p *schedulingPolicy) bounds() (lo, hi time.Duration) {
+	lo, hi = minDelay, maxDelay
+	if p == nil {
+		return lo, hi
+	}
+	if p.MinInterval > 0 {
+		lo = p.MinInterval
+	}
+	if p.MaxInterval > 0 {
+		hi = p.MaxInterval
+	}
+	if lo > hi {
+		lo = hi
+	}
+	return lo, hi
+}
+
+// clamp bounds interval by the interval bounds of the policy.
+func (p *schedulingPolicy) clamp(interval time.Duration) time.Duration {
+	lo, hi := p.bounds()
+	switch {
+	case interval > hi:
+		return hi
+	case interval < lo:
+		return lo
+	default:
+		return interval
+	}
+}
+
+// queuePriority returns the priority scheduled updates of matching repos are
+// enqueued with.
+func (p *schedulingPolicy) queuePriority() priority {
+	if p == nil {
+		return priorityLow
+	}
+	switch p.Priority {
+	case "low":
+		return priorityDeferred
+	case "high":
+		return priorityElevated
+	default:
+		return priorityLow
+	}
+}
+
+// quietUntil returns the end of the quiet window now falls into and true, or
+// false if now is outside all quiet windows of the policy.
+func (p *schedulingPolicy) quietUntil(now time.Time) (time.Time, bool) {
+	if p == nil || len(p.windows) == 0 {
+		return time.Time{}, false
+	}
+
+	local := now.In(p.location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, p.location)
+	sinceMidnight := local.Sub(midnight)
+
+	var (
+		until time.Time
+		quiet bool
+	)
+	for _, w := range p.windows {
+		var end time.Time
+		switch {
+		case w.start <= w.end && sinceMidnight >= w.start && sinceMidnight < w.end:
+			end = midnight.Add(w.end)
+		case w.start > w.end && sinceMidnight >= w.start:
+			// The window spans midnight and we're before midnight.
+			end = midnight.AddDate(0, 0, 1).Add(w.end)
+		case w.start > w.end && sinceMidnight < w.end:
+			// The window spans midnight and we're after midnight.
+			end = midnight.Add(w.end)
+		default:
+			continue
+		}
+		if end.After(until) {
+			until = end
+		}
+		quiet = true
+	}
+	return until, quiet
+}
+
+// toProtocol returns the policy in its repo-updater protocol form.
+func (p *schedulingPolicy) toProtocol() *protocol.RepoSchedulePolicy {
+	lo, hi := p.bounds()
+	return &protocol.RepoSchedulePolicy{
+		ExternalServiceID:  p.ExternalServiceID,
+		Pattern:            p.Pattern,
+		MinIntervalSeconds: int(lo / time.Second),
+		MaxIntervalSeconds: int(hi / time.Second),
+		Priority:           p.Priority,
+		QuietHours:         p.QuietHours,
+		Timezone:           p.Timezone,
+	}
+}
+
+// schedulingPolicies holds the update scheduling policies of all code hosts.
+// It is safe for concurrent use, and a nil *schedulingPolicies has no
+// policies.
+type schedulingPolicies struct {
+	mu sync.RWMutex
+	// byService maps external service IDs to their policies, in the order
+	// they're declared in the configuration.
+	byService map[int64][]*schedulingPolicy
+}
+
+// set replaces all policies.
+func (ps *schedulingPolicies) set(byService map[int64][]*schedulingPolicy) {
+	ps.mu.Lock()
+	ps.byService = byService
+	ps.mu.Unlock()
+}
+
+// match returns the policy that applies to repo, or nil if there is none.
+//
+// If the repo is synced from multiple code hosts, the policies of the code
+// host with the lowest external service ID take precedence.
+func (ps *schedulingPolicies) match(repo configuredRepo) *schedulingPolicy {
+	if ps == nil || len(repo.ExternalServiceIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(repo.ExternalServiceIDs))
+	copy(ids, repo.ExternalServiceIDs)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	for _, id := range ids {
+		for _, p := range ps.byService[id] {
+			if p.matches(string(repo.Name)) {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+// runPolicyRefreshLoop periodically reloads the update scheduling policies
+// from the code host configurations.
+func (s *UpdateScheduler) runPolicyRefreshLoop(ctx context.Context) {
+	ticker := time.NewTicker(policyRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.refreshPolicies(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Warn("failed to refresh update scheduling policies", log.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refreshPolicies loads the update scheduling policies of all code hosts.
+// Code hosts with invalid policies are logged and skipped.
+func (s *UpdateScheduler) refreshPolicies(ctx context.Context) error {
+	svcs, err := s.db.ExternalServices().List(ctx, database.ExternalServicesListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "listing external services")
+	}
+
+	byService := make(map[int64][]*schedulingPolicy)
+	for _, svc := range svcs {
+		config, err := svc.Config.Decrypt(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "decrypting config of external service %d", svc.ID)
+		}
+
+		policies, err := parseSchedulingPolicies(svc.ID, config)
+		if err != nil {
+			s.logger.Warn("ignoring invalid update scheduling policies",
+				log.Int64("externalServiceID", svc.ID),
+				log.Error(err),
+			)
+			continue
+		}
+		if len(policies) > 0 {
+			byService[svc.ID] = policies
+		}
+	}
+
+	s.policies.set(byService)
+	return nil
+}
@@ -0,0 +1,260 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+)
+
+func TestParseSchedulingPolicies(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		policies, err := parseSchedulingPolicies(1, `{
+			// comments are allowed
+			"url": "https://github.com",
+			"updateSchedulingPolicies": [
+				{"pattern": "^github.com/sourcegraph/", "minInterval": 1, "maxInterval": 5, "priority": "high"},
+				{"maxInterval": 10080, "priority": "low", "quietHours": ["22:00-06:00"], "timezone": "Europe/Berlin"},
+				{}
+			]
+		}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		have := make([]*protocol.RepoSchedulePolicy, 0, len(policies))
+		for _, p := range policies {
+			have = append(have, p.toProtocol())
+		}
+		want := []*protocol.RepoSchedulePolicy{
+			{ExternalServiceID: 1, Pattern: "^github.com/sourcegraph/", MinIntervalSeconds: 60, MaxIntervalSeconds: 300, Priority: "high"},
+			{ExternalServiceID: 1, MinIntervalSeconds: 45, MaxIntervalSeconds: 604800, Priority: "low", QuietHours: []string{"22:00-06:00"}, Timezone: "Europe/Berlin"},
+			{ExternalServiceID: 1, MinIntervalSeconds: 45, MaxIntervalSeconds: 28800, Priority: "normal"},
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatalf("unexpected policies (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("no policies", func(t *testing.T) {
+		policies, err := parseSchedulingPolicies(1, `{"url": "https://github.com"}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(policies) != 0 {
+			t.Fatalf("expected no policies, got %d", len(policies))
+		}
+	})
+
+	for _, tc := range []struct {
+		name   string
+		policy string
+	}{
+		{name: "invalid pattern", policy: `{"pattern": "("}`},
+		{name: "invalid priority", policy: `{"priority": "urgent"}`},
+		{name: "inverted bounds", policy: `{"minInterval": 10, "maxInterval": 5}`},
+		{name: "invalid quiet hours", policy: `{"quietHours": ["22:00"]}`},
+		{name: "invalid quiet hours time", policy: `{"quietHours": ["25:00-06:00"]}`},
+		{name: "invalid timezone", policy: `{"timezone": "Mars/Olympus_Mons"}`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := parseSchedulingPolicies(1, `{"updateSchedulingPolicies": [`+tc.policy+`]}`); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestSchedulingPolicy_quietUntil(t *testing.T) {
+	policy, err := newSchedulingPolicy(1, rawSchedulingPolicy{
+		QuietHours: []string{"22:00-06:00", "12:00-13:00"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		now       time.Time
+		wantQuiet bool
+		wantUntil time.Time
+	}{
+		{now: day.Add(23 * time.Hour), wantQuiet: true, wantUntil: day.Add(30 * time.Hour)},
+		{now: day.Add(2 * time.Hour), wantQuiet: true, wantUntil: day.Add(6 * time.Hour)},
+		{now: day.Add(6 * time.Hour), wantQuiet: false},
+		{now: day.Add(12*time.Hour + 30*time.Minute), wantQuiet: true, wantUntil: day.Add(13 * time.Hour)},
+		{now: day.Add(18 * time.Hour), wantQuiet: false},
+	} {
+		until, quiet := policy.quietUntil(tc.now)
+		if quiet != tc.wantQuiet {
+			t.Errorf("%s: want quiet %t, have %t", tc.now, tc.wantQuiet, quiet)
+		}
+		if quiet && !until.Equal(tc.wantUntil) {
+			t.Errorf("%s: want until %s, have %s", tc.now, tc.wantUntil, until)
+		}
+	}
+
+	t.Run("timezone", func(t *testing.T) {
+		policy, err := newSchedulingPolicy(1, rawSchedulingPolicy{
+			QuietHours: []string{"08:00-18:00"},
+			Timezone:   "America/New_York",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// 13:00 UTC is 09:00 in New York during daylight saving time.
+		until, quiet := policy.quietUntil(day.Add(13 * time.Hour))
+		if !quiet {
+			t.Fatal("expected to be in quiet hours")
+		}
+		if want := day.Add(22 * time.Hour); !until.Equal(want) {
+			t.Fatalf("want until %s, have %s", want, until)
+		}
+	})
+}
+
+func TestSchedulingPolicies_match(t *testing.T) {
+	var ps schedulingPolicies
+	ps.set(map[int64][]*schedulingPolicy{
+		1: mustParseSchedulingPolicies(t, 1, `{"updateSchedulingPolicies": [{"pattern": "^a$", "priority": "high"}]}`),
+		2: mustParseSchedulingPolicies(t, 2, `{"updateSchedulingPolicies": [{"pattern": "^b$", "priority": "low"}, {"priority": "normal"}]}`),
+	})
+
+	for _, tc := range []struct {
+		name        string
+		repo        configuredRepo
+		wantPolicy  bool
+		wantPattern string
+	}{
+		{
+			name:        "lowest external service ID first",
+			repo:        configuredRepo{ID: 1, Name: "a", ExternalServiceIDs: []int64{2, 1}},
+			wantPolicy:  true,
+			wantPattern: "^a$",
+		},
+		{
+			name:        "falls through to other external service",
+			repo:        configuredRepo{ID: 2, Name: "b", ExternalServiceIDs: []int64{1, 2}},
+			wantPolicy:  true,
+			wantPattern: "^b$",
+		},
+		{
+			name:       "catch-all policy",
+			repo:       configuredRepo{ID: 3, Name: "c", ExternalServiceIDs: []int64{2}},
+			wantPolicy: true,
+		},
+		{
+			name: "no matching policy",
+			repo: configuredRepo{ID: 3, Name: "c", ExternalServiceIDs: []int64{1}},
+		},
+		{
+			name: "unknown external services",
+			repo: configuredRepo{ID: 1, Name: "a"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := ps.match(tc.repo)
+			if (p != nil) != tc.wantPolicy {
+				t.Fatalf("want policy %t, have %+v", tc.wantPolicy, p)
+			}
+			if p != nil && p.Pattern != tc.wantPattern {
+				t.Fatalf("want pattern %q, have %q", tc.wantPattern, p.Pattern)
+			}
+		})
+	}
+
+	var nilPolicies *schedulingPolicies
+	if p := nilPolicies.match(configuredRepo{ID: 1, Name: "a", ExternalServiceIDs: []int64{1}}); p != nil {
+		t.Fatalf("expected no policy, have %+v", p)
+	}
+}
+
+func TestUpdateScheduler_runSchedulePolicies(t *testing.T) {
+	a := configuredRepo{ID: 1, Name: "a", ExternalServiceIDs: []int64{1}}
+	b := configuredRepo{ID: 2, Name: "b", ExternalServiceIDs: []int64{1}}
+	c := configuredRepo{ID: 3, Name: "c"}
+
+	r, stop := startRecording()
+	defer stop()
+
+	s := NewUpdateScheduler(logtest.Scoped(t), dbmocks.NewMockDB(), gitserver.NewMockClient())
+	s.policies.set(map[int64][]*schedulingPolicy{
+		1: mustParseSchedulingPolicies(t, 1, `{"updateSchedulingPolicies": [
+			{"pattern": "^a$", "priority": "high"},
+			{"pattern": "^b$", "quietHours": ["00:00-02:00"]}
+		]}`),
+	})
+
+	setupInitialSchedule(s, []*scheduledRepoUpdate{
+		{Repo: c, Interval: time.Minute, Due: defaultTime.Add(-3 * time.Minute)},
+		{Repo: b, Interval: time.Minute, Due: defaultTime.Add(-2 * time.Minute)},
+		{Repo: a, Interval: time.Minute, Due: defaultTime.Add(-1 * time.Minute)},
+	})
+
+	s.runSchedule()
+
+	// defaultTime is in the quiet hours of b, so its update is postponed to
+	// the end of them.
+	quietEnd := time.Date(2000, 1, 1, 2, 0, 0, 0, time.UTC)
+	verifySchedule(t, s, []*scheduledRepoUpdate{
+		{Repo: a, Interval: time.Minute, Due: defaultTime.Add(time.Minute)},
+		{Repo: c, Interval: time.Minute, Due: defaultTime.Add(time.Minute)},
+		{Repo: b, Interval: time.Minute, Due: quietEnd},
+	})
+	verifyQueue(t, s, []*repoUpdate{
+		{Repo: a, Priority: priorityElevated, Seq: 2},
+		{Repo: c, Priority: priorityLow, Seq: 1},
+	})
+	verifyRecording(t, s, []time.Duration{time.Minute}, func(s *UpdateScheduler) []chan struct{} {
+		return []chan struct{}{s.updateQueue.notifyEnqueue, s.updateQueue.notifyEnqueue, s.schedule.wakeup}
+	}, r)
+}
+
+func TestSchedule_updateIntervalPolicies(t *testing.T) {
+	a := configuredRepo{ID: 1, Name: "a", ExternalServiceIDs: []int64{1}}
+
+	_, stop := startRecording()
+	defer stop()
+
+	s := NewUpdateScheduler(logtest.Scoped(t), dbmocks.NewMockDB(), gitserver.NewMockClient())
+	s.schedule.randGenerator = &mockRandomGenerator{}
+	s.policies.set(map[int64][]*schedulingPolicy{
+		1: mustParseSchedulingPolicies(t, 1, `{"updateSchedulingPolicies": [{"minInterval": 10, "maxInterval": 20}]}`),
+	})
+	setupInitialSchedule(s, []*scheduledRepoUpdate{
+		{Repo: a, Interval: minDelay, Due: defaultTime},
+	})
+
+	for _, tc := range []struct {
+		interval time.Duration
+		want     time.Duration
+	}{
+		{interval: time.Minute, want: 10 * time.Minute},
+		{interval: 15 * time.Minute, want: 15 * time.Minute},
+		{interval: maxDelay, want: 20 * time.Minute},
+	} {
+		// Manual update requests don't know the code hosts of the repo, so
+		// the policy must be found through the scheduled repo.
+		s.schedule.updateInterval(configuredRepo{ID: a.ID, Name: a.Name}, tc.interval)
+
+		if have, _ := s.schedule.getCurrentInterval(a); have != tc.want {
+			t.Errorf("interval %s: want %s, have %s", tc.interval, tc.want, have)
+		}
+	}
+}
+
+func mustParseSchedulingPolicies(t *testing.T, externalServiceID int64, config string) []*schedulingPolicy {
+	t.Helper()
+
+	policies, err := parseSchedulingPolicies(externalServiceID, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return policies
+}
@@ -23,6 +23,10 @@ type schedule struct {
 	wakeup chan struct{}
 	logger log.Logger
 
+	// policies are the update scheduling policies used to bound the update
+	// intervals of repos.
+	policies *schedulingPolicies
+
 	// random source used to add jitter to repo update intervals.
 	randGenerator interface {
 		Int63n(n int64) int64
@@ -39,6 +43,9 @@ func (s *schedule) upsert(repo configuredRepo) (updated bool) {
 	defer s.mu.Unlock()
 
 	if update := s.index[repo.ID]; update != nil {
+		if repo.ExternalServiceIDs == nil {
+			repo.ExternalServiceIDs = update.Repo.ExternalServiceIDs
+		}
 		update.Repo = repo
 		return true
 	}
@@ -129,14 +136,9 @@ func (s *schedule) updateInterval(repo configuredRepo, interval time.Duration) {
 
 	s.mu.Lock()
 	if update := s.index[repo.ID]; update != nil {
-		switch {
-		case interval > maxDelay:
-			update.Interval = maxDelay
-		case interval < minDelay:
-			update.Interval = minDelay
-		default:
-			update.Interval = interval
-		}
+		// We match against the scheduled repo since repo might come from a
+		// manual update request, which doesn't know the repo's code hosts.
+		update.Interval = s.policies.match(update.Repo).clamp(interval)
 
 		// Add a jitter of 5% on either side of the interval to avoid
 		// repos getting updated at the same time.
@@ -48,11 +48,16 @@ const (
 //
 // A worker continuously dequeues repos and sends updates to gitserver, but its concurrency
 // is limited by the gitMaxConcurrentClones site configuration.
+//
+// Code host configurations can declare update scheduling policies that
+// override the interval bounds and queue priority of the repos they match, and
+// postpone scheduled updates during quiet hours.
 type UpdateScheduler struct {
 	db              database.DB
 	gitserverClient gitserver.Client
 	updateQueue     *updateQueue
 	schedule        *schedule
+	policies        *schedulingPolicies
 	logger          log.Logger
 	cancelCtx       context.CancelFunc
 }
@@ -63,6 +68,10 @@ type UpdateScheduler struct {
 type configuredRepo struct {
 	ID   api.RepoID
 	Name api.RepoName
+
+	// ExternalServiceIDs are the IDs of the code hosts the repo is synced
+	// from. They're used to find the update scheduling policy of the repo.
+	ExternalServiceIDs []int64 `json:",omitempty"`
 }
 
 // notifyChanBuffer controls the buffer size of notification channels.
@@ -73,6 +82,7 @@ const notifyChanBuffer = 1
 // NewUpdateScheduler returns a new scheduler.
 func NewUpdateScheduler(logger log.Logger, db database.DB, gitserverClient gitserver.Client) *UpdateScheduler {
 	updateSchedLogger := logger.Scoped("UpdateScheduler", "repo update scheduler")
+	policies := &schedulingPolicies{}
 
 	return &UpdateScheduler{
 		db:              db,
@@ -85,9 +95,11 @@ func NewUpdateScheduler(logger log.Logger, db database.DB, gitserverClient gitse
 			index:         make(map[api.RepoID]*scheduledRepoUpdate),
 			wakeup:        make(chan struct{}, notifyChanBuffer),
 			randGenerator: rand.New(rand.NewSource(time.Now().UnixNano())),
+			policies:      policies,
 			logger:        updateSchedLogger.Scoped("Schedule", ""),
 		},
-		logger: updateSchedLogger,
+		policies: policies,
+		logger:   updateSchedLogger,
 	}
 }
 
@@ -99,6 +111,7 @@ func (s *UpdateScheduler) Start() {
 
 	go s.runUpdateLoop(ctx)
 	go s.runScheduleLoop(ctx)
+	go s.runPolicyRefreshLoop(ctx)
 }
 
 func (s *UpdateScheduler) Stop() {
@@ -137,8 +150,17 @@ func (s *UpdateScheduler) runSchedule() {
 			break
 		}
 
+		policy := s.policies.match(repoUpdate.Repo)
+		if until, ok := policy.quietUntil(timeNow()); ok {
+			// Postpone the update until the end of the quiet hours.
+			schedQuietHoursPostponed.Inc()
+			repoUpdate.Due = until
+			heap.Fix(s.schedule, 0)
+			continue
+		}
+
 		schedAutoFetch.Inc()
-		s.updateQueue.enqueue(repoUpdate.Repo, priorityLow)
+		s.updateQueue.enqueue(repoUpdate.Repo, policy.queuePriority())
 		repoUpdate.Due = timeNow().Add(repoUpdate.Interval)
 		heap.Fix(s.schedule, 0)
 	}
@@ -324,7 +346,7 @@ func (s *UpdateScheduler) upsert(r *types.Repo, enqueue bool) {
 	if !enqueue {
 		return
 	}
-	updated = s.updateQueue.enqueue(repo, priorityLow)
+	updated = s.updateQueue.enqueue(repo, s.policies.match(repo).queuePriority())
 	logger.Debug("scheduler.updateQueue.enqueued", log.Bool("updated", updated))
 }
 
@@ -346,6 +368,9 @@ func configuredRepoFromRepo(r *types.Repo) configuredRepo {
 		ID:   r.ID,
 		Name: r.Name,
 	}
+	if len(r.Sources) > 0 {
+		repo.ExternalServiceIDs = r.ExternalServiceIDs()
+	}
 
 	return repo
 }
@@ -431,6 +456,9 @@ func (s *UpdateScheduler) ScheduleInfo(id api.RepoID) *protocol.RepoUpdateSchedu
 			IntervalSeconds: int(update.Interval / time.Second),
 			Due:             update.Due,
 		}
+		if policy := s.policies.match(update.Repo); policy != nil {
+			result.Schedule.Policy = policy.toProtocol()
+		}
 	}
 	s.schedule.mu.Unlock()
 
@@ -451,7 +479,15 @@ func (s *UpdateScheduler) ScheduleInfo(id api.RepoID) *protocol.RepoUpdateSchedu
 type priority int
 
 const (
-	priorityLow priority = iota
+	// priorityDeferred is used for scheduled updates of repos whose update
+	// scheduling policy has a low priority.
+	priorityDeferred priority = iota - 1
+	// priorityLow is used for scheduled updates by default.
+	priorityLow
+	// priorityElevated is used for scheduled updates of repos whose update
+	// scheduling policy has a high priority.
+	priorityElevated
+	// priorityHigh is used for updates that were explicitly requested.
 	priorityHigh
 )
 
@@ -80,6 +80,8 @@ func NewSource(ctx context.Context, logger log.Logger, db database.DB, svc *type
 		return NewRubyPackagesSource(ctx, svc, cf)
 	case extsvc.KindOther:
 		return NewOtherSource(ctx, svc, cf, logger.Scoped("OtherSource", ""))
+	case extsvc.VariantGitManifest.AsKind():
+		return NewGitManifestSource(ctx, svc, cf)
 	case extsvc.VariantLocalGit.AsKind():
 		return NewLocalGitSource(ctx, logger.Scoped("LocalSource", "local repo source"), svc)
 	default:
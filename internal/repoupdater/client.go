@@ -390,6 +390,43 @@ func (c *Client) ExternalServiceNamespaces(ctx context.Context, args protocol.Ex
 	return result, err
 }
 
+// MockPermsSyncDryRun mocks (*Client).PermsSyncDryRun for tests.
+var MockPermsSyncDryRun func(ctx context.Context, args protocol.PermsSyncDryRunArgs) (*protocol.PermsSyncDryRunResult, error)
+
+// PermsSyncDryRun fetches the permissions of a user or repository from the code
+// host and returns how they differ from the stored ones, without saving them.
+func (c *Client) PermsSyncDryRun(ctx context.Context, args protocol.PermsSyncDryRunArgs) (result *protocol.PermsSyncDryRunResult, err error) {
+	if MockPermsSyncDryRun != nil {
+		return MockPermsSyncDryRun(ctx, args)
+	}
+
+	if conf.IsGRPCEnabled(ctx) {
+		client, err := c.grpcClient()
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.PermsSyncDryRun(ctx, args.ToProto())
+		if err != nil {
+			return nil, err
+		}
+
+		return protocol.PermsSyncDryRunResultFromProto(resp), nil
+	}
+
+	resp, err := c.httpPost(ctx, "perms-sync-dry-run", args)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err == nil && result != nil && result.Error != "" {
+		err = errors.New(result.Error)
+	}
+	return result, err
+}
+
 // MockExternalServiceRepositories mocks (*Client).ExternalServiceRepositories for tests.
 var MockExternalServiceRepositories func(ctx context.Context, args protocol.ExternalServiceRepositoriesArgs) (*protocol.ExternalServiceRepositoriesResult, error)
 
@@ -39,6 +39,17 @@ func (r *RepoUpdateSchedulerInfoResult) ToProto() *proto.RepoUpdateSchedulerInfo
 			IntervalSeconds: int64(r.Schedule.IntervalSeconds),
 			Due:             timestamppb.New(r.Schedule.Due),
 		}
+		if p := r.Schedule.Policy; p != nil {
+			res.Schedule.Policy = &proto.RepoSchedulePolicy{
+				ExternalServiceId:  p.ExternalServiceID,
+				Pattern:            p.Pattern,
+				MinIntervalSeconds: int64(p.MinIntervalSeconds),
+				MaxIntervalSeconds: int64(p.MaxIntervalSeconds),
+				Priority:           p.Priority,
+				QuietHours:         p.QuietHours,
+				Timezone:           p.Timezone,
+			}
+		}
 	}
 
 	if r.Queue != nil {
@@ -62,6 +73,17 @@ func RepoUpdateSchedulerInfoResultFromProto(p *proto.RepoUpdateSchedulerInfoResp
 			IntervalSeconds: int(p.Schedule.GetIntervalSeconds()),
 			Due:             p.Schedule.GetDue().AsTime(),
 		}
+		if policy := p.Schedule.GetPolicy(); policy != nil {
+			r.Schedule.Policy = &RepoSchedulePolicy{
+				ExternalServiceID:  policy.GetExternalServiceId(),
+				Pattern:            policy.GetPattern(),
+				MinIntervalSeconds: int(policy.GetMinIntervalSeconds()),
+				MaxIntervalSeconds: int(policy.GetMaxIntervalSeconds()),
+				Priority:           policy.GetPriority(),
+				QuietHours:         policy.GetQuietHours(),
+				Timezone:           policy.GetTimezone(),
+			}
+		}
 	}
 
 	if p.Queue != nil {
@@ -81,6 +103,21 @@ type RepoScheduleState struct {
 	Total           int
 	IntervalSeconds int
 	Due             time.Time
+	// Policy is the code host update scheduling policy that applies to the
+	// repo, if any.
+	Policy *RepoSchedulePolicy `json:",omitempty"`
+}
+
+// RepoSchedulePolicy describes an update scheduling policy declared in a code
+// host configuration.
+type RepoSchedulePolicy struct {
+	ExternalServiceID  int64
+	Pattern            string
+	MinIntervalSeconds int
+	MaxIntervalSeconds int
+	Priority           string
+	QuietHours         []string
+	Timezone           string
 }
 
 type RepoQueueState struct {
@@ -414,6 +451,54 @@ type PermsSyncResponse struct {
 	Error string
 }
 
+// PermsSyncDryRunArgs is a request to fetch the permissions of either a user or
+// a repository from the code host and compare them with the stored ones,
+// without saving them.
+type PermsSyncDryRunArgs struct {
+	UserID int32
+	RepoID api.RepoID
+}
+
+func (a *PermsSyncDryRunArgs) ToProto() *proto.PermsSyncDryRunRequest {
+	return &proto.PermsSyncDryRunRequest{
+		UserId: a.UserID,
+		RepoId: int32(a.RepoID),
+	}
+}
+
+func PermsSyncDryRunArgsFromProto(p *proto.PermsSyncDryRunRequest) *PermsSyncDryRunArgs {
+	return &PermsSyncDryRunArgs{
+		UserID: p.GetUserId(),
+		RepoID: api.RepoID(p.GetRepoId()),
+	}
+}
+
+// PermsSyncDryRunResult is the difference between the stored permissions and
+// the permissions a sync would save. For a user, the IDs are repository IDs;
+// for a repository, they are user IDs.
+type PermsSyncDryRunResult struct {
+	Added     []int32
+	Removed   []int32
+	Unchanged int
+	Error     string `json:",omitempty"`
+}
+
+func (r *PermsSyncDryRunResult) ToProto() *proto.PermsSyncDryRunResponse {
+	return &proto.PermsSyncDryRunResponse{
+		Added:     r.Added,
+		Removed:   r.Removed,
+		Unchanged: int32(r.Unchanged),
+	}
+}
+
+func PermsSyncDryRunResultFromProto(p *proto.PermsSyncDryRunResponse) *PermsSyncDryRunResult {
+	return &PermsSyncDryRunResult{
+		Added:     p.GetAdded(),
+		Removed:   p.GetRemoved(),
+		Unchanged: int(p.GetUnchanged()),
+	}
+}
+
 // ExternalServiceSyncRequest is a request to sync a specific external service eagerly.
 //
 // The FrontendAPI is one of the issuers of this request. It does so when creating or
@@ -133,6 +133,7 @@ type RepoScheduleState struct {
 	Total           int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	IntervalSeconds int64                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
 	Due             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due,proto3" json:"due,omitempty"`
+	Policy          *RepoSchedulePolicy    `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
 func (x *RepoScheduleState) Reset() {
@@ -195,6 +196,108 @@ func (x *RepoScheduleState) GetDue() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *RepoScheduleState) GetPolicy() *RepoSchedulePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type RepoSchedulePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalServiceId  int64    `protobuf:"varint,1,opt,name=external_service_id,json=externalServiceId,proto3" json:"external_service_id,omitempty"`
+	Pattern            string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MinIntervalSeconds int64    `protobuf:"varint,3,opt,name=min_interval_seconds,json=minIntervalSeconds,proto3" json:"min_interval_seconds,omitempty"`
+	MaxIntervalSeconds int64    `protobuf:"varint,4,opt,name=max_interval_seconds,json=maxIntervalSeconds,proto3" json:"max_interval_seconds,omitempty"`
+	Priority           string   `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	QuietHours         []string `protobuf:"bytes,6,rep,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	Timezone           string   `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *RepoSchedulePolicy) Reset() {
+	*x = RepoSchedulePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repoupdater_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoSchedulePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoSchedulePolicy) ProtoMessage() {}
+
+func (x *RepoSchedulePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_repoupdater_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoSchedulePolicy.ProtoReflect.Descriptor instead.
+func (*RepoSchedulePolicy) Descriptor() ([]byte, []int) {
+	return file_repoupdater_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RepoSchedulePolicy) GetExternalServiceId() int64 {
+	if x != nil {
+		return x.ExternalServiceId
+	}
+	return 0
+}
+
+func (x *RepoSchedulePolicy) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *RepoSchedulePolicy) GetMinIntervalSeconds() int64 {
+	if x != nil {
+		return x.MinIntervalSeconds
+	}
+	return 0
+}
+
+func (x *RepoSchedulePolicy) GetMaxIntervalSeconds() int64 {
+	if x != nil {
+		return x.MaxIntervalSeconds
+	}
+	return 0
+}
+
+func (x *RepoSchedulePolicy) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *RepoSchedulePolicy) GetQuietHours() []string {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *RepoSchedulePolicy) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 type RepoQueueState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -209,7 +312,7 @@ type RepoQueueState struct {
 func (x *RepoQueueState) Reset() {
 	*x = RepoQueueState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[3]
+		mi := &file_repoupdater_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -222,7 +325,7 @@ func (x *RepoQueueState) String() string {
 func (*RepoQueueState) ProtoMessage() {}
 
 func (x *RepoQueueState) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[3]
+	mi := &file_repoupdater_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +338,7 @@ func (x *RepoQueueState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoQueueState.ProtoReflect.Descriptor instead.
 func (*RepoQueueState) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{3}
+	return file_repoupdater_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RepoQueueState) GetIndex() int64 {
@@ -278,7 +381,7 @@ type RepoLookupRequest struct {
 func (x *RepoLookupRequest) Reset() {
 	*x = RepoLookupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[4]
+		mi := &file_repoupdater_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -291,7 +394,7 @@ func (x *RepoLookupRequest) String() string {
 func (*RepoLookupRequest) ProtoMessage() {}
 
 func (x *RepoLookupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[4]
+	mi := &file_repoupdater_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +407,7 @@ func (x *RepoLookupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoLookupRequest.ProtoReflect.Descriptor instead.
 func (*RepoLookupRequest) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{4}
+	return file_repoupdater_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RepoLookupRequest) GetRepo() string {
@@ -332,7 +435,7 @@ type RepoLookupResponse struct {
 func (x *RepoLookupResponse) Reset() {
 	*x = RepoLookupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[5]
+		mi := &file_repoupdater_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -345,7 +448,7 @@ func (x *RepoLookupResponse) String() string {
 func (*RepoLookupResponse) ProtoMessage() {}
 
 func (x *RepoLookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[5]
+	mi := &file_repoupdater_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +461,7 @@ func (x *RepoLookupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoLookupResponse.ProtoReflect.Descriptor instead.
 func (*RepoLookupResponse) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{5}
+	return file_repoupdater_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RepoLookupResponse) GetRepo() *RepoInfo {
@@ -419,7 +522,7 @@ type RepoInfo struct {
 func (x *RepoInfo) Reset() {
 	*x = RepoInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[6]
+		mi := &file_repoupdater_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -432,7 +535,7 @@ func (x *RepoInfo) String() string {
 func (*RepoInfo) ProtoMessage() {}
 
 func (x *RepoInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[6]
+	mi := &file_repoupdater_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +548,7 @@ func (x *RepoInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoInfo.ProtoReflect.Descriptor instead.
 func (*RepoInfo) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{6}
+	return file_repoupdater_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RepoInfo) GetId() int32 {
@@ -524,7 +627,7 @@ type VCSInfo struct {
 func (x *VCSInfo) Reset() {
 	*x = VCSInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[7]
+		mi := &file_repoupdater_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -537,7 +640,7 @@ func (x *VCSInfo) String() string {
 func (*VCSInfo) ProtoMessage() {}
 
 func (x *VCSInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[7]
+	mi := &file_repoupdater_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +653,7 @@ func (x *VCSInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VCSInfo.ProtoReflect.Descriptor instead.
 func (*VCSInfo) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{7}
+	return file_repoupdater_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *VCSInfo) GetUrl() string {
@@ -579,7 +682,7 @@ type RepoLinks struct {
 func (x *RepoLinks) Reset() {
 	*x = RepoLinks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[8]
+		mi := &file_repoupdater_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -592,7 +695,7 @@ func (x *RepoLinks) String() string {
 func (*RepoLinks) ProtoMessage() {}
 
 func (x *RepoLinks) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[8]
+	mi := &file_repoupdater_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +708,7 @@ func (x *RepoLinks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoLinks.ProtoReflect.Descriptor instead.
 func (*RepoLinks) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{8}
+	return file_repoupdater_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RepoLinks) GetRoot() string {
@@ -665,7 +768,7 @@ type ExternalRepoSpec struct {
 func (x *ExternalRepoSpec) Reset() {
 	*x = ExternalRepoSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[9]
+		mi := &file_repoupdater_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -678,7 +781,7 @@ func (x *ExternalRepoSpec) String() string {
 func (*ExternalRepoSpec) ProtoMessage() {}
 
 func (x *ExternalRepoSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[9]
+	mi := &file_repoupdater_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +794,7 @@ func (x *ExternalRepoSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalRepoSpec.ProtoReflect.Descriptor instead.
 func (*ExternalRepoSpec) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{9}
+	return file_repoupdater_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ExternalRepoSpec) GetId() string {
@@ -726,7 +829,7 @@ type EnqueueRepoUpdateRequest struct {
 func (x *EnqueueRepoUpdateRequest) Reset() {
 	*x = EnqueueRepoUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[10]
+		mi := &file_repoupdater_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -739,7 +842,7 @@ func (x *EnqueueRepoUpdateRequest) String() string {
 func (*EnqueueRepoUpdateRequest) ProtoMessage() {}
 
 func (x *EnqueueRepoUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[10]
+	mi := &file_repoupdater_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,7 +855,7 @@ func (x *EnqueueRepoUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueRepoUpdateRequest.ProtoReflect.Descriptor instead.
 func (*EnqueueRepoUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{10}
+	return file_repoupdater_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EnqueueRepoUpdateRequest) GetRepo() string {
@@ -777,7 +880,7 @@ type EnqueueRepoUpdateResponse struct {
 func (x *EnqueueRepoUpdateResponse) Reset() {
 	*x = EnqueueRepoUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[11]
+		mi := &file_repoupdater_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -790,7 +893,7 @@ func (x *EnqueueRepoUpdateResponse) String() string {
 func (*EnqueueRepoUpdateResponse) ProtoMessage() {}
 
 func (x *EnqueueRepoUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[11]
+	mi := &file_repoupdater_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +906,7 @@ func (x *EnqueueRepoUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueRepoUpdateResponse.ProtoReflect.Descriptor instead.
 func (*EnqueueRepoUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{11}
+	return file_repoupdater_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EnqueueRepoUpdateResponse) GetId() int32 {
@@ -831,7 +934,7 @@ type EnqueueChangesetSyncRequest struct {
 func (x *EnqueueChangesetSyncRequest) Reset() {
 	*x = EnqueueChangesetSyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[12]
+		mi := &file_repoupdater_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -844,7 +947,7 @@ func (x *EnqueueChangesetSyncRequest) String() string {
 func (*EnqueueChangesetSyncRequest) ProtoMessage() {}
 
 func (x *EnqueueChangesetSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[12]
+	mi := &file_repoupdater_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +960,7 @@ func (x *EnqueueChangesetSyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueChangesetSyncRequest.ProtoReflect.Descriptor instead.
 func (*EnqueueChangesetSyncRequest) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{12}
+	return file_repoupdater_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EnqueueChangesetSyncRequest) GetIds() []int64 {
@@ -876,7 +979,7 @@ type EnqueueChangesetSyncResponse struct {
 func (x *EnqueueChangesetSyncResponse) Reset() {
 	*x = EnqueueChangesetSyncResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[13]
+		mi := &file_repoupdater_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -889,7 +992,7 @@ func (x *EnqueueChangesetSyncResponse) String() string {
 func (*EnqueueChangesetSyncResponse) ProtoMessage() {}
 
 func (x *EnqueueChangesetSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[13]
+	mi := &file_repoupdater_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +1005,7 @@ func (x *EnqueueChangesetSyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueChangesetSyncResponse.ProtoReflect.Descriptor instead.
 func (*EnqueueChangesetSyncResponse) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{13}
+	return file_repoupdater_proto_rawDescGZIP(), []int{14}
 }
 
 type FetchPermsOptions struct {
@@ -916,7 +1019,7 @@ type FetchPermsOptions struct {
 func (x *FetchPermsOptions) Reset() {
 	*x = FetchPermsOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[14]
+		mi := &file_repoupdater_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -929,7 +1032,7 @@ func (x *FetchPermsOptions) String() string {
 func (*FetchPermsOptions) ProtoMessage() {}
 
 func (x *FetchPermsOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[14]
+	mi := &file_repoupdater_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,7 +1045,7 @@ func (x *FetchPermsOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchPermsOptions.ProtoReflect.Descriptor instead.
 func (*FetchPermsOptions) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{14}
+	return file_repoupdater_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FetchPermsOptions) GetInvalidateCaches() bool {
@@ -963,7 +1066,7 @@ type SyncExternalServiceRequest struct {
 func (x *SyncExternalServiceRequest) Reset() {
 	*x = SyncExternalServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[15]
+		mi := &file_repoupdater_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -976,7 +1079,7 @@ func (x *SyncExternalServiceRequest) String() string {
 func (*SyncExternalServiceRequest) ProtoMessage() {}
 
 func (x *SyncExternalServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[15]
+	mi := &file_repoupdater_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,7 +1092,7 @@ func (x *SyncExternalServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncExternalServiceRequest.ProtoReflect.Descriptor instead.
 func (*SyncExternalServiceRequest) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{15}
+	return file_repoupdater_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SyncExternalServiceRequest) GetExternalServiceId() int64 {
@@ -1008,7 +1111,7 @@ type SyncExternalServiceResponse struct {
 func (x *SyncExternalServiceResponse) Reset() {
 	*x = SyncExternalServiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[16]
+		mi := &file_repoupdater_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1021,7 +1124,7 @@ func (x *SyncExternalServiceResponse) String() string {
 func (*SyncExternalServiceResponse) ProtoMessage() {}
 
 func (x *SyncExternalServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[16]
+	mi := &file_repoupdater_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1034,7 +1137,7 @@ func (x *SyncExternalServiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncExternalServiceResponse.ProtoReflect.Descriptor instead.
 func (*SyncExternalServiceResponse) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{16}
+	return file_repoupdater_proto_rawDescGZIP(), []int{17}
 }
 
 type ExternalServiceNamespacesRequest struct {
@@ -1050,7 +1153,7 @@ type ExternalServiceNamespacesRequest struct {
 func (x *ExternalServiceNamespacesRequest) Reset() {
 	*x = ExternalServiceNamespacesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[17]
+		mi := &file_repoupdater_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1063,7 +1166,7 @@ func (x *ExternalServiceNamespacesRequest) String() string {
 func (*ExternalServiceNamespacesRequest) ProtoMessage() {}
 
 func (x *ExternalServiceNamespacesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[17]
+	mi := &file_repoupdater_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1179,7 @@ func (x *ExternalServiceNamespacesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalServiceNamespacesRequest.ProtoReflect.Descriptor instead.
 func (*ExternalServiceNamespacesRequest) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{17}
+	return file_repoupdater_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ExternalServiceNamespacesRequest) GetExternalServiceId() int64 {
@@ -1111,7 +1214,7 @@ type ExternalServiceNamespacesResponse struct {
 func (x *ExternalServiceNamespacesResponse) Reset() {
 	*x = ExternalServiceNamespacesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[18]
+		mi := &file_repoupdater_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1124,7 +1227,7 @@ func (x *ExternalServiceNamespacesResponse) String() string {
 func (*ExternalServiceNamespacesResponse) ProtoMessage() {}
 
 func (x *ExternalServiceNamespacesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[18]
+	mi := &file_repoupdater_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1240,7 @@ func (x *ExternalServiceNamespacesResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ExternalServiceNamespacesResponse.ProtoReflect.Descriptor instead.
 func (*ExternalServiceNamespacesResponse) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{18}
+	return file_repoupdater_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ExternalServiceNamespacesResponse) GetNamespaces() []*ExternalServiceNamespace {
@@ -1161,7 +1264,7 @@ type ExternalServiceNamespace struct {
 func (x *ExternalServiceNamespace) Reset() {
 	*x = ExternalServiceNamespace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[19]
+		mi := &file_repoupdater_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1174,7 +1277,7 @@ func (x *ExternalServiceNamespace) String() string {
 func (*ExternalServiceNamespace) ProtoMessage() {}
 
 func (x *ExternalServiceNamespace) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[19]
+	mi := &file_repoupdater_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,7 +1290,7 @@ func (x *ExternalServiceNamespace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalServiceNamespace.ProtoReflect.Descriptor instead.
 func (*ExternalServiceNamespace) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{19}
+	return file_repoupdater_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ExternalServiceNamespace) GetId() int64 {
@@ -1227,7 +1330,7 @@ type ExternalServiceRepositoriesRequest struct {
 func (x *ExternalServiceRepositoriesRequest) Reset() {
 	*x = ExternalServiceRepositoriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[20]
+		mi := &file_repoupdater_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1240,7 +1343,7 @@ func (x *ExternalServiceRepositoriesRequest) String() string {
 func (*ExternalServiceRepositoriesRequest) ProtoMessage() {}
 
 func (x *ExternalServiceRepositoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[20]
+	mi := &file_repoupdater_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,7 +1356,7 @@ func (x *ExternalServiceRepositoriesRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ExternalServiceRepositoriesRequest.ProtoReflect.Descriptor instead.
 func (*ExternalServiceRepositoriesRequest) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{20}
+	return file_repoupdater_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ExternalServiceRepositoriesRequest) GetExternalServiceId() int64 {
@@ -1309,7 +1412,7 @@ type ExternalServiceRepositoriesResponse struct {
 func (x *ExternalServiceRepositoriesResponse) Reset() {
 	*x = ExternalServiceRepositoriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[21]
+		mi := &file_repoupdater_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1322,7 +1425,7 @@ func (x *ExternalServiceRepositoriesResponse) String() string {
 func (*ExternalServiceRepositoriesResponse) ProtoMessage() {}
 
 func (x *ExternalServiceRepositoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[21]
+	mi := &file_repoupdater_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1335,7 +1438,7 @@ func (x *ExternalServiceRepositoriesResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ExternalServiceRepositoriesResponse.ProtoReflect.Descriptor instead.
 func (*ExternalServiceRepositoriesResponse) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{21}
+	return file_repoupdater_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ExternalServiceRepositoriesResponse) GetRepos() []*ExternalServiceRepository {
@@ -1359,7 +1462,7 @@ type ExternalServiceRepository struct {
 func (x *ExternalServiceRepository) Reset() {
 	*x = ExternalServiceRepository{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repoupdater_proto_msgTypes[22]
+		mi := &file_repoupdater_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1372,7 +1475,7 @@ func (x *ExternalServiceRepository) String() string {
 func (*ExternalServiceRepository) ProtoMessage() {}
 
 func (x *ExternalServiceRepository) ProtoReflect() protoreflect.Message {
-	mi := &file_repoupdater_proto_msgTypes[22]
+	mi := &file_repoupdater_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,7 +1488,7 @@ func (x *ExternalServiceRepository) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalServiceRepository.ProtoReflect.Descriptor instead.
 func (*ExternalServiceRepository) Descriptor() ([]byte, []int) {
-	return file_repoupdater_proto_rawDescGZIP(), []int{22}
+	return file_repoupdater_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ExternalServiceRepository) GetId() int32 {
@@ -1409,6 +1512,126 @@ func (x *ExternalServiceRepository) GetExternalId() string {
 	return ""
 }
 
+type PermsSyncDryRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Exactly one of user_id and repo_id must be set.
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RepoId int32 `protobuf:"varint,2,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+}
+
+func (x *PermsSyncDryRunRequest) Reset() {
+	*x = PermsSyncDryRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repoupdater_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermsSyncDryRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermsSyncDryRunRequest) ProtoMessage() {}
+
+func (x *PermsSyncDryRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repoupdater_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermsSyncDryRunRequest.ProtoReflect.Descriptor instead.
+func (*PermsSyncDryRunRequest) Descriptor() ([]byte, []int) {
+	return file_repoupdater_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PermsSyncDryRunRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PermsSyncDryRunRequest) GetRepoId() int32 {
+	if x != nil {
+		return x.RepoId
+	}
+	return 0
+}
+
+type PermsSyncDryRunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// For a user, repository IDs; for a repository, user IDs.
+	Added     []int32 `protobuf:"varint,1,rep,packed,name=added,proto3" json:"added,omitempty"`
+	Removed   []int32 `protobuf:"varint,2,rep,packed,name=removed,proto3" json:"removed,omitempty"`
+	Unchanged int32   `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+}
+
+func (x *PermsSyncDryRunResponse) Reset() {
+	*x = PermsSyncDryRunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repoupdater_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermsSyncDryRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermsSyncDryRunResponse) ProtoMessage() {}
+
+func (x *PermsSyncDryRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repoupdater_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermsSyncDryRunResponse.ProtoReflect.Descriptor instead.
+func (*PermsSyncDryRunResponse) Descriptor() ([]byte, []int) {
+	return file_repoupdater_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PermsSyncDryRunResponse) GetAdded() []int32 {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *PermsSyncDryRunResponse) GetRemoved() []int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *PermsSyncDryRunResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
 var File_repoupdater_proto protoreflect.FileDescriptor
 
 var file_repoupdater_proto_rawDesc = []byte{
@@ -1429,7 +1652,7 @@ var file_repoupdater_proto_rawDesc = []byte{
 	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x51, 0x75,
 	0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x22,
-	0x98, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0xd4, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74,
 	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
@@ -1438,197 +1661,236 @@ var file_repoupdater_proto_rawDesc = []byte{
 	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x03,
 	0x64, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x64, 0x75, 0x65, 0x22, 0x74, 0x0a, 0x0e, 0x52, 0x65,
-	0x70, 0x6f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x22, 0x35, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52,
-	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72,
-	0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x26, 0x0a, 0x0f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x46,
-	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x75, 0x6e,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x11, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x1d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x65, 0x6d,
-	0x70, 0x6f, 0x72, 0x61, 0x72, 0x69, 0x6c, 0x79, 0x5f, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x69, 0x6c, 0x79, 0x55, 0x6e, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xc6, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6f,
-	0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x12, 0x1a,
-	0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x76, 0x63, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x43, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x07, 0x76, 0x63, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e,
-	0x6b, 0x73, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x65, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x70,
-	0x65, 0x63, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
-	0x22, 0x1b, 0x0a, 0x07, 0x56, 0x43, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x5f, 0x0a,
-	0x09, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x72,
-	0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x64,
-	0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x18, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x72, 0x65, 0x70, 0x6f, 0x22, 0x3f, 0x0a, 0x19, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x1b, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50,
-	0x65, 0x72, 0x6d, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69,
-	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x1a, 0x53, 0x79, 0x6e, 0x63,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x20, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
-	0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x16, 0x0a, 0x14, 0x5f,
-	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x21, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72,
-	0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x73, 0x22, 0x5f, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x64, 0x75, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x70,
+	0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x9b, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a,
+	0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x69, 0x65, 0x74,
+	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75,
+	0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x74, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x35, 0x0a, 0x11, 0x52, 0x65,
+	0x70, 0x6f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x22, 0xdd, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2d,
+	0x0a, 0x12, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x75, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x42, 0x0a,
+	0x1d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x69,
+	0x6c, 0x79, 0x5f, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x72, 0x69, 0x6c, 0x79, 0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0xc6, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
 	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x22, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
-	0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x16, 0x0a, 0x14,
-	0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x23, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x72,
-	0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x65, 0x70,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x32,
+	0x0a, 0x08, 0x76, 0x63, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x43, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x76, 0x63, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x05, 0x6c, 0x69,
+	0x6e, 0x6b, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x70,
 	0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x60, 0x0a, 0x19,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x32, 0xbe,
-	0x06, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x2e, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x53, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12,
-	0x21, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x72, 0x65,
-	0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x71, 0x0a, 0x14, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x72, 0x65, 0x70,
-	0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x1b, 0x0a, 0x07, 0x56, 0x43,
+	0x53, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x5f, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x72, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6c, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x64, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x70, 0x65, 0x63, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x2e,
+	0x0a, 0x18, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x3f,
+	0x0a, 0x19, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x2f, 0x0a, 0x1b, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x22, 0x1e, 0x0a, 0x1c, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x40, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x73, 0x22, 0x4c, 0x0a, 0x1a, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9b, 0x01, 0x0a, 0x20, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x6d, 0x0a,
+	0x21, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x73, 0x12, 0x30, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x18,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xee, 0x01,
+	0x0a, 0x22, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x66,
+	0x0a, 0x23, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x60, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d,
+	0x73, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x65, 0x70, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65,
+	0x70, 0x6f, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x17, 0x50, 0x65, 0x72, 0x6d, 0x73, 0x53, 0x79, 0x6e,
+	0x63, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x32, 0xa2, 0x07,
+	0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x2e, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x21,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x72, 0x65, 0x70,
+	0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x71, 0x0a, 0x14, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x30, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
 	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x72, 0x65, 0x70,
-	0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x72,
-	0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x0f, 0x50, 0x65, 0x72, 0x6d, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x12, 0x26, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x73,
+	0x53, 0x79, 0x6e, 0x63, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1643,62 +1905,68 @@ func file_repoupdater_proto_rawDescGZIP() []byte {
 	return file_repoupdater_proto_rawDescData
 }
 
-var file_repoupdater_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_repoupdater_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_repoupdater_proto_goTypes = []interface{}{
 	(*RepoUpdateSchedulerInfoRequest)(nil),      // 0: repoupdater.v1.RepoUpdateSchedulerInfoRequest
 	(*RepoUpdateSchedulerInfoResponse)(nil),     // 1: repoupdater.v1.RepoUpdateSchedulerInfoResponse
 	(*RepoScheduleState)(nil),                   // 2: repoupdater.v1.RepoScheduleState
-	(*RepoQueueState)(nil),                      // 3: repoupdater.v1.RepoQueueState
-	(*RepoLookupRequest)(nil),                   // 4: repoupdater.v1.RepoLookupRequest
-	(*RepoLookupResponse)(nil),                  // 5: repoupdater.v1.RepoLookupResponse
-	(*RepoInfo)(nil),                            // 6: repoupdater.v1.RepoInfo
-	(*VCSInfo)(nil),                             // 7: repoupdater.v1.VCSInfo
-	(*RepoLinks)(nil),                           // 8: repoupdater.v1.RepoLinks
-	(*ExternalRepoSpec)(nil),                    // 9: repoupdater.v1.ExternalRepoSpec
-	(*EnqueueRepoUpdateRequest)(nil),            // 10: repoupdater.v1.EnqueueRepoUpdateRequest
-	(*EnqueueRepoUpdateResponse)(nil),           // 11: repoupdater.v1.EnqueueRepoUpdateResponse
-	(*EnqueueChangesetSyncRequest)(nil),         // 12: repoupdater.v1.EnqueueChangesetSyncRequest
-	(*EnqueueChangesetSyncResponse)(nil),        // 13: repoupdater.v1.EnqueueChangesetSyncResponse
-	(*FetchPermsOptions)(nil),                   // 14: repoupdater.v1.FetchPermsOptions
-	(*SyncExternalServiceRequest)(nil),          // 15: repoupdater.v1.SyncExternalServiceRequest
-	(*SyncExternalServiceResponse)(nil),         // 16: repoupdater.v1.SyncExternalServiceResponse
-	(*ExternalServiceNamespacesRequest)(nil),    // 17: repoupdater.v1.ExternalServiceNamespacesRequest
-	(*ExternalServiceNamespacesResponse)(nil),   // 18: repoupdater.v1.ExternalServiceNamespacesResponse
-	(*ExternalServiceNamespace)(nil),            // 19: repoupdater.v1.ExternalServiceNamespace
-	(*ExternalServiceRepositoriesRequest)(nil),  // 20: repoupdater.v1.ExternalServiceRepositoriesRequest
-	(*ExternalServiceRepositoriesResponse)(nil), // 21: repoupdater.v1.ExternalServiceRepositoriesResponse
-	(*ExternalServiceRepository)(nil),           // 22: repoupdater.v1.ExternalServiceRepository
-	(*timestamppb.Timestamp)(nil),               // 23: google.protobuf.Timestamp
+	(*RepoSchedulePolicy)(nil),                  // 3: repoupdater.v1.RepoSchedulePolicy
+	(*RepoQueueState)(nil),                      // 4: repoupdater.v1.RepoQueueState
+	(*RepoLookupRequest)(nil),                   // 5: repoupdater.v1.RepoLookupRequest
+	(*RepoLookupResponse)(nil),                  // 6: repoupdater.v1.RepoLookupResponse
+	(*RepoInfo)(nil),                            // 7: repoupdater.v1.RepoInfo
+	(*VCSInfo)(nil),                             // 8: repoupdater.v1.VCSInfo
+	(*RepoLinks)(nil),                           // 9: repoupdater.v1.RepoLinks
+	(*ExternalRepoSpec)(nil),                    // 10: repoupdater.v1.ExternalRepoSpec
+	(*EnqueueRepoUpdateRequest)(nil),            // 11: repoupdater.v1.EnqueueRepoUpdateRequest
+	(*EnqueueRepoUpdateResponse)(nil),           // 12: repoupdater.v1.EnqueueRepoUpdateResponse
+	(*EnqueueChangesetSyncRequest)(nil),         // 13: repoupdater.v1.EnqueueChangesetSyncRequest
+	(*EnqueueChangesetSyncResponse)(nil),        // 14: repoupdater.v1.EnqueueChangesetSyncResponse
+	(*FetchPermsOptions)(nil),                   // 15: repoupdater.v1.FetchPermsOptions
+	(*SyncExternalServiceRequest)(nil),          // 16: repoupdater.v1.SyncExternalServiceRequest
+	(*SyncExternalServiceResponse)(nil),         // 17: repoupdater.v1.SyncExternalServiceResponse
+	(*ExternalServiceNamespacesRequest)(nil),    // 18: repoupdater.v1.ExternalServiceNamespacesRequest
+	(*ExternalServiceNamespacesResponse)(nil),   // 19: repoupdater.v1.ExternalServiceNamespacesResponse
+	(*ExternalServiceNamespace)(nil),            // 20: repoupdater.v1.ExternalServiceNamespace
+	(*ExternalServiceRepositoriesRequest)(nil),  // 21: repoupdater.v1.ExternalServiceRepositoriesRequest
+	(*ExternalServiceRepositoriesResponse)(nil), // 22: repoupdater.v1.ExternalServiceRepositoriesResponse
+	(*ExternalServiceRepository)(nil),           // 23: repoupdater.v1.ExternalServiceRepository
+	(*PermsSyncDryRunRequest)(nil),              // 24: repoupdater.v1.PermsSyncDryRunRequest
+	(*PermsSyncDryRunResponse)(nil),             // 25: repoupdater.v1.PermsSyncDryRunResponse
+	(*timestamppb.Timestamp)(nil),               // 26: google.protobuf.Timestamp
 }
 var file_repoupdater_proto_depIdxs = []int32{
 	2,  // 0: repoupdater.v1.RepoUpdateSchedulerInfoResponse.schedule:type_name -> repoupdater.v1.RepoScheduleState
-	3,  // 1: repoupdater.v1.RepoUpdateSchedulerInfoResponse.queue:type_name -> repoupdater.v1.RepoQueueState
-	23, // 2: repoupdater.v1.RepoScheduleState.due:type_name -> google.protobuf.Timestamp
-	6,  // 3: repoupdater.v1.RepoLookupResponse.repo:type_name -> repoupdater.v1.RepoInfo
-	7,  // 4: repoupdater.v1.RepoInfo.vcs_info:type_name -> repoupdater.v1.VCSInfo
-	8,  // 5: repoupdater.v1.RepoInfo.links:type_name -> repoupdater.v1.RepoLinks
-	9,  // 6: repoupdater.v1.RepoInfo.external_repo:type_name -> repoupdater.v1.ExternalRepoSpec
-	19, // 7: repoupdater.v1.ExternalServiceNamespacesResponse.namespaces:type_name -> repoupdater.v1.ExternalServiceNamespace
-	22, // 8: repoupdater.v1.ExternalServiceRepositoriesResponse.repos:type_name -> repoupdater.v1.ExternalServiceRepository
-	0,  // 9: repoupdater.v1.RepoUpdaterService.RepoUpdateSchedulerInfo:input_type -> repoupdater.v1.RepoUpdateSchedulerInfoRequest
-	4,  // 10: repoupdater.v1.RepoUpdaterService.RepoLookup:input_type -> repoupdater.v1.RepoLookupRequest
-	10, // 11: repoupdater.v1.RepoUpdaterService.EnqueueRepoUpdate:input_type -> repoupdater.v1.EnqueueRepoUpdateRequest
-	12, // 12: repoupdater.v1.RepoUpdaterService.EnqueueChangesetSync:input_type -> repoupdater.v1.EnqueueChangesetSyncRequest
-	15, // 13: repoupdater.v1.RepoUpdaterService.SyncExternalService:input_type -> repoupdater.v1.SyncExternalServiceRequest
-	17, // 14: repoupdater.v1.RepoUpdaterService.ExternalServiceNamespaces:input_type -> repoupdater.v1.ExternalServiceNamespacesRequest
-	20, // 15: repoupdater.v1.RepoUpdaterService.ExternalServiceRepositories:input_type -> repoupdater.v1.ExternalServiceRepositoriesRequest
-	1,  // 16: repoupdater.v1.RepoUpdaterService.RepoUpdateSchedulerInfo:output_type -> repoupdater.v1.RepoUpdateSchedulerInfoResponse
-	5,  // 17: repoupdater.v1.RepoUpdaterService.RepoLookup:output_type -> repoupdater.v1.RepoLookupResponse
-	11, // 18: repoupdater.v1.RepoUpdaterService.EnqueueRepoUpdate:output_type -> repoupdater.v1.EnqueueRepoUpdateResponse
-	13, // 19: repoupdater.v1.RepoUpdaterService.EnqueueChangesetSync:output_type -> repoupdater.v1.EnqueueChangesetSyncResponse
-	16, // 20: repoupdater.v1.RepoUpdaterService.SyncExternalService:output_type -> repoupdater.v1.SyncExternalServiceResponse
-	18, // 21: repoupdater.v1.RepoUpdaterService.ExternalServiceNamespaces:output_type -> repoupdater.v1.ExternalServiceNamespacesResponse
-	21, // 22: repoupdater.v1.RepoUpdaterService.ExternalServiceRepositories:output_type -> repoupdater.v1.ExternalServiceRepositoriesResponse
-	16, // [16:23] is the sub-list for method output_type
-	9,  // [9:16] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	4,  // 1: repoupdater.v1.RepoUpdateSchedulerInfoResponse.queue:type_name -> repoupdater.v1.RepoQueueState
+	26, // 2: repoupdater.v1.RepoScheduleState.due:type_name -> google.protobuf.Timestamp
+	3,  // 3: repoupdater.v1.RepoScheduleState.policy:type_name -> repoupdater.v1.RepoSchedulePolicy
+	7,  // 4: repoupdater.v1.RepoLookupResponse.repo:type_name -> repoupdater.v1.RepoInfo
+	8,  // 5: repoupdater.v1.RepoInfo.vcs_info:type_name -> repoupdater.v1.VCSInfo
+	9,  // 6: repoupdater.v1.RepoInfo.links:type_name -> repoupdater.v1.RepoLinks
+	10, // 7: repoupdater.v1.RepoInfo.external_repo:type_name -> repoupdater.v1.ExternalRepoSpec
+	20, // 8: repoupdater.v1.ExternalServiceNamespacesResponse.namespaces:type_name -> repoupdater.v1.ExternalServiceNamespace
+	23, // 9: repoupdater.v1.ExternalServiceRepositoriesResponse.repos:type_name -> repoupdater.v1.ExternalServiceRepository
+	0,  // 10: repoupdater.v1.RepoUpdaterService.RepoUpdateSchedulerInfo:input_type -> repoupdater.v1.RepoUpdateSchedulerInfoRequest
+	5,  // 11: repoupdater.v1.RepoUpdaterService.RepoLookup:input_type -> repoupdater.v1.RepoLookupRequest
+	11, // 12: repoupdater.v1.RepoUpdaterService.EnqueueRepoUpdate:input_type -> repoupdater.v1.EnqueueRepoUpdateRequest
+	13, // 13: repoupdater.v1.RepoUpdaterService.EnqueueChangesetSync:input_type -> repoupdater.v1.EnqueueChangesetSyncRequest
+	16, // 14: repoupdater.v1.RepoUpdaterService.SyncExternalService:input_type -> repoupdater.v1.SyncExternalServiceRequest
+	18, // 15: repoupdater.v1.RepoUpdaterService.ExternalServiceNamespaces:input_type -> repoupdater.v1.ExternalServiceNamespacesRequest
+	21, // 16: repoupdater.v1.RepoUpdaterService.ExternalServiceRepositories:input_type -> repoupdater.v1.ExternalServiceRepositoriesRequest
+	24, // 17: repoupdater.v1.RepoUpdaterService.PermsSyncDryRun:input_type -> repoupdater.v1.PermsSyncDryRunRequest
+	1,  // 18: repoupdater.v1.RepoUpdaterService.RepoUpdateSchedulerInfo:output_type -> repoupdater.v1.RepoUpdateSchedulerInfoResponse
+	6,  // 19: repoupdater.v1.RepoUpdaterService.RepoLookup:output_type -> repoupdater.v1.RepoLookupResponse
+	12, // 20: repoupdater.v1.RepoUpdaterService.EnqueueRepoUpdate:output_type -> repoupdater.v1.EnqueueRepoUpdateResponse
+	14, // 21: repoupdater.v1.RepoUpdaterService.EnqueueChangesetSync:output_type -> repoupdater.v1.EnqueueChangesetSyncResponse
+	17, // 22: repoupdater.v1.RepoUpdaterService.SyncExternalService:output_type -> repoupdater.v1.SyncExternalServiceResponse
+	19, // 23: repoupdater.v1.RepoUpdaterService.ExternalServiceNamespaces:output_type -> repoupdater.v1.ExternalServiceNamespacesResponse
+	22, // 24: repoupdater.v1.RepoUpdaterService.ExternalServiceRepositories:output_type -> repoupdater.v1.ExternalServiceRepositoriesResponse
+	25, // 25: repoupdater.v1.RepoUpdaterService.PermsSyncDryRun:output_type -> repoupdater.v1.PermsSyncDryRunResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_repoupdater_proto_init() }
@@ -1744,7 +2012,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoQueueState); i {
+			switch v := v.(*RepoSchedulePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1756,7 +2024,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoLookupRequest); i {
+			switch v := v.(*RepoQueueState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1768,7 +2036,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoLookupResponse); i {
+			switch v := v.(*RepoLookupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1780,7 +2048,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoInfo); i {
+			switch v := v.(*RepoLookupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1792,7 +2060,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VCSInfo); i {
+			switch v := v.(*RepoInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1804,7 +2072,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoLinks); i {
+			switch v := v.(*VCSInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1816,7 +2084,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalRepoSpec); i {
+			switch v := v.(*RepoLinks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1828,7 +2096,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueRepoUpdateRequest); i {
+			switch v := v.(*ExternalRepoSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1840,7 +2108,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueRepoUpdateResponse); i {
+			switch v := v.(*EnqueueRepoUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1852,7 +2120,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueChangesetSyncRequest); i {
+			switch v := v.(*EnqueueRepoUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1864,7 +2132,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueChangesetSyncResponse); i {
+			switch v := v.(*EnqueueChangesetSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1876,7 +2144,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchPermsOptions); i {
+			switch v := v.(*EnqueueChangesetSyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1888,7 +2156,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncExternalServiceRequest); i {
+			switch v := v.(*FetchPermsOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1900,7 +2168,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncExternalServiceResponse); i {
+			switch v := v.(*SyncExternalServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1912,7 +2180,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalServiceNamespacesRequest); i {
+			switch v := v.(*SyncExternalServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1924,7 +2192,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalServiceNamespacesResponse); i {
+			switch v := v.(*ExternalServiceNamespacesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1936,7 +2204,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalServiceNamespace); i {
+			switch v := v.(*ExternalServiceNamespacesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1948,7 +2216,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalServiceRepositoriesRequest); i {
+			switch v := v.(*ExternalServiceNamespace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1960,7 +2228,7 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalServiceRepositoriesResponse); i {
+			switch v := v.(*ExternalServiceRepositoriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1972,6 +2240,18 @@ func file_repoupdater_proto_init() {
 			}
 		}
 		file_repoupdater_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalServiceRepositoriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repoupdater_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExternalServiceRepository); i {
 			case 0:
 				return &v.state
@@ -1983,16 +2263,40 @@ func file_repoupdater_proto_init() {
 				return nil
 			}
 		}
+		file_repoupdater_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermsSyncDryRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repoupdater_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermsSyncDryRunResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_repoupdater_proto_msgTypes[17].OneofWrappers = []interface{}{}
-	file_repoupdater_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_repoupdater_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_repoupdater_proto_msgTypes[21].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_repoupdater_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,7 @@ const (
 	RepoUpdaterService_SyncExternalService_FullMethodName         = "/repoupdater.v1.RepoUpdaterService/SyncExternalService"
 	RepoUpdaterService_ExternalServiceNamespaces_FullMethodName   = "/repoupdater.v1.RepoUpdaterService/ExternalServiceNamespaces"
 	RepoUpdaterService_ExternalServiceRepositories_FullMethodName = "/repoupdater.v1.RepoUpdaterService/ExternalServiceRepositories"
+	RepoUpdaterService_PermsSyncDryRun_FullMethodName             = "/repoupdater.v1.RepoUpdaterService/PermsSyncDryRun"
 )
 
 // RepoUpdaterServiceClient is the client API for RepoUpdaterService service.
@@ -46,6 +47,9 @@ type RepoUpdaterServiceClient interface {
 	ExternalServiceNamespaces(ctx context.Context, in *ExternalServiceNamespacesRequest, opts ...grpc.CallOption) (*ExternalServiceNamespacesResponse, error)
 	// ExternalServiceRepositories retrieves a list of repositories sourced by the given external service configuration
 	ExternalServiceRepositories(ctx context.Context, in *ExternalServiceRepositoriesRequest, opts ...grpc.CallOption) (*ExternalServiceRepositoriesResponse, error)
+	// PermsSyncDryRun fetches the permissions of a user or repository from the code
+	// host and compares them with the stored ones, without saving them.
+	PermsSyncDryRun(ctx context.Context, in *PermsSyncDryRunRequest, opts ...grpc.CallOption) (*PermsSyncDryRunResponse, error)
 }
 
 type repoUpdaterServiceClient struct {
@@ -119,6 +123,15 @@ func (c *repoUpdaterServiceClient) ExternalServiceRepositories(ctx context.Conte
 	return out, nil
 }
 
+func (c *repoUpdaterServiceClient) PermsSyncDryRun(ctx context.Context, in *PermsSyncDryRunRequest, opts ...grpc.CallOption) (*PermsSyncDryRunResponse, error) {
+	out := new(PermsSyncDryRunResponse)
+	err := c.cc.Invoke(ctx, RepoUpdaterService_PermsSyncDryRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepoUpdaterServiceServer is the server API for RepoUpdaterService service.
 // All implementations must embed UnimplementedRepoUpdaterServiceServer
 // for forward compatibility
@@ -137,6 +150,9 @@ type RepoUpdaterServiceServer interface {
 	ExternalServiceNamespaces(context.Context, *ExternalServiceNamespacesRequest) (*ExternalServiceNamespacesResponse, error)
 	// ExternalServiceRepositories retrieves a list of repositories sourced by the given external service configuration
 	ExternalServiceRepositories(context.Context, *ExternalServiceRepositoriesRequest) (*ExternalServiceRepositoriesResponse, error)
+	// PermsSyncDryRun fetches the permissions of a user or repository from the code
+	// host and compares them with the stored ones, without saving them.
+	PermsSyncDryRun(context.Context, *PermsSyncDryRunRequest) (*PermsSyncDryRunResponse, error)
 	mustEmbedUnimplementedRepoUpdaterServiceServer()
 }
 
@@ -165,6 +181,9 @@ func (UnimplementedRepoUpdaterServiceServer) ExternalServiceNamespaces(context.C
 func (UnimplementedRepoUpdaterServiceServer) ExternalServiceRepositories(context.Context, *ExternalServiceRepositoriesRequest) (*ExternalServiceRepositoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExternalServiceRepositories not implemented")
 }
+func (UnimplementedRepoUpdaterServiceServer) PermsSyncDryRun(context.Context, *PermsSyncDryRunRequest) (*PermsSyncDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PermsSyncDryRun not implemented")
+}
 func (UnimplementedRepoUpdaterServiceServer) mustEmbedUnimplementedRepoUpdaterServiceServer() {}
 
 // UnsafeRepoUpdaterServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -304,6 +323,24 @@ func _RepoUpdaterService_ExternalServiceRepositories_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoUpdaterService_PermsSyncDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermsSyncDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoUpdaterServiceServer).PermsSyncDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoUpdaterService_PermsSyncDryRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoUpdaterServiceServer).PermsSyncDryRun(ctx, req.(*PermsSyncDryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RepoUpdaterService_ServiceDesc is the grpc.ServiceDesc for RepoUpdaterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -339,6 +376,10 @@ var RepoUpdaterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExternalServiceRepositories",
 			Handler:    _RepoUpdaterService_ExternalServiceRepositories_Handler,
 		},
+		{
+			MethodName: "PermsSyncDryRun",
+			Handler:    _RepoUpdaterService_PermsSyncDryRun_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "repoupdater.proto",
@@ -117,7 +117,7 @@ func (o *Observer) alertForNoResolvedRepos(ctx context.Context, q query.Q) *sear
 			proposedQueries = append(proposedQueries,
 				&search.QueryDescription{
 					Description: "include forked repositories in your query.",
-					Query:       o.OriginalQuery + " fork:yes",
+					Query:       o.ExpandedQuery + " fork:yes",
 					PatternType: o.PatternType,
 				},
 			)
@@ -136,7 +136,7 @@ func (o *Observer) alertForNoResolvedRepos(ctx context.Context, q query.Q) *sear
 			proposedQueries = append(proposedQueries,
 				&search.QueryDescription{
 					Description: "include archived repositories in your query.",
-					Query:       o.OriginalQuery + " archived:yes",
+					Query:       o.ExpandedQuery + " archived:yes",
 					PatternType: o.PatternType,
 				},
 			)
@@ -204,8 +204,8 @@ func (o *Observer) update(alert *search.Alert) {
 // Done returns the highest priority alert and an error.MultiError containing
 // all errors that could not be converted to alerts.
 func (o *Observer) Done() (*search.Alert, error) {
-	if !o.HasResults && o.PatternType != query.SearchTypeStructural && comby.MatchHoleRegexp.MatchString(o.OriginalQuery) {
-		o.update(search.AlertForStructuralSearchNotSet(o.OriginalQuery))
+	if !o.HasResults && o.PatternType != query.SearchTypeStructural && comby.MatchHoleRegexp.MatchString(o.ExpandedQuery) {
+		o.update(search.AlertForStructuralSearchNotSet(o.ExpandedQuery))
 	}
 
 	if o.HasResults && o.err != nil {
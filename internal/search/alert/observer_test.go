@@ -75,6 +75,7 @@ func TestAlertForNoResolvedReposWithNonGlobalSearchContext(t *testing.T) {
 		Db:     db,
 		Inputs: &search.Inputs{
 			OriginalQuery: searchQuery,
+			ExpandedQuery: searchQuery,
 			Query:         q,
 			UserSettings:  &schema.Settings{},
 			Features:      &search.Features{},
@@ -116,12 +116,25 @@ func (s *searchClient) Plan(
 		return sc.Query, nil
 	})
 
+	// Expand the query macros defined in user, org and global settings
+	// before parsing, so that the expanded query is validated as a whole.
+	expandedQuery, err := query.ExpandMacros(searchQuery, query.ParseMacros(settings.SearchMacros))
+	if err != nil {
+		return nil, &QueryError{Query: searchQuery, Err: err}
+	}
+	if expandedQuery != searchQuery {
+		tr.AddEvent("expanded query macros", attribute.String("expandedQuery", expandedQuery))
+	}
+
 	var plan query.Plan
 	plan, err = query.Pipeline(
-		query.Init(searchQuery, searchType),
+		query.Init(expandedQuery, searchType),
 		query.With(searchContextsQueryEnabled, substituteContextsStep),
 	)
 	if err != nil {
+		if expandedQuery != searchQuery {
+			err = errors.Wrapf(err, "after expanding macros to %q", expandedQuery)
+		}
 		return nil, &QueryError{Query: searchQuery, Err: err}
 	}
 	tr.AddEvent("parsing done")
@@ -130,6 +143,7 @@ func (s *searchClient) Plan(
 		Plan:                   plan,
 		Query:                  plan.ToQ(),
 		OriginalQuery:          searchQuery,
+		ExpandedQuery:          expandedQuery,
 		SearchMode:             searchMode,
 		UserSettings:           settings,
 		OnSourcegraphDotCom:    s.sourcegraphDotComMode,
@@ -156,6 +170,7 @@ func (s *searchClient) Execute(
 	if err != nil {
 		return nil, err
 	}
+	job.RecordPlan(ctx, planJob)
 
 	return planJob.Run(ctx, s.JobClients(), stream)
 }
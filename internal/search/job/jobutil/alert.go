@@ -62,7 +62,7 @@ func (j *alertJob) Run(ctx context.Context, clients job.RuntimeClients, stream s
 		if !statsObserver.Status.Any(search.RepoStatusTimedout) {
 			usedTime := time.Since(start)
 			suggestTime := longer(2, usedTime)
-			return search.AlertForTimeout(usedTime, suggestTime, j.inputs.OriginalQuery, j.inputs.PatternType), nil
+			return search.AlertForTimeout(usedTime, suggestTime, j.inputs.ExpandedQuery, j.inputs.PatternType), nil
 		} else {
 			err = nil
 		}
@@ -102,6 +102,7 @@ func (p *repoPagerJob) Run(ctx context.Context, clients job.RuntimeClients, stre
 		if err != nil {
 			return maxAlerter.Alert, err
 		}
+		job.RecordRepos(ctx, indexed.Len(), len(unindexed))
 
 		job := p.child.Resolve(resolvedRepos{indexed, unindexed})
 		alert, err := job.Run(ctx, clients, stream)
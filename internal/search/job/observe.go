@@ -16,10 +16,12 @@ type finishSpanFunc func(*search.Alert, error)
 func StartSpan(ctx context.Context, stream streaming.Sender, job Job) (trace.Trace, context.Context, streaming.Sender, finishSpanFunc) {
 	tr, ctx := trace.New(ctx, job.Name())
 	tr.SetAttributes(job.Attributes(VerbosityMax)...)
+	ctx, finishProfile := startProfileNode(ctx, job)
 
 	observingStream := newObservingStream(tr, stream)
 
 	return tr, ctx, observingStream, func(alert *search.Alert, err error) {
+		finishProfile(observingStream.totalEvents.Load(), err)
 		tr.SetError(err)
 		if alert != nil {
 			tr.SetAttributes(attribute.String("alert", alert.Title))
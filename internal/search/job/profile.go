@@ -0,0 +1,167 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Profile records the jobs run by a search, along with their wall time and the number
+// of results they sent. Profiling is enabled by attaching a profile to the context
+// with WithProfile, after which StartSpan records every job that runs.
+type Profile struct {
+	mu             sync.Mutex
+	plan           Describer
+	roots          []*ProfileNode
+	reposRecorded  bool
+	indexedRepos   int
+	unindexedRepos int
+}
+
+// ProfileNode is a single run of a job of a profiled search. A job that runs
+// several times (e.g. once per page of repositories) has a node per run.
+type ProfileNode struct {
+	Name       string
+	Attributes []attribute.KeyValue
+	Duration   time.Duration
+	Results    int64
+	Err        error
+	Children   []*ProfileNode
+
+	// IndexedRepos and UnindexedRepos are the number of repositories this job
+	// sent to indexed (zoekt) and unindexed (searcher) search.
+	IndexedRepos   int
+	UnindexedRepos int
+}
+
+// NewProfile returns an empty profile.
+func NewProfile() *Profile {
+	return &Profile{}
+}
+
+// Jobs returns the tree of jobs that ran, in the order they started.
+func (p *Profile) Jobs() []*ProfileNode {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return copyProfileNodes(p.roots)
+}
+
+// Plan returns the job that was executed, or nil if no job was recorded with
+// RecordPlan.
+func (p *Profile) Plan() Describer {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.plan
+}
+
+// Repos returns the total number of repositories sent to indexed and unindexed
+// search by the jobs of the profile. ok is false if no job resolved repositories,
+// e.g. because only global searches ran, in which case the counts are unknown.
+func (p *Profile) Repos() (indexed, unindexed int, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.indexedRepos, p.unindexedRepos, p.reposRecorded
+}
+
+func copyProfileNodes(nodes []*ProfileNode) []*ProfileNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	cp := make([]*ProfileNode, 0, len(nodes))
+	for _, node := range nodes {
+		nodeCopy := *node
+		nodeCopy.Children = copyProfileNodes(node.Children)
+		cp = append(cp, &nodeCopy)
+	}
+	return cp
+}
+
+type profileKey struct{}
+type profileNodeKey struct{}
+
+// WithProfile returns a context that records the jobs run with it to the given profile.
+func WithProfile(ctx context.Context, p *Profile) context.Context {
+	return context.WithValue(ctx, profileKey{}, p)
+}
+
+// profileFromContext returns the profile attached to the given context, if any, along
+// with the node of the job currently running in the given context.
+func profileFromContext(ctx context.Context) (*Profile, *ProfileNode) {
+	p, _ := ctx.Value(profileKey{}).(*Profile)
+	node, _ := ctx.Value(profileNodeKey{}).(*ProfileNode)
+	return p, node
+}
+
+// startProfileNode adds a node for the given job to the profile attached to the given
+// context, as a child of the job currently running in it. The returned function records
+// the outcome of the job. If the context carries no profile, this function is a no-op.
+func startProfileNode(ctx context.Context, job Describer) (context.Context, func(results int64, err error)) {
+	p, parent := profileFromContext(ctx)
+	if p == nil {
+		return ctx, func(int64, error) {}
+	}
+
+	node := &ProfileNode{
+		Name:       job.Name(),
+		Attributes: job.Attributes(VerbosityBasic),
+	}
+
+	p.mu.Lock()
+	if parent != nil {
+		parent.Children = append(parent.Children, node)
+	} else {
+		p.roots = append(p.roots, node)
+	}
+	p.mu.Unlock()
+
+	start := time.Now()
+	return context.WithValue(ctx, profileNodeKey{}, node), func(results int64, err error) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		node.Duration = time.Since(start)
+		node.Results = results
+		node.Err = err
+	}
+}
+
+// RecordPlan records the job that is executed for the search. If the context
+// carries no profile, this function is a no-op.
+func RecordPlan(ctx context.Context, plan Describer) {
+	p, _ := profileFromContext(ctx)
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.plan = plan
+}
+
+// RecordRepos records that the job running in the given context sent the given number
+// of repositories to indexed and unindexed search. If the context carries no profile,
+// this function is a no-op.
+func RecordRepos(ctx context.Context, indexed, unindexed int) {
+	p, node := profileFromContext(ctx)
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.reposRecorded = true
+	p.indexedRepos += indexed
+	p.unindexedRepos += unindexed
+	if node != nil {
+		node.IndexedRepos += indexed
+		node.UnindexedRepos += unindexed
+	}
+}
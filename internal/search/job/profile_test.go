@@ -0,0 +1,90 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type profileTestJob struct{ name string }
+
+func (j profileTestJob) Name() string                              { return j.name }
+func (j profileTestJob) Attributes(Verbosity) []attribute.KeyValue { return nil }
+func (j profileTestJob) Children() []Describer                     { return nil }
+
+func TestProfileConcurrentChildren(t *testing.T) {
+	profile := NewProfile()
+	ctx := WithProfile(context.Background(), profile)
+
+	RecordPlan(ctx, profileTestJob{name: "Plan"})
+	parentCtx, finishParent := startProfileNode(ctx, profileTestJob{name: "Parent"})
+
+	const numChildren = 50
+	var wg sync.WaitGroup
+	for i := 0; i < numChildren; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			childCtx, finishChild := startProfileNode(parentCtx, profileTestJob{name: fmt.Sprintf("Child%02d", i)})
+			RecordRepos(childCtx, 1, 2)
+			// Grandchildren are recorded below the child that runs them.
+			_, finishGrandchild := startProfileNode(childCtx, profileTestJob{name: "Grandchild"})
+			finishGrandchild(1, nil)
+
+			var err error
+			if i%10 == 0 {
+				err = errors.New("failed")
+			}
+			finishChild(int64(i), err)
+		}(i)
+	}
+	wg.Wait()
+	finishParent(numChildren, nil)
+
+	require.Equal(t, "Plan", profile.Plan().Name())
+
+	indexed, unindexed, ok := profile.Repos()
+	require.True(t, ok)
+	require.Equal(t, numChildren, indexed)
+	require.Equal(t, 2*numChildren, unindexed)
+
+	roots := profile.Jobs()
+	require.Len(t, roots, 1)
+	require.Equal(t, "Parent", roots[0].Name)
+	require.Equal(t, int64(numChildren), roots[0].Results)
+
+	children := roots[0].Children
+	require.Len(t, children, numChildren)
+	sort.Slice(children, func(i, j int) bool { return children[i].Name < children[j].Name })
+	for i, child := range children {
+		require.Equal(t, fmt.Sprintf("Child%02d", i), child.Name)
+		require.Equal(t, int64(i), child.Results)
+		require.Equal(t, i%10 == 0, child.Err != nil)
+		require.Equal(t, 1, child.IndexedRepos)
+		require.Equal(t, 2, child.UnindexedRepos)
+		require.Len(t, child.Children, 1)
+		require.Equal(t, "Grandchild", child.Children[0].Name)
+	}
+}
+
+func TestProfileWithoutProfile(t *testing.T) {
+	ctx := context.Background()
+
+	// Without a profile in the context, recording is a no-op.
+	gotCtx, finish := startProfileNode(ctx, profileTestJob{name: "Job"})
+	require.Equal(t, ctx, gotCtx)
+	finish(1, nil)
+	RecordPlan(ctx, profileTestJob{name: "Plan"})
+	RecordRepos(ctx, 1, 1)
+
+	_, _, ok := NewProfile().Repos()
+	require.False(t, ok)
+}
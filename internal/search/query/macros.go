@@ -0,0 +1,323 @@
+package query
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Macro is a named query fragment, defined in the `search.macros` setting, that
+// is substituted for `@name` or `@name(arg, ...)` in a query.
+type Macro struct {
+	Name       string
+	Parameters []string
+	Definition string
+
+	// err is set if the macro is not well-defined. It is reported when the
+	// macro is used, so that a broken definition doesn't fail unrelated queries.
+	err error
+}
+
+// Macros are query macros indexed by name.
+type Macros map[string]Macro
+
+var (
+	macroKeyRegexp  = lazyregexp.New(`^([A-Za-z_][A-Za-z0-9_-]*)(?:\((.*)\))?$`)
+	macroNameRegexp = lazyregexp.New(`^[A-Za-z_][A-Za-z0-9_-]*`)
+	identRegexp     = lazyregexp.New(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// ParseMacros parses macro definitions as found in the `search.macros`
+// setting. Keys are of the form `name` or `name(param1, param2)`, and values
+// are the query fragments they expand to, in which `$param1` refers to the
+// value of a parameter. Keys that don't start with a valid macro name are
+// ignored.
+func ParseMacros(definitions map[string]string) Macros {
+	keys := make([]string, 0, len(definitions))
+	for key := range definitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	macros := make(Macros, len(definitions))
+	for _, key := range keys {
+		m := parseMacro(strings.TrimSpace(key), definitions[key])
+		if m.Name == "" {
+			continue
+		}
+		if _, ok := macros[m.Name]; ok {
+			m.err = errors.Errorf("macro @%s is defined more than once", m.Name)
+		}
+		macros[m.Name] = m
+	}
+	return macros
+}
+
+func parseMacro(key, definition string) Macro {
+	matches := macroKeyRegexp.FindStringSubmatch(key)
+	if matches == nil {
+		name := macroNameRegexp.FindString(key)
+		return Macro{Name: name, err: errors.Errorf("invalid definition %q for macro @%s", key, name)}
+	}
+
+	m := Macro{Name: matches[1], Definition: definition}
+	if strings.HasSuffix(key, ")") {
+		seen := map[string]struct{}{}
+		for _, param := range strings.Split(matches[2], ",") {
+			param = strings.TrimSpace(param)
+			if !identRegexp.MatchString(param) {
+				m.err = errors.Errorf("invalid parameter %q for macro @%s", param, m.Name)
+				return m
+			}
+			if _, ok := seen[param]; ok {
+				m.err = errors.Errorf("parameter %q of macro @%s is declared more than once", param, m.Name)
+				return m
+			}
+			seen[param] = struct{}{}
+			m.Parameters = append(m.Parameters, param)
+		}
+	}
+	return m
+}
+
+// Limits on the expansion of macros, which guard against definitions that
+// grow exponentially, such as `@a(x)` expanding to `$x $x` and being nested.
+const (
+	maxMacroInvocations  = 1000
+	maxExpandedQuerySize = 64 << 10
+)
+
+// ExpandMacros substitutes the macros invoked in the input query with the
+// query fragments they expand to, recursively. A macro invocation is a token
+// of the form `@name` or `@name(arg1, arg2)` that is not quoted. Invocations
+// of names that are not macros are left as is, so that patterns like
+// `@Override` are searched for literally. It is an error for a macro to
+// invoke itself, directly or through other macros, and for the expansion to
+// exceed a fixed number of invocations or size.
+func ExpandMacros(in string, macros Macros) (string, error) {
+	if len(macros) == 0 || !strings.Contains(in, "@") {
+		return in, nil
+	}
+	e := &macroExpander{macros: macros}
+	return e.expandMacros(in, nil)
+}
+
+type macroExpander struct {
+	macros Macros
+
+	// invocations is the number of macro invocations expanded so far.
+	invocations int
+}
+
+// expandMacros expands the macros invoked in the input. The stack holds the
+// names of the macros currently being expanded, which are not allowed to be
+// invoked again.
+func (e *macroExpander) expandMacros(in string, stack []string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(in); {
+		atTokenStart := i == 0 || isMacroBoundary(in[i-1])
+
+		switch c := in[i]; {
+		case (c == '"' || c == '\'') && (atTokenStart || in[i-1] == ':'):
+			// Skip quoted values, which may contain anything.
+			_, n, err := ScanDelimited([]byte(in[i:]), false, rune(c))
+			if err != nil {
+				n = 1
+			}
+			b.WriteString(in[i : i+n])
+			i += n
+			continue
+
+		case c == '@' && atTokenStart:
+			name, args, n, ok := scanMacroInvocation(in[i+1:])
+			m, defined := e.macros[name]
+			if !ok || !defined {
+				break
+			}
+			expanded, err := e.expandMacro(m, args, stack)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(expanded)
+			if b.Len() > maxExpandedQuerySize {
+				return "", errors.Errorf("expanding macro @%s exceeds the maximum query size of %d bytes", m.Name, maxExpandedQuerySize)
+			}
+			i += 1 + n
+			continue
+		}
+
+		b.WriteByte(in[i])
+		i++
+	}
+	return b.String(), nil
+}
+
+// expandMacro expands a single invocation of the macro m with the given
+// arguments.
+func (e *macroExpander) expandMacro(m Macro, args []string, stack []string) (string, error) {
+	for i, name := range stack {
+		if name == m.Name {
+			cycle := append(append([]string{}, stack[i:]...), m.Name)
+			return "", errors.Errorf("macro @%s invokes itself: @%s", m.Name, strings.Join(cycle, " -> @"))
+		}
+	}
+	if m.err != nil {
+		return "", m.err
+	}
+	if len(args) != len(m.Parameters) {
+		return "", errors.Errorf("macro @%s expects %d argument(s), got %d", m.Name, len(m.Parameters), len(args))
+	}
+	e.invocations++
+	if e.invocations > maxMacroInvocations {
+		return "", errors.Errorf("expanding macro @%s exceeds the maximum of %d macro invocations", m.Name, maxMacroInvocations)
+	}
+
+	// Arguments are expanded in the context of the invocation, before they
+	// are substituted into the definition.
+	values := make(map[string]string, len(args))
+	for i, arg := range args {
+		expanded, err := e.expandMacros(arg, stack)
+		if err != nil {
+			return "", err
+		}
+		values[m.Parameters[i]] = expanded
+	}
+
+	substituted := substituteMacroParameters(m.Definition, values)
+	if len(substituted) > maxExpandedQuerySize {
+		return "", errors.Errorf("expanding macro @%s exceeds the maximum query size of %d bytes", m.Name, maxExpandedQuerySize)
+	}
+	expanded, err := e.expandMacros(substituted, append(stack, m.Name))
+	if err != nil {
+		return "", err
+	}
+
+	expanded = strings.TrimSpace(expanded)
+	if hasTopLevelOr(expanded) {
+		// Preserve the meaning of the definition when it is combined with the
+		// rest of the query.
+		expanded = "(" + expanded + ")"
+	}
+	return expanded, nil
+}
+
+// scanMacroInvocation scans the name and arguments of a macro invocation,
+// following the leading `@`. It returns false if the input does not form a
+// complete token of the form `name` or `name(arg1, arg2)`.
+func scanMacroInvocation(in string) (name string, args []string, count int, ok bool) {
+	name = macroNameRegexp.FindString(in)
+	if name == "" {
+		return "", nil, 0, false
+	}
+	count = len(name)
+
+	if count < len(in) && in[count] == '(' {
+		argsValue, n, ok := ScanBalancedParens([]byte(in[count:]))
+		if !ok {
+			return "", nil, 0, false
+		}
+		args = splitMacroArguments(argsValue[1 : len(argsValue)-1])
+		count += n
+	}
+
+	if count < len(in) && !isMacroBoundary(in[count]) && in[count] != ')' {
+		return "", nil, 0, false
+	}
+	return name, args, count, true
+}
+
+// splitMacroArguments splits the comma-separated arguments of a macro
+// invocation, ignoring commas in nested parentheses and quotes.
+func splitMacroArguments(in string) []string {
+	if strings.TrimSpace(in) == "" {
+		return nil
+	}
+
+	var args []string
+	depth, start := 0, 0
+	for i := 0; i < len(in); i++ {
+		switch in[i] {
+		case '"', '\'':
+			if _, n, err := ScanDelimited([]byte(in[i:]), false, rune(in[i])); err == nil {
+				i += n - 1
+			}
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(in[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(args, strings.TrimSpace(in[start:]))
+}
+
+// substituteMacroParameters replaces references of the form `$param` to the
+// given parameters with their values. Other uses of `$`, such as regular
+// expression anchors, are left as is.
+func substituteMacroParameters(definition string, values map[string]string) string {
+	if len(values) == 0 {
+		return definition
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(definition); i++ {
+		if definition[i] == '$' {
+			param := identPrefix(definition[i+1:])
+			if value, ok := values[param]; ok {
+				b.WriteString(value)
+				i += len(param)
+				continue
+			}
+		}
+		b.WriteByte(definition[i])
+	}
+	return b.String()
+}
+
+// identPrefix returns the longest prefix of the input that is a valid
+// parameter name.
+func identPrefix(in string) string {
+	for i := 0; i < len(in); i++ {
+		c := in[i]
+		if c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || i > 0 && '0' <= c && c <= '9' {
+			continue
+		}
+		return in[:i]
+	}
+	return in
+}
+
+// hasTopLevelOr returns whether the query contains an `or` operator outside
+// of parentheses and quotes.
+func hasTopLevelOr(in string) bool {
+	depth := 0
+	for i := 0; i < len(in); i++ {
+		switch c := in[i]; {
+		case c == '"' || c == '\'':
+			if _, n, err := ScanDelimited([]byte(in[i:]), false, rune(c)); err == nil {
+				i += n - 1
+			}
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth == 0 && (i == 0 || isMacroBoundary(in[i-1])) && len(in) >= i+2 && strings.EqualFold(in[i:i+2], "or"):
+			if len(in) == i+2 || isMacroBoundary(in[i+2]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isMacroBoundary returns whether a macro invocation may start after the
+// given character.
+func isMacroBoundary(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '('
+}
@@ -0,0 +1,168 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandMacros(t *testing.T) {
+	macros := ParseMacros(map[string]string{
+		"backend":        `repo:^github\.com/acme/(api|worker)$ -file:_test\.go$`,
+		"svc(name)":      `repo:^github\.com/acme/$name$`,
+		"either(a, b)":   `$a or $b`,
+		"go-svc( name )": `@svc($name) lang:go`,
+		"todo":           `TODO`,
+		"cycle":          `@cycle2 foo`,
+		"cycle2":         `@cycle`,
+		"self":           `@self`,
+		"dup(x, x)":      `$x`,
+		"bad(1)":         `x`,
+	})
+
+	cases := []struct {
+		name  string
+		input string
+		want  string
+	}{{
+		name:  "no macros",
+		input: `repo:foo bar`,
+		want:  `repo:foo bar`,
+	}, {
+		name:  "macro",
+		input: `@backend bar`,
+		want:  `repo:^github\.com/acme/(api|worker)$ -file:_test\.go$ bar`,
+	}, {
+		name:  "macro with parameters",
+		input: `@svc(api) bar`,
+		want:  `repo:^github\.com/acme/api$ bar`,
+	}, {
+		name:  "macro in group",
+		input: `(@todo or bar) @backend`,
+		want:  `(TODO or bar) repo:^github\.com/acme/(api|worker)$ -file:_test\.go$`,
+	}, {
+		name:  "or is grouped",
+		input: `@either(repo:a, repo:b) bar`,
+		want:  `(repo:a or repo:b) bar`,
+	}, {
+		name:  "nested macro",
+		input: `@go-svc(web)`,
+		want:  `repo:^github\.com/acme/web$ lang:go`,
+	}, {
+		name:  "macro in argument",
+		input: `@either(@todo, FIXME)`,
+		want:  `(TODO or FIXME)`,
+	}, {
+		name:  "undefined macro",
+		input: `@Override public`,
+		want:  `@Override public`,
+	}, {
+		name:  "not a token",
+		input: `foo@backend @backend.go`,
+		want:  `foo@backend @backend.go`,
+	}, {
+		name:  "quoted",
+		input: `"@backend" repo:'@todo'`,
+		want:  `"@backend" repo:'@todo'`,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ExpandMacros(tc.input, macros)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	errorCases := []struct {
+		name  string
+		input string
+		want  string
+	}{{
+		name:  "cycle",
+		input: `@cycle`,
+		want:  `macro @cycle invokes itself: @cycle -> @cycle2 -> @cycle`,
+	}, {
+		name:  "self",
+		input: `foo @self`,
+		want:  `macro @self invokes itself: @self -> @self`,
+	}, {
+		name:  "wrong number of arguments",
+		input: `@svc(a, b)`,
+		want:  `macro @svc expects 1 argument(s), got 2`,
+	}, {
+		name:  "missing arguments",
+		input: `@svc`,
+		want:  `macro @svc expects 1 argument(s), got 0`,
+	}, {
+		name:  "duplicate parameter",
+		input: `@dup(a, b)`,
+		want:  `parameter "x" of macro @dup is declared more than once`,
+	}, {
+		name:  "invalid parameter",
+		input: `@bad(a)`,
+		want:  `invalid parameter "1" for macro @bad`,
+	}}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ExpandMacros(tc.input, macros)
+			require.EqualError(t, err, tc.want)
+		})
+	}
+}
+
+func TestExpandMacrosLimits(t *testing.T) {
+	t.Run("size", func(t *testing.T) {
+		// Each level doubles the size of the expansion.
+		macros := ParseMacros(map[string]string{
+			"double(x)": `$x $x`,
+			"a":         `@double(@double(@double(@double(foobar))))`,
+			"b":         `@double(@double(@double(@double(@a))))`,
+			"c":         `@double(@double(@double(@double(@b))))`,
+			"d":         `@double(@double(@double(@double(@c))))`,
+		})
+		_, err := ExpandMacros(`@b`, macros)
+		require.NoError(t, err)
+		_, err = ExpandMacros(`@d`, macros)
+		require.EqualError(t, err, `expanding macro @double exceeds the maximum query size of 65536 bytes`)
+	})
+
+	t.Run("invocations", func(t *testing.T) {
+		macros := ParseMacros(map[string]string{
+			"x": `x`,
+			"a": `@x @x @x @x @x @x @x @x @x @x`,
+			"b": `@a @a @a @a @a @a @a @a @a @a`,
+			"c": `@b @b @b @b @b @b @b @b @b @b`,
+		})
+		_, err := ExpandMacros(`@b`, macros)
+		require.NoError(t, err)
+		_, err = ExpandMacros(`@c`, macros)
+		require.EqualError(t, err, `expanding macro @b exceeds the maximum of 1000 macro invocations`)
+	})
+}
+
+func TestExpandMacrosPlan(t *testing.T) {
+	// The expanded query is what is parsed, validated and printed.
+	macros := ParseMacros(map[string]string{
+		"backend": `repo:^api$ -file:_test\.go$`,
+		"either":  `repo:a or repo:b`,
+		"exact":   `case:yes`,
+	})
+
+	test := func(input string) string {
+		expanded, err := ExpandMacros(input, macros)
+		if err != nil {
+			return err.Error()
+		}
+		plan, err := Pipeline(InitLiteral(expanded))
+		if err != nil {
+			return err.Error()
+		}
+		return StringHuman(plan.ToQ())
+	}
+
+	require.Equal(t, `repo:^api$ -file:_test\.go$ foo`, test(`@backend foo`))
+	require.Equal(t, `(repo:a foo OR repo:b foo)`, test(`@either foo`))
+	require.Equal(t, `field "case" may not be used more than once`, test(`@exact case:no foo`))
+}
@@ -39,6 +39,7 @@ type FrontendStreamDecoder struct {
 	OnFilters  func([]*EventFilter)
 	OnAlert    func(*EventAlert)
 	OnError    func(*EventError)
+	OnExplain  func(*EventExplain)
 	OnUnknown  func(event, data []byte)
 }
 
@@ -98,6 +99,15 @@ func (rr FrontendStreamDecoder) ReadAll(r io.Reader) error {
 				return errors.Errorf("failed to decode error payload: %w", err)
 			}
 			rr.OnError(&d)
+		} else if bytes.Equal(event, []byte("explain")) {
+			if rr.OnExplain == nil {
+				continue
+			}
+			var d EventExplain
+			if err := json.Unmarshal(data, &d); err != nil {
+				return errors.Errorf("failed to decode explain payload: %w", err)
+			}
+			rr.OnExplain(&d)
 		} else if bytes.Equal(event, []byte("done")) {
 			// Always the last event
 			break
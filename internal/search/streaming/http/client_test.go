@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
 func TestFrontendClient(t *testing.T) {
@@ -66,6 +67,18 @@ func TestFrontendClient(t *testing.T) {
 		Value: &EventError{
 			Message: "error",
 		},
+	}, {
+		Name: "explain",
+		Value: &EventExplain{
+			Plan:           "(REPOPAGER)",
+			IndexedRepos:   pointers.Ptr(1),
+			UnindexedRepos: pointers.Ptr(0),
+			Jobs: []EventExplainJob{{
+				Name:       "RepoPagerJob",
+				DurationMs: 5,
+				Children:   []EventExplainJob{{Name: "ZoektRepoSubsetTextSearchJob", Results: 3}},
+			}},
+		},
 	}}
 
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -108,6 +121,9 @@ func TestFrontendClient(t *testing.T) {
 		OnError: func(d *EventError) {
 			got = append(got, Event{Name: "error", Value: d})
 		},
+		OnExplain: func(d *EventExplain) {
+			got = append(got, Event{Name: "explain", Value: d})
+		},
 		OnUnknown: func(event, data []byte) {
 			t.Fatalf("got unexpected event: %s %s", event, data)
 		},
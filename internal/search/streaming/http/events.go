@@ -231,6 +231,34 @@ type Annotation struct {
 	Value string `json:"value"`
 }
 
+// EventExplain describes how a search was planned and executed. It is sent as the
+// final event before done when a search is run in explain mode.
+type EventExplain struct {
+	// Plan is the compiled job tree of the search, as an s-expression.
+	Plan string `json:"plan"`
+
+	// IndexedRepos and UnindexedRepos are the number of repositories sent to
+	// indexed (zoekt) and unindexed (searcher) search. They are omitted if no
+	// repositories were resolved, e.g. because only global searches ran.
+	IndexedRepos   *int `json:"indexedRepos,omitempty"`
+	UnindexedRepos *int `json:"unindexedRepos,omitempty"`
+
+	// Jobs is the tree of jobs that ran, in the order they started.
+	Jobs []EventExplainJob `json:"jobs"`
+}
+
+// EventExplainJob is a single run of a job of a search in explain mode.
+type EventExplainJob struct {
+	Name           string            `json:"name"`
+	Attributes     map[string]string `json:"attributes,omitempty"`
+	DurationMs     int64             `json:"durationMs"`
+	Results        int64             `json:"results"`
+	IndexedRepos   int               `json:"indexedRepos,omitempty"`
+	UnindexedRepos int               `json:"unindexedRepos,omitempty"`
+	Error          string            `json:"error,omitempty"`
+	Children       []EventExplainJob `json:"children,omitempty"`
+}
+
 // EventError emulates a JavaScript error with a message property
 // as is returned when the search encounters an error.
 type EventError struct {
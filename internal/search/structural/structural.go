@@ -188,6 +188,7 @@ func (s *SearchJob) Run(ctx context.Context, clients job.RuntimeClients, stream
 		if err != nil {
 			return nil, err
 		}
+		job.RecordRepos(ctx, indexed.Len(), len(unindexed))
 
 		repoSet := []repoData{UnindexedList(unindexed)}
 		if indexed != nil {
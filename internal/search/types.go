@@ -31,6 +31,7 @@ type Inputs struct {
 	Plan                   query.Plan // the comprehensive query plan
 	Query                  query.Q    // the current basic query being evaluated, one part of query.Plan
 	OriginalQuery          string     // the raw string of the original search query
+	ExpandedQuery          string     // the raw string of the original search query after expanding query macros
 	SearchMode             Mode
 	PatternType            query.SearchType
 	UserSettings           *schema.Settings
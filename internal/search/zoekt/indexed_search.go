@@ -45,6 +45,14 @@ type IndexedRepoRevs struct {
 	branchRepos map[string]*zoektquery.BranchRepos
 }
 
+// Len returns the number of repositories to search.
+func (rb *IndexedRepoRevs) Len() int {
+	if rb == nil {
+		return 0
+	}
+	return len(rb.RepoRevs)
+}
+
 // GetRepoRevsFromBranchRepos updates RepoRevs by replacing revision values that are not defined branches in
 // Zoekt and replaces with a known indexed branch.
 // This is used for structural search querying revisions of RepositoryRevisions that are indexed but not the branch name.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"sort"
+	"strings"
 
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -172,9 +174,42 @@ func mergeSettings(allSettings ...*schema.Settings) *schema.Settings {
 }
 
 func mergeSettingsLeft(left, right *schema.Settings) *schema.Settings {
+	if left != nil && right != nil {
+		left.SearchMacros = withoutOverriddenMacros(left.SearchMacros, right.SearchMacros)
+	}
 	return mergeLeft(reflect.ValueOf(left), reflect.ValueOf(right), 1).Interface().(*schema.Settings)
 }
 
+// withoutOverriddenMacros returns the search macros of left that are not
+// defined in right. Macros are matched by name rather than by key, because
+// keys such as `svc(a)` and `svc( name )` define the same macro.
+func withoutOverriddenMacros(left, right map[string]string) map[string]string {
+	if len(left) == 0 || len(right) == 0 {
+		return left
+	}
+
+	overridden := make(map[string]struct{}, len(right))
+	for key := range right {
+		overridden[macroName(key)] = struct{}{}
+	}
+
+	macros := make(map[string]string, len(left))
+	for key, definition := range left {
+		if _, ok := overridden[macroName(key)]; !ok {
+			macros[key] = definition
+		}
+	}
+	return macros
+}
+
+var macroNameRegexp = lazyregexp.New(`^[A-Za-z_][A-Za-z0-9_-]*`)
+
+// macroName returns the name of the macro defined by a key of the
+// `search.macros` setting, or "" if the key doesn't start with a valid name.
+func macroName(key string) string {
+	return macroNameRegexp.FindString(strings.TrimSpace(key))
+}
+
 var settingsFieldMergeDepths = map[string]int{
 	"SearchScopes":         1,
 	"SearchSavedQueries":   1,
@@ -182,6 +217,7 @@ var settingsFieldMergeDepths = map[string]int{
 	"Notices":              1,
 	"Extensions":           1,
 	"ExperimentalFeatures": 1,
+	"SearchMacros":         1,
 }
 
 // mergeLeft takes two values of the same type and merges them if possible, ignoring
@@ -205,6 +205,28 @@ func TestMergeSettings(t *testing.T) {
 		expected: &schema.Settings{
 			SearchScopes: []*schema.SearchScope{{Name: "test1"}, {Name: "test2"}},
 		},
+	}, {
+		name: "deep merge macros",
+		left: &schema.Settings{
+			SearchMacros: map[string]string{"backend": "repo:a", "frontend": "repo:b"},
+		},
+		right: &schema.Settings{
+			SearchMacros: map[string]string{"backend": "repo:c"},
+		},
+		expected: &schema.Settings{
+			SearchMacros: map[string]string{"backend": "repo:c", "frontend": "repo:b"},
+		},
+	}, {
+		name: "merge macros by name",
+		left: &schema.Settings{
+			SearchMacros: map[string]string{"svc( name )": "repo:$name", "backend": "repo:a"},
+		},
+		right: &schema.Settings{
+			SearchMacros: map[string]string{"svc(a)": "repo:^$a$"},
+		},
+		expected: &schema.Settings{
+			SearchMacros: map[string]string{"svc(a)": "repo:^$a$", "backend": "repo:a"},
+		},
 	},
 	}
 
@@ -97,6 +97,8 @@ func (e *ExternalService) RedactedConfig(ctx context.Context) (string, error) {
 		es.redactString(c.Maven.Credentials, "maven", "credentials")
 	case *schema.PagureConnection:
 		es.redactString(c.Token, "token")
+	case *schema.GitManifestConnection:
+		es.redactString(c.Token, "token")
 	case *schema.NpmPackagesConnection:
 		es.redactString(c.Credentials, "credentials")
 	case *schema.OtherExternalServiceConnection:
@@ -256,6 +258,12 @@ func (e *ExternalService) UnredactConfig(ctx context.Context, old *ExternalServi
 			return errCodeHostIdentityChanged{"url", "token"}
 		}
 		es.unredactString(c.Token, o.Token, "token")
+	case *schema.GitManifestConnection:
+		o := oldCfg.(*schema.GitManifestConnection)
+		if c.Token == RedactedSecret && c.Url != o.Url {
+			return errCodeHostIdentityChanged{"url", "token"}
+		}
+		es.unredactString(c.Token, o.Token, "token")
 	case *schema.AzureDevOpsConnection:
 		o := oldCfg.(*schema.AzureDevOpsConnection)
 		if c.Token == RedactedSecret && c.Url != o.Url {
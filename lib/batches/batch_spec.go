@@ -3,6 +3,7 @@ package batches
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/sourcegraph/sourcegraph/lib/batches/env"
 	"github.com/sourcegraph/sourcegraph/lib/batches/overridable"
@@ -37,6 +38,7 @@ type BatchSpec struct {
 	TransformChanges  *TransformChanges        `json:"transformChanges,omitempty" yaml:"transformChanges,omitempty"`
 	ImportChangesets  []ImportChangeset        `json:"importChangesets,omitempty" yaml:"importChangesets"`
 	ChangesetTemplate *ChangesetTemplate       `json:"changesetTemplate,omitempty" yaml:"changesetTemplate"`
+	AutoMerge         *AutoMerge               `json:"autoMerge,omitempty" yaml:"autoMerge,omitempty"`
 }
 
 type ChangesetTemplate struct {
@@ -46,6 +48,10 @@ type ChangesetTemplate struct {
 	Fork      *bool                        `json:"fork,omitempty" yaml:"fork"`
 	Commit    ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
 	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
+	Reviewers []string                     `json:"reviewers,omitempty" yaml:"reviewers"`
+	Assignees []string                     `json:"assignees,omitempty" yaml:"assignees"`
+	Labels    []string                     `json:"labels,omitempty" yaml:"labels"`
+	Milestone string                       `json:"milestone,omitempty" yaml:"milestone"`
 }
 
 type GitCommitAuthor struct {
@@ -96,6 +102,39 @@ type Step struct {
 	Outputs   Outputs           `json:"outputs,omitempty" yaml:"outputs,omitempty"`
 	Mount     []Mount           `json:"mount,omitempty" yaml:"mount,omitempty"`
 	If        any               `json:"if,omitempty" yaml:"if,omitempty"`
+
+	// Timeout and RetryBackoff are duration strings, such as "10m".
+	Timeout      string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	Retries      int    `json:"retries,omitempty" yaml:"retries,omitempty"`
+	RetryBackoff string `json:"retryBackoff,omitempty" yaml:"retryBackoff,omitempty"`
+
+	// CacheKey is included in the cache key of the step, so that changing it
+	// invalidates the cached results of the step.
+	CacheKey string `json:"cacheKey,omitempty" yaml:"cacheKey,omitempty"`
+}
+
+// DefaultStepRetryBackoff is the duration to wait before retrying a failed
+// step for the first time, if the step doesn't define a retryBackoff.
+const DefaultStepRetryBackoff = 5 * time.Second
+
+// TimeoutDuration returns the parsed timeout of the step, or 0 if the step
+// doesn't have a timeout.
+func (s *Step) TimeoutDuration() time.Duration {
+	d, err := time.ParseDuration(s.Timeout)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// RetryBackoffDuration returns the parsed retry backoff of the step, or the
+// default backoff if the step doesn't define one.
+func (s *Step) RetryBackoffDuration() time.Duration {
+	d, err := time.ParseDuration(s.RetryBackoff)
+	if err != nil || d <= 0 {
+		return DefaultStepRetryBackoff
+	}
+	return d
 }
 
 func (s *Step) IfCondition() string {
@@ -127,6 +166,54 @@ type Group struct {
 	Directory  string `json:"directory,omitempty" yaml:"directory"`
 	Branch     string `json:"branch,omitempty" yaml:"branch"`
 	Repository string `json:"repository,omitempty" yaml:"repository"`
+	DependsOn  string `json:"dependsOn,omitempty" yaml:"dependsOn"`
+}
+
+// AutoMerge is a policy describing when the changesets of a batch change are
+// merged automatically, and how.
+type AutoMerge struct {
+	Checks  string            `json:"checks,omitempty" yaml:"checks"`
+	Reviews string            `json:"reviews,omitempty" yaml:"reviews"`
+	Method  string            `json:"method,omitempty" yaml:"method"`
+	Windows []AutoMergeWindow `json:"windows,omitempty" yaml:"windows"`
+}
+
+const (
+	AutoMergeChecksPassed    = "passed"
+	AutoMergeChecksAny       = "any"
+	AutoMergeReviewsApproved = "approved"
+	AutoMergeReviewsAny      = "any"
+	AutoMergeMethodMerge     = "merge"
+	AutoMergeMethodSquash    = "squash"
+)
+
+// RequiredChecks returns the required check state, defaulting to
+// AutoMergeChecksPassed.
+func (am *AutoMerge) RequiredChecks() string {
+	if am.Checks == "" {
+		return AutoMergeChecksPassed
+	}
+	return am.Checks
+}
+
+// RequiredReviews returns the required review state, defaulting to
+// AutoMergeReviewsApproved.
+func (am *AutoMerge) RequiredReviews() string {
+	if am.Reviews == "" {
+		return AutoMergeReviewsApproved
+	}
+	return am.Reviews
+}
+
+// Squash returns whether changesets should be squash merged.
+func (am *AutoMerge) Squash() bool {
+	return am.Method == AutoMergeMethodSquash
+}
+
+type AutoMergeWindow struct {
+	Days  []string `json:"days,omitempty" yaml:"days"`
+	Start string   `json:"start,omitempty" yaml:"start"`
+	End   string   `json:"end,omitempty" yaml:"end"`
 }
 
 type Mount struct {
@@ -166,7 +253,21 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 		errs = errors.Append(errs, NewValidationError(errors.New("batch spec includes steps but no changesetTemplate")))
 	}
 
+	if spec.AutoMerge != nil && spec.ChangesetTemplate == nil {
+		errs = errors.Append(errs, NewValidationError(errors.New("batch spec includes autoMerge but no changesetTemplate")))
+	}
+
 	for i, step := range spec.Steps {
+		if step.Timeout != "" {
+			if d, err := time.ParseDuration(step.Timeout); err != nil || d <= 0 {
+				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d timeout must be a positive duration", i+1)))
+			}
+		}
+		if step.RetryBackoff != "" {
+			if d, err := time.ParseDuration(step.RetryBackoff); err != nil || d <= 0 {
+				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d retryBackoff must be a positive duration", i+1)))
+			}
+		}
 		for _, mount := range step.Mount {
 			if strings.Contains(mount.Path, invalidMountCharacters) {
 				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d mount path contains invalid characters", i+1)))
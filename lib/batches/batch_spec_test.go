@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
 )
 
@@ -211,6 +213,151 @@ changesetTemplate:
 		_, err := ParseBatchSpec([]byte(spec))
 		assert.Equal(t, "step 1 mount mountpoint contains invalid characters", err.Error())
 	})
+
+	t.Run("autoMerge", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+autoMerge:
+  checks: any
+  method: squash
+  windows:
+    - days: [mon, tue]
+      start: "09:00"
+      end: "17:00"
+`
+		have, err := ParseBatchSpec([]byte(spec))
+		if err != nil {
+			t.Fatalf("parsing valid spec returned error: %s", err)
+		}
+
+		want := &AutoMerge{
+			Checks: AutoMergeChecksAny,
+			Method: AutoMergeMethodSquash,
+			Windows: []AutoMergeWindow{
+				{Days: []string{"mon", "tue"}, Start: "09:00", End: "17:00"},
+			},
+		}
+		if diff := cmp.Diff(want, have.AutoMerge); diff != "" {
+			t.Fatalf("wrong autoMerge (-want +have):\n%s", diff)
+		}
+		assert.Equal(t, AutoMergeChecksAny, have.AutoMerge.RequiredChecks())
+		assert.Equal(t, AutoMergeReviewsApproved, have.AutoMerge.RequiredReviews())
+		assert.True(t, have.AutoMerge.Squash())
+	})
+
+	t.Run("autoMerge without changesetTemplate", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+importChangesets:
+  - repository: github.com/sourcegraph/sourcegraph
+    externalIDs: [1]
+autoMerge:
+  method: merge
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Equal(t, "batch spec includes autoMerge but no changesetTemplate", err.Error())
+	})
+
+	t.Run("autoMerge with invalid method", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+autoMerge:
+  method: rebase
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Error(t, err)
+	})
+
+	t.Run("step timeout and retries", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: npm install
+    container: node:18
+    timeout: 10m
+    retries: 3
+    retryBackoff: 30s
+    cacheKey: v2
+  - run: npm test
+    container: node:18
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+`
+		got, err := ParseBatchSpec([]byte(spec))
+		require.NoError(t, err)
+		require.Len(t, got.Steps, 2)
+
+		assert.Equal(t, 10*time.Minute, got.Steps[0].TimeoutDuration())
+		assert.Equal(t, 3, got.Steps[0].Retries)
+		assert.Equal(t, 30*time.Second, got.Steps[0].RetryBackoffDuration())
+		assert.Equal(t, "v2", got.Steps[0].CacheKey)
+
+		assert.Equal(t, time.Duration(0), got.Steps[1].TimeoutDuration())
+		assert.Equal(t, DefaultStepRetryBackoff, got.Steps[1].RetryBackoffDuration())
+	})
+
+	t.Run("invalid step timeout", func(t *testing.T) {
+		for _, timeout := range []string{"10 minutes", "0s"} {
+			spec := `
+name: test-spec
+description: A test spec
+steps:
+  - run: npm install
+    container: node:18
+    timeout: ` + timeout + `
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+`
+			_, err := ParseBatchSpec([]byte(spec))
+			assert.Error(t, err, timeout)
+		}
+	})
+
+	t.Run("too many retries", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: npm install
+    container: node:18
+    retries: 11
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Error(t, err)
+	})
 }
 
 func TestOnQueryOrRepository_Branches(t *testing.T) {
@@ -78,6 +78,15 @@ type ChangesetSpec struct {
 	Body  string `json:"body,omitempty"`
 	Fork  *bool  `json:"fork,omitempty"`
 
+	Reviewers []string `json:"reviewers,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Milestone string   `json:"milestone,omitempty"`
+
+	// DependsOn is the head ref of another changeset in the same repository
+	// that this changeset is stacked on.
+	DependsOn string `json:"dependsOn,omitempty"`
+
 	Commits []GitCommitDescription `json:"commits,omitempty"`
 
 	Published PublishedValue `json:"published,omitempty"`
@@ -101,6 +110,11 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		HeadRef        string                 `json:"headRef,omitempty"`
 		Title          string                 `json:"title,omitempty"`
 		Body           string                 `json:"body,omitempty"`
+		Reviewers      []string               `json:"reviewers,omitempty"`
+		Assignees      []string               `json:"assignees,omitempty"`
+		Labels         []string               `json:"labels,omitempty"`
+		Milestone      string                 `json:"milestone,omitempty"`
+		DependsOn      string                 `json:"dependsOn,omitempty"`
 		Commits        []GitCommitDescription `json:"commits,omitempty"`
 		Published      *PublishedValue        `json:"published,omitempty"`
 	}{
@@ -112,6 +126,11 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		HeadRef:        c.HeadRef,
 		Title:          c.Title,
 		Body:           c.Body,
+		Reviewers:      c.Reviewers,
+		Assignees:      c.Assignees,
+		Labels:         c.Labels,
+		Milestone:      c.Milestone,
+		DependsOn:      c.DependsOn,
 		Commits:        c.Commits,
 	}
 	if !c.Published.Nil() {
@@ -94,6 +94,26 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 		return nil, err
 	}
 
+	reviewers, err := renderChangesetTemplateList("reviewers", input.Template.Reviewers, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignees, err := renderChangesetTemplateList("assignees", input.Template.Assignees, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := renderChangesetTemplateList("labels", input.Template.Labels, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, err := template.RenderChangesetTemplateField("milestone", input.Template.Milestone, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: As a next step, we should extend the ChangesetTemplateContext to also include
 	// TransformChanges.Group and then change validateGroups and groupFileDiffs to, for each group,
 	// render the branch name *before* grouping the diffs.
@@ -121,10 +141,14 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 			BaseRef:        input.Repository.BaseRef,
 			BaseRev:        input.Repository.BaseRev,
 
-			HeadRef: git.EnsureRefPrefix(branch),
-			Title:   title,
-			Body:    body,
-			Fork:    fork,
+			HeadRef:   git.EnsureRefPrefix(branch),
+			Title:     title,
+			Body:      body,
+			Fork:      fork,
+			Reviewers: reviewers,
+			Assignees: assignees,
+			Labels:    labels,
+			Milestone: milestone,
 			Commits: []GitCommitDescription{
 				{
 					Version:     version,
@@ -153,8 +177,21 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 			return specs, errors.Wrap(err, "grouping diffs failed")
 		}
 
+		dependencies := make(map[string]string, len(groups))
+		for _, g := range groups {
+			dependencies[g.Branch] = g.DependsOn
+		}
+
 		for branch, diff := range diffsByBranch {
 			spec := newSpec(branch, diff)
+			// If the changeset this one depends on has no changes in this
+			// repository, there is nothing to stack on and the changeset
+			// targets the base branch directly.
+			if dependsOn := dependencies[branch]; dependsOn != "" {
+				if _, ok := diffsByBranch[dependsOn]; ok {
+					spec.DependsOn = git.EnsureRefPrefix(dependsOn)
+				}
+			}
 			specs = append(specs, spec)
 		}
 	} else {
@@ -165,6 +202,37 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 	return specs, nil
 }
 
+// renderChangesetTemplateList renders each of the given templated values. A
+// rendered value can expand to several entries separated by commas or newlines,
+// so that a single template can produce, for example, the list of owners of the
+// changed files. Empty and duplicate entries are dropped.
+func renderChangesetTemplateList(name string, values []string, tmplCtx *template.ChangesetTemplateContext) ([]string, error) {
+	var (
+		rendered []string
+		seen     = map[string]struct{}{}
+	)
+	for _, value := range values {
+		out, err := template.RenderChangesetTemplateField(name, value, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range strings.FieldsFunc(out, func(r rune) bool { return r == ',' || r == '\n' }) {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			if _, ok := seen[entry]; ok {
+				continue
+			}
+			seen[entry] = struct{}{}
+			rendered = append(rendered, entry)
+		}
+	}
+
+	return rendered, nil
+}
+
 type RepoFetcher func(context.Context, []string) (map[string]string, error)
 
 func BuildImportChangesetSpecs(ctx context.Context, importChangesets []ImportChangeset, repoFetcher RepoFetcher) (specs []*ChangesetSpec, errs error) {
@@ -239,6 +307,37 @@ func validateGroups(repoName, defaultBranch string, groups []Group) error {
 		}
 	}
 
+	return validateGroupDependencies(repoName, defaultBranch, groups)
+}
+
+// validateGroupDependencies checks that every group depends on the branch of
+// another group or on the default branch, and that the dependencies don't
+// form a cycle.
+func validateGroupDependencies(repoName, defaultBranch string, groups []Group) error {
+	dependencies := make(map[string]string, len(groups))
+	for _, g := range groups {
+		dependencies[g.Branch] = g.DependsOn
+	}
+
+	for _, g := range groups {
+		if g.DependsOn == "" {
+			continue
+		}
+		if _, ok := dependencies[g.DependsOn]; !ok && g.DependsOn != defaultBranch {
+			return NewValidationError(errors.Newf("transformChanges group branch %q in repository %s depends on unknown branch %q", g.Branch, repoName, g.DependsOn))
+		}
+
+		// Follow the dependencies: there are only len(groups) of them, so if
+		// we take more steps than that, we're going in circles.
+		branch := g.Branch
+		for i := 0; branch != ""; i++ {
+			if i > len(groups) {
+				return NewValidationError(errors.Newf("transformChanges group branch %q in repository %s has circular dependencies", g.Branch, repoName))
+			}
+			branch = dependencies[branch]
+		}
+	}
+
 	return nil
 }
 
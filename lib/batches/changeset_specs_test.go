@@ -158,6 +158,26 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "reviewers, assignees, labels and milestone",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Reviewers = []string{"alice", "${{ join outputs.owners \",\" }}", "alice"}
+				input.Template.Assignees = []string{"${{ batch_change.name }}-owner"}
+				input.Template.Labels = []string{"automated", "", "lang:${{ index outputs.owners 0 }}"}
+				input.Template.Milestone = "${{ batch_change.name }}"
+				input.Template.Published = parsePublishedFieldString(t, "false")
+				input.Result.Outputs = map[string]any{"owners": []string{"bob", " carol "}}
+			}),
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.Reviewers = []string{"alice", "bob", "carol"}
+					s.Assignees = []string{"the name-owner"}
+					s.Labels = []string{"automated", "lang:bob"}
+					s.Milestone = "the name"
+				}),
+			},
+			wantErr: "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -326,6 +346,26 @@ func TestValidateGroups(t *testing.T) {
 			},
 			wantErr: "transformChanges group branch for repository github.com/sourcegraph/src-cli is the same as branch \"my-batch-change\" in changesetTemplate",
 		},
+		{
+			groups: []Group{
+				{Directory: "a", Branch: "my-batch-change-a", DependsOn: defaultBranch},
+				{Directory: "b", Branch: "my-batch-change-b", DependsOn: "my-batch-change-a"},
+			},
+			wantErr: "",
+		},
+		{
+			groups: []Group{
+				{Directory: "a", Branch: "my-batch-change-a", DependsOn: "my-batch-change-UNKNOWN"},
+			},
+			wantErr: "transformChanges group branch \"my-batch-change-a\" in repository github.com/sourcegraph/src-cli depends on unknown branch \"my-batch-change-UNKNOWN\"",
+		},
+		{
+			groups: []Group{
+				{Directory: "a", Branch: "my-batch-change-a", DependsOn: "my-batch-change-b"},
+				{Directory: "b", Branch: "my-batch-change-b", DependsOn: "my-batch-change-a"},
+			},
+			wantErr: "transformChanges group branch \"my-batch-change-a\" in repository github.com/sourcegraph/src-cli has circular dependencies",
+		},
 	}
 
 	for _, tc := range tests {
@@ -112,7 +112,7 @@ func (key CacheKey) Key() (string, error) {
 	// Setup a copy of the cache key that only includes the Steps up to and
 	// including key.StepIndex.
 	clone := key
-	clone.Steps = key.Steps[0 : key.StepIndex+1]
+	clone.Steps = cacheableSteps(key.Steps[0 : key.StepIndex+1])
 
 	// Resolve environment only for the subset of Steps.
 	envs, err := resolveStepsEnvironment(key.GlobalEnv, clone.Steps)
@@ -131,6 +131,21 @@ func (key CacheKey) Key() (string, error) {
 	return fmt.Sprintf("%s-step-%d", hash, key.StepIndex), err
 }
 
+// cacheableSteps returns a copy of the steps without the settings that only
+// control how a step is run, so that changing them doesn't invalidate cached
+// results. The CacheKey of a step is kept, since changing it is how cached
+// results are invalidated on purpose.
+func cacheableSteps(steps []batches.Step) []batches.Step {
+	cacheable := make([]batches.Step, len(steps))
+	for i, step := range steps {
+		step.Timeout = ""
+		step.Retries = 0
+		step.RetryBackoff = ""
+		cacheable[i] = step
+	}
+	return cacheable
+}
+
 func (key CacheKey) Slug() string {
 	return SlugForRepo(key.Repository.Name, key.Repository.BaseRev)
 }
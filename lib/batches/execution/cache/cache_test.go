@@ -68,6 +68,25 @@ func TestKeyer_Key(t *testing.T) {
 			},
 			expectedKey: "_zR95x8sdhauCYxjtOHCbA-step-1",
 		},
+		{
+			name: "timeout and retries",
+			keyer: &CacheKey{
+				Repository: repo,
+				Steps:      []batches.Step{{Run: "foo", Timeout: "10m", Retries: 3, RetryBackoff: "30s"}},
+				StepIndex:  0,
+			},
+			// Same key as "simple": these settings don't invalidate the cache.
+			expectedKey: "NxWM6tGwnsIG5EoaivFOsg-step-0",
+		},
+		{
+			name: "cache key",
+			keyer: &CacheKey{
+				Repository: repo,
+				Steps:      []batches.Step{{Run: "foo", CacheKey: "v2"}},
+				StepIndex:  0,
+			},
+			expectedKey: "UjUUTKwhaVcqALqZE8JG_A-step-0",
+		},
 		{
 			name: "step env",
 			keyer: &CacheKey{
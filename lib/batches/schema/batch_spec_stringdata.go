@@ -237,6 +237,29 @@ const BatchSpecJSON = `{
                 }
               }
             }
+          },
+          "timeout": {
+            "type": "string",
+            "description": "The maximum duration the step is allowed to run for, as a duration string. A step that runs for longer is stopped and fails.",
+            "pattern": "^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$",
+            "examples": ["30s", "10m", "1h30m"]
+          },
+          "retries": {
+            "type": "integer",
+            "description": "The number of times the step is retried if it fails or times out. Retries are spaced out by ` + "`" + `retryBackoff` + "`" + `, which doubles with every attempt.",
+            "minimum": 0,
+            "maximum": 10
+          },
+          "retryBackoff": {
+            "type": "string",
+            "description": "The duration to wait before the first retry of the step, as a duration string. Defaults to 5s.",
+            "pattern": "^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$",
+            "examples": ["5s", "1m"]
+          },
+          "cacheKey": {
+            "type": "string",
+            "description": "An arbitrary value that is included in the cache key of the step. Changing it invalidates the cached results of this step and all following steps.",
+            "examples": ["v2", "2023-10-18"]
           }
         }
       }
@@ -269,6 +292,11 @@ const BatchSpecJSON = `{
                 "type": "string",
                 "description": "Only apply this transformation in the repository with this name (as it is known to Sourcegraph).",
                 "examples": ["github.com/foo/bar"]
+              },
+              "dependsOn": {
+                "type": "string",
+                "description": "The branch of another changeset in the same repository that this changeset is stacked on: either the branch of another group or the branch of the changeset template. The changeset targets that branch until the other changeset is merged or closed, and is then retargeted to the base branch.",
+                "minLength": 1
               }
             }
           }
@@ -328,6 +356,31 @@ const BatchSpecJSON = `{
           "type": "boolean",
           "description": "Whether to publish the changeset to a fork of the target repository. If omitted, the changeset will be published to a branch directly on the target repository, unless the global ` + "`" + `batches.enforceFork` + "`" + ` setting is enabled. If set, this property will override any global setting."
         },
+        "reviewers": {
+          "type": "array",
+          "description": "The users (or teams, on code hosts that support them) to request reviews from. Each entry is templated, and may expand to several comma-separated names, such as the owners of the changed files. Not every code host supports reviewers.",
+          "items": {
+            "type": "string"
+          }
+        },
+        "assignees": {
+          "type": "array",
+          "description": "The users to assign the changeset to. Each entry is templated, and may expand to several comma-separated names. Not every code host supports assignees.",
+          "items": {
+            "type": "string"
+          }
+        },
+        "labels": {
+          "type": "array",
+          "description": "The labels to add to the changeset. Each entry is templated, and may expand to several comma-separated labels. Not every code host supports labels.",
+          "items": {
+            "type": "string"
+          }
+        },
+        "milestone": {
+          "type": "string",
+          "description": "The title of the milestone to add the changeset to. The value is templated. Not every code host supports milestones."
+        },
         "commit": {
           "title": "ExpandedGitCommitDescription",
           "type": "object",
@@ -401,6 +454,61 @@ const BatchSpecJSON = `{
           ]
         }
       }
+    },
+    "autoMerge": {
+      "title": "AutoMerge",
+      "type": ["object", "null"],
+      "description": "A policy to automatically merge the published changesets of this batch change once they satisfy it. Every decision taken by the policy is recorded as a changeset event.",
+      "additionalProperties": false,
+      "properties": {
+        "checks": {
+          "type": "string",
+          "description": "The state the checks on a changeset must be in for it to be merged. \"passed\" requires all checks to have passed, and \"any\" ignores checks.",
+          "enum": ["passed", "any"],
+          "default": "passed"
+        },
+        "reviews": {
+          "type": "string",
+          "description": "The review state a changeset must be in for it to be merged. \"approved\" requires the changeset to have been approved, and \"any\" ignores reviews.",
+          "enum": ["approved", "any"],
+          "default": "approved"
+        },
+        "method": {
+          "type": "string",
+          "description": "How changesets are merged.",
+          "enum": ["merge", "squash"],
+          "default": "merge"
+        },
+        "windows": {
+          "type": ["array", "null"],
+          "description": "The windows during which changesets may be merged, in UTC. If omitted, changesets are merged at any time.",
+          "items": {
+            "title": "AutoMergeWindow",
+            "type": "object",
+            "additionalProperties": false,
+            "properties": {
+              "days": {
+                "type": "array",
+                "description": "Day(s) the window applies to. If omitted, this window applies to all days of the week.",
+                "items": {
+                  "type": "string",
+                  "enum": ["mon", "tue", "wed", "thu", "fri", "sat", "sun"]
+                }
+              },
+              "start": {
+                "type": "string",
+                "description": "Window start time, in the form HH:MM. If omitted, the window applies to the whole day.",
+                "pattern": "^[0-9]{1,2}:[0-9]{2}$"
+              },
+              "end": {
+                "type": "string",
+                "description": "Window end time, in the form HH:MM. If omitted, the window applies to the whole day.",
+                "pattern": "^[0-9]{1,2}:[0-9]{2}$"
+              }
+            }
+          }
+        }
+      }
     }
   }
 }
@@ -68,6 +68,28 @@ const ChangesetSpecJSON = `{
         },
         "title": { "type": "string", "description": "The title of the changeset on the code host." },
         "body": { "type": "string", "description": "The body (description) of the changeset on the code host." },
+        "reviewers": {
+          "type": "array",
+          "description": "The users (or teams) to request reviews from on the code host.",
+          "items": { "type": "string" }
+        },
+        "assignees": {
+          "type": "array",
+          "description": "The users to assign the changeset to on the code host.",
+          "items": { "type": "string" }
+        },
+        "labels": {
+          "type": "array",
+          "description": "The labels to add to the changeset on the code host.",
+          "items": { "type": "string" }
+        },
+        "milestone": { "type": "string", "description": "The title of the milestone to add the changeset to on the code host." },
+        "dependsOn": {
+          "type": "string",
+          "description": "The full name of the head ref of another changeset in the same repository and batch change that this changeset is stacked on. The changeset targets that ref until the other changeset is merged or closed, and is then retargeted to the base ref.",
+          "pattern": "^refs\\/heads\\/\\S+$",
+          "examples": ["refs/heads/step-1"]
+        },
         "commits": {
           "type": "array",
           "description": "The Git commits with the proposed changes. These commits are pushed to the head ref.",
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS sub_repo_perms_acls;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS sub_repo_perms_acls (
+    repo_id integer PRIMARY KEY REFERENCES repo(id) ON DELETE CASCADE,
+    contents text NOT NULL,
+    source text NOT NULL,
+    commit_id text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE sub_repo_perms_acls IS 'Path ACL files, in CODEOWNERS syntax, from which sub-repo permissions are derived.';
+COMMENT ON COLUMN sub_repo_perms_acls.source IS 'Either "upload" for ACL files uploaded by a site admin, or "repository" for ACL files committed to the repository.';
+COMMENT ON COLUMN sub_repo_perms_acls.commit_id IS 'The commit the ACL file was read from, if committed to the repository.';
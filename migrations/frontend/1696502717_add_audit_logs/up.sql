@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id bigserial NOT NULL,
+    audit_id text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    entity text NOT NULL,
+    action text NOT NULL,
+    actor_uid integer NOT NULL,
+    anonymous_actor_uid text NOT NULL,
+    ip text NOT NULL,
+    user_agent text NOT NULL,
+    forwarded_for text NOT NULL,
+    fields jsonb NOT NULL DEFAULT '{}'::jsonb
+) PARTITION BY RANGE (created_at);
+
+-- Monthly partitions are created ahead of time by the audit logs janitor. The
+-- default partition only catches records outside of these.
+CREATE TABLE IF NOT EXISTS audit_logs_default PARTITION OF audit_logs DEFAULT;
+
+CREATE INDEX IF NOT EXISTS audit_logs_created_at ON audit_logs (created_at);
+CREATE INDEX IF NOT EXISTS audit_logs_actor_uid_created_at ON audit_logs (actor_uid, created_at);
+CREATE INDEX IF NOT EXISTS audit_logs_entity ON audit_logs (entity);
+CREATE INDEX IF NOT EXISTS audit_logs_action ON audit_logs (action);
+
+COMMENT ON TABLE audit_logs IS 'Audit log records, when the audit log location is "database" or "all". Partitioned by month of created_at.';
+COMMENT ON COLUMN audit_logs.actor_uid IS 'The ID of the user that took the action, or 0 if the actor is not a user.';
+COMMENT ON COLUMN audit_logs.fields IS 'The additional context of the record as a JSON object.';
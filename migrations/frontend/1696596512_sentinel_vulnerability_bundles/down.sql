@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS vulnerability_bundles;
+
+ALTER TABLE vulnerabilities DROP COLUMN IF EXISTS provenance;
@@ -0,0 +1,17 @@
+ALTER TABLE vulnerabilities ADD COLUMN IF NOT EXISTS provenance TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN vulnerabilities.provenance IS 'Where the advisory was ingested from, such as the URL or the bundle it was read from.';
+
+CREATE TABLE IF NOT EXISTS vulnerability_bundles (
+    id SERIAL PRIMARY KEY,
+    source TEXT NOT NULL,
+    name TEXT NOT NULL,
+    version TEXT NOT NULL,
+    num_vulnerabilities INTEGER NOT NULL DEFAULT 0,
+    ingested_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS vulnerability_bundles_source_name ON vulnerability_bundles (source, name);
+
+COMMENT ON TABLE vulnerability_bundles IS 'OSV bundles ingested into the vulnerabilities table, so that unchanged bundles are skipped.';
+COMMENT ON COLUMN vulnerability_bundles.version IS 'Identifies the ingested content of the bundle, such as its modification time and size.';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS vulnerability_matches_reachability_unchecked;
+
+ALTER TABLE vulnerability_matches DROP COLUMN IF EXISTS reachability_checked_at;
+ALTER TABLE vulnerability_matches DROP COLUMN IF EXISTS reachability_call_sites;
+ALTER TABLE vulnerability_matches DROP COLUMN IF EXISTS reachability;
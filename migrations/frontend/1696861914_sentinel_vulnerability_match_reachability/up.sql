@@ -0,0 +1,9 @@
+ALTER TABLE vulnerability_matches ADD COLUMN IF NOT EXISTS reachability TEXT NOT NULL DEFAULT 'unknown';
+ALTER TABLE vulnerability_matches ADD COLUMN IF NOT EXISTS reachability_call_sites JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE vulnerability_matches ADD COLUMN IF NOT EXISTS reachability_checked_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS vulnerability_matches_reachability_unchecked ON vulnerability_matches (id) WHERE reachability_checked_at IS NULL;
+
+COMMENT ON COLUMN vulnerability_matches.reachability IS 'Whether a symbol affected by the vulnerability is referenced from the upload: reachable, unreachable, or unknown.';
+COMMENT ON COLUMN vulnerability_matches.reachability_call_sites IS 'Example locations in the upload that reference an affected symbol.';
+COMMENT ON COLUMN vulnerability_matches.reachability_checked_at IS 'When the reachability of the match was last determined, or NULL if it has not been checked.';
@@ -0,0 +1,4 @@
+ALTER TABLE changeset_specs ADD COLUMN IF NOT EXISTS reviewers TEXT[] NOT NULL DEFAULT '{}'::TEXT[];
+ALTER TABLE changeset_specs ADD COLUMN IF NOT EXISTS assignees TEXT[] NOT NULL DEFAULT '{}'::TEXT[];
+ALTER TABLE changeset_specs ADD COLUMN IF NOT EXISTS labels TEXT[] NOT NULL DEFAULT '{}'::TEXT[];
+ALTER TABLE changeset_specs ADD COLUMN IF NOT EXISTS milestone TEXT;
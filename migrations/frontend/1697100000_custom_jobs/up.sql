@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS custom_jobs
+(
+    id                SERIAL PRIMARY KEY,
+    state             text                     DEFAULT 'queued'::text,
+    template          text                                   NOT NULL,
+    spec              jsonb                                  NOT NULL,
+    repository_id     integer                                NOT NULL REFERENCES repo (id) ON DELETE CASCADE,
+    commit            text                                   NOT NULL,
+    user_id           integer                                REFERENCES users (id) ON DELETE SET NULL DEFERRABLE,
+    failure_message   text,
+    started_at        timestamp with time zone,
+    finished_at       timestamp with time zone,
+    process_after     timestamp with time zone,
+    num_resets        integer                  DEFAULT 0     NOT NULL,
+    num_failures      integer                  DEFAULT 0     NOT NULL,
+    last_heartbeat_at timestamp with time zone,
+    execution_logs    json[],
+    worker_hostname   text                                   NOT NULL DEFAULT '',
+    cancel            boolean                                NOT NULL DEFAULT false,
+    created_at        timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at        timestamp with time zone DEFAULT now() NOT NULL,
+    queued_at         timestamp with time zone DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS custom_jobs_state ON custom_jobs (state);
+CREATE INDEX IF NOT EXISTS custom_jobs_repository_id ON custom_jobs (repository_id);
+CREATE INDEX IF NOT EXISTS custom_jobs_user_id ON custom_jobs (user_id);
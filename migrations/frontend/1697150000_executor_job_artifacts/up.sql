@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS executor_job_artifacts
+(
+    id         SERIAL PRIMARY KEY,
+    queue      text                                   NOT NULL,
+    job_id     integer                                NOT NULL,
+    name       text                                   NOT NULL,
+    object_key text                                   NOT NULL,
+    size       bigint                                 NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS executor_job_artifacts_queue_job_id_name ON executor_job_artifacts (queue, job_id, name);
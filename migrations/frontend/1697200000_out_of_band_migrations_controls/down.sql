@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS out_of_band_migrations_progress;
+
+ALTER TABLE out_of_band_migrations DROP COLUMN IF EXISTS throttle_interval_seconds;
+ALTER TABLE out_of_band_migrations DROP COLUMN IF EXISTS paused;
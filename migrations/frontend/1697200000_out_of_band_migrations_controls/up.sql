@@ -0,0 +1,20 @@
+ALTER TABLE out_of_band_migrations ADD COLUMN IF NOT EXISTS paused boolean DEFAULT false NOT NULL;
+ALTER TABLE out_of_band_migrations ADD COLUMN IF NOT EXISTS throttle_interval_seconds integer;
+
+COMMENT ON COLUMN out_of_band_migrations.paused IS 'Whether an admin has paused this migration. Paused migrations are not run until resumed.';
+COMMENT ON COLUMN out_of_band_migrations.throttle_interval_seconds IS 'The minimum number of seconds between invocations of this migration, if throttled by an admin.';
+
+CREATE TABLE IF NOT EXISTS out_of_band_migrations_progress
+(
+    id           SERIAL PRIMARY KEY,
+    migration_id integer                                NOT NULL REFERENCES out_of_band_migrations (id) ON DELETE CASCADE,
+    progress     double precision                       NOT NULL,
+    created      timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS out_of_band_migrations_progress_migration_id_created ON out_of_band_migrations_progress (migration_id, created);
+
+COMMENT ON TABLE out_of_band_migrations_progress IS 'Stores recent progress samples of an out-of-band migration, used to estimate its time to completion.';
+COMMENT ON COLUMN out_of_band_migrations_progress.migration_id IS 'The identifier of the migration.';
+COMMENT ON COLUMN out_of_band_migrations_progress.progress IS 'The progress of the migration in the up direction at the time of the sample.';
+COMMENT ON COLUMN out_of_band_migrations_progress.created IS 'The date and time the sample was recorded.';
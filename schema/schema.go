@@ -33,6 +33,8 @@ type AWSCodeCommitConnection struct {
 	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
 	// SecretAccessKey description: The AWS secret access key (that corresponds to the AWS access key ID set in `accessKeyID`).
 	SecretAccessKey string `json:"secretAccessKey"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*AWSCodeCommitUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
 }
 
 // AWSCodeCommitGitCredentials description: The Git credentials used for authentication when cloning an AWS CodeCommit repository over HTTPS.
@@ -45,6 +47,20 @@ type AWSCodeCommitGitCredentials struct {
 	// Username description: The Git username
 	Username string `json:"username"`
 }
+type AWSCodeCommitUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
 
 // AWSKMSEncryptionKey description: AWS KMS Encryption Key, used to encrypt data in AWS environments
 type AWSKMSEncryptionKey struct {
@@ -72,12 +88,16 @@ type AppNotifications struct {
 
 // AuditLog description: EXPERIMENTAL: Configuration for audit logging (specially formatted log entries for tracking sensitive events)
 type AuditLog struct {
+	// DatabaseRetentionDays description: The number of days audit log records written to the database are kept for.
+	DatabaseRetentionDays int `json:"databaseRetentionDays,omitempty"`
 	// GitserverAccess description: Capture gitserver access logs as part of the audit log.
 	GitserverAccess bool `json:"gitserverAccess"`
 	// GraphQL description: Capture GraphQL requests and responses as part of the audit log.
 	GraphQL bool `json:"graphQL"`
 	// InternalTraffic description: Capture security events performed by the internal traffic (adds significant noise).
 	InternalTraffic bool `json:"internalTraffic"`
+	// Location description: Where to write audit log records [auditlog, database, all] where auditlog is the default logging to stdout. Records written to the database can be queried and exported by site admins.
+	Location string `json:"location,omitempty"`
 	// SeverityLevel description: DEPRECATED: No effect, audit logs are always set to SRC_LOG_LEVEL
 	SeverityLevel string `json:"severityLevel,omitempty"`
 }
@@ -199,6 +219,26 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"azureDevOps", "bitbucketcloud", "builtin", "gerrit", "github", "gitlab", "http-header", "openidconnect", "saml"})
 }
 
+// AutoMerge description: A policy to automatically merge the published changesets of this batch change once they satisfy it. Every decision taken by the policy is recorded as a changeset event.
+type AutoMerge struct {
+	// Checks description: The state the checks on a changeset must be in for it to be merged. "passed" requires all checks to have passed, and "any" ignores checks.
+	Checks string `json:"checks,omitempty"`
+	// Method description: How changesets are merged.
+	Method string `json:"method,omitempty"`
+	// Reviews description: The review state a changeset must be in for it to be merged. "approved" requires the changeset to have been approved, and "any" ignores reviews.
+	Reviews string `json:"reviews,omitempty"`
+	// Windows description: The windows during which changesets may be merged, in UTC. If omitted, changesets are merged at any time.
+	Windows []*AutoMergeWindow `json:"windows,omitempty"`
+}
+type AutoMergeWindow struct {
+	// Days description: Day(s) the window applies to. If omitted, this window applies to all days of the week.
+	Days []string `json:"days,omitempty"`
+	// End description: Window end time, in the form HH:MM. If omitted, the window applies to the whole day.
+	End string `json:"end,omitempty"`
+	// Start description: Window start time, in the form HH:MM. If omitted, the window applies to the whole day.
+	Start string `json:"start,omitempty"`
+}
+
 // AzureDevOpsAuthProvider description: Azure auth provider for dev.azure.com
 type AzureDevOpsAuthProvider struct {
 	// AllowOrgs description: Restricts new logins and signups (if allowSignup is true) to members of these Azure DevOps organizations only. Existing sessions won't be invalidated. Leave empty or unset for no org restrictions.
@@ -230,11 +270,27 @@ type AzureDevOpsConnection struct {
 	Projects []string `json:"projects,omitempty"`
 	// Token description: The Personal Access Token associated with the Azure DevOps username used for authentication.
 	Token string `json:"token"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*AzureDevOpsUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
 	// Url description: URL for Azure DevOps Services, set to https://dev.azure.com.
 	Url string `json:"url"`
 	// Username description: A username for authentication with the Azure DevOps code host.
 	Username string `json:"username"`
 }
+type AzureDevOpsUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
 type BatchChangeRolloutWindow struct {
 	// Days description: Day(s) the window applies to. If omitted, this rule applies to all days of the week.
 	Days []string `json:"days,omitempty"`
@@ -248,6 +304,8 @@ type BatchChangeRolloutWindow struct {
 
 // BatchSpec description: A batch specification, which describes the batch change and what kinds of changes to make (or what existing changesets to track).
 type BatchSpec struct {
+	// AutoMerge description: A policy to automatically merge the published changesets of this batch change once they satisfy it. Every decision taken by the policy is recorded as a changeset event.
+	AutoMerge *AutoMerge `json:"autoMerge,omitempty"`
 	// ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 	ChangesetTemplate *ChangesetTemplate `json:"changesetTemplate,omitempty"`
 	// Description description: The description of the batch change.
@@ -329,6 +387,8 @@ type BitbucketCloudConnection struct {
 	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
 	// Teams description: An array of team names identifying Bitbucket Cloud teams whose repositories should be mirrored on Sourcegraph.
 	Teams []string `json:"teams,omitempty"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*BitbucketCloudUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
 	// Url description: URL of Bitbucket Cloud, such as https://bitbucket.org. Generally, admin should not modify the value of this option because Bitbucket Cloud is a public hosting platform.
 	Url string `json:"url"`
 	// Username description: The username to use when authenticating to the Bitbucket Cloud. Also set the corresponding "appPassword" field.
@@ -344,6 +404,20 @@ type BitbucketCloudRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 500, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 500 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+type BitbucketCloudUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
 
 // BitbucketServerAuthorization description: If non-null, enforces Bitbucket Server / Bitbucket Data Center repository permissions.
 type BitbucketServerAuthorization struct {
@@ -403,6 +477,8 @@ type BitbucketServerConnection struct {
 	//
 	// For Bitbucket Server / Bitbucket Data Center instances that don't support personal access tokens (Bitbucket Server / Bitbucket Data Center version 5.4 and older), specify user-password credentials in the "username" and "password" fields.
 	Token string `json:"token,omitempty"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*BitbucketServerUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
 	// Url description: URL of a Bitbucket Server / Bitbucket Data Center instance, such as https://bitbucket.example.com.
 	Url string `json:"url"`
 	// Username description: The username to use when authenticating to the Bitbucket Server / Bitbucket Data Center instance. Also set the corresponding "token" or "password" field.
@@ -464,10 +540,26 @@ type BitbucketServerRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 500, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 500 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+type BitbucketServerUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
 type BitbucketServerUsernameIdentity struct {
 	Type string `json:"type"`
 }
 type BranchChangesetSpec struct {
+	// Assignees description: The users to assign the changeset to on the code host.
+	Assignees []string `json:"assignees,omitempty"`
 	// BaseRef description: The full name of the Git ref in the base repository that this changeset is based on (and is proposing to be merged into). This ref must exist on the base repository.
 	BaseRef string `json:"baseRef"`
 	// BaseRepository description: The GraphQL ID of the repository that this changeset spec is proposing to change.
@@ -478,12 +570,20 @@ type BranchChangesetSpec struct {
 	Body string `json:"body"`
 	// Commits description: The Git commits with the proposed changes. These commits are pushed to the head ref.
 	Commits []*GitCommitDescription `json:"commits"`
+	// DependsOn description: The full name of the head ref of another changeset in the same repository and batch change that this changeset is stacked on. The changeset targets that ref until the other changeset is merged or closed, and is then retargeted to the base ref.
+	DependsOn string `json:"dependsOn,omitempty"`
 	// HeadRef description: The full name of the Git ref that holds the changes proposed by this changeset. This ref will be created or updated with the commits.
 	HeadRef string `json:"headRef"`
 	// HeadRepository description: The GraphQL ID of the repository that contains the branch with this changeset's changes. Fork repositories and cross-repository changesets are not yet supported. Therefore, headRepository must be equal to baseRepository.
 	HeadRepository string `json:"headRepository"`
+	// Labels description: The labels to add to the changeset on the code host.
+	Labels []string `json:"labels,omitempty"`
+	// Milestone description: The title of the milestone to add the changeset to on the code host.
+	Milestone string `json:"milestone,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host.
 	Published any `json:"published,omitempty"`
+	// Reviewers description: The users (or teams) to request reviews from on the code host.
+	Reviewers []string `json:"reviewers,omitempty"`
 	// Title description: The title of the changeset on the code host.
 	Title string `json:"title"`
 	// Version description: A field for versioning the payload.
@@ -521,6 +621,8 @@ type BuiltinAuthProvider struct {
 
 // ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 type ChangesetTemplate struct {
+	// Assignees description: The users to assign the changeset to. Each entry is templated, and may expand to several comma-separated names. Not every code host supports assignees.
+	Assignees []string `json:"assignees,omitempty"`
 	// Body description: The body (description) of the changeset.
 	Body string `json:"body,omitempty"`
 	// Branch description: The name of the Git branch to create or update on each repository with the changes.
@@ -529,8 +631,14 @@ type ChangesetTemplate struct {
 	Commit ExpandedGitCommitDescription `json:"commit"`
 	// Fork description: Whether to publish the changeset to a fork of the target repository. If omitted, the changeset will be published to a branch directly on the target repository, unless the global `batches.enforceFork` setting is enabled. If set, this property will override any global setting.
 	Fork bool `json:"fork,omitempty"`
+	// Labels description: The labels to add to the changeset. Each entry is templated, and may expand to several comma-separated labels. Not every code host supports labels.
+	Labels []string `json:"labels,omitempty"`
+	// Milestone description: The title of the milestone to add the changeset to. The value is templated. Not every code host supports milestones.
+	Milestone string `json:"milestone,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host. If omitted, the publication state is controlled from the Batch Changes UI.
 	Published any `json:"published,omitempty"`
+	// Reviewers description: The users (or teams, on code hosts that support them) to request reviews from. Each entry is templated, and may expand to several comma-separated names, such as the owners of the changed files. Not every code host supports reviewers.
+	Reviewers []string `json:"reviewers,omitempty"`
 	// Title description: The title of the changeset.
 	Title string `json:"title"`
 }
@@ -590,12 +698,76 @@ type Completions struct {
 	FastChatModelMaxTokens int `json:"fastChatModelMaxTokens,omitempty"`
 	// Model description: DEPRECATED. Use chatModel instead.
 	Model string `json:"model,omitempty"`
+	// ModelPrices description: The price of the models used for completions, used to compute the cost of requests for cost budgets. Requests to models without a configured price cost nothing.
+	ModelPrices []*CompletionsModelPrice `json:"modelPrices,omitempty"`
+	// OpenAICompatibleModels description: The catalogue of models served by the 'openai-compatible' provider, such as models hosted with vLLM or llama.cpp. If empty, any model is accepted and requests use the OpenAI chat API as-is.
+	OpenAICompatibleModels []*OpenAICompatibleModel `json:"openAICompatibleModels,omitempty"`
 	// PerUserCodeCompletionsDailyLimit description: If > 0, enables the maximum number of code completions requests allowed to be made by a single user account in a day. On instances that allow anonymous requests, the rate limit is enforced by IP.
 	PerUserCodeCompletionsDailyLimit int `json:"perUserCodeCompletionsDailyLimit,omitempty"`
 	// PerUserDailyLimit description: If > 0, enables the maximum number of completions requests allowed to be made by a single user account in a day. On instances that allow anonymous requests, the rate limit is enforced by IP.
 	PerUserDailyLimit int `json:"perUserDailyLimit,omitempty"`
 	// Provider description: The external completions provider. Defaults to 'sourcegraph'.
 	Provider string `json:"provider,omitempty"`
+	// Routing description: Configures additional completions providers to fail over to, or to spread traffic across, when the primary provider is rate limited or unavailable.
+	Routing *CompletionsRouting `json:"routing,omitempty"`
+	// UsageBudgets description: Token and cost budgets for completions requests made through this instance, based on the usage reported by the provider. Once a budget is used up, requests are rejected until the end of the budget's period. Usage is estimated from the prompt and completion length if the provider does not report it.
+	UsageBudgets []*CompletionsUsageBudget `json:"usageBudgets,omitempty"`
+	// UsageReports description: Whether to count the usage of completions requests for the completionsUsage GraphQL query even if no usageBudgets are configured. Usage is always counted while budgets are configured.
+	UsageReports bool `json:"usageReports,omitempty"`
+}
+
+// CompletionsBackend description: An additional completions provider to route requests to. Models that are not set default to the same values as for the primary provider of the same type.
+type CompletionsBackend struct {
+	// AccessToken description: The access token used to authenticate with the provider.
+	AccessToken string `json:"accessToken,omitempty"`
+	// ChatModel description: The model used in place of the primary chat model.
+	ChatModel string `json:"chatModel,omitempty"`
+	// CompletionModel description: The model used in place of the primary code completion model.
+	CompletionModel string `json:"completionModel,omitempty"`
+	// Endpoint description: The endpoint under which to reach the provider.
+	Endpoint string `json:"endpoint,omitempty"`
+	// FastChatModel description: The model used in place of the primary fast chat model.
+	FastChatModel string `json:"fastChatModel,omitempty"`
+	// Provider description: The external completions provider.
+	Provider string `json:"provider"`
+	// Weight description: The weight of this provider when using the 'weighted' strategy.
+	Weight int `json:"weight,omitempty"`
+}
+
+// CompletionsModelPrice description: The price of a completions model.
+type CompletionsModelPrice struct {
+	// InputPer1KTokensUSD description: The price in US dollars of 1000 prompt tokens.
+	InputPer1KTokensUSD float64 `json:"inputPer1KTokensUSD,omitempty"`
+	// Model description: The name of the model, as used in chatModel, fastChatModel or completionModel.
+	Model string `json:"model"`
+	// OutputPer1KTokensUSD description: The price in US dollars of 1000 completion tokens.
+	OutputPer1KTokensUSD float64 `json:"outputPer1KTokensUSD,omitempty"`
+}
+
+// CompletionsRouting description: Configures additional completions providers to fail over to, or to spread traffic across, when the primary provider is rate limited or unavailable.
+type CompletionsRouting struct {
+	// CooldownSeconds description: The number of seconds an unhealthy provider is skipped before it is tried again.
+	CooldownSeconds int `json:"cooldownSeconds,omitempty"`
+	// FailureThreshold description: The number of consecutive retryable failures after which a provider is considered unhealthy and skipped until the cooldown has passed.
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+	// Fallbacks description: The providers to route requests to in addition to the primary provider.
+	Fallbacks []*CompletionsBackend `json:"fallbacks,omitempty"`
+	// Strategy description: How requests are routed across the primary provider and the configured fallbacks. 'ordered' always tries the primary provider first and then each fallback in order. 'weighted' picks the first provider to try at random, proportional to its weight, and fails over to the remaining providers by weight.
+	Strategy string `json:"strategy,omitempty"`
+	// Weight description: The weight of the primary provider when using the 'weighted' strategy.
+	Weight int `json:"weight,omitempty"`
+}
+
+// CompletionsUsageBudget description: A token or cost budget for completions requests.
+type CompletionsUsageBudget struct {
+	// MaxCostUSD description: If > 0, the maximum cost in US dollars of the requests made in a period, as computed from modelPrices.
+	MaxCostUSD float64 `json:"maxCostUSD,omitempty"`
+	// MaxTokens description: If > 0, the maximum number of prompt and completion tokens that can be used in a period.
+	MaxTokens int `json:"maxTokens,omitempty"`
+	// Period description: The period after which the budget resets. Periods are calendar days and months in UTC.
+	Period string `json:"period"`
+	// Scope description: Who the budget applies to. 'user' applies the budget to every user individually (by IP address for anonymous requests), 'org' to every organization individually, counting the usage of all its members, and 'site' to all requests made through this instance.
+	Scope string `json:"scope"`
 }
 
 // CustomGitFetchMapping description: Mapping from Git clone URl domain/path to git fetch command. The `domainPath` field contains the Git clone URL domain/path part. The `fetch` field contains the custom git fetch command.
@@ -605,6 +777,52 @@ type CustomGitFetchMapping struct {
 	// Fetch description: Git fetch command
 	Fetch string `json:"fetch"`
 }
+type CustomJobCacheVolume struct {
+	// KeyFiles description: Paths of files, relative to the repository root, whose contents key the volume, e.g. lockfiles. When one of them changes, a fresh volume is used.
+	KeyFiles []string `json:"keyFiles,omitempty"`
+	// MountPath description: The absolute path in the step containers to mount the volume at.
+	MountPath string `json:"mountPath"`
+	// Name description: The name of the cache, e.g. the package manager it holds dependencies for.
+	Name string `json:"name"`
+}
+type CustomJobStep struct {
+	// Artifacts description: Paths of files, relative to the directory of the step, that are uploaded as artifacts of the job once all steps ran. Paths may contain glob patterns.
+	Artifacts []string `json:"artifacts,omitempty"`
+	// Dir description: The directory to run the step in, relative to the repository root.
+	Dir string `json:"dir,omitempty"`
+	// Env description: Additional environment variables for the step, in the form KEY=VALUE.
+	Env []string `json:"env,omitempty"`
+	// Image description: The container image to run the step in, if different from the template's image.
+	Image string `json:"image,omitempty"`
+	// Run description: The shell command to run.
+	Run string `json:"run"`
+}
+
+// CustomJobTemplate description: A template for custom jobs run on executors.
+type CustomJobTemplate struct {
+	// CacheVolumes description: Cache volumes to mount into all steps, e.g. to share package manager caches between jobs of the same repository. Only used by executors that have cache volumes enabled.
+	CacheVolumes []*CustomJobCacheVolume `json:"cacheVolumes,omitempty"`
+	// Description description: A description of what jobs of this template do.
+	Description string `json:"description,omitempty"`
+	// Env description: Environment variables for all steps, in the form KEY=VALUE.
+	Env []string `json:"env,omitempty"`
+	// Image description: The default container image the steps of the job run in.
+	Image string `json:"image"`
+	// Name description: The unique name of the template, used to trigger jobs.
+	Name string `json:"name"`
+	// Secrets description: The names of global executor secrets with the customjobs scope to expose as environment variables to all steps. Secret values are redacted from the job logs.
+	Secrets []string `json:"secrets,omitempty"`
+	// Steps description: The steps of the job, run in order in the repository checkout.
+	Steps []*CustomJobStep `json:"steps"`
+}
+
+// Customjobs description: The configuration for the customjobs queue.
+type Customjobs struct {
+	// Limit description: The maximum number of dequeues allowed within the expiration window.
+	Limit int `json:"limit"`
+	// Weight description: The relative weight of this queue. Higher weights mean a higher chance of being picked at random.
+	Weight int `json:"weight"`
+}
 
 // DebugLog description: Turns on debug logging for specific debugging scenarios.
 type DebugLog struct {
@@ -618,6 +836,8 @@ type DequeueCacheConfig struct {
 	Batches *Batches `json:"batches,omitempty"`
 	// Codeintel description: The configuration for the codeintel queue.
 	Codeintel *Codeintel `json:"codeintel,omitempty"`
+	// Customjobs description: The configuration for the customjobs queue.
+	Customjobs *Customjobs `json:"customjobs,omitempty"`
 }
 
 // Dotcom description: Configuration options for Sourcegraph.com only.
@@ -796,6 +1016,14 @@ type ExcludedGitLabProject struct {
 	// Pattern description: Regular expression which matches against the name of a GitLab project ("group/name").
 	Pattern string `json:"pattern,omitempty"`
 }
+type ExcludedGitManifestRepo struct {
+	// Archived description: If set to true, archived repositories will be excluded.
+	Archived bool `json:"archived,omitempty"`
+	// Name description: The name of a repository in the manifest ("team/myrepo") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the name of a repository in the manifest to exclude from mirroring.
+	Pattern string `json:"pattern,omitempty"`
+}
 type ExcludedGitoliteRepo struct {
 	// Name description: The name of a Gitolite repo ("my-repo") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
@@ -1049,11 +1277,27 @@ type GerritConnection struct {
 	Password string `json:"password"`
 	// Projects description: An array of project strings specifying which Gerrit projects to mirror on Sourcegraph. If empty, all projects will be mirrored.
 	Projects []string `json:"projects,omitempty"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*GerritUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
 	// Url description: URL of a Gerrit instance, such as https://gerrit.example.com.
 	Url string `json:"url"`
 	// Username description: A username for authentication withe the Gerrit code host.
 	Username string `json:"username"`
 }
+type GerritUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
 
 // GitCommitAuthor description: The author of the Git commit.
 type GitCommitAuthor struct {
@@ -1197,6 +1441,8 @@ type GitHubConnection struct {
 	RepositoryQuery []string `json:"repositoryQuery,omitempty"`
 	// Token description: A GitHub personal access token. Create one for GitHub.com at https://github.com/settings/tokens/new?description=Sourcegraph (for GitHub Enterprise, replace github.com with your instance's hostname). See https://docs.sourcegraph.com/admin/external_service/github#github-api-token-and-access for which scopes are required for which use cases.
 	Token string `json:"token,omitempty"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*GitHubUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
 	// Url description: URL of a GitHub instance, such as https://github.com or https://github-enterprise.example.com.
 	Url string `json:"url"`
 	// Webhooks description: An array of configurations defining existing GitHub webhooks that send updates back to Sourcegraph.
@@ -1210,6 +1456,20 @@ type GitHubRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+type GitHubUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
 type GitHubWebhook struct {
 	// Org description: The name of the GitHub organization to which the webhook belongs
 	Org string `json:"org"`
@@ -1292,6 +1552,8 @@ type GitLabConnection struct {
 	TokenOauthRefresh string `json:"token.oauth.refresh,omitempty"`
 	// TokenType description: The type of the token
 	TokenType string `json:"token.type,omitempty"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*GitLabUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
 	// Url description: URL of a GitLab instance, such as https://gitlab.example.com or (for GitLab.com) https://gitlab.com.
 	Url string `json:"url"`
 	// Webhooks description: An array of webhook configurations
@@ -1317,11 +1579,57 @@ type GitLabRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+type GitLabUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
 type GitLabWebhook struct {
 	// Secret description: The secret used to authenticate incoming webhook requests
 	Secret string `json:"secret"`
 }
 
+// GitManifestConnection description: Configuration for a connection to a Git hosting service that publishes its repositories in a JSON manifest.
+type GitManifestConnection struct {
+	// Exclude description: A list of repositories to never mirror. Supports excluding by the name of the repository in the manifest ({"name": "team/myrepo"}), by regular expression matched against that name ({"pattern": ".*secret.*"}), or all archived repositories ({"archived": true}).
+	Exclude []*ExcludedGitManifestRepo `json:"exclude,omitempty"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for a repository in the manifest. In the pattern, the variable "{host}" is replaced with the host of the manifest URL, and "{name}" is replaced with the repository name from the manifest.
+	//
+	// For example, if your manifest is at https://git.example.com/manifest.json and your Sourcegraph URL is https://src.example.com, then a repositoryPathPattern of "{host}/{name}" would mean that the manifest repository "team/myrepo" is available on Sourcegraph at https://src.example.com/git.example.com/team/myrepo.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Token description: A token sent as a bearer token in the Authorization header when fetching the manifest. Leave empty if the manifest is publicly accessible.
+	Token string `json:"token,omitempty"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*GitManifestUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
+	// Url description: URL of the JSON manifest listing the repositories to sync. The manifest is fetched on every sync of this code host connection. It must be an object with a "repositories" array, whose elements have the fields "name", "cloneURL", "description", "visibility" ("public", "internal" or "private"), "archived" and "topics". Only "name" and "cloneURL" are required.
+	Url string `json:"url"`
+}
+type GitManifestUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
+
 // GitRecorder description: Record git operations that are executed on configured repositories.
 type GitRecorder struct {
 	// IgnoredGitCommands description: List of git commands that should be ignored and not recorded.
@@ -1358,6 +1666,22 @@ type GitoliteConnection struct {
 	//
 	// It is important that the Sourcegraph repository name generated with this prefix be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
 	Prefix string `json:"prefix"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*GitoliteUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
+}
+type GitoliteUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
 }
 
 // GoModulesConnection description: Configuration for a connection to Go module proxies
@@ -1733,6 +2057,20 @@ type OnboardingTourConfiguration struct {
 	Tasks           []*OnboardingTask `json:"tasks"`
 }
 
+// OpenAICompatibleModel description: A model served by an OpenAI-compatible server.
+type OpenAICompatibleModel struct {
+	// ChatTemplate description: How chat messages are sent to the model. 'openai' uses the chat completions API. All other templates render the messages into a single prompt sent to the completions API.
+	ChatTemplate string `json:"chatTemplate,omitempty"`
+	// ContextWindow description: The maximum number of tokens of the prompt and the completion combined. If set, prompts that do not fit are rejected and the number of tokens to sample is reduced to fit.
+	ContextWindow int `json:"contextWindow,omitempty"`
+	// Name description: The name of the model, as sent to the server.
+	Name string `json:"name"`
+	// StopSequences description: Additional stop sequences sent with every request to this model.
+	StopSequences []string `json:"stopSequences,omitempty"`
+	// Tokenizer description: How tokens are counted to enforce the context window. 'approximate' assumes 4 characters per token. 'characters' counts every character as a token.
+	Tokenizer string `json:"tokenizer,omitempty"`
+}
+
 // OpenIDConnectAuthProvider description: Configures the OpenID Connect authentication provider for SSO.
 type OpenIDConnectAuthProvider struct {
 	// AllowSignup description: Allows new visitors to sign up for accounts via OpenID Connect authentication. If false, users signing in via OpenID Connect must have an existing Sourcegraph account, which will be linked to their OpenID Connect identity after sign-in.
@@ -1802,7 +2140,23 @@ type OtherExternalServiceConnection struct {
 	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
 	// Root description: The root directory to walk for discovering local git repositories to mirror. To sync with local repositories and use this root property one must run Cody App and define the repos configuration property such as ["src-serve-local"].
 	Root string `json:"root,omitempty"`
-	Url  string `json:"url,omitempty"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*OtherUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
+	Url                      string                         `json:"url,omitempty"`
+}
+type OtherUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
 }
 type OutputVariable struct {
 	// Format description: The expected format of the output. If set, the output is being parsed in that format before being stored in the var. If not set, 'text' is assumed to the format.
@@ -1825,6 +2179,8 @@ type PagureConnection struct {
 	Tags []string `json:"tags,omitempty"`
 	// Token description: API token for the Pagure instance.
 	Token string `json:"token,omitempty"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*PagureUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
 	// Url description: URL of a Pagure instance, such as https://pagure.example.com
 	Url string `json:"url,omitempty"`
 }
@@ -1836,6 +2192,20 @@ type PagureRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 500, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 500 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+type PagureUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
 
 // ParentSourcegraph description: URL to fetch unreachable repository details from. Defaults to "https://sourcegraph.com"
 type ParentSourcegraph struct {
@@ -1890,6 +2260,8 @@ type PerforceConnection struct {
 	//
 	// It is important that the Sourcegraph repository name generated with this pattern be unique to this Perforce Server. If different Perforce Servers generate repository names that collide, Sourcegraph's behavior is undefined.
 	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// UpdateSchedulingPolicies description: Policies that control how often and with which priority repositories synced from this code host are updated. For each repository, the first policy whose pattern matches the repository name is used. Repositories that match no policy use the default update schedule.
+	UpdateSchedulingPolicies []*PerforceUpdateSchedulingPolicy `json:"updateSchedulingPolicies,omitempty"`
 }
 
 // PerforceRateLimit description: Rate limit applied when making background API requests to Perforce.
@@ -1899,6 +2271,20 @@ type PerforceRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+type PerforceUpdateSchedulingPolicy struct {
+	// MaxInterval description: The maximum number of minutes between two updates of a repository.
+	MaxInterval int `json:"maxInterval,omitempty"`
+	// MinInterval description: The minimum number of minutes between two updates of a repository.
+	MinInterval int `json:"minInterval,omitempty"`
+	// Pattern description: A regular expression matching the names of the repositories this policy applies to. If empty, the policy applies to all repositories of this code host.
+	Pattern string `json:"pattern,omitempty"`
+	// Priority description: The priority with which scheduled updates of matching repositories are queued, relative to repositories without a policy. Updates requested by users always take precedence.
+	Priority string `json:"priority,omitempty"`
+	// QuietHours description: Time windows, in the form HH:MM-HH:MM, during which matching repositories are not updated on schedule. Updates that become due during a window are postponed until its end. A window whose end is before its start spans midnight.
+	QuietHours []string `json:"quietHours,omitempty"`
+	// Timezone description: The IANA time zone that quietHours are expressed in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
 
 // PermissionsUserMapping description: Settings for Sourcegraph explicit permissions, which allow the site admin to explicitly manage repository permissions via the GraphQL API. This will mark repositories as restricted by default.
 type PermissionsUserMapping struct {
@@ -2259,6 +2645,8 @@ type Settings struct {
 	SearchIncludeArchived *bool `json:"search.includeArchived,omitempty"`
 	// SearchIncludeForks description: Whether searches should include searching forked repositories.
 	SearchIncludeForks *bool `json:"search.includeForks,omitempty"`
+	// SearchMacros description: Named query fragments that can be used in search queries as `@name`, or as `@name(arg1, arg2)` for macros with parameters. A key has the form `name` or `name(param1, param2)`, and its value is the query fragment it expands to, in which `$param1` refers to the value of a parameter. Macros may use other macros. Macros defined in user settings take precedence over those in organization settings, which take precedence over those in global settings.
+	SearchMacros map[string]string `json:"search.macros,omitempty"`
 	// SearchSavedQueries description: DEPRECATED: Saved search queries
 	SearchSavedQueries []*SearchSavedQueries `json:"search.savedQueries,omitempty"`
 	// SearchScopes description: Predefined search snippets that can be appended to any search (also known as search scopes)
@@ -2327,6 +2715,7 @@ func (v *Settings) UnmarshalJSON(data []byte) error {
 	delete(m, "search.hideSuggestions")
 	delete(m, "search.includeArchived")
 	delete(m, "search.includeForks")
+	delete(m, "search.macros")
 	delete(m, "search.savedQueries")
 	delete(m, "search.scopes")
 	if len(m) > 0 {
@@ -2614,6 +3003,8 @@ type SiteConfiguration struct {
 	ExecutorsBatcheshelperImage string `json:"executors.batcheshelperImage,omitempty"`
 	// ExecutorsBatcheshelperImageTag description: The tag to use for the batcheshelper image in executors. Use this value to use a custom tag. Sourcegraph by default uses the best match, so use this setting only if you really need to overwrite it and make sure to keep it updated.
 	ExecutorsBatcheshelperImageTag string `json:"executors.batcheshelperImageTag,omitempty"`
+	// ExecutorsCustomJobTemplates description: Templates for custom jobs that users can run on executors against a repository at a given commit, such as custom linters or SBOM generation. Custom jobs run in the customjobs executor queue.
+	ExecutorsCustomJobTemplates []*CustomJobTemplate `json:"executors.customJobTemplates,omitempty"`
 	// ExecutorsFrontendURL description: The URL where Sourcegraph executors can reach the Sourcegraph instance. If not set, defaults to externalURL. URLs with a path (other than `/`) are not allowed. For Docker executors, the special hostname `host.docker.internal` can be used to refer to the Docker container's host.
 	ExecutorsFrontendURL string `json:"executors.frontendURL,omitempty"`
 	// ExecutorsLsifGoImage description: The tag to use for the lsif-go image in executors. Use this value to use a custom tag. Sourcegraph by default uses the best match, so use this setting only if you really need to overwrite it and make sure to keep it updated.
@@ -2849,6 +3240,7 @@ func (v *SiteConfiguration) UnmarshalJSON(data []byte) error {
 	delete(m, "executors.accessToken")
 	delete(m, "executors.batcheshelperImage")
 	delete(m, "executors.batcheshelperImageTag")
+	delete(m, "executors.customJobTemplates")
 	delete(m, "executors.frontendURL")
 	delete(m, "executors.lsifGoImage")
 	delete(m, "executors.multiqueue")
@@ -2943,6 +3335,8 @@ type SrcCliVersionCache struct {
 
 // Step description: A command to run (as part of a sequence) in a repository branch to produce the required changes.
 type Step struct {
+	// CacheKey description: An arbitrary value that is included in the cache key of the step. Changing it invalidates the cached results of this step and all following steps.
+	CacheKey string `json:"cacheKey,omitempty"`
 	// Container description: The Docker image used to launch the Docker container in which the shell command is run.
 	Container string `json:"container"`
 	// Env description: Environment variables to set in the step environment.
@@ -2955,10 +3349,18 @@ type Step struct {
 	Mount []*Mount `json:"mount,omitempty"`
 	// Outputs description: Output variables of this step that can be referenced in the changesetTemplate or other steps via outputs.<name-of-output>
 	Outputs map[string]OutputVariable `json:"outputs,omitempty"`
+	// Retries description: The number of times the step is retried if it fails or times out. Retries are spaced out by `retryBackoff`, which doubles with every attempt.
+	Retries int `json:"retries,omitempty"`
+	// RetryBackoff description: The duration to wait before the first retry of the step, as a duration string. Defaults to 5s.
+	RetryBackoff string `json:"retryBackoff,omitempty"`
 	// Run description: The shell command to run in the container. It can also be a multi-line shell script. The working directory is the root directory of the repository checkout.
 	Run string `json:"run"`
+	// Timeout description: The maximum duration the step is allowed to run for, as a duration string. A step that runs for longer is stopped and fails.
+	Timeout string `json:"timeout,omitempty"`
 }
 type SubRepoPermissions struct {
+	// AclFile description: The path of a path ACL file, in CODEOWNERS syntax, that is read from the default branch of private repositories to derive their sub-repo permissions. ACL files uploaded by site admins take precedence. If unset, only uploaded ACL files are used.
+	AclFile string `json:"aclFile,omitempty"`
 	// Enabled description: Enables sub-repo permission checking
 	Enabled bool `json:"enabled,omitempty"`
 	// UserCacheSize description: The number of user permissions to cache
@@ -3016,6 +3418,8 @@ type TransformChanges struct {
 type TransformChangesGroup struct {
 	// Branch description: The branch on the repository to propose changes to. If unset, the repository's default branch is used.
 	Branch string `json:"branch"`
+	// DependsOn description: The branch of another changeset in the same repository that this changeset is stacked on: either the branch of another group or the branch of the changeset template. The changeset targets that branch until the other changeset is merged or closed, and is then retargeted to the base branch.
+	DependsOn string `json:"dependsOn,omitempty"`
 	// Directory description: The directory path (relative to the repository root) of the changes to include in this group.
 	Directory string `json:"directory"`
 	// Repository description: Only apply this transformation in the repository with this name (as it is known to Sourcegraph).
@@ -37,6 +37,11 @@ var ChangesetSpecSchemaJSON string
 //go:embed gerrit.schema.json
 var GerritSchemaJSON string
 
+// GitManifestSchemaJSON is the content of the file "git_manifest.schema.json".
+//
+//go:embed git_manifest.schema.json
+var GitManifestSchemaJSON string
+
 // GitHubSchemaJSON is the content of the file "github.schema.json".
 //
 //go:embed github.schema.json